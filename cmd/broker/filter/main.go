@@ -29,6 +29,7 @@ import (
 	"go.uber.org/zap"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
+	filteredsecretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered"
 	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 	configmap "knative.dev/pkg/configmap/informer"
 	"knative.dev/pkg/controller"
@@ -41,6 +42,7 @@ import (
 	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/eventing/pkg/broker/filter"
+	"knative.dev/eventing/pkg/claimcheck"
 	eventingclient "knative.dev/eventing/pkg/client/injection/client"
 	brokerinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker"
 	triggerinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/trigger"
@@ -48,6 +50,7 @@ import (
 	subscriptioninformer "knative.dev/eventing/pkg/client/injection/informers/messaging/v1/subscription"
 	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/eventing/pkg/eventtype"
+	"knative.dev/eventing/pkg/kncloudevents"
 	o11yconfigmap "knative.dev/eventing/pkg/observability/configmap"
 	"knative.dev/eventing/pkg/utils"
 )
@@ -64,6 +67,8 @@ type envConfig struct {
 	ContainerName string `envconfig:"CONTAINER_NAME" required:"true"`
 	HTTPPort      int    `envconfig:"FILTER_PORT" default:"8080"`
 	HTTPSPort     int    `envconfig:"FILTER_PORT_HTTPS" default:"8443"`
+
+	claimcheck.EnvConfig
 }
 
 func main() {
@@ -84,6 +89,8 @@ func main() {
 	ctx = filteredFactory.WithSelectors(ctx,
 		auth.OIDCLabelSelector,
 		eventingtls.TrustBundleLabelSelector,
+		eventingtls.ClientCABundleLabelSelector,
+		eventingtls.ClientCertificateLabelSelector,
 	)
 
 	ctx, informers := injection.Default.SetupInformers(ctx, cfg)
@@ -124,8 +131,11 @@ func main() {
 	// TODO change the component name to broker once Stackdriver metrics are approved.
 	// Watch the observability config map and dynamically update request logs.
 	configMapWatcher.Watch(logging.ConfigMapName(), logging.UpdateLevelFromConfigMap(sl, atomicLevel, component))
+	// Watch the TLS policies applied to destinations.
+	kncloudevents.WatchTLSPolicies(sl, configMapWatcher)
 
 	trustBundleConfigMapLister := configmapinformer.Get(ctx, eventingtls.TrustBundleLabelSelector).Lister().ConfigMaps(system.Namespace())
+	clientCertificates := eventingtls.NewClientCertificates(filteredsecretinformer.Get(ctx, eventingtls.ClientCertificateLabelSelector).Lister())
 
 	var featureStore *feature.Store
 	var handler *filter.Handler
@@ -161,6 +171,7 @@ func main() {
 		brokerinformer.Get(ctx),
 		subscriptioninformer.Get(ctx),
 		trustBundleConfigMapLister,
+		clientCertificates,
 		ctxFunc,
 		mp,
 		tp,
@@ -168,6 +179,10 @@ func main() {
 	if err != nil {
 		logger.Fatal("Error creating Handler", zap.Error(err))
 	}
+	handler.ClaimCheckStore, err = claimcheck.NewStore(env.EnvConfig)
+	if err != nil {
+		logger.Fatal("Error creating claim check store", zap.Error(err))
+	}
 	serverManager, err := filter.NewServerManager(
 		ctx,
 		logger,
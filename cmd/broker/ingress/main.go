@@ -27,12 +27,14 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	"go.uber.org/zap"
 	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
+	filteredsecretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered"
 
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 	configmap "knative.dev/pkg/configmap/informer"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
 	"knative.dev/pkg/logging"
 	k8sruntime "knative.dev/pkg/observability/runtime/k8s"
 	"knative.dev/pkg/signals"
@@ -42,12 +44,14 @@ import (
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/eventing/pkg/broker"
 	"knative.dev/eventing/pkg/broker/ingress"
+	"knative.dev/eventing/pkg/claimcheck"
 	eventingclient "knative.dev/eventing/pkg/client/injection/client"
 	brokerinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker"
 	eventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	eventtypeinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1beta3/eventtype"
 	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/eventing/pkg/eventtype"
+	"knative.dev/eventing/pkg/kncloudevents"
 	o11yconfigmap "knative.dev/eventing/pkg/observability/configmap"
 	"knative.dev/eventing/pkg/observability/otel"
 	"knative.dev/eventing/pkg/utils"
@@ -75,6 +79,19 @@ type envConfig struct {
 	MaxTTL        int32  `envconfig:"MAX_TTL" default:"255"`
 	HTTPPort      int    `envconfig:"INGRESS_PORT" default:"8080"`
 	HTTPSPort     int    `envconfig:"INGRESS_PORT_HTTPS" default:"8443"`
+	// DelayStoreCapacity is the maximum number of events parked until their delivery time
+	// for the Brokers of a namespace.
+	DelayStoreCapacity int `envconfig:"DELAY_STORE_CAPACITY" default:"1000"`
+	// DelayStoreMaxAge is the time after their delivery time after which the parked events
+	// which failed to be released are sent to the dead letter sink of their Broker.
+	DelayStoreMaxAge time.Duration `envconfig:"DELAY_STORE_MAX_AGE" default:"1h"`
+	// ClusterName is the name of the cluster added to the events by the Broker ingress rules.
+	ClusterName string `envconfig:"CLUSTER_NAME"`
+	// RedactionHashKey is the secret key of the hashes of the values redacted by the
+	// Broker ingress rules. Without key, the values to hash are removed.
+	RedactionHashKey string `envconfig:"REDACTION_HASH_KEY"`
+
+	claimcheck.EnvConfig
 }
 
 func main() {
@@ -100,6 +117,8 @@ func main() {
 	ctx = filteredFactory.WithSelectors(ctx,
 		auth.OIDCLabelSelector,
 		eventingtls.TrustBundleLabelSelector,
+		eventingtls.ClientCABundleLabelSelector,
+		eventingtls.ClientCertificateLabelSelector,
 	)
 
 	ctx, informers := injection.Default.SetupInformers(ctx, cfg)
@@ -140,8 +159,11 @@ func main() {
 	// TODO change the component name to broker once Stackdriver metrics are approved.
 	// Watch the observability config map and dynamically update request logs.
 	configMapWatcher.Watch(logging.ConfigMapName(), logging.UpdateLevelFromConfigMap(sl, atomicLevel, component))
+	// Watch the TLS policies applied to destinations.
+	kncloudevents.WatchTLSPolicies(sl, configMapWatcher)
 
 	trustBundleConfigMapLister := configmapinformer.Get(ctx, eventingtls.TrustBundleLabelSelector).Lister().ConfigMaps(system.Namespace())
+	clientCertificates := eventingtls.NewClientCertificates(filteredsecretinformer.Get(ctx, eventingtls.ClientCertificateLabelSelector).Lister())
 
 	var featureStore *feature.Store
 	var handler *ingress.Handler
@@ -174,6 +196,7 @@ func main() {
 		authVerifier,
 		oidcTokenProvider,
 		trustBundleConfigMapLister,
+		clientCertificates,
 		ctxFunc,
 		mp,
 		tp,
@@ -181,6 +204,33 @@ func main() {
 	if err != nil {
 		logger.Fatal("Error creating Handler", zap.Error(err))
 	}
+	handler.ClusterName = env.ClusterName
+	handler.RedactionHashKey = []byte(env.RedactionHashKey)
+	handler.ClaimCheckStore, err = claimcheck.NewStore(env.EnvConfig)
+	if err != nil {
+		logger.Fatal("Error creating claim check store", zap.Error(err))
+	}
+	if handler.ClaimCheckStore != nil && env.TTL > 0 {
+		// The ingress offloads the data, so it is responsible for deleting it.
+		go claimcheck.RunExpiration(ctx, handler.ClaimCheckStore, claimcheck.Prefix("brokers", "", ""), env.TTL)
+	}
+
+	delayStore, err := ingress.NewDelayStore(
+		logger,
+		kubeclient.Get(ctx),
+		secretinformer.Get(ctx).Lister().Secrets(system.Namespace()),
+		system.Namespace(),
+		env.PodName,
+		env.DelayStoreCapacity,
+		env.DelayStoreMaxAge,
+		handler.ReleaseDelayed,
+		handler.DeadLetterDelayed,
+		mp,
+	)
+	if err != nil {
+		logger.Fatal("Error creating delay store", zap.Error(err))
+	}
+	handler.DelayStore = delayStore
 
 	serverManager, err := ingress.NewServerManager(
 		ctx,
@@ -218,6 +268,9 @@ func main() {
 		logger.Fatal("Failed to start informers", zap.Error(err))
 	}
 
+	// Release the parked events once they are due.
+	go delayStore.Run(ctx)
+
 	// Start the servers
 	logger.Info("Ingress starting...")
 	err = serverManager.StartServers(ctx)
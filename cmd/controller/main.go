@@ -38,7 +38,10 @@ import (
 
 	"knative.dev/eventing/pkg/reconciler/apiserversource"
 	"knative.dev/eventing/pkg/reconciler/channel"
+	"knative.dev/eventing/pkg/reconciler/clientcertificate"
 	"knative.dev/eventing/pkg/reconciler/containersource"
+	containersourceresources "knative.dev/eventing/pkg/reconciler/containersource/resources"
+	"knative.dev/eventing/pkg/reconciler/deadlettersink"
 	"knative.dev/eventing/pkg/reconciler/eventtype"
 	integrationsink "knative.dev/eventing/pkg/reconciler/integration/sink"
 	integrationsource "knative.dev/eventing/pkg/reconciler/integration/source"
@@ -57,10 +60,12 @@ func main() {
 	ctx = kubefilteredfactory.WithSelectors(ctx,
 		auth.OIDCLabelSelector,
 		eventingtls.TrustBundleLabelSelector,
+		eventingtls.ClientCertificateLabelSelector,
 		sinks.JobSinkJobsLabelSelector,
 		eventtransform.JsonataResourcesSelector,
 		certificates.SecretLabelSelectorPair,
 		requestreply.SecretLabelSelector,
+		containersourceresources.LabelSelector,
 	)
 
 	ctx = eventingfilteredfactory.WithSelectors(ctx,
@@ -75,6 +80,7 @@ func main() {
 		// Eventing
 		eventtype.NewController,
 		eventpolicy.NewController,
+		clientcertificate.NewController,
 
 		// Flows
 		parallel.NewController,
@@ -92,6 +98,7 @@ func main() {
 		// Sinks
 		jobsink.NewController,
 		integrationsink.NewController,
+		deadlettersink.NewController,
 
 		// Sugar
 		sugarnamespace.NewController,
@@ -0,0 +1,403 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/logging"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/apis/sinks"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/claimcheck"
+	"knative.dev/eventing/pkg/eventfilter"
+	"knative.dev/eventing/pkg/eventfilter/attributes"
+	"knative.dev/eventing/pkg/eventfilter/subscriptionsapi"
+	"knative.dev/eventing/pkg/kncloudevents"
+)
+
+const (
+	// eventsSubresource is the subresource of DeadLetterSinks the events API requests
+	// are authorized for.
+	eventsSubresource = "events"
+
+	// redriveVerb is the verb authorizing to redrive the events of DeadLetterSinks.
+	redriveVerb = "redrive"
+)
+
+var (
+	errNoSubscriber      = errors.New("the Trigger or Subscription of the event doesn't exist anymore")
+	errUnknownSubscriber = errors.New("the event wasn't sent by an authenticated Trigger or Subscription")
+)
+
+// handleEvents serves the events API of the given DeadLetterSink, path contains the
+// request path segments following "/<namespace>/<name>/events".
+func (h *Handler) handleEvents(ctx context.Context, w http.ResponseWriter, r *http.Request, dls *sinksv1alpha1.DeadLetterSink, path []string) {
+	logger := logging.FromContext(ctx).Desugar()
+
+	var verb string
+	switch {
+	case len(path) == 0 && r.Method == http.MethodGet:
+		verb = "list"
+	case len(path) == 1 && r.Method == http.MethodGet:
+		verb = "get"
+	case len(path) == 1 && r.Method == http.MethodDelete:
+		verb = "delete"
+	case len(path) == 2 && path[1] == "redrive" && r.Method == http.MethodPost:
+		verb = redriveVerb
+	default:
+		logger.Info("Unexpected events API request", zap.String("method", r.Method), zap.String("URI", r.RequestURI))
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if status, err := h.authorize(ctx, r, dls, verb); err != nil {
+		logger.Info("Events API request not authorized", zap.String("verb", verb), zap.Error(err))
+		w.Header().Add("Reason", err.Error())
+		w.WriteHeader(status)
+		return
+	}
+
+	if verb == "list" {
+		events, err := h.listEvents(ctx, dls)
+		if err != nil {
+			logger.Warn("Failed to list stored events", zap.Error(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writeJSON(ctx, w, http.StatusOK, storedEventList{Items: events})
+		return
+	}
+
+	id := path[0]
+	record, err := h.getEvent(ctx, dls, id)
+	if errors.Is(err, claimcheck.ErrNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		logger.Warn("Failed to get stored event", zap.String("id", id), zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	switch verb {
+	case "get":
+		e, err := record.storedEvent(id, true)
+		if err != nil {
+			logger.Warn("Failed to read stored event", zap.String("id", id), zap.Error(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writeJSON(ctx, w, http.StatusOK, e)
+	case "delete":
+		if err := h.deleteEvent(ctx, dls, id); err != nil {
+			logger.Warn("Failed to delete stored event", zap.String("id", id), zap.Error(err))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case redriveVerb:
+		h.redriveEvent(ctx, w, dls, id, record)
+	}
+}
+
+// redriveEvent sends the stored event to the Trigger or Subscription which sent it to the
+// DeadLetterSink, with its OIDC identity, and deletes it once delivered. The filter of the
+// Trigger is applied again, so that only events the Trigger subscribes to are redriven.
+func (h *Handler) redriveEvent(ctx context.Context, w http.ResponseWriter, dls *sinksv1alpha1.DeadLetterSink, id string, record *storedRecord) {
+	logger := logging.FromContext(ctx).Desugar().With(zap.String("id", id))
+
+	event, err := record.event()
+	if err != nil {
+		logger.Warn("Failed to read stored event", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	dl, err := parseDeadLetteredEvent(event)
+	if err != nil {
+		logger.Info("Failed to read dead lettered event", zap.Error(err))
+		w.Header().Add("Reason", err.Error())
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		return
+	}
+	if record.Subscriber == nil {
+		w.Header().Add("Reason", errUnknownSubscriber.Error())
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		return
+	}
+
+	sub, err := h.getSubscriber(dls.GetNamespace(), record.Subscriber)
+	if errors.Is(err, errNoSubscriber) {
+		logger.Info("Cannot redrive event", zap.String("kind", record.Subscriber.Kind), zap.String("name", record.Subscriber.Name), zap.Error(err))
+		w.Header().Add("Reason", err.Error())
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+	if err != nil {
+		logger.Warn("Failed to get the subscriber of the event", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if sub.trigger != nil && filterEvent(ctx, sub.trigger, *dl.original) == eventfilter.FailFilter {
+		logger.Info("Cannot redrive event not passing the filter of the trigger", zap.String("name", sub.name))
+		w.Header().Add("Reason", fmt.Sprintf("the event doesn't pass the filter of Trigger %s", sub.name))
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+
+	var opts []kncloudevents.SendOption
+	if feature.FromContext(ctx).IsOIDCAuthentication() && sub.auth != nil && sub.auth.ServiceAccountName != nil {
+		opts = append(opts, kncloudevents.WithOIDCAuthentication(&types.NamespacedName{
+			Name:      *sub.auth.ServiceAccountName,
+			Namespace: dls.GetNamespace(),
+		}))
+	}
+	if sub.delivery != nil && sub.delivery.Format != nil {
+		opts = append(opts, kncloudevents.WithEventFormat(sub.delivery.Format))
+	}
+
+	info, err := h.dispatcher.SendEvent(ctx, *dl.original, sub.target, opts...)
+	if err != nil {
+		logger.Info("Failed to redrive event", zap.String("kind", sub.kind), zap.String("name", sub.name), zap.Int("code", info.ResponseCode), zap.Error(err))
+		w.Header().Add("Reason", err.Error())
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	if err := h.deleteEvent(ctx, dls, id); err != nil {
+		// The event was delivered, report it while the event will be redriven again if requested.
+		logger.Warn("Failed to delete redriven event", zap.Error(err))
+	}
+
+	writeJSON(ctx, w, http.StatusOK, redriveResult{Kind: sub.kind, Name: sub.name, ResponseCode: info.ResponseCode})
+}
+
+// redriveResult is the response of the redrive API.
+type redriveResult struct {
+	// Kind and Name are the kind, Trigger or Subscription, and the name of the
+	// subscriber the event was redriven for.
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// ResponseCode is the response status code of the destination.
+	ResponseCode int `json:"code"`
+}
+
+// subscriber is the Trigger or Subscription an event is redriven for.
+type subscriber struct {
+	kind     string
+	name     string
+	target   duckv1.Addressable
+	auth     *duckv1.AuthStatus
+	delivery *eventingduckv1.DeliverySpec
+	// trigger is the Trigger of the subscriber, nil for Subscriptions.
+	trigger *eventingv1.Trigger
+}
+
+// subscriberForIdentity returns the Trigger or Subscription of the namespace which sends
+// events with the identity service account of the authenticated subject. Subscribers
+// sharing the same identity are narrowed down to the one subscribing the destination. It
+// returns nil when the subject is unknown or doesn't identify a single subscriber.
+func (h *Handler) subscriberForIdentity(namespace, subject, destination string) (*subscriberRef, error) {
+	serviceAccount, ok := strings.CutPrefix(subject, "system:serviceaccount:"+namespace+":")
+	if !ok || serviceAccount == "" {
+		return nil, nil
+	}
+
+	triggers, err := h.triggerLister.Triggers(namespace).List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list triggers: %w", err)
+	}
+	subscriptions, err := h.subscriptionLister.Subscriptions(namespace).List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+
+	var found, subscribing []*subscriberRef
+	add := func(ref *subscriberRef, auth *duckv1.AuthStatus, subscriberURI *apis.URL) {
+		if auth == nil || auth.ServiceAccountName == nil || *auth.ServiceAccountName != serviceAccount {
+			return
+		}
+		found = append(found, ref)
+		if subscriberURI != nil && subscriberURI.String() == destination {
+			subscribing = append(subscribing, ref)
+		}
+	}
+	for _, t := range triggers {
+		add(&subscriberRef{Kind: "Trigger", Name: t.Name, UID: t.UID}, t.Status.Auth, t.Status.SubscriberURI)
+	}
+	for _, s := range subscriptions {
+		add(&subscriberRef{Kind: "Subscription", Name: s.Name, UID: s.UID}, s.Status.Auth, s.Status.PhysicalSubscription.SubscriberURI)
+	}
+
+	switch {
+	case len(found) == 1:
+		return found[0], nil
+	case len(subscribing) == 1:
+		return subscribing[0], nil
+	default:
+		return nil, nil
+	}
+}
+
+// getSubscriber returns the Trigger or Subscription of the namespace referenced by ref, it
+// returns errNoSubscriber when it doesn't exist anymore or has been recreated.
+func (h *Handler) getSubscriber(namespace string, ref *subscriberRef) (*subscriber, error) {
+	switch ref.Kind {
+	case "Trigger":
+		t, err := h.triggerLister.Triggers(namespace).Get(ref.Name)
+		if apierrors.IsNotFound(err) || (err == nil && t.UID != ref.UID) {
+			return nil, fmt.Errorf("%w: Trigger %s", errNoSubscriber, ref.Name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get trigger: %w", err)
+		}
+		if t.Status.SubscriberURI == nil {
+			return nil, fmt.Errorf("%w: Trigger %s has no subscriber", errNoSubscriber, ref.Name)
+		}
+		return &subscriber{
+			kind: "Trigger",
+			name: t.Name,
+			target: duckv1.Addressable{
+				URL:      t.Status.SubscriberURI,
+				CACerts:  t.Status.SubscriberCACerts,
+				Audience: t.Status.SubscriberAudience,
+			},
+			auth:     t.Status.Auth,
+			delivery: t.Spec.Delivery,
+			trigger:  t,
+		}, nil
+	case "Subscription":
+		s, err := h.subscriptionLister.Subscriptions(namespace).Get(ref.Name)
+		if apierrors.IsNotFound(err) || (err == nil && s.UID != ref.UID) {
+			return nil, fmt.Errorf("%w: Subscription %s", errNoSubscriber, ref.Name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get subscription: %w", err)
+		}
+		physical := s.Status.PhysicalSubscription
+		if physical.SubscriberURI == nil {
+			return nil, fmt.Errorf("%w: Subscription %s has no subscriber", errNoSubscriber, ref.Name)
+		}
+		return &subscriber{
+			kind: "Subscription",
+			name: s.Name,
+			target: duckv1.Addressable{
+				URL:      physical.SubscriberURI,
+				CACerts:  physical.SubscriberCACerts,
+				Audience: physical.SubscriberAudience,
+			},
+			auth:     s.Status.Auth,
+			delivery: s.Spec.Delivery,
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown kind %s", errNoSubscriber, ref.Kind)
+	}
+}
+
+// filterEvent applies the filters of the trigger to the event, like the broker filter does.
+func filterEvent(ctx context.Context, trigger *eventingv1.Trigger, event cloudevents.Event) eventfilter.FilterResult {
+	switch {
+	case len(trigger.Spec.Filters) > 0:
+		return subscriptionsapi.CreateSubscriptionsAPIFilters(logging.FromContext(ctx).Desugar(), trigger.Spec.Filters).Filter(ctx, event)
+	case trigger.Spec.Filter != nil:
+		return attributes.NewAttributesFilter(trigger.Spec.Filter.Attributes).Filter(ctx, event)
+	default:
+		return eventfilter.NoFilter
+	}
+}
+
+// authorize authenticates the bearer token of the request and checks that its user is
+// allowed to perform the verb on the events of the DeadLetterSink. It returns the HTTP
+// status code to respond with when the request is not authorized.
+func (h *Handler) authorize(ctx context.Context, r *http.Request, dls *sinksv1alpha1.DeadLetterSink, verb string) (int, error) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return http.StatusUnauthorized, errors.New("missing bearer token")
+	}
+
+	review, err := h.k8s.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to review token: %w", err)
+	}
+	if !review.Status.Authenticated {
+		return http.StatusUnauthorized, errors.New("invalid bearer token")
+	}
+
+	user := review.Status.User
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	access, err := h.k8s.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   dls.GetNamespace(),
+				Verb:        verb,
+				Group:       sinks.DeadLetterSinkResource.Group,
+				Resource:    sinks.DeadLetterSinkResource.Resource,
+				Subresource: eventsSubresource,
+				Name:        dls.GetName(),
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return http.StatusInternalServerError, fmt.Errorf("failed to review access: %w", err)
+	}
+	if !access.Status.Allowed {
+		return http.StatusForbidden, fmt.Errorf("user %q cannot %s %s/%s of %s/%s", user.Username, verb,
+			sinks.DeadLetterSinkResource.String(), eventsSubresource, dls.GetNamespace(), dls.GetName())
+	}
+	return http.StatusOK, nil
+}
+
+func writeJSON(ctx context.Context, w http.ResponseWriter, status int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		logging.FromContext(ctx).Warnw("Failed to marshal response", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(b)
+}
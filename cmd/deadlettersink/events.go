@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetypes "github.com/cloudevents/sdk-go/v2/types"
+	"k8s.io/apimachinery/pkg/types"
+
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/claimcheck"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/eventing/pkg/kncloudevents/attributes"
+)
+
+// storedEvent is the representation of an event stored by a DeadLetterSink in the
+// responses of the events API.
+type storedEvent struct {
+	// ID identifies the stored event in the events API.
+	ID string `json:"id"`
+	// StoredAt is the time at which the event was stored.
+	StoredAt time.Time `json:"storedAt"`
+
+	EventID     string `json:"eventId"`
+	EventSource string `json:"eventSource"`
+	EventType   string `json:"eventType"`
+
+	// Destination is the destination the event could not be delivered to.
+	Destination string `json:"destination,omitempty"`
+	// ResponseCode is the response status code of the last delivery attempt.
+	ResponseCode int `json:"code,omitempty"`
+	// Subscriber is the Trigger or Subscription which sent the event to the DeadLetterSink.
+	// It is only known when the sender was authenticated, and events are only redriven for it.
+	Subscriber *subscriberRef `json:"subscriber,omitempty"`
+
+	// Event is the event in the structured JSON format, including its knativeerror*
+	// extensions. It is omitted when listing events.
+	Event json.RawMessage `json:"event,omitempty"`
+}
+
+// storedEventList is the response of the events list API.
+type storedEventList struct {
+	Items []storedEvent `json:"items"`
+}
+
+// storedEventIDRegexp matches the ids of the stored events.
+var storedEventIDRegexp = regexp.MustCompile(`^[0-9a-f]{32}$`)
+
+// storedEventID returns the id of the stored event with the given source and id, events
+// with the same source and id are stored once.
+func storedEventID(source, id string) string {
+	h := md5.Sum([]byte(source + id)) //nolint:gosec
+	return hex.EncodeToString(h[:])
+}
+
+// eventStoreResource is the resource the keys of the events in the event store are prefixed
+// with.
+const eventStoreResource = "deadlettersinks"
+
+// eventStorePrefix returns the prefix of the keys of the events stored by the given
+// DeadLetterSink in the event store. It includes the UID of the DeadLetterSink, so that a
+// DeadLetterSink recreated with the same name doesn't list the events of the deleted one.
+func eventStorePrefix(dls *sinksv1alpha1.DeadLetterSink) string {
+	return claimcheck.Prefix(eventStoreResource, dls.GetNamespace(), dls.GetName()) + string(dls.GetUID()) + "/"
+}
+
+// parseEventStoreKey returns the namespace, name and UID of the DeadLetterSink storing
+// the event under the given key.
+func parseEventStoreKey(key string) (namespace, name string, uid types.UID, ok bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 5 || parts[0] != eventStoreResource {
+		return "", "", "", false
+	}
+	return parts[1], parts[2], types.UID(parts[3]), true
+}
+
+// subscriberRef identifies the Trigger or Subscription, in the namespace of the
+// DeadLetterSink, which sent an event to the DeadLetterSink.
+type subscriberRef struct {
+	Kind string    `json:"kind"`
+	Name string    `json:"name"`
+	UID  types.UID `json:"uid"`
+}
+
+// storedRecord is the data stored for an event in the event store.
+type storedRecord struct {
+	StoredAt   time.Time       `json:"storedAt"`
+	Subscriber *subscriberRef  `json:"subscriber,omitempty"`
+	Event      json.RawMessage `json:"event"`
+}
+
+// newStoredRecord returns the data to store for the given event, sent by the given
+// subscriber which is nil when unknown.
+func newStoredRecord(event *cloudevents.Event, storedAt time.Time, subscriber *subscriberRef) ([]byte, error) {
+	eventBytes, err := event.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event: %w", err)
+	}
+	return json.Marshal(storedRecord{StoredAt: storedAt.UTC(), Subscriber: subscriber, Event: eventBytes})
+}
+
+// parseStoredRecord returns the record stored in the event store.
+func parseStoredRecord(data []byte) (*storedRecord, error) {
+	r := &storedRecord{}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stored event: %w", err)
+	}
+	return r, nil
+}
+
+// event returns the event of the record.
+func (r *storedRecord) event() (*cloudevents.Event, error) {
+	event := cloudevents.NewEvent()
+	if err := event.UnmarshalJSON(r.Event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event: %w", err)
+	}
+	return &event, nil
+}
+
+// storedEvent returns the representation of the event of the record, the event itself is
+// only included when withEvent is true.
+func (r *storedRecord) storedEvent(id string, withEvent bool) (storedEvent, error) {
+	e := storedEvent{
+		ID:         id,
+		StoredAt:   r.StoredAt,
+		Subscriber: r.Subscriber,
+	}
+
+	event, err := r.event()
+	if err != nil {
+		return e, err
+	}
+	e.EventID = event.ID()
+	e.EventSource = event.Source()
+	e.EventType = event.Type()
+
+	dl, err := parseDeadLetteredEvent(event)
+	if err != nil {
+		return e, err
+	}
+	e.Destination = dl.destination
+	e.ResponseCode = dl.responseCode
+
+	if withEvent {
+		e.Event = r.Event
+	}
+	return e, nil
+}
+
+// deadLetteredEvent is an event which could not be delivered to its destination.
+type deadLetteredEvent struct {
+	// original is the event as it was sent to the destination, without the
+	// knativeerror* extensions nor dead letter envelope.
+	original *cloudevents.Event
+	// destination is the destination the event could not be delivered to.
+	destination string
+	// responseCode is the response status code of the last delivery attempt.
+	responseCode int
+}
+
+// deadLetterEnvelope is the part of the data of dead letter envelope events, as sent by
+// kncloudevents.Dispatcher with the envelope dead letter format, needed for redrive.
+type deadLetterEnvelope struct {
+	Destination  string             `json:"destination"`
+	ResponseCode int                `json:"code"`
+	Event        *cloudevents.Event `json:"event"`
+}
+
+// parseDeadLetteredEvent returns the original event and delivery error of the given event,
+// either carried by its knativeerror* extensions or by a dead letter envelope.
+func parseDeadLetteredEvent(event *cloudevents.Event) (*deadLetteredEvent, error) {
+	if event.Type() == kncloudevents.DeadLetterEnvelopeEventType {
+		var envelope deadLetterEnvelope
+		if err := event.DataAs(&envelope); err != nil {
+			return nil, fmt.Errorf("failed to read dead letter envelope: %w", err)
+		}
+		if envelope.Event == nil {
+			return nil, fmt.Errorf("dead letter envelope without event")
+		}
+		return &deadLetteredEvent{
+			original:     envelope.Event,
+			destination:  envelope.Destination,
+			responseCode: envelope.ResponseCode,
+		}, nil
+	}
+
+	original := event.Clone()
+	dl := &deadLetteredEvent{original: &original}
+	extensions := event.Extensions()
+	if v, ok := extensions[attributes.KnativeErrorDestExtensionKey]; ok {
+		dl.destination, _ = cetypes.ToString(v)
+	}
+	if v, ok := extensions[attributes.KnativeErrorCodeExtensionKey]; ok {
+		code, _ := cetypes.ToInteger(v)
+		dl.responseCode = int(code)
+	}
+	for k := range extensions {
+		if strings.HasPrefix(k, "knativeerror") {
+			dl.original.SetExtension(k, nil)
+		}
+	}
+	return dl, nil
+}
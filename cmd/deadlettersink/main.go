@@ -0,0 +1,394 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/kelseyhightower/envconfig"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	configmap "knative.dev/pkg/configmap/informer"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
+	"knative.dev/pkg/logging"
+	k8sruntime "knative.dev/pkg/observability/runtime/k8s"
+	"knative.dev/pkg/signals"
+	"knative.dev/pkg/system"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/auth"
+	"knative.dev/eventing/pkg/claimcheck"
+	triggerinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/trigger"
+	eventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
+	subscriptioninformer "knative.dev/eventing/pkg/client/injection/informers/messaging/v1/subscription"
+	"knative.dev/eventing/pkg/client/injection/informers/sinks/v1alpha1/deadlettersink"
+	eventinglisters "knative.dev/eventing/pkg/client/listers/eventing/v1"
+	messaginglisters "knative.dev/eventing/pkg/client/listers/messaging/v1"
+	sinkslister "knative.dev/eventing/pkg/client/listers/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/eventing/pkg/observability"
+	o11yconfigmap "knative.dev/eventing/pkg/observability/configmap"
+	"knative.dev/eventing/pkg/observability/otel"
+	eventingtracing "knative.dev/eventing/pkg/tracing"
+	"knative.dev/eventing/pkg/utils"
+)
+
+const (
+	component = "dead_letter_sink"
+	ScopeName = "knative.dev/cmd/deadlettersink"
+
+	// eventsPath is the path segment, following the DeadLetterSink path, of the events API.
+	eventsPath = "events"
+)
+
+// envConfig is the configuration of the DeadLetterSink data plane read from environment variables.
+type envConfig struct {
+	// The claim check store configuration is reused for the store of the events, the
+	// events are deleted according to the limits of their DeadLetterSink rather than
+	// after CLAIM_CHECK_TTL.
+	claimcheck.EnvConfig
+}
+
+var (
+	latencyBounds = []float64{0.005, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2.5, 5, 7.5, 10}
+)
+
+func main() {
+
+	ctx := signals.NewContext()
+
+	var env envConfig
+	if err := envconfig.Process("", &env); err != nil {
+		log.Fatal("Failed to process env var: ", err)
+	}
+
+	cfg := injection.ParseAndGetRESTConfigOrDie()
+	ctx = injection.WithConfig(ctx, cfg)
+
+	ctx, informers := injection.Default.SetupInformers(ctx, cfg)
+	ctx = injection.WithConfig(ctx, cfg)
+
+	loggingConfig, err := utils.GetLoggingConfig(ctx, system.Namespace(), logging.ConfigMapName())
+	if err != nil {
+		log.Fatal("Error loading/parsing logging configuration:", err)
+	}
+	sl, atomicLevel := logging.NewLoggerFromConfig(loggingConfig, component)
+	logger := sl.Desugar()
+	defer flush(sl)
+
+	pprof := k8sruntime.NewProfilingServer(sl.Named("pprof"))
+
+	mp, tp := otel.SetupObservabilityOrDie(ctx, "deadlettersink", sl, pprof)
+
+	defer func() {
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		if err := mp.Shutdown(ctx); err != nil {
+			sl.Errorw("Error flushing metrics", zap.Error(err))
+		}
+
+		if err := tp.Shutdown(ctx); err != nil {
+			sl.Errorw("Error flushing traces", zap.Error(err))
+		}
+	}()
+
+	// Watch the logging config map and dynamically update logging levels.
+	configMapWatcher := configmap.NewInformedWatcher(kubeclient.Get(ctx), system.Namespace())
+	// Watch the observability config map and dynamically update metrics exporter.
+	configMapWatcher.Watch(o11yconfigmap.Name(), pprof.UpdateFromConfigMap)
+	// Watch the observability config map and dynamically update request logs.
+	configMapWatcher.Watch(logging.ConfigMapName(), logging.UpdateLevelFromConfigMap(sl, atomicLevel, component))
+	// Watch the TLS policies applied to the redrive destinations.
+	kncloudevents.WatchTLSPolicies(sl, configMapWatcher)
+
+	logger.Info("Starting the DeadLetterSink Ingress")
+
+	trustBundleConfigMapLister := newSystemConfigMapLister(ctx, eventingtls.TrustBundleLabelSelector)
+
+	featureStore := feature.NewStore(logging.FromContext(ctx).Named("feature-config-store"))
+	featureStore.WatchConfigs(configMapWatcher)
+
+	// Decorate contexts with the current state of the feature config.
+	ctxFunc := func(ctx context.Context) context.Context {
+		return logging.WithLogger(featureStore.ToContext(ctx), sl)
+	}
+
+	h := &Handler{
+		k8s:                kubeclient.Get(ctx),
+		lister:             deadlettersink.Get(ctx).Lister(),
+		triggerLister:      triggerinformer.Get(ctx).Lister(),
+		subscriptionLister: subscriptioninformer.Get(ctx).Lister(),
+		withContext:        ctxFunc,
+		now:                time.Now,
+		authVerifier:       auth.NewVerifier(ctx, eventpolicyinformer.Get(ctx).Lister(), trustBundleConfigMapLister, configMapWatcher),
+		dispatcher: kncloudevents.NewDispatcher(
+			eventingtls.ClientConfig{TrustBundleConfigMapLister: trustBundleConfigMapLister},
+			auth.NewOIDCTokenProvider(ctx),
+			kncloudevents.WithMeterProvider(mp),
+			kncloudevents.WithTraceProvider(tp),
+		),
+	}
+
+	h.eventStore, err = claimcheck.NewStore(env.EnvConfig)
+	if err != nil {
+		logger.Fatal("Failed to create the event store", zap.Error(err))
+	}
+	if h.eventStore == nil {
+		logger.Fatal("No event store configured, CLAIM_CHECK_STORE must be set")
+	}
+
+	meter := mp.Meter(ScopeName)
+
+	h.dispatchDuration, err = meter.Float64Histogram(
+		"kn.eventing.dispatch.duration",
+		metric.WithDescription("The duration to dispatch the event"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(latencyBounds...),
+	)
+	if err != nil {
+		logger.Fatal("failed to create dispatch metric", zap.Error(err))
+	}
+
+	handler := otel.NewHandler(h, "receive", mp, tp)
+
+	tlsConfig, err := getServerTLSConfig(ctx)
+	if err != nil {
+		log.Fatal("Failed to get TLS config", err)
+	}
+
+	sm, err := eventingtls.NewServerManager(ctx,
+		kncloudevents.NewHTTPEventReceiver(8080),
+		kncloudevents.NewHTTPEventReceiver(8443,
+			kncloudevents.WithTLSConfig(tlsConfig)),
+		handler,
+		configMapWatcher,
+	)
+	if err != nil {
+		logger.Fatal("failed to start eventingtls server", zap.Error(err))
+	}
+
+	// configMapWatcher does not block, so start it first.
+	logger.Info("Starting ConfigMap watcher")
+	if err = configMapWatcher.Start(ctx.Done()); err != nil {
+		logger.Fatal("Failed to start ConfigMap watcher", zap.Error(err))
+	}
+
+	// Start informers and wait for them to sync.
+	logger.Info("Starting informers.")
+	if err := controller.StartInformers(ctx.Done(), informers...); err != nil {
+		logger.Fatal("Failed to start informers", zap.Error(err))
+	}
+
+	go h.runSweep(logging.WithLogger(ctx, sl))
+
+	// Start the servers
+	logger.Info("Starting...")
+	if err = sm.StartServers(ctx); err != nil {
+		logger.Fatal("StartServers() returned an error", zap.Error(err))
+	}
+	logger.Info("Exiting...")
+}
+
+type Handler struct {
+	k8s                kubernetes.Interface
+	lister             sinkslister.DeadLetterSinkLister
+	triggerLister      eventinglisters.TriggerLister
+	subscriptionLister messaginglisters.SubscriptionLister
+	withContext        func(ctx context.Context) context.Context
+	now                func() time.Time
+	authVerifier       *auth.Verifier
+	dispatcher         *kncloudevents.Dispatcher
+	dispatchDuration   metric.Float64Histogram
+
+	// eventStore holds the stored events, under keys prefixed by eventStorePrefix.
+	eventStore claimcheck.Store
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := h.withContext(r.Context())
+	logger := logging.FromContext(ctx).Desugar()
+	start := time.Now()
+
+	ctx = observability.WithRequestLabels(ctx, r)
+
+	defer func() {
+		labeler, _ := otelhttp.LabelerFromContext(ctx)
+		h.dispatchDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(labeler.Get()...))
+	}()
+
+	// Paths are /<namespace>/<name> to store events and /<namespace>/<name>/events(/...)
+	// for the events API.
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(parts) < 2 || (len(parts) > 2 && parts[2] != eventsPath) {
+		logger.Info("Malformed uri", zap.String("URI", r.RequestURI), zap.Any("parts", parts))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ref := types.NamespacedName{
+		Namespace: parts[0],
+		Name:      parts[1],
+	}
+
+	ctx = observability.WithSinkLabels(ctx, ref, "DeadLetterSink")
+
+	dls, err := h.lister.DeadLetterSinks(ref.Namespace).Get(ref.Name)
+	if err != nil {
+		logger.Warn("Failed to retrieve deadlettersink", zap.String("ref", ref.String()), zap.Error(err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if len(parts) > 2 {
+		h.handleEvents(ctx, w, r, dls, parts[3:])
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		logger.Info("Unexpected HTTP method", zap.String("method", r.Method))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	logger.Debug("Handling POST request", zap.String("URI", r.RequestURI))
+
+	var audience *string
+	if dls.Status.Address != nil {
+		audience = dls.Status.Address.Audience
+	}
+	subject, err := h.authVerifier.VerifyRequestSubject(ctx, feature.FromContext(ctx), audience, dls.Namespace, dls.Status.Policies, r, w)
+	if err != nil {
+		logger.Warn("Failed to verify AuthN and AuthZ.", zap.Error(err))
+		return
+	}
+
+	message := cehttp.NewMessageFromHttpRequest(r)
+	defer message.Finish(nil)
+
+	event, err := binding.ToEvent(r.Context(), message, eventingtracing.PopulateCEDistributedTracing(ctx))
+	if err != nil {
+		logger.Warn("failed to extract event from request", zap.Error(err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := event.Validate(); err != nil {
+		logger.Info("failed to validate event from request", zap.Error(err))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx = observability.WithMinimalEventLabels(ctx, event)
+
+	// The subscriber the event is redriven for is the one authenticated as its sender, the
+	// knativeerrordest extension is set by the sender and only narrows it down.
+	var destination string
+	if dl, err := parseDeadLetteredEvent(event); err == nil {
+		destination = dl.destination
+	}
+	subscriber, err := h.subscriberForIdentity(dls.Namespace, subject, destination)
+	if err != nil {
+		logger.Warn("Failed to get the subscriber of the sender", zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	id, err := h.putEvent(r.Context(), dls, event, subscriber)
+	if err != nil {
+		logger.Warn("Failed to store event", zap.Error(err))
+
+		w.Header().Add("Reason", err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// Delete the oldest events once the maximum number of events is exceeded.
+	objects, err := h.eventStore.List(r.Context(), eventStorePrefix(dls))
+	if err == nil {
+		err = h.enforceLimits(ctx, dls, objects)
+	}
+	if err != nil {
+		logger.Warn("Failed to enforce the stored events limits", zap.Error(err))
+	}
+
+	w.Header().Add("Location", fmt.Sprintf("/%s/%s/%s/%s", ref.Namespace, ref.Name, eventsPath, id))
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func flush(logger *zap.SugaredLogger) {
+	_ = logger.Sync()
+}
+
+func getServerTLSConfig(ctx context.Context) (*tls.Config, error) {
+	secret := types.NamespacedName{
+		Namespace: system.Namespace(),
+		Name:      eventingtls.DeadLetterSinkServerTLSSecretName,
+	}
+
+	serverTLSConfig := eventingtls.NewDefaultServerConfig()
+	serverTLSConfig.GetCertificate = eventingtls.GetCertificateFromSecret(ctx, secretinformer.Get(ctx), kubeclient.Get(ctx), secret)
+	serverTLSConfig.ClientCAs = eventingtls.GetClientCAsFromBundles(newSystemConfigMapLister(ctx, eventingtls.ClientCABundleLabelSelector))
+	return eventingtls.GetTLSServerConfig(serverTLSConfig)
+}
+
+// newSystemConfigMapLister returns the lister of the ConfigMaps of the system namespace with
+// the given label selector. The informer is created manually, rather than with the filtered
+// informer factory, so that it only watches the system namespace and the DeadLetterSink
+// doesn't need to read ConfigMaps cluster-wide.
+func newSystemConfigMapLister(ctx context.Context, selector string) corev1listers.ConfigMapNamespaceLister {
+	infFactory := informers.NewSharedInformerFactoryWithOptions(
+		kubeclient.Get(ctx),
+		controller.GetResyncPeriod(ctx),
+		informers.WithNamespace(system.Namespace()),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = selector
+		}),
+	)
+
+	go func() {
+		<-ctx.Done()
+		infFactory.Shutdown()
+	}()
+
+	inf := infFactory.Core().V1().ConfigMaps()
+
+	_ = inf.Informer() // Actually create informer
+
+	infFactory.Start(ctx.Done())
+	_ = infFactory.WaitForCacheSync(ctx.Done())
+
+	return inf.Lister().ConfigMaps(system.Namespace())
+}
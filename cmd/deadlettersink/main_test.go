@@ -0,0 +1,655 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"go.opentelemetry.io/otel/metric/noop"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	"knative.dev/eventing/pkg/apis/sinks"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/auth"
+	"knative.dev/eventing/pkg/claimcheck"
+	eventinglisters "knative.dev/eventing/pkg/client/listers/eventing/v1"
+	messaginglisters "knative.dev/eventing/pkg/client/listers/messaging/v1"
+	sinkslister "knative.dev/eventing/pkg/client/listers/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/eventing/pkg/kncloudevents/attributes"
+)
+
+const (
+	testNamespace = "test-namespace"
+	testDLS       = "test-dls"
+
+	// allowedToken is the bearer token of a user allowed to use the events API.
+	allowedToken = "allowed"
+	// deniedToken is the bearer token of a user not allowed to use the events API.
+	deniedToken = "denied"
+)
+
+var testNow = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestHandler(t *testing.T, subscribers ...runtime.Object) (*Handler, claimcheck.Store) {
+	t.Helper()
+
+	k8s := fake.NewSimpleClientset()
+	k8s.PrependReactor("create", "tokenreviews", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		review := action.(clientgotesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		switch review.Spec.Token {
+		case allowedToken, deniedToken:
+			review.Status.Authenticated = true
+			review.Status.User.Username = review.Spec.Token
+		}
+		return true, review, nil
+	})
+	k8s.PrependReactor("create", "subjectaccessreviews", func(action clientgotesting.Action) (bool, runtime.Object, error) {
+		review := action.(clientgotesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		attrs := review.Spec.ResourceAttributes
+		review.Status.Allowed = review.Spec.User == allowedToken &&
+			attrs.Group == sinks.GroupName &&
+			attrs.Resource == "deadlettersinks" &&
+			attrs.Subresource == "events" &&
+			attrs.Namespace == testNamespace &&
+			attrs.Name == testDLS
+		return true, review, nil
+	})
+
+	dlsIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	if err := dlsIndexer.Add(&sinksv1alpha1.DeadLetterSink{
+		ObjectMeta: metav1.ObjectMeta{Name: testDLS, Namespace: testNamespace, UID: "dls-uid"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	triggerIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	subscriptionIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, obj := range subscribers {
+		indexer := triggerIndexer
+		if _, ok := obj.(*messagingv1.Subscription); ok {
+			indexer = subscriptionIndexer
+		}
+		if err := indexer.Add(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store, err := claimcheck.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dispatchDuration, err := noop.NewMeterProvider().Meter(ScopeName).Float64Histogram("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &Handler{
+		k8s:                k8s,
+		lister:             sinkslister.NewDeadLetterSinkLister(dlsIndexer),
+		triggerLister:      eventinglisters.NewTriggerLister(triggerIndexer),
+		subscriptionLister: messaginglisters.NewSubscriptionLister(subscriptionIndexer),
+		withContext: func(ctx context.Context) context.Context {
+			// The senders of the events are authenticated with their client certificate.
+			return feature.ToContext(ctx, feature.Flags{
+				feature.TransportEncryption:      feature.Strict,
+				feature.ClientCertAuthentication: feature.Enabled,
+			})
+		},
+		authVerifier:     &auth.Verifier{},
+		now:              func() time.Time { return testNow },
+		dispatcher:       kncloudevents.NewDispatcher(eventingtls.ClientConfig{}, nil),
+		dispatchDuration: dispatchDuration,
+		eventStore:       store,
+	}, store
+}
+
+// getTestDLS returns the DeadLetterSink of the test handler.
+func getTestDLS(t *testing.T, h *Handler) *sinksv1alpha1.DeadLetterSink {
+	t.Helper()
+
+	dls, err := h.lister.DeadLetterSinks(testNamespace).Get(testDLS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dls
+}
+
+// storedKeys returns the keys of the events stored in the store.
+func storedKeys(t *testing.T, store claimcheck.Store) []string {
+	t.Helper()
+
+	objects, err := store.List(context.Background(), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := make([]string, 0, len(objects))
+	for _, o := range objects {
+		keys = append(keys, o.Key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// newTrigger returns a Trigger sending events with the identity service account of the
+// same name.
+func newTrigger(name, subscriber string) *eventingv1.Trigger {
+	u, _ := apis.ParseURL(subscriber)
+	tr := &eventingv1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, UID: types.UID(name + "-uid")},
+	}
+	tr.Status.SubscriberURI = u
+	tr.Status.Auth = &duckv1.AuthStatus{ServiceAccountName: ptr.To(name)}
+	return tr
+}
+
+// newSubscription returns a Subscription sending events with the identity service account
+// of the same name.
+func newSubscription(name, subscriber string) *messagingv1.Subscription {
+	u, _ := apis.ParseURL(subscriber)
+	s := &messagingv1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, UID: types.UID(name + "-uid")},
+	}
+	s.Status.PhysicalSubscription.SubscriberURI = u
+	s.Status.Auth = &duckv1.AuthStatus{ServiceAccountName: ptr.To(name)}
+	return s
+}
+
+func newDeadLetteredEvent(destination string) cloudevents.Event {
+	return newDeadLetteredEventWithID("1234", destination)
+}
+
+func newDeadLetteredEventWithID(id, destination string) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetSource("/test/source")
+	event.SetType("test.type")
+	_ = event.SetData(cloudevents.ApplicationJSON, map[string]string{"hello": "world"})
+	event.SetExtension(attributes.KnativeErrorDestExtensionKey, destination)
+	event.SetExtension(attributes.KnativeErrorCodeExtensionKey, 500)
+	event.SetExtension(attributes.KnativeErrorDataExtensionKey, "failed")
+	return event
+}
+
+func serve(h *Handler, method, path, token string, body []byte, header http.Header) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, bytes.NewReader(body))
+	for k, v := range header {
+		r.Header[k] = v
+	}
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func storeEvent(t *testing.T, h *Handler, event cloudevents.Event) string {
+	t.Helper()
+	return storeEventFrom(t, h, event, "sender")
+}
+
+// storeEventFrom stores the event sent with the client certificate of the given service
+// account of the test namespace.
+func storeEventFrom(t *testing.T, h *Handler, event cloudevents.Event, serviceAccount string) string {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/"+testNamespace+"/"+testDLS, nil)
+	if err := cehttp.WriteRequest(context.Background(), binding.ToMessage(&event), req); err != nil {
+		t.Fatal(err)
+	}
+	cert := &x509.Certificate{
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		URIs:        []*url.URL{{Scheme: "system", Opaque: "serviceaccount:" + testNamespace + ":" + serviceAccount}},
+	}
+	req.TLS = &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{cert},
+		VerifiedChains:   [][]*x509.Certificate{{cert}},
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("failed to store event, got status %d", w.Code)
+	}
+	wantLocation := "/" + testNamespace + "/" + testDLS + "/events/" + storedEventID(event.Source(), event.ID())
+	if got := w.Header().Get("Location"); got != wantLocation {
+		t.Fatalf("unexpected Location %q, want %q", got, wantLocation)
+	}
+	return storedEventID(event.Source(), event.ID())
+}
+
+func TestStoreEvent(t *testing.T) {
+	h, store := newTestHandler(t)
+
+	id := storeEvent(t, h, newDeadLetteredEvent("http://subscriber.example.com"))
+
+	want := []string{eventStoreResource + "/" + testNamespace + "/" + testDLS + "/dls-uid/" + id}
+	if got := storedKeys(t, store); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected stored events %v, want %v", got, want)
+	}
+
+	// Storing the same event again replaces it.
+	storeEvent(t, h, newDeadLetteredEvent("http://subscriber.example.com"))
+	if got := storedKeys(t, store); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected stored events %v, want %v", got, want)
+	}
+}
+
+func TestStoreEventHoldsDataRef(t *testing.T) {
+	ctx := context.Background()
+	h, store := newTestHandler(t)
+
+	// The event references data offloaded by a Broker to the shared store.
+	ref, err := store.Put(ctx, "brokers/"+testNamespace+"/broker/data", []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := newDeadLetteredEvent("http://subscriber.example.com")
+	event.SetExtension(claimcheck.DataRefExtension, ref)
+	id := storeEvent(t, h, event)
+
+	expire := func() {
+		t.Helper()
+		if err := claimcheck.DeleteExpired(ctx, store, claimcheck.Prefix("brokers", "", ""), time.Now().Add(time.Minute)); err != nil {
+			t.Fatal("DeleteExpired() =", err)
+		}
+	}
+	expire()
+	if _, err := store.Get(ctx, ref); err != nil {
+		t.Fatalf("expected the data referenced by the stored event not to expire, got %v", err)
+	}
+
+	if err := h.deleteEvent(ctx, getTestDLS(t, h), id); err != nil {
+		t.Fatal("deleteEvent() =", err)
+	}
+	expire()
+	if _, err := store.Get(ctx, ref); !errors.Is(err, claimcheck.ErrNotFound) {
+		t.Errorf("expected the data to expire once the event is deleted, got %v", err)
+	}
+}
+
+func TestStoreEventMaxEvents(t *testing.T) {
+	h, store := newTestHandler(t)
+	dls := getTestDLS(t, h)
+	dls.Spec.MaxEvents = ptr.To[int32](2)
+
+	var ids []string
+	for i, id := range []string{"1", "2", "3"} {
+		ids = append(ids, storeEvent(t, h, newDeadLetteredEventWithID(id, "http://subscriber.example.com")))
+		// Age the stored events, the oldest event is deleted first.
+		path, _ := strings.CutPrefix(store.Ref(eventStorePrefix(dls)+ids[i]), "file://")
+		mtime := testNow.Add(time.Duration(i) * time.Minute)
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []string{eventStorePrefix(dls) + ids[1], eventStorePrefix(dls) + ids[2]}
+	sort.Strings(want)
+	if got := storedKeys(t, store); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected stored events %v, want %v", got, want)
+	}
+}
+
+func TestSweep(t *testing.T) {
+	ctx := context.Background()
+	h, store := newTestHandler(t)
+	dls := getTestDLS(t, h)
+	dls.Spec.Retention = ptr.To("PT2H")
+
+	put := func(key string, age time.Duration) {
+		t.Helper()
+		ref, err := store.Put(ctx, key, []byte("{}"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		path, _ := strings.CutPrefix(ref, "file://")
+		mtime := testNow.Add(-age)
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	prefix := eventStorePrefix(dls)
+	put(prefix+"expired", 3*time.Hour)
+	put(prefix+"recent", time.Hour)
+	// Events of a deleted DeadLetterSink, and of a deleted one with the same name.
+	put(eventStoreResource+"/"+testNamespace+"/deleted/uid/event", time.Minute)
+	put(eventStoreResource+"/"+testNamespace+"/"+testDLS+"/old-uid/event", time.Minute)
+	// Data of other components sharing the store.
+	put("brokers/"+testNamespace+"/"+testDLS+"/old-uid/event", 3*time.Hour)
+
+	if err := h.sweep(ctx); err != nil {
+		t.Fatal("sweep() =", err)
+	}
+	want := []string{"brokers/" + testNamespace + "/" + testDLS + "/old-uid/event", prefix + "recent"}
+	if got := storedKeys(t, store); !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected stored events %v, want %v", got, want)
+	}
+}
+
+func TestEventsAPIAuthorization(t *testing.T) {
+	h, _ := newTestHandler(t)
+	path := "/" + testNamespace + "/" + testDLS + "/events"
+
+	tests := []struct {
+		name  string
+		token string
+		want  int
+	}{{
+		name: "no token",
+		want: http.StatusUnauthorized,
+	}, {
+		name:  "invalid token",
+		token: "invalid",
+		want:  http.StatusUnauthorized,
+	}, {
+		name:  "not allowed",
+		token: deniedToken,
+		want:  http.StatusForbidden,
+	}, {
+		name:  "allowed",
+		token: allowedToken,
+		want:  http.StatusOK,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := serve(h, http.MethodGet, path, tt.token, nil, nil).Code; got != tt.want {
+				t.Errorf("unexpected status %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventsAPI(t *testing.T) {
+	h, store := newTestHandler(t)
+	id := storeEvent(t, h, newDeadLetteredEvent("http://subscriber.example.com"))
+	path := "/" + testNamespace + "/" + testDLS + "/events"
+
+	w := serve(h, http.MethodGet, path, allowedToken, nil, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("list: unexpected status %d", w.Code)
+	}
+	var list storedEventList
+	if err := json.Unmarshal(w.Body.Bytes(), &list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 {
+		t.Fatalf("list: unexpected items %v", list.Items)
+	}
+	item := list.Items[0]
+	if item.ID != id || item.EventID != "1234" || item.Destination != "http://subscriber.example.com" || item.ResponseCode != 500 || item.Event != nil || !item.StoredAt.Equal(testNow) {
+		t.Errorf("list: unexpected item %+v", item)
+	}
+
+	w = serve(h, http.MethodGet, path+"/"+id, allowedToken, nil, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("get: unexpected status %d", w.Code)
+	}
+	var got storedEvent
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	event := cloudevents.NewEvent()
+	if err := event.UnmarshalJSON(got.Event); err != nil {
+		t.Fatal("get: unexpected event:", err)
+	}
+	if _, ok := event.Extensions()[attributes.KnativeErrorDataExtensionKey]; !ok {
+		t.Errorf("get: expected the stored event to keep its %s extension", attributes.KnativeErrorDataExtensionKey)
+	}
+
+	if code := serve(h, http.MethodGet, path+"/unknown", allowedToken, nil, nil).Code; code != http.StatusNotFound {
+		t.Errorf("get unknown: unexpected status %d", code)
+	}
+	if code := serve(h, http.MethodGet, path+"/..", allowedToken, nil, nil).Code; code != http.StatusNotFound {
+		t.Errorf("get invalid id: unexpected status %d", code)
+	}
+
+	if code := serve(h, http.MethodDelete, path+"/"+id, allowedToken, nil, nil).Code; code != http.StatusNoContent {
+		t.Fatalf("delete: unexpected status %d", code)
+	}
+	if keys := storedKeys(t, store); len(keys) != 0 {
+		t.Errorf("delete: event not deleted, got %v", keys)
+	}
+	if code := serve(h, http.MethodDelete, path+"/"+id, allowedToken, nil, nil).Code; code != http.StatusNotFound {
+		t.Errorf("delete deleted: unexpected status %d", code)
+	}
+}
+
+func TestRedrive(t *testing.T) {
+	var received []*cloudevents.Event
+	subscriber := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event, err := cloudevents.NewEventFromHTTPRequest(r)
+		if err != nil {
+			t.Error("unexpected request:", err)
+		}
+		received = append(received, event)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer subscriber.Close()
+
+	// filteredTrigger subscribes to events of another type.
+	filteredTrigger := newTrigger("trigger", subscriber.URL)
+	filteredTrigger.Spec.Filter = &eventingv1.TriggerFilter{Attributes: eventingv1.TriggerFilterAttributes{"type": "other.type"}}
+	// sharedIdentityTrigger sends events with the identity of trigger.
+	sharedIdentityTrigger := newTrigger("shared", "http://other.example.com")
+	sharedIdentityTrigger.Status.Auth.ServiceAccountName = ptr.To("trigger")
+
+	tests := []struct {
+		name         string
+		subscribers  []runtime.Object
+		event        cloudevents.Event
+		sender       string
+		want         int
+		wantReceived bool
+		wantResult   redriveResult
+	}{{
+		name:         "redrive to the trigger subscriber",
+		subscribers:  []runtime.Object{newTrigger("trigger", subscriber.URL), newTrigger("other", "http://other.example.com")},
+		event:        newDeadLetteredEvent(subscriber.URL),
+		sender:       "trigger",
+		want:         http.StatusOK,
+		wantReceived: true,
+		wantResult:   redriveResult{Kind: "Trigger", Name: "trigger", ResponseCode: http.StatusAccepted},
+	}, {
+		name:         "redrive to the subscription subscriber",
+		subscribers:  []runtime.Object{newSubscription("subscription", subscriber.URL), newTrigger("other", "http://other.example.com")},
+		event:        newDeadLetteredEvent(subscriber.URL),
+		sender:       "subscription",
+		want:         http.StatusOK,
+		wantReceived: true,
+		wantResult:   redriveResult{Kind: "Subscription", Name: "subscription", ResponseCode: http.StatusAccepted},
+	}, {
+		name:         "destination set by the sender is ignored",
+		subscribers:  []runtime.Object{newTrigger("trigger", subscriber.URL), newTrigger("other", "http://other.example.com")},
+		event:        newDeadLetteredEvent("http://other.example.com"),
+		sender:       "trigger",
+		want:         http.StatusOK,
+		wantReceived: true,
+		wantResult:   redriveResult{Kind: "Trigger", Name: "trigger", ResponseCode: http.StatusAccepted},
+	}, {
+		name:         "shared identity narrowed down by the destination",
+		subscribers:  []runtime.Object{newTrigger("trigger", subscriber.URL), sharedIdentityTrigger},
+		event:        newDeadLetteredEvent(subscriber.URL),
+		sender:       "trigger",
+		want:         http.StatusOK,
+		wantReceived: true,
+		wantResult:   redriveResult{Kind: "Trigger", Name: "trigger", ResponseCode: http.StatusAccepted},
+	}, {
+		name:        "shared identity without destination",
+		subscribers: []runtime.Object{newTrigger("trigger", subscriber.URL), sharedIdentityTrigger},
+		event:       newDeadLetteredEvent(""),
+		sender:      "trigger",
+		want:        http.StatusUnprocessableEntity,
+	}, {
+		name:        "sender is not a subscriber",
+		subscribers: []runtime.Object{newTrigger("trigger", subscriber.URL)},
+		event:       newDeadLetteredEvent(subscriber.URL),
+		sender:      "producer",
+		want:        http.StatusUnprocessableEntity,
+	}, {
+		name:        "event not passing the trigger filter",
+		subscribers: []runtime.Object{filteredTrigger},
+		event:       newDeadLetteredEvent(subscriber.URL),
+		sender:      "trigger",
+		want:        http.StatusConflict,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = nil
+			h, store := newTestHandler(t, tt.subscribers...)
+			id := storeEventFrom(t, h, tt.event, tt.sender)
+
+			w := serve(h, http.MethodPost, "/"+testNamespace+"/"+testDLS+"/events/"+id+"/redrive", allowedToken, nil, nil)
+			if w.Code != tt.want {
+				t.Fatalf("unexpected status %d, want %d (%s)", w.Code, tt.want, w.Header().Get("Reason"))
+			}
+
+			if deleted := len(storedKeys(t, store)) == 0; tt.wantReceived != deleted {
+				t.Errorf("expected the event to be deleted only once redriven, got deleted %v", deleted)
+			}
+
+			if !tt.wantReceived {
+				if len(received) != 0 {
+					t.Errorf("unexpected events received %v", received)
+				}
+				return
+			}
+			if len(received) != 1 {
+				t.Fatalf("expected 1 event received, got %d", len(received))
+			}
+			if received[0].ID() != "1234" {
+				t.Errorf("unexpected event %v", received[0])
+			}
+			var result redriveResult
+			if err := json.Unmarshal(w.Body.Bytes(), &result); err != nil {
+				t.Fatal(err)
+			}
+			if result != tt.wantResult {
+				t.Errorf("unexpected result %+v, want %+v", result, tt.wantResult)
+			}
+			for k := range received[0].Extensions() {
+				t.Errorf("unexpected extension %q on redriven event", k)
+			}
+		})
+	}
+}
+
+func TestParseDeadLetteredEventEnvelope(t *testing.T) {
+	original := cloudevents.NewEvent()
+	original.SetID("1234")
+	original.SetSource("/test/source")
+	original.SetType("test.type")
+
+	envelope := cloudevents.NewEvent()
+	envelope.SetID("1234")
+	envelope.SetSource("/test/source")
+	envelope.SetType(kncloudevents.DeadLetterEnvelopeEventType)
+	if err := envelope.SetData(cloudevents.ApplicationJSON, map[string]interface{}{
+		"destination": "http://subscriber.example.com",
+		"code":        503,
+		"attempts":    []interface{}{},
+		"event":       original,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	dl, err := parseDeadLetteredEvent(&envelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dl.destination != "http://subscriber.example.com" || dl.responseCode != 503 {
+		t.Errorf("unexpected destination %q and code %d", dl.destination, dl.responseCode)
+	}
+	if dl.original.Type() != "test.type" {
+		t.Errorf("unexpected original event %v", dl.original)
+	}
+}
+
+func TestStoredRecord(t *testing.T) {
+	event := newDeadLetteredEvent("http://subscriber.example.com")
+	subscriber := &subscriberRef{Kind: "Trigger", Name: "trigger", UID: "trigger-uid"}
+	data, err := newStoredRecord(&event, testNow, subscriber)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record, err := parseStoredRecord(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e, err := record.storedEvent("id", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.ID != "id" || !e.StoredAt.Equal(testNow) || e.EventID != "1234" || e.EventSource != "/test/source" || e.EventType != "test.type" || !reflect.DeepEqual(e.Subscriber, subscriber) {
+		t.Errorf("unexpected stored event %+v", e)
+	}
+	stored, err := record.event()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.ID() != event.ID() {
+		t.Errorf("unexpected stored event %v", stored)
+	}
+}
+
+func TestParseEventStoreKey(t *testing.T) {
+	dls := &sinksv1alpha1.DeadLetterSink{
+		ObjectMeta: metav1.ObjectMeta{Name: testDLS, Namespace: testNamespace, UID: "dls-uid"},
+	}
+	namespace, name, uid, ok := parseEventStoreKey(eventStorePrefix(dls) + storedEventID("/test/source", "1234"))
+	if !ok || namespace != testNamespace || name != testDLS || uid != "dls-uid" {
+		t.Errorf("parseEventStoreKey() = %q, %q, %q, %v", namespace, name, uid, ok)
+	}
+	if _, _, _, ok := parseEventStoreKey(eventStoreResource + "/ns/name/event"); ok {
+		t.Error("expected a key without UID not to be parsed")
+	}
+	if _, _, _, ok := parseEventStoreKey("brokers/ns/name/uid/event"); ok {
+		t.Error("expected a key of another resource not to be parsed")
+	}
+}
@@ -0,0 +1,258 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/rickb777/date/period"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/logging"
+
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/claimcheck"
+)
+
+// sweepInterval is the interval at which the events exceeding their retention, or stored
+// by deleted DeadLetterSinks, are deleted.
+const sweepInterval = time.Minute
+
+// putEvent stores the given event, sent by the given subscriber, for the DeadLetterSink and
+// returns its id. Storing an event with the same source and id again replaces it.
+func (h *Handler) putEvent(ctx context.Context, dls *sinksv1alpha1.DeadLetterSink, event *cloudevents.Event, subscriber *subscriberRef) (string, error) {
+	data, err := newStoredRecord(event, h.now(), subscriber)
+	if err != nil {
+		return "", err
+	}
+	id := storedEventID(event.Source(), event.ID())
+	key := eventStorePrefix(dls) + id
+
+	// The offloaded data referenced by the replaced event is released, and the one
+	// referenced by the event is held while it is stored.
+	if replaced, err := h.eventStore.Get(ctx, h.eventStore.Ref(key)); err == nil {
+		if err := h.releaseDataRef(ctx, key, replaced); err != nil {
+			return "", err
+		}
+	} else if !errors.Is(err, claimcheck.ErrNotFound) {
+		return "", fmt.Errorf("failed to get stored event: %w", err)
+	}
+	if ref, ok := claimcheck.DataRef(event); ok {
+		if err := claimcheck.Hold(ctx, h.eventStore, ref, key); err != nil {
+			return "", err
+		}
+	}
+
+	if _, err := h.eventStore.Put(ctx, key, data); err != nil {
+		return "", fmt.Errorf("failed to store event: %w", err)
+	}
+	return id, nil
+}
+
+// getEvent returns the record of the event stored by the DeadLetterSink with the given id,
+// it returns claimcheck.ErrNotFound when there is no such event.
+func (h *Handler) getEvent(ctx context.Context, dls *sinksv1alpha1.DeadLetterSink, id string) (*storedRecord, error) {
+	if !storedEventIDRegexp.MatchString(id) {
+		return nil, claimcheck.ErrNotFound
+	}
+	data, err := h.eventStore.Get(ctx, h.eventStore.Ref(eventStorePrefix(dls)+id))
+	if err != nil {
+		return nil, err
+	}
+	return parseStoredRecord(data)
+}
+
+// deleteEvent deletes the event stored by the DeadLetterSink with the given id, it doesn't
+// fail when the event doesn't exist.
+func (h *Handler) deleteEvent(ctx context.Context, dls *sinksv1alpha1.DeadLetterSink, id string) error {
+	return h.deleteStoredEvent(ctx, eventStorePrefix(dls)+id)
+}
+
+// deleteStoredEvent deletes the event stored under key, and releases the offloaded data it
+// references. It doesn't fail when the event doesn't exist.
+func (h *Handler) deleteStoredEvent(ctx context.Context, key string) error {
+	data, err := h.eventStore.Get(ctx, h.eventStore.Ref(key))
+	if errors.Is(err, claimcheck.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get stored event %s: %w", key, err)
+	}
+	if err := h.releaseDataRef(ctx, key, data); err != nil {
+		return err
+	}
+	if err := h.eventStore.Delete(ctx, h.eventStore.Ref(key)); err != nil {
+		return fmt.Errorf("failed to delete stored event %s: %w", key, err)
+	}
+	return nil
+}
+
+// releaseDataRef releases the offloaded data referenced by the given record of the event
+// stored under key. Records which can't be read hold nothing.
+func (h *Handler) releaseDataRef(ctx context.Context, key string, data []byte) error {
+	record, err := parseStoredRecord(data)
+	if err != nil {
+		return nil
+	}
+	event, err := record.event()
+	if err != nil {
+		return nil
+	}
+	ref, ok := claimcheck.DataRef(event)
+	if !ok {
+		return nil
+	}
+	return claimcheck.Release(ctx, h.eventStore, ref, key)
+}
+
+// listEvents returns the events stored by the DeadLetterSink, oldest events first. Events
+// which can't be read are still listed, so that they can be deleted.
+func (h *Handler) listEvents(ctx context.Context, dls *sinksv1alpha1.DeadLetterSink) ([]storedEvent, error) {
+	logger := logging.FromContext(ctx).Desugar()
+
+	prefix := eventStorePrefix(dls)
+	objects, err := h.eventStore.List(ctx, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stored events: %w", err)
+	}
+
+	events := make([]storedEvent, 0, len(objects))
+	for _, o := range objects {
+		id := strings.TrimPrefix(o.Key, prefix)
+		data, err := h.eventStore.Get(ctx, h.eventStore.Ref(o.Key))
+		if errors.Is(err, claimcheck.ErrNotFound) {
+			// Deleted since listed.
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get stored event %s: %w", id, err)
+		}
+
+		e := storedEvent{ID: id, StoredAt: o.LastModified.UTC()}
+		record, err := parseStoredRecord(data)
+		if err == nil {
+			e, err = record.storedEvent(id, false)
+		}
+		if err != nil {
+			logger.Warn("Failed to read stored event", zap.String("id", id), zap.Error(err))
+		}
+		events = append(events, e)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].StoredAt.Before(events[j].StoredAt)
+	})
+	return events, nil
+}
+
+// enforceLimits deletes the given events of the DeadLetterSink, stored in the event store,
+// exceeding its maximum number of events or its retention.
+func (h *Handler) enforceLimits(ctx context.Context, dls *sinksv1alpha1.DeadLetterSink, objects []claimcheck.Object) error {
+	// Oldest events first.
+	sort.Slice(objects, func(i, j int) bool {
+		if objects[i].LastModified.Equal(objects[j].LastModified) {
+			return objects[i].Key < objects[j].Key
+		}
+		return objects[i].LastModified.Before(objects[j].LastModified)
+	})
+
+	var retention time.Duration
+	if dls.Spec.Retention != nil {
+		p, _ := period.Parse(*dls.Spec.Retention)
+		retention = p.DurationApprox()
+	}
+	maxEvents := int(ptr.Deref(dls.Spec.MaxEvents, sinksv1alpha1.DefaultDeadLetterSinkMaxEvents))
+
+	for len(objects) > 0 {
+		oldest := objects[0]
+		if len(objects) <= maxEvents && (retention == 0 || h.now().Sub(oldest.LastModified) < retention) {
+			break
+		}
+		logging.FromContext(ctx).Debugw("Deleting stored event", zap.String("key", oldest.Key))
+		if err := h.deleteStoredEvent(ctx, oldest.Key); err != nil {
+			return err
+		}
+		objects = objects[1:]
+	}
+	return nil
+}
+
+// sweep deletes the stored events exceeding the limits of their DeadLetterSink, and the
+// events of the DeadLetterSinks which don't exist anymore.
+func (h *Handler) sweep(ctx context.Context) error {
+	objects, err := h.eventStore.List(ctx, claimcheck.Prefix(eventStoreResource, "", ""))
+	if err != nil {
+		return fmt.Errorf("failed to list stored events: %w", err)
+	}
+
+	type sinkEvents struct {
+		dls     *sinksv1alpha1.DeadLetterSink
+		objects []claimcheck.Object
+	}
+	sinks := make(map[string]*sinkEvents)
+	for _, o := range objects {
+		namespace, name, uid, ok := parseEventStoreKey(o.Key)
+		if !ok {
+			continue
+		}
+		dls, err := h.lister.DeadLetterSinks(namespace).Get(name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		if err != nil || dls.GetUID() != uid {
+			if err := h.deleteStoredEvent(ctx, o.Key); err != nil {
+				return err
+			}
+			continue
+		}
+		prefix := eventStorePrefix(dls)
+		if sinks[prefix] == nil {
+			sinks[prefix] = &sinkEvents{dls: dls}
+		}
+		sinks[prefix].objects = append(sinks[prefix].objects, o)
+	}
+
+	for _, s := range sinks {
+		if err := h.enforceLimits(ctx, s.dls, s.objects); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runSweep sweeps the stored events every sweepInterval until the context is done.
+func (h *Handler) runSweep(ctx context.Context) {
+	logger := logging.FromContext(ctx)
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := h.sweep(ctx); err != nil {
+				logger.Warnw("Failed to sweep stored events", zap.Error(err))
+			}
+		}
+	}
+}
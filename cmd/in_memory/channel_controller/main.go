@@ -23,6 +23,7 @@ import (
 	"context"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection/sharedmain"
@@ -34,6 +35,7 @@ import (
 	"knative.dev/pkg/webhook/resourcesemantics/validation"
 
 	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
+	"knative.dev/eventing/pkg/reconciler/autoscaler"
 	inmemorychannel "knative.dev/eventing/pkg/reconciler/inmemorychannel/controller"
 )
 
@@ -96,6 +98,7 @@ func main() {
 		// SecretName must match the name of the Secret created in the configuration.
 		SecretName: "inmemorychannel-webhook-certs",
 	})
+	ctx = filteredFactory.WithSelectors(ctx, autoscaler.PodLabelSelector)
 
 	sharedmain.MainWithContext(ctx, webhook.NameFromEnv(),
 		certificates.NewController,
@@ -103,5 +106,7 @@ func main() {
 		NewDefaultingAdmissionController,
 
 		inmemorychannel.NewController,
+
+		autoscaler.NewInMemoryChannelController,
 	)
 }
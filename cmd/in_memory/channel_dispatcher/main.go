@@ -42,6 +42,8 @@ func main() {
 	ctx = filteredFactory.WithSelectors(ctx,
 		auth.OIDCLabelSelector,
 		eventingtls.TrustBundleLabelSelector,
+		eventingtls.ClientCABundleLabelSelector,
+		eventingtls.ClientCertificateLabelSelector,
 	)
 
 	ctx = sharedmain.WithHealthProbesDisabled(ctx)
@@ -0,0 +1,279 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coordinationv1client "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/logging"
+
+	"knative.dev/eventing/pkg/apis/sinks"
+	sinksv "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/eventfilter/attributes"
+)
+
+var (
+	// errConcurrentJob is returned when the concurrency policy forbids creating a Job.
+	errConcurrentJob = errors.New("a job for the same concurrency key is running")
+
+	// errMaxRunningJobs is returned when the maximum number of running Jobs is reached.
+	errMaxRunningJobs = errors.New("maximum number of running jobs reached")
+)
+
+// concurrencyKey returns the hashed value of the JobSink concurrency key attribute of the
+// given event, or an empty string when the concurrency policy does not apply to the event.
+func concurrencyKey(js *sinksv.JobSink, event *cloudevents.Event) string {
+	if js.Spec.ConcurrencyPolicy == "" || js.Spec.ConcurrencyPolicy == sinksv.AllowConcurrent {
+		return ""
+	}
+	v, ok := attributes.LookupAttribute(*event, js.Spec.ConcurrencyKey)
+	if !ok {
+		return ""
+	}
+	s := fmt.Sprintf("%v", v)
+	if s == "" {
+		return ""
+	}
+	h := md5.Sum([]byte(s)) //nolint:gosec
+	return hex.EncodeToString(h[:])
+}
+
+const (
+	// jobAdmissionLeaseDuration is how long the admission Lease of a JobSink is held
+	// before other replicas consider that its holder is gone.
+	jobAdmissionLeaseDuration = 15 * time.Second
+
+	// jobAdmissionRetryPeriod is how often the admission Lease of a JobSink is checked
+	// while it is held by another admission.
+	jobAdmissionRetryPeriod = 100 * time.Millisecond
+)
+
+// jobAdmission is the admission Lease of a JobSink, it is held until the Job of the
+// admitted event is created so that admissions are serialized across replicas.
+type jobAdmission struct {
+	leases coordinationv1client.LeaseInterface
+	lease  *coordinationv1.Lease
+}
+
+// release releases the admission Lease of the JobSink, it is a no-op when the event didn't
+// require an admission.
+func (ja *jobAdmission) release(ctx context.Context) {
+	if ja == nil {
+		return
+	}
+	// The Lease is released even when the request is canceled, so that other admissions
+	// don't wait for it to expire.
+	ctx = context.WithoutCancel(ctx)
+
+	lease := ja.lease.DeepCopy()
+	lease.Spec.HolderIdentity = nil
+	_, err := ja.leases.Update(ctx, lease, metav1.UpdateOptions{})
+	// A conflict means the Lease expired and was acquired by another admission.
+	if err != nil && !apierrors.IsConflict(err) && !apierrors.IsNotFound(err) {
+		logging.FromContext(ctx).Warnw("Failed to release job admission lease", zap.String("lease", lease.Name), zap.Error(err))
+	}
+}
+
+// admitJob enforces the JobSink concurrency policy and the maximum number of running Jobs
+// before a Job is created for an event with the given concurrency key.
+//
+// Admissions of the same JobSink are serialized through a Lease, the returned jobAdmission
+// must be released once the Job is created, or on failure. It is nil when no admission is
+// required.
+func (h *Handler) admitJob(ctx context.Context, js *sinksv.JobSink, ref types.NamespacedName, key string) (*jobAdmission, error) {
+	if key == "" && js.Spec.MaxRunningJobs == nil {
+		return nil, nil
+	}
+
+	ja, err := h.acquireAdmission(ctx, js)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.admitJobLocked(ctx, js, ref, key); err != nil {
+		ja.release(ctx)
+		return nil, err
+	}
+	return ja, nil
+}
+
+// acquireAdmission waits until it acquires the admission Lease of the JobSink.
+func (h *Handler) acquireAdmission(ctx context.Context, js *sinksv.JobSink) (*jobAdmission, error) {
+	leases := h.k8s.CoordinationV1().Leases(js.GetNamespace())
+	name := kmeta.ChildName(js.GetName(), "-admission")
+	identity := uuid.NewString()
+
+	for {
+		lease, err := tryAcquireLease(ctx, leases, js, name, identity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire lease %s: %w", name, err)
+		}
+		if lease != nil {
+			return &jobAdmission{leases: leases, lease: lease}, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to acquire lease %s: %w", name, ctx.Err())
+		case <-time.After(wait.Jitter(jobAdmissionRetryPeriod, 1.0)):
+		}
+	}
+}
+
+// tryAcquireLease acquires the named Lease when it is missing, released or expired. It
+// returns a nil Lease when the Lease is held, or was acquired concurrently.
+func tryAcquireLease(ctx context.Context, leases coordinationv1client.LeaseInterface, js *sinksv.JobSink, name, identity string) (*coordinationv1.Lease, error) {
+	now := metav1.NowMicro()
+	spec := coordinationv1.LeaseSpec{
+		HolderIdentity:       ptr.To(identity),
+		LeaseDurationSeconds: ptr.To(int32(jobAdmissionLeaseDuration / time.Second)),
+		AcquireTime:          &now,
+		RenewTime:            &now,
+	}
+
+	lease, err := leases.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: js.GetNamespace(),
+				Name:      name,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: sinksv.SchemeGroupVersion.String(),
+					Kind:       "JobSink",
+					Name:       js.GetName(),
+					UID:        js.GetUID(),
+				}},
+			},
+			Spec: spec,
+		}
+		lease, err = leases.Create(ctx, lease, metav1.CreateOptions{})
+		if apierrors.IsAlreadyExists(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return lease, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if isLeaseHeld(lease, now.Time) {
+		return nil, nil
+	}
+
+	// The update is rejected with a conflict when another admission updated the Lease
+	// since it was read.
+	lease = lease.DeepCopy()
+	lease.Spec = spec
+	lease, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+	if apierrors.IsConflict(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+func isLeaseHeld(lease *coordinationv1.Lease, now time.Time) bool {
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity == "" || lease.Spec.RenewTime == nil {
+		return false
+	}
+	duration := jobAdmissionLeaseDuration
+	if lease.Spec.LeaseDurationSeconds != nil {
+		duration = time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+	}
+	return now.Before(lease.Spec.RenewTime.Add(duration))
+}
+
+func (h *Handler) admitJobLocked(ctx context.Context, js *sinksv.JobSink, ref types.NamespacedName, key string) error {
+	// Jobs are listed from the API server rather than the Job informer, which might not
+	// have observed the Jobs admitted by other replicas yet, and which only holds the Jobs
+	// labeled with sinks.JobSinkJobLabel, a label the Jobs created by earlier releases lack.
+	jobs, err := h.k8s.BatchV1().Jobs(ref.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{sinks.JobSinkNameLabel: ref.Name}).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	running := 0
+	var concurrent []string
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		if isJobFinished(job) || job.DeletionTimestamp != nil {
+			continue
+		}
+		running++
+		if key != "" && job.Labels[sinks.JobSinkConcurrencyKeyLabel] == key {
+			concurrent = append(concurrent, job.Name)
+		}
+	}
+
+	if len(concurrent) > 0 {
+		switch js.Spec.ConcurrencyPolicy {
+		case sinksv.ForbidConcurrent:
+			return errConcurrentJob
+		case sinksv.ReplaceConcurrent:
+			sort.Strings(concurrent)
+			for _, name := range concurrent {
+				logging.FromContext(ctx).Debugw("Replacing concurrent job", zap.String("job", name))
+				err := h.k8s.BatchV1().Jobs(ref.Namespace).Delete(ctx, name, metav1.DeleteOptions{
+					PropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+				})
+				if err != nil && !apierrors.IsNotFound(err) {
+					return fmt.Errorf("failed to delete concurrent job %s: %w", name, err)
+				}
+				running--
+			}
+		}
+	}
+
+	if js.Spec.MaxRunningJobs != nil && running >= int(*js.Spec.MaxRunningJobs) {
+		return errMaxRunningJobs
+	}
+	return nil
+}
+
+func isJobFinished(job *batchv1.Job) bool {
+	for _, c := range job.Status.Conditions {
+		if (c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed) && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
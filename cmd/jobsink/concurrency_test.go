@@ -0,0 +1,287 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"knative.dev/eventing/pkg/apis/sinks"
+	sinksv "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+)
+
+const (
+	testNamespace = "test-namespace"
+	testJobSink   = "job-sink"
+)
+
+func TestConcurrencyKey(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetSubject("order-1")
+	event.SetExtension("tenant", "acme")
+
+	testCases := map[string]struct {
+		spec    sinksv.JobSinkSpec
+		wantKey bool
+	}{
+		"allow": {
+			spec: sinksv.JobSinkSpec{ConcurrencyPolicy: sinksv.AllowConcurrent, ConcurrencyKey: "subject"},
+		},
+		"subject": {
+			spec:    sinksv.JobSinkSpec{ConcurrencyPolicy: sinksv.ForbidConcurrent, ConcurrencyKey: "subject"},
+			wantKey: true,
+		},
+		"extension": {
+			spec:    sinksv.JobSinkSpec{ConcurrencyPolicy: sinksv.ReplaceConcurrent, ConcurrencyKey: "tenant"},
+			wantKey: true,
+		},
+		"missing attribute": {
+			spec: sinksv.JobSinkSpec{ConcurrencyPolicy: sinksv.ForbidConcurrent, ConcurrencyKey: "region"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			js := &sinksv.JobSink{Spec: tc.spec}
+			key := concurrencyKey(js, &event)
+			if (key != "") != tc.wantKey {
+				t.Errorf("concurrencyKey() = %q, want key %v", key, tc.wantKey)
+			}
+			if len(key) > 63 {
+				t.Errorf("concurrencyKey() = %q is not a valid label value", key)
+			}
+		})
+	}
+}
+
+func TestAdmitJob(t *testing.T) {
+	testCases := map[string]struct {
+		spec        sinksv.JobSinkSpec
+		key         string
+		jobs        []*batchv1.Job
+		wantErr     error
+		wantDeleted []string
+	}{
+		"no constraints": {
+			spec: sinksv.JobSinkSpec{},
+			jobs: []*batchv1.Job{testJob("running", "k1", false)},
+		},
+		"forbid with running job": {
+			spec:    sinksv.JobSinkSpec{ConcurrencyPolicy: sinksv.ForbidConcurrent},
+			key:     "k1",
+			jobs:    []*batchv1.Job{testJob("running", "k1", false)},
+			wantErr: errConcurrentJob,
+		},
+		"forbid with finished job": {
+			spec: sinksv.JobSinkSpec{ConcurrencyPolicy: sinksv.ForbidConcurrent},
+			key:  "k1",
+			jobs: []*batchv1.Job{testJob("finished", "k1", true)},
+		},
+		"forbid with other key": {
+			spec: sinksv.JobSinkSpec{ConcurrencyPolicy: sinksv.ForbidConcurrent},
+			key:  "k1",
+			jobs: []*batchv1.Job{testJob("running", "k2", false)},
+		},
+		"replace running job": {
+			spec:        sinksv.JobSinkSpec{ConcurrencyPolicy: sinksv.ReplaceConcurrent, MaxRunningJobs: ptr.To[int32](1)},
+			key:         "k1",
+			jobs:        []*batchv1.Job{testJob("running", "k1", false)},
+			wantDeleted: []string{"running"},
+		},
+		"max running jobs reached": {
+			spec: sinksv.JobSinkSpec{MaxRunningJobs: ptr.To[int32](2)},
+			jobs: []*batchv1.Job{
+				testJob("running-1", "", false),
+				testJob("running-2", "", false),
+				testJob("finished", "", true),
+			},
+			wantErr: errMaxRunningJobs,
+		},
+		"max running jobs not reached": {
+			spec: sinksv.JobSinkSpec{MaxRunningJobs: ptr.To[int32](2)},
+			jobs: []*batchv1.Job{
+				testJob("running-1", "", false),
+				testJob("finished", "", true),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			objs := make([]runtime.Object, 0, len(tc.jobs))
+			for _, job := range tc.jobs {
+				objs = append(objs, job)
+			}
+			k8s := fake.NewSimpleClientset(objs...)
+			h := &Handler{k8s: k8s}
+			js := newTestJobSink(tc.spec)
+			ref := types.NamespacedName{Namespace: testNamespace, Name: testJobSink}
+
+			ctx := context.Background()
+			admission, err := h.admitJob(ctx, js, ref, tc.key)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("admitJob() = %v, want %v", err, tc.wantErr)
+			}
+			admission.release(ctx)
+
+			var deleted []string
+			for _, action := range k8s.Actions() {
+				if action.GetVerb() == "delete" {
+					deleted = append(deleted, action.(interface{ GetName() string }).GetName())
+				}
+			}
+			if len(deleted) != len(tc.wantDeleted) {
+				t.Fatalf("deleted jobs = %v, want %v", deleted, tc.wantDeleted)
+			}
+			for i := range deleted {
+				if deleted[i] != tc.wantDeleted[i] {
+					t.Errorf("deleted jobs = %v, want %v", deleted, tc.wantDeleted)
+				}
+			}
+		})
+	}
+}
+
+func TestAdmitJobSerialized(t *testing.T) {
+	ctx := context.Background()
+	k8s := fake.NewSimpleClientset()
+	h := &Handler{k8s: k8s}
+	js := newTestJobSink(sinksv.JobSinkSpec{MaxRunningJobs: ptr.To[int32](1)})
+	ref := types.NamespacedName{Namespace: testNamespace, Name: testJobSink}
+
+	admission, err := h.admitJob(ctx, js, ref, "")
+	if err != nil {
+		t.Fatal("admitJob() =", err)
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := h.admitJob(ctx, js, ref, "")
+		result <- err
+	}()
+
+	select {
+	case err := <-result:
+		t.Fatal("admitJob() returned before the previous admission was released:", err)
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	if _, err := k8s.BatchV1().Jobs(testNamespace).Create(ctx, testJob("job-1", "", false), metav1.CreateOptions{}); err != nil {
+		t.Fatal("Failed to create job:", err)
+	}
+	admission.release(ctx)
+	if err := <-result; !errors.Is(err, errMaxRunningJobs) {
+		t.Fatalf("admitJob() = %v, want %v", err, errMaxRunningJobs)
+	}
+}
+
+func TestAdmitJobLease(t *testing.T) {
+	testCases := map[string]struct {
+		renewed time.Time
+		wantErr bool
+	}{
+		"held by another admission": {
+			renewed: time.Now(),
+			wantErr: true,
+		},
+		"expired": {
+			renewed: time.Now().Add(-2 * jobAdmissionLeaseDuration),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			lease := &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testJobSink + "-admission"},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       ptr.To("other"),
+					LeaseDurationSeconds: ptr.To(int32(jobAdmissionLeaseDuration / time.Second)),
+					RenewTime:            &metav1.MicroTime{Time: tc.renewed},
+				},
+			}
+			h := &Handler{k8s: fake.NewSimpleClientset(lease)}
+			js := newTestJobSink(sinksv.JobSinkSpec{MaxRunningJobs: ptr.To[int32](1)})
+			ref := types.NamespacedName{Namespace: testNamespace, Name: testJobSink}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+
+			admission, err := h.admitJob(ctx, js, ref, "")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("admitJob() = %v, want error %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if holder := admission.lease.Spec.HolderIdentity; holder == nil || *holder == "other" {
+				t.Errorf("lease holder = %v, want the admission", holder)
+			}
+			admission.release(ctx)
+
+			got, err := h.k8s.CoordinationV1().Leases(testNamespace).Get(ctx, lease.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal("Failed to get lease:", err)
+			}
+			if got.Spec.HolderIdentity != nil {
+				t.Errorf("lease holder = %q after release, want none", *got.Spec.HolderIdentity)
+			}
+		})
+	}
+}
+
+func newTestJobSink(spec sinksv.JobSinkSpec) *sinksv.JobSink {
+	return &sinksv.JobSink{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testJobSink},
+		Spec:       spec,
+	}
+}
+
+// testJob returns a Job of the test JobSink lacking the sinks.JobSinkJobLabel label, like
+// the Jobs created by earlier releases.
+func testJob(name, key string, finished bool) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      name,
+			Labels: map[string]string{
+				sinks.JobSinkNameLabel: testJobSink,
+			},
+		},
+	}
+	if key != "" {
+		job.Labels[sinks.JobSinkConcurrencyKeyLabel] = key
+	}
+	if finished {
+		job.Status.Conditions = []batchv1.JobCondition{{
+			Type:   batchv1.JobComplete,
+			Status: corev1.ConditionTrue,
+		}}
+	}
+	return job
+}
@@ -21,6 +21,7 @@ import (
 	"crypto/md5" //nolint:gosec
 	"crypto/tls"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -28,8 +29,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/kelseyhightower/envconfig"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/metric"
+	jobinformer "knative.dev/pkg/client/injection/kube/informers/batch/v1/job/filtered"
 	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
 	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 
@@ -42,6 +45,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	batchv1listers "k8s.io/client-go/listers/batch/v1"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	configmap "knative.dev/pkg/configmap/informer"
 	"knative.dev/pkg/controller"
@@ -59,6 +63,7 @@ import (
 	"knative.dev/eventing/pkg/apis/sinks"
 	sinksv "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
 	"knative.dev/eventing/pkg/auth"
+	"knative.dev/eventing/pkg/claimcheck"
 	eventpolicyinformer "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/sinks/v1alpha1/jobsink"
 	sinkslister "knative.dev/eventing/pkg/client/listers/sinks/v1alpha1"
@@ -76,6 +81,15 @@ const (
 	ScopeName = "knative.dev/cmd/jobsink"
 )
 
+// envConfig is the configuration of the JobSink ingress read from environment variables.
+type envConfig struct {
+	EventFetcherImage string `envconfig:"EVENT_FETCHER_IMAGE"`
+
+	// The claim check store configuration is reused for the event store of the
+	// EmptyDir event storage.
+	claimcheck.EnvConfig
+}
+
 var (
 	latencyBounds = []float64{0.005, 0.01, 0.025, 0.05, 0.075, 0.1, 0.25, 0.5, 0.75, 1, 2.5, 5, 7.5, 10}
 )
@@ -84,10 +98,17 @@ func main() {
 
 	ctx := signals.NewContext()
 
+	var env envConfig
+	if err := envconfig.Process("", &env); err != nil {
+		log.Fatal("Failed to process env var: ", err)
+	}
+
 	cfg := injection.ParseAndGetRESTConfigOrDie()
 	ctx = injection.WithConfig(ctx, cfg)
 	ctx = filteredFactory.WithSelectors(ctx,
 		eventingtls.TrustBundleLabelSelector,
+		eventingtls.ClientCABundleLabelSelector,
+		sinks.JobSinkJobsLabelSelector,
 	)
 
 	ctx, informers := injection.Default.SetupInformers(ctx, cfg)
@@ -141,8 +162,21 @@ func main() {
 	h = &Handler{
 		k8s:          kubeclient.Get(ctx),
 		lister:       jobsink.Get(ctx).Lister(),
+		jobLister:    jobinformer.Get(ctx, sinks.JobSinkJobsLabelSelector).Lister(),
 		withContext:  ctxFunc,
 		authVerifier: auth.NewVerifier(ctx, eventpolicyinformer.Get(ctx).Lister(), trustBundleConfigMapLister, configMapWatcher),
+
+		eventFetcherImage: env.EventFetcherImage,
+	}
+
+	h.eventStore, err = claimcheck.NewStore(env.EnvConfig)
+	if err != nil {
+		logger.Fatal("Failed to create the event store", zap.Error(err))
+	}
+	if h.eventStore != nil {
+		// The events are deleted once pulled or when their Job finishes, rather than
+		// after CLAIM_CHECK_TTL, so that queued or suspended Jobs can still pull them.
+		go h.runEventStoreSweep(ctx)
 	}
 
 	meter := mp.Meter(ScopeName)
@@ -198,9 +232,15 @@ func main() {
 type Handler struct {
 	k8s              kubernetes.Interface
 	lister           sinkslister.JobSinkLister
+	jobLister        batchv1listers.JobLister
 	withContext      func(ctx context.Context) context.Context
 	authVerifier     *auth.Verifier
 	dispatchDuration metric.Float64Histogram
+
+	// eventStore holds the events of the Jobs using the EmptyDir event storage,
+	// they can't be stored when it is nil.
+	eventStore        claimcheck.Store
+	eventFetcherImage string
 }
 
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -215,6 +255,11 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		h.dispatchDuration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(labeler.Get()...))
 	}()
 
+	if r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, eventsPathPrefix) {
+		h.handleEventFetch(ctx, w, r)
+		return
+	}
+
 	if r.Method == http.MethodGet {
 		h.handleGet(ctx, w, r)
 		return
@@ -278,16 +323,13 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	jobName := toJobName(ref.Name, event.Source(), event.ID())
 	logger.Debug("Getting job for event", zap.String("URI", r.RequestURI), zap.String("jobName", jobName))
 
-	jobs, err := h.k8s.BatchV1().Jobs(js.GetNamespace()).List(r.Context(), metav1.ListOptions{
-		LabelSelector: jobLabelSelector(ref, jobName),
-		Limit:         1,
-	})
-	if err != nil {
+	existing, err := h.jobLister.Jobs(js.GetNamespace()).Get(jobName)
+	if err != nil && !apierrors.IsNotFound(err) {
 		logger.Warn("Failed to retrieve job", zap.Error(err))
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	if len(jobs.Items) > 0 {
+	if err == nil && existing.Labels[sinks.JobSinkNameLabel] == ref.Name {
 		w.Header().Add("Location", locationHeader(ref, event.Source(), event.ID()))
 		w.WriteHeader(http.StatusAccepted)
 		return
@@ -303,13 +345,42 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	js = js.DeepCopy() // Do not modify informer copy.
 	js.SetDefaults(ctx)
 
+	key := concurrencyKey(js, event)
+	admission, err := h.admitJob(r.Context(), js, ref, key)
+	if err != nil {
+		if errors.Is(err, errConcurrentJob) || errors.Is(err, errMaxRunningJobs) {
+			logger.Debug("Rejecting event", zap.String("jobName", jobName), zap.Error(err))
+
+			w.Header().Add("Reason", err.Error())
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		logger.Warn("Failed to admit job", zap.Error(err))
+
+		w.Header().Add("Reason", err.Error())
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	defer admission.release(r.Context())
+
 	job := js.Spec.Job.DeepCopy()
 	job.Name = jobName
 	if job.Labels == nil {
 		job.Labels = make(map[string]string, 4)
 	}
+	job.Labels[sinks.JobSinkJobLabel] = "true"
 	job.Labels[sinks.JobSinkIDLabel] = jobName
 	job.Labels[sinks.JobSinkNameLabel] = ref.Name
+	if key != "" {
+		job.Labels[sinks.JobSinkConcurrencyKeyLabel] = key
+	}
+	if job.Annotations == nil {
+		job.Annotations = make(map[string]string, 3)
+	}
+	job.Annotations[sinks.JobSinkEventIDAnnotation] = event.ID()
+	job.Annotations[sinks.JobSinkEventSourceAnnotation] = event.Source()
+	job.Annotations[sinks.JobSinkEventStorageAnnotation] = string(js.Spec.GetEventStorageType())
 	job.OwnerReferences = append(job.OwnerReferences, metav1.OwnerReference{
 		APIVersion:         sinksv.SchemeGroupVersion.String(),
 		Kind:               sinks.JobSinkResource.Resource,
@@ -322,7 +393,7 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	for i := range job.Spec.Template.Spec.Containers {
 		found := false
 		for j := range job.Spec.Template.Spec.Containers[i].VolumeMounts {
-			if job.Spec.Template.Spec.Containers[i].VolumeMounts[j].Name == "jobsink-event" {
+			if job.Spec.Template.Spec.Containers[i].VolumeMounts[j].Name == eventVolumeName {
 				found = true
 				mountPathName = job.Spec.Template.Spec.Containers[i].VolumeMounts[j].MountPath
 				break
@@ -330,34 +401,57 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		if !found {
 			job.Spec.Template.Spec.Containers[i].VolumeMounts = append(job.Spec.Template.Spec.Containers[i].VolumeMounts, corev1.VolumeMount{
-				Name:      "jobsink-event",
+				Name:      eventVolumeName,
 				ReadOnly:  true,
-				MountPath: "/etc/jobsink-event",
+				MountPath: eventVolumePath,
 			})
-			mountPathName = "/etc/jobsink-event"
+			mountPathName = eventVolumePath
 		}
 		job.Spec.Template.Spec.Containers[i].Env = append(job.Spec.Template.Spec.Containers[i].Env, corev1.EnvVar{
 			Name:  "K_EVENT_PATH",
 			Value: mountPathName,
 		})
+		job.Spec.Template.Spec.Containers[i].Env = append(job.Spec.Template.Spec.Containers[i].Env, eventAttributesEnv(js, event)...)
 	}
 
 	found := false
 	for i := range job.Spec.Template.Spec.Volumes {
-		if job.Spec.Template.Spec.Volumes[i].Name == "jobsink-event" {
+		if job.Spec.Template.Spec.Volumes[i].Name == eventVolumeName {
 			found = true
 			break
 		}
 	}
 	if !found {
 		job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, corev1.Volume{
-			Name: "jobsink-event",
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{SecretName: jobName},
-			},
+			Name:         eventVolumeName,
+			VolumeSource: eventVolumeSource(js, jobName),
 		})
 	}
 
+	var token string
+	if js.Spec.GetEventStorageType() == sinksv.EmptyDirEventStorage {
+		// The event is stored before the Job is created, so that it is available
+		// as soon as the init container pulls it.
+		token, err = h.putEvent(r.Context(), ref.Namespace, jobName, eventBytes)
+		if err != nil {
+			logger.Warn("Failed to store event", zap.Error(err))
+
+			w.Header().Add("Reason", err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fetcher, err := h.eventFetcherContainer(js, jobName)
+		if err != nil {
+			logger.Warn("Failed to create event fetcher container", zap.Error(err))
+
+			w.Header().Add("Reason", err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		job.Spec.Template.Spec.InitContainers = append([]corev1.Container{fetcher}, job.Spec.Template.Spec.InitContainers...)
+		job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, eventTokenVolume(jobName))
+	}
+
 	logger.Debug("Creating job for event",
 		zap.String("URI", r.RequestURI),
 		zap.String("jobName", jobName),
@@ -374,50 +468,24 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 	if apierrors.IsAlreadyExists(err) {
 		logger.Debug("Job already exists", zap.String("URI", r.RequestURI), zap.String("jobName", jobName))
-	}
 
-	secret := &corev1.Secret{
-		TypeMeta: metav1.TypeMeta{},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      jobName,
-			Namespace: ref.Namespace,
-			Labels: map[string]string{
-				sinks.JobSinkIDLabel:   jobName,
-				sinks.JobSinkNameLabel: ref.Name,
-			},
-			OwnerReferences: []metav1.OwnerReference{
-				{
-					APIVersion:         "batch/v1",
-					Kind:               "Job",
-					Name:               createdJob.Name,
-					UID:                createdJob.UID,
-					Controller:         ptr.Bool(true),
-					BlockOwnerDeletion: ptr.Bool(false),
-				},
-			},
-		},
-		Immutable: ptr.Bool(true),
-		Data:      map[string][]byte{"event": eventBytes},
-		Type:      corev1.SecretTypeOpaque,
-	}
-
-	logger.Debug("Creating secret for event",
-		zap.String("URI", r.RequestURI),
-		zap.String("jobName", jobName),
-		zap.Any("secret.metadata", secret.ObjectMeta),
-	)
+		createdJob, err = h.k8s.BatchV1().Jobs(ref.Namespace).Get(r.Context(), jobName, metav1.GetOptions{})
+		if err != nil {
+			logger.Warn("Failed to get job", zap.Error(err))
 
-	_, err = h.k8s.CoreV1().Secrets(ref.Namespace).Create(r.Context(), secret, metav1.CreateOptions{})
-	if err != nil && !apierrors.IsAlreadyExists(err) {
-		logger.Warn("Failed to create secret", zap.Error(err))
+			w.Header().Add("Reason", err.Error())
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if err := h.storeEvent(r.Context(), js, ref, createdJob, eventBytes, token); err != nil {
+		logger.Warn("Failed to store event", zap.Error(err))
 
 		w.Header().Add("Reason", err.Error())
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	if apierrors.IsAlreadyExists(err) {
-		logger.Debug("Secret already exists", zap.String("URI", r.RequestURI), zap.String("jobName", jobName))
-	}
 
 	w.Header().Add("Location", locationHeader(ref, event.Source(), event.ID()))
 	w.WriteHeader(http.StatusAccepted)
@@ -487,6 +555,8 @@ func getServerTLSConfig(ctx context.Context) (*tls.Config, error) {
 
 	serverTLSConfig := eventingtls.NewDefaultServerConfig()
 	serverTLSConfig.GetCertificate = eventingtls.GetCertificateFromSecret(ctx, secretinformer.Get(ctx), kubeclient.Get(ctx), secret)
+	serverTLSConfig.ClientCAs = eventingtls.GetClientCAsFromBundles(
+		configmapinformer.Get(ctx, eventingtls.ClientCABundleLabelSelector).Lister().ConfigMaps(system.Namespace()))
 	return eventingtls.GetTLSServerConfig(serverTLSConfig)
 }
 
@@ -500,10 +570,6 @@ func locationHeader(ref types.NamespacedName, source, id string) string {
 	)
 }
 
-func jobLabelSelector(ref types.NamespacedName, id string) string {
-	return fmt.Sprintf("%s=%s,%s=%s", sinks.JobSinkIDLabel, id, sinks.JobSinkNameLabel, ref.Name)
-}
-
 func parseLocation(requestURI string) (types.NamespacedName, string, string, error) {
 	parts := strings.Split(strings.TrimSuffix(requestURI, "/"), "/")
 	if len(parts) != 9 {
@@ -0,0 +1,353 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/ptr"
+
+	"knative.dev/eventing/pkg/apis/sinks"
+	sinksv "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/claimcheck"
+	"knative.dev/eventing/pkg/eventfilter/attributes"
+)
+
+const (
+	// eventsPathPrefix is the path prefix the Job init containers pull the events
+	// of the EmptyDir event storage from. Namespace names can't contain an underscore,
+	// so it never collides with the path of a JobSink.
+	eventsPathPrefix = "/_events/"
+
+	// eventStoreResource is the resource the keys of the events in the event store are
+	// prefixed with.
+	eventStoreResource = "jobs"
+
+	eventVolumeName   = "jobsink-event"
+	eventVolumePath   = "/etc/jobsink-event"
+	eventFetcherName  = "jobsink-event-fetcher"
+	eventAttributeEnv = "K_CE_"
+
+	// The token the init container pulls the event of the EmptyDir event storage with
+	// is mounted from the Secret of the Job.
+	eventTokenVolumeName = "jobsink-event-token"
+	eventTokenVolumePath = "/etc/jobsink-event-token"
+	eventTokenKey        = "token"
+
+	// eventStoreSweepInterval is the interval at which the events of finished or deleted
+	// Jobs are deleted from the event store.
+	eventStoreSweepInterval = time.Minute
+	// eventStoreGracePeriod is the time during which the event of a Job that isn't known
+	// yet is kept, the event is stored before the Job is created.
+	eventStoreGracePeriod = time.Minute
+)
+
+var eventTokenRegexp = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// eventStoreKey returns the key of the event of the given Job in the event store. The key
+// includes the token handed to the Job, so that only the Job can pull its event.
+func eventStoreKey(namespace, jobName, token string) string {
+	return claimcheck.Prefix(eventStoreResource, namespace, jobName) + token
+}
+
+// putEvent stores the event of the given Job in the event store and returns the token
+// required to pull it.
+func (h *Handler) putEvent(ctx context.Context, namespace, jobName string, eventBytes []byte) (string, error) {
+	if h.eventStore == nil {
+		return "", fmt.Errorf("no event store configured for the %s event storage", sinksv.EmptyDirEventStorage)
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	token := hex.EncodeToString(b)
+
+	if _, err := h.eventStore.Put(ctx, eventStoreKey(namespace, jobName, token), eventBytes); err != nil {
+		return "", fmt.Errorf("failed to store event: %w", err)
+	}
+	return token, nil
+}
+
+// sweepEvents deletes the events of the Jobs which are finished or don't exist anymore from
+// the event store.
+func (h *Handler) sweepEvents(ctx context.Context) error {
+	objects, err := h.eventStore.List(ctx, claimcheck.Prefix(eventStoreResource, "", ""))
+	if err != nil {
+		return fmt.Errorf("failed to list events: %w", err)
+	}
+	for _, o := range objects {
+		parts := strings.Split(o.Key, "/")
+		if len(parts) != 4 {
+			continue
+		}
+		namespace, jobName := parts[1], parts[2]
+		job, err := h.jobLister.Jobs(namespace).Get(jobName)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		if apierrors.IsNotFound(err) && time.Since(o.LastModified) < eventStoreGracePeriod {
+			continue
+		}
+		if err == nil && !isJobFinished(job) {
+			continue
+		}
+		if err := h.eventStore.Delete(ctx, h.eventStore.Ref(o.Key)); err != nil {
+			return fmt.Errorf("failed to delete event %s: %w", o.Key, err)
+		}
+	}
+	return nil
+}
+
+// runEventStoreSweep sweeps the event store every eventStoreSweepInterval until the context
+// is done.
+func (h *Handler) runEventStoreSweep(ctx context.Context) {
+	logger := logging.FromContext(ctx)
+	ticker := time.NewTicker(eventStoreSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := h.sweepEvents(ctx); err != nil {
+				logger.Warnw("Failed to sweep the event store", zap.Error(err))
+			}
+		}
+	}
+}
+
+// eventVolumeSource returns the source of the volume holding the event of the given Job.
+func eventVolumeSource(js *sinksv.JobSink, jobName string) corev1.VolumeSource {
+	switch js.Spec.GetEventStorageType() {
+	case sinksv.ConfigMapEventStorage:
+		return corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: jobName},
+			},
+		}
+	case sinksv.EmptyDirEventStorage:
+		return corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+	default:
+		return corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: jobName},
+		}
+	}
+}
+
+// eventTokenVolume returns the volume holding the token the init container pulls the event
+// of the given Job with.
+func eventTokenVolume(jobName string) corev1.Volume {
+	return corev1.Volume{
+		Name: eventTokenVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: jobName},
+		},
+	}
+}
+
+// eventFetcherContainer returns the init container pulling the event of the given Job
+// from the JobSink service into the event volume.
+func (h *Handler) eventFetcherContainer(js *sinksv.JobSink, jobName string) (corev1.Container, error) {
+	if h.eventFetcherImage == "" {
+		return corev1.Container{}, fmt.Errorf("no image configured to pull events for the %s event storage", sinksv.EmptyDirEventStorage)
+	}
+	if js.Status.Address == nil || js.Status.Address.URL == nil {
+		return corev1.Container{}, fmt.Errorf("JobSink %s/%s has no address", js.GetNamespace(), js.GetName())
+	}
+
+	u := *js.Status.Address.URL
+	u.Path = eventsPathPrefix + js.GetNamespace() + "/" + jobName
+
+	env := []corev1.EnvVar{
+		{Name: "K_EVENT_URL", Value: u.String()},
+		{Name: "K_EVENT_TOKEN_PATH", Value: eventTokenVolumePath + "/" + eventTokenKey},
+		{Name: "K_EVENT_PATH", Value: eventVolumePath},
+	}
+	if js.Status.Address.CACerts != nil {
+		env = append(env, corev1.EnvVar{Name: "K_CA_CERTS", Value: *js.Status.Address.CACerts})
+	}
+
+	return corev1.Container{
+		Name:  eventFetcherName,
+		Image: h.eventFetcherImage,
+		Env:   env,
+		VolumeMounts: []corev1.VolumeMount{{
+			Name:      eventVolumeName,
+			MountPath: eventVolumePath,
+		}, {
+			Name:      eventTokenVolumeName,
+			ReadOnly:  true,
+			MountPath: eventTokenVolumePath,
+		}},
+	}, nil
+}
+
+// storeEvent stores the event of the given Job in a Secret or ConfigMap owned by the Job,
+// depending on the JobSink event storage. For the EmptyDir event storage, the event is in
+// the event store and the Secret holds the token to pull it.
+func (h *Handler) storeEvent(ctx context.Context, js *sinksv.JobSink, ref types.NamespacedName, job *batchv1.Job, eventBytes []byte, token string) error {
+	logger := logging.FromContext(ctx).Desugar()
+
+	objectMeta := metav1.ObjectMeta{
+		Name:      job.Name,
+		Namespace: ref.Namespace,
+		Labels: map[string]string{
+			sinks.JobSinkIDLabel:   job.Name,
+			sinks.JobSinkNameLabel: ref.Name,
+		},
+		OwnerReferences: []metav1.OwnerReference{
+			{
+				APIVersion:         "batch/v1",
+				Kind:               "Job",
+				Name:               job.Name,
+				UID:                job.UID,
+				Controller:         ptr.Bool(true),
+				BlockOwnerDeletion: ptr.Bool(false),
+			},
+		},
+	}
+
+	var err error
+	switch js.Spec.GetEventStorageType() {
+	case sinksv.EmptyDirEventStorage:
+		secret := &corev1.Secret{
+			ObjectMeta: objectMeta,
+			Immutable:  ptr.Bool(true),
+			Data:       map[string][]byte{eventTokenKey: []byte(token)},
+			Type:       corev1.SecretTypeOpaque,
+		}
+
+		logger.Debug("Creating secret for event token",
+			zap.String("jobName", job.Name),
+			zap.Any("secret.metadata", secret.ObjectMeta),
+		)
+
+		_, err = h.k8s.CoreV1().Secrets(ref.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+	case sinksv.ConfigMapEventStorage:
+		cm := &corev1.ConfigMap{
+			ObjectMeta: objectMeta,
+			Immutable:  ptr.Bool(true),
+			BinaryData: map[string][]byte{sinks.JobSinkEventKey: eventBytes},
+		}
+
+		logger.Debug("Creating config map for event",
+			zap.String("jobName", job.Name),
+			zap.Any("configmap.metadata", cm.ObjectMeta),
+		)
+
+		_, err = h.k8s.CoreV1().ConfigMaps(ref.Namespace).Create(ctx, cm, metav1.CreateOptions{})
+	default:
+		secret := &corev1.Secret{
+			ObjectMeta: objectMeta,
+			Immutable:  ptr.Bool(true),
+			Data:       map[string][]byte{sinks.JobSinkEventKey: eventBytes},
+			Type:       corev1.SecretTypeOpaque,
+		}
+
+		logger.Debug("Creating secret for event",
+			zap.String("jobName", job.Name),
+			zap.Any("secret.metadata", secret.ObjectMeta),
+		)
+
+		_, err = h.k8s.CoreV1().Secrets(ref.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+	}
+	if apierrors.IsAlreadyExists(err) {
+		logger.Debug("Event already stored", zap.String("jobName", job.Name))
+		return nil
+	}
+	return err
+}
+
+// eventAttributesEnv returns the environment variables holding the JobSink event attributes
+// of the given event.
+func eventAttributesEnv(js *sinksv.JobSink, event *cloudevents.Event) []corev1.EnvVar {
+	var env []corev1.EnvVar
+	for _, attr := range js.Spec.EventAttributes {
+		v, ok := attributes.LookupAttribute(*event, attr)
+		if !ok {
+			continue
+		}
+		s := fmt.Sprintf("%v", v)
+		if s == "" {
+			continue
+		}
+		env = append(env, corev1.EnvVar{
+			Name:  eventAttributeEnv + strings.ToUpper(attr),
+			Value: s,
+		})
+	}
+	return env
+}
+
+// handleEventFetch serves the events of the EmptyDir event storage to the Job init containers.
+func (h *Handler) handleEventFetch(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	logger := logging.FromContext(ctx)
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, eventsPathPrefix), "/")
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || !eventTokenRegexp.MatchString(token) {
+		logger.Infow("Malformed event request", zap.String("path", r.URL.Path))
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if h.eventStore == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	key := eventStoreKey(parts[0], parts[1], token)
+	data, err := h.eventStore.Get(ctx, h.eventStore.Ref(key))
+	if errors.Is(err, claimcheck.ErrNotFound) {
+		logger.Debugw("Event not found", zap.String("path", r.URL.Path))
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		logger.Warnw("Failed to get event", zap.String("path", r.URL.Path), zap.Error(err))
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/cloudevents+json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		logger.Debugw("Failed to write event", zap.String("path", r.URL.Path), zap.Error(err))
+		return
+	}
+
+	// The event is pulled once, it is otherwise deleted when the Job finishes.
+	if err := h.eventStore.Delete(ctx, h.eventStore.Ref(key)); err != nil {
+		logger.Warnw("Failed to delete event", zap.String("path", r.URL.Path), zap.Error(err))
+	}
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	batchv1listers "k8s.io/client-go/listers/batch/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/eventing/pkg/apis/sinks"
+	sinksv "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/claimcheck"
+)
+
+func TestHandleEventFetch(t *testing.T) {
+	ctx := context.Background()
+	store, err := claimcheck.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{eventStore: store}
+	token, err := h.putEvent(ctx, testNamespace, "job", []byte("event"))
+	if err != nil {
+		t.Fatal("putEvent() =", err)
+	}
+	otherToken := strings.Repeat("0", 64)
+
+	testCases := map[string]struct {
+		handler  *Handler
+		path     string
+		token    string
+		wantCode int
+		wantBody string
+	}{
+		"wrong token": {
+			path:     eventsPathPrefix + testNamespace + "/job",
+			token:    otherToken,
+			wantCode: http.StatusNotFound,
+		},
+		"malformed token": {
+			path:     eventsPathPrefix + testNamespace + "/job",
+			token:    "../../token",
+			wantCode: http.StatusBadRequest,
+		},
+		"malformed path": {
+			path:     eventsPathPrefix + testNamespace + "/job/" + token,
+			token:    token,
+			wantCode: http.StatusBadRequest,
+		},
+		"not found": {
+			path:     eventsPathPrefix + testNamespace + "/other-job",
+			token:    token,
+			wantCode: http.StatusNotFound,
+		},
+		"no event store": {
+			handler:  &Handler{},
+			path:     eventsPathPrefix + testNamespace + "/job",
+			token:    token,
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	fetch := func(handler *Handler, path, token string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, path, nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		handler.handleEventFetch(ctx, w, r)
+		return w
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			handler := h
+			if tc.handler != nil {
+				handler = tc.handler
+			}
+			w := fetch(handler, tc.path, tc.token)
+
+			if w.Code != tc.wantCode {
+				t.Errorf("handleEventFetch() code = %d, want %d", w.Code, tc.wantCode)
+			}
+			if got := w.Body.String(); got != tc.wantBody {
+				t.Errorf("handleEventFetch() body = %q, want %q", got, tc.wantBody)
+			}
+		})
+	}
+
+	// The event is deleted once pulled.
+	path := eventsPathPrefix + testNamespace + "/job"
+	if w := fetch(h, path, token); w.Code != http.StatusOK || w.Body.String() != "event" {
+		t.Errorf("handleEventFetch() = %d %q, want %d %q", w.Code, w.Body.String(), http.StatusOK, "event")
+	}
+	if w := fetch(h, path, token); w.Code != http.StatusNotFound {
+		t.Errorf("handleEventFetch() of a pulled event code = %d, want %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestSweepEvents(t *testing.T) {
+	ctx := context.Background()
+	store, err := claimcheck.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	jobs := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	h := &Handler{eventStore: store, jobLister: batchv1listers.NewJobLister(jobs)}
+
+	put := func(jobName string, age time.Duration) string {
+		t.Helper()
+		token, err := h.putEvent(ctx, testNamespace, jobName, []byte("event"))
+		if err != nil {
+			t.Fatal("putEvent() =", err)
+		}
+		key := eventStoreKey(testNamespace, jobName, token)
+		path, _ := strings.CutPrefix(store.Ref(key), "file://")
+		mtime := time.Now().Add(-age)
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+		return key
+	}
+	addJob := func(name string, conditions ...batchv1.JobCondition) {
+		t.Helper()
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: name}}
+		job.Status.Conditions = conditions
+		if err := jobs.Add(job); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Jobs queued or suspended for longer than the claim check TTL keep their event.
+	addJob("running")
+	running := put("running", 48*time.Hour)
+	addJob("complete", batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue})
+	put("complete", time.Minute)
+	addJob("failed", batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue})
+	put("failed", time.Minute)
+	// The Job of a recent event might not be known yet.
+	pending := put("pending", 0)
+	put("deleted", time.Hour)
+
+	if err := h.sweepEvents(ctx); err != nil {
+		t.Fatal("sweepEvents() =", err)
+	}
+
+	objects, err := store.List(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, o := range objects {
+		got = append(got, o.Key)
+	}
+	want := []string{pending, running}
+	sort.Strings(got)
+	sort.Strings(want)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Error("unexpected events (-want, +got):", diff)
+	}
+}
+
+func TestPutEventWithoutStore(t *testing.T) {
+	h := &Handler{}
+	if _, err := h.putEvent(context.Background(), testNamespace, "job", []byte("event")); err == nil {
+		t.Error("putEvent() without event store succeeded")
+	}
+}
+
+func TestStoreEvent(t *testing.T) {
+	ref := types.NamespacedName{Namespace: testNamespace, Name: testJobSink}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "job", UID: "uid"},
+	}
+
+	testCases := map[string]struct {
+		storage       sinksv.EventStorageType
+		wantSecret    map[string][]byte
+		wantConfigMap bool
+	}{
+		"secret": {
+			storage:    sinksv.SecretEventStorage,
+			wantSecret: map[string][]byte{sinks.JobSinkEventKey: []byte("event")},
+		},
+		"config map": {
+			storage:       sinksv.ConfigMapEventStorage,
+			wantConfigMap: true,
+		},
+		"empty dir": {
+			storage:    sinksv.EmptyDirEventStorage,
+			wantSecret: map[string][]byte{eventTokenKey: []byte("token")},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			k8s := fake.NewSimpleClientset()
+			h := &Handler{k8s: k8s}
+			js := &sinksv.JobSink{
+				ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testJobSink},
+				Spec: sinksv.JobSinkSpec{
+					EventStorage: &sinksv.JobSinkEventStorage{Type: tc.storage},
+				},
+			}
+
+			if err := h.storeEvent(ctx, js, ref, job, []byte("event"), "token"); err != nil {
+				t.Fatal("storeEvent() =", err)
+			}
+			// Storing the event twice is a no-op.
+			if err := h.storeEvent(ctx, js, ref, job, []byte("event"), "token"); err != nil {
+				t.Fatal("storeEvent() =", err)
+			}
+
+			secret, err := k8s.CoreV1().Secrets(testNamespace).Get(ctx, "job", metav1.GetOptions{})
+			if (tc.wantSecret != nil) != (err == nil) {
+				t.Errorf("Secret exists = %v, want %v", err == nil, tc.wantSecret != nil)
+			}
+			if tc.wantSecret != nil && !reflect.DeepEqual(secret.Data, tc.wantSecret) {
+				t.Errorf("unexpected Secret data %v", secret.Data)
+			}
+
+			cm, err := k8s.CoreV1().ConfigMaps(testNamespace).Get(ctx, "job", metav1.GetOptions{})
+			if tc.wantConfigMap != (err == nil) {
+				t.Errorf("ConfigMap exists = %v, want %v", err == nil, tc.wantConfigMap)
+			}
+			if tc.wantConfigMap && string(cm.BinaryData[sinks.JobSinkEventKey]) != "event" {
+				t.Errorf("unexpected ConfigMap data %v", cm.BinaryData)
+			}
+		})
+	}
+}
+
+func TestEventFetcherContainer(t *testing.T) {
+	js := &sinksv.JobSink{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testJobSink},
+	}
+	js.Status.Address = &duckv1.Addressable{
+		URL: apis.HTTP("job-sink.knative-eventing.svc.cluster.local"),
+	}
+	js.Status.Address.URL.Path = "/" + testNamespace + "/" + testJobSink
+
+	h := &Handler{}
+	if _, err := h.eventFetcherContainer(js, "job"); err == nil {
+		t.Error("eventFetcherContainer() without image succeeded")
+	}
+
+	h.eventFetcherImage = "fetcher"
+	c, err := h.eventFetcherContainer(js, "job")
+	if err != nil {
+		t.Fatal("eventFetcherContainer() =", err)
+	}
+	want := []corev1.EnvVar{
+		{Name: "K_EVENT_URL", Value: "http://job-sink.knative-eventing.svc.cluster.local/_events/test-namespace/job"},
+		{Name: "K_EVENT_TOKEN_PATH", Value: eventTokenVolumePath + "/" + eventTokenKey},
+		{Name: "K_EVENT_PATH", Value: eventVolumePath},
+	}
+	if diff := cmp.Diff(want, c.Env); diff != "" {
+		t.Error("unexpected env (-want, +got):", diff)
+	}
+	wantMounts := []corev1.VolumeMount{
+		{Name: eventVolumeName, MountPath: eventVolumePath},
+		{Name: eventTokenVolumeName, ReadOnly: true, MountPath: eventTokenVolumePath},
+	}
+	if diff := cmp.Diff(wantMounts, c.VolumeMounts); diff != "" {
+		t.Error("unexpected volume mounts (-want, +got):", diff)
+	}
+}
+
+func TestEventAttributesEnv(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetType("order.created")
+	event.SetExtension("tenant", "acme")
+
+	js := &sinksv.JobSink{
+		Spec: sinksv.JobSinkSpec{
+			EventAttributes: []string{"type", "tenant", "subject"},
+		},
+	}
+
+	want := []corev1.EnvVar{
+		{Name: "K_CE_TYPE", Value: "order.created"},
+		{Name: "K_CE_TENANT", Value: "acme"},
+	}
+	if diff := cmp.Diff(want, eventAttributesEnv(js, &event)); diff != "" {
+		t.Error("unexpected env (-want, +got):", diff)
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// jobsink_event_fetcher is the init container of the Jobs of JobSinks using the
+// EmptyDir event storage. It pulls the event from the JobSink service and writes
+// it into the event volume.
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// eventFile is the name of the file the event is written to.
+	eventFile = "event"
+
+	maxAttempts  = 30
+	initialDelay = 500 * time.Millisecond
+	maxDelay     = 10 * time.Second
+)
+
+func main() {
+	url := mustGetenv("K_EVENT_URL")
+	dir := mustGetenv("K_EVENT_PATH")
+
+	// The token is mounted from the Secret of the Job, rather than set in its spec.
+	token, err := os.ReadFile(mustGetenv("K_EVENT_TOKEN_PATH"))
+	if err != nil {
+		log.Fatal("Failed to read token: ", err)
+	}
+
+	client, err := newClient(os.Getenv("K_CA_CERTS"))
+	if err != nil {
+		log.Fatal("Failed to create HTTP client: ", err)
+	}
+
+	delay := initialDelay
+	for attempt := 1; ; attempt++ {
+		data, err := fetch(client, url, strings.TrimSpace(string(token)))
+		if err == nil {
+			if err := os.WriteFile(filepath.Join(dir, eventFile), data, 0o644); err != nil {
+				log.Fatal("Failed to write event: ", err)
+			}
+			return
+		}
+		if attempt == maxAttempts {
+			log.Fatal("Failed to fetch event: ", err)
+		}
+
+		// The JobSink service or the event store might be temporarily
+		// unavailable, retry with a backoff.
+		log.Printf("Failed to fetch event, attempt %d/%d: %v", attempt, maxAttempts, err)
+		time.Sleep(delay)
+		delay = min(2*delay, maxDelay)
+	}
+}
+
+func fetch(client *http.Client, url, token string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+func newClient(caCerts string) (*http.Client, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	if caCerts == "" {
+		return client, nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM([]byte(caCerts)) {
+		return nil, fmt.Errorf("failed to parse CA certs")
+	}
+	client.Transport = &http.Transport{
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    pool,
+		},
+	}
+	return client, nil
+}
+
+func mustGetenv(name string) string {
+	v := os.Getenv(name)
+	if v == "" {
+		log.Fatalf("Environment variable %s is not set", name)
+	}
+	return v
+}
@@ -25,6 +25,7 @@ import (
 	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 	"knative.dev/pkg/signals"
 
+	"knative.dev/eventing/pkg/reconciler/autoscaler"
 	"knative.dev/eventing/pkg/reconciler/broker"
 	mttrigger "knative.dev/eventing/pkg/reconciler/broker/trigger"
 )
@@ -37,7 +38,9 @@ func main() {
 	ctx := signals.NewContext()
 
 	ctx = filteredFactory.WithSelectors(ctx,
-		auth.OIDCLabelSelector)
+		auth.OIDCLabelSelector,
+		autoscaler.PodLabelSelector,
+	)
 
 	sharedmain.MainWithContext(ctx,
 		component,
@@ -45,5 +48,7 @@ func main() {
 		broker.NewController,
 
 		mttrigger.NewController,
+
+		autoscaler.NewBrokerController,
 	)
 }
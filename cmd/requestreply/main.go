@@ -64,6 +64,7 @@ func main() {
 
 	ctx = filteredfactory.WithSelectors(ctx,
 		eventingtls.TrustBundleLabelSelector,
+		eventingtls.ClientCABundleLabelSelector,
 	)
 
 	log.Printf("Registering %d clients", len(injection.Default.GetClients()))
@@ -91,6 +92,8 @@ func main() {
 	configMapWatcher := configmap.NewInformedWatcher(kubeClient, system.Namespace())
 
 	configMapWatcher.Watch(logging.ConfigMapName(), logging.UpdateLevelFromConfigMap(sl, atomicLevel, "request-reply"))
+	// Watch the TLS policies applied to destinations.
+	kncloudevents.WatchTLSPolicies(sl, configMapWatcher)
 
 	trustBundleConfigMapLister := configmapinformer.Get(ctx, eventingtls.TrustBundleLabelSelector).Lister().ConfigMaps(system.Namespace())
 
@@ -152,6 +155,8 @@ func getServerTLSConfig(ctx context.Context) (*tls.Config, error) {
 
 	serverTLSConfig := eventingtls.NewDefaultServerConfig()
 	serverTLSConfig.GetCertificate = eventingtls.GetCertificateFromSecret(ctx, secretinformer.Get(ctx), kubeclient.Get(ctx), secret)
+	serverTLSConfig.ClientCAs = eventingtls.GetClientCAsFromBundles(
+		configmapinformer.Get(ctx, eventingtls.ClientCABundleLabelSelector).Lister().ConfigMaps(system.Namespace()))
 	return eventingtls.GetTLSServerConfig(serverTLSConfig)
 }
 
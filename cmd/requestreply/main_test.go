@@ -17,19 +17,27 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"testing"
 
+	filteredfactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 	reconcilertesting "knative.dev/pkg/reconciler/testing"
 
+	"knative.dev/eventing/pkg/eventingtls"
+
 	// Fake injection informers and clients
 	_ "knative.dev/pkg/client/injection/kube/client/fake"
+	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered/fake"
+	_ "knative.dev/pkg/client/injection/kube/informers/factory/filtered/fake"
 	_ "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret/fake"
 )
 
 func TestGetServerTLSConfig(t *testing.T) {
 	t.Setenv("SYSTEM_NAMESPACE", "knative-eventing")
 
-	ctx, _ := reconcilertesting.SetupFakeContext(t)
+	ctx, _ := reconcilertesting.SetupFakeContext(t, func(ctx context.Context) context.Context {
+		return filteredfactory.WithSelectors(ctx, eventingtls.ClientCABundleLabelSelector)
+	})
 
 	tlsConfig, err := getServerTLSConfig(ctx)
 	if err != nil {
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+	"knative.dev/pkg/logging"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/eventing/pkg/scheduler/statefulset"
+)
+
+// scheduler_simulator previews the placements, evictions and replicas computed by the
+// statefulset scheduler and autoscaler for a cluster snapshot, without accessing the
+// API server.
+//
+// Usage:
+//
+//	scheduler_simulator -snapshot snapshot.yaml [-pod-capacity 20] [-min-replicas 2] [-plugins NodeSpread]
+func main() {
+	snapshotPath := flag.String("snapshot", "-", "Path of the YAML cluster snapshot, - for stdin.")
+	podCapacity := flag.Int("pod-capacity", 0, "Overrides config.podCapacity.")
+	minReplicas := flag.Int("min-replicas", 0, "Overrides config.minReplicas.")
+	podLoadCapacity := flag.Float64("pod-load-capacity", 0, "Overrides config.podLoadCapacity.")
+	plugins := flag.String("plugins", "", "Overrides config.plugins, comma separated.")
+	verbose := flag.Bool("verbose", false, "Logs the scheduler and autoscaler decisions to stderr.")
+	flag.Parse()
+
+	snapshot, err := readSnapshot(*snapshotPath)
+	if err != nil {
+		log.Fatal("Failed to read snapshot: ", err)
+	}
+
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "pod-capacity":
+			snapshot.Config.PodCapacity = int32(*podCapacity) //nolint:gosec // G115: capacities are small.
+		case "min-replicas":
+			snapshot.Config.MinReplicas = int32(*minReplicas) //nolint:gosec // G115: replicas are small.
+		case "pod-load-capacity":
+			snapshot.Config.PodLoadCapacity = *podLoadCapacity
+		case "plugins":
+			snapshot.Config.Plugins = nil
+			if *plugins != "" {
+				snapshot.Config.Plugins = strings.Split(*plugins, ",")
+			}
+		}
+	})
+
+	logger := zap.NewNop()
+	if *verbose {
+		if logger, err = zap.NewDevelopment(); err != nil {
+			log.Fatal("Failed to create logger: ", err)
+		}
+	}
+	ctx := logging.WithLogger(context.Background(), logger.Sugar())
+
+	result, err := statefulset.Simulate(ctx, snapshot)
+	if err != nil {
+		log.Fatal("Failed to simulate: ", err)
+	}
+
+	out, err := yaml.Marshal(result)
+	if err != nil {
+		log.Fatal("Failed to marshal result: ", err)
+	}
+	fmt.Print(string(out))
+}
+
+func readSnapshot(path string) (*statefulset.Snapshot, error) {
+	var (
+		b   []byte
+		err error
+	)
+	if path == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &statefulset.Snapshot{}
+	if err := yaml.UnmarshalStrict(b, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
@@ -323,6 +323,7 @@ func main() {
 	ctx = filteredFactory.WithSelectors(ctx,
 		auth.OIDCLabelSelector,
 		eventingtls.TrustBundleLabelSelector,
+		sourcesv1.SinkBindingOutputLabelSelector,
 	)
 
 	sharedmain.WebhookMainWithContext(ctx, webhook.NameFromEnv(),
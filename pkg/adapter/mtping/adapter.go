@@ -29,10 +29,12 @@ import (
 	"go.uber.org/zap"
 
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	secretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered"
 	"knative.dev/pkg/logging"
 
 	"knative.dev/eventing/pkg/adapter/v2"
 	sourcesv1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/eventingtls"
 )
 
 const (
@@ -63,7 +65,11 @@ func NewAdapter(ctx context.Context, env adapter.EnvConfigAccessor, ceClient clo
 		cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
 	))
 
-	runner := NewCronJobsRunner(adapter.GetClientConfig(ctx), kubeclient.Get(ctx), logging.FromContext(ctx), opts)
+	clientConfig := adapter.GetClientConfig(ctx)
+	clientConfig.ClientCertificates = eventingtls.NewClientCertificates(
+		secretinformer.Get(ctx, eventingtls.ClientCertificateLabelSelector).Lister())
+
+	runner := NewCronJobsRunner(clientConfig, kubeclient.Get(ctx), logging.FromContext(ctx), opts)
 
 	return &mtpingAdapter{
 		logger:    logger,
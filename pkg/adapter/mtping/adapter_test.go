@@ -29,14 +29,18 @@ import (
 	"github.com/robfig/cron/v3"
 
 	_ "knative.dev/pkg/client/injection/kube/client/fake"
+	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered/fake"
+	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
+	_ "knative.dev/pkg/client/injection/kube/informers/factory/filtered/fake"
 	"knative.dev/pkg/logging"
 	rectesting "knative.dev/pkg/reconciler/testing"
 
 	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/eventing/pkg/eventingtls"
 )
 
 func TestStartStopAdapter(t *testing.T) {
-	ctx, _ := rectesting.SetupFakeContext(t)
+	ctx, _ := rectesting.SetupFakeContext(t, setUpInformerSelector)
 	ctx, cancel := context.WithCancel(ctx)
 	envCfg := NewEnvConfig()
 
@@ -62,7 +66,7 @@ func TestStartStopAdapter(t *testing.T) {
 }
 
 func TestUpdateRemoveAdapter(t *testing.T) {
-	ctx, _ := rectesting.SetupFakeContext(t)
+	ctx, _ := rectesting.SetupFakeContext(t, setUpInformerSelector)
 	adapter := mtpingAdapter{
 		logger:    logging.FromContext(ctx),
 		runner:    &testRunner{},
@@ -100,3 +104,7 @@ func (*testRunner) AddSchedule(*sourcesv1.PingSource) cron.EntryID {
 	return cron.EntryID(1)
 }
 func (*testRunner) RemoveSchedule(cron.EntryID) {}
+
+func setUpInformerSelector(ctx context.Context) context.Context {
+	return filteredFactory.WithSelectors(ctx, eventingtls.ClientCertificateLabelSelector)
+}
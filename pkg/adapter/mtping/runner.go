@@ -21,6 +21,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"math/rand"
+	"sync"
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
@@ -101,9 +102,14 @@ func (a *cronJobsRunner) AddSchedule(source *sourcesv1.PingSource) cron.EntryID
 	ctx = observability.WithSpanData(ctx, spanName, int(trace.SpanKindProducer),
 		observability.K8sAttributes(source.Name, source.Namespace, sourcesv1.Resource("pingsource").String()))
 
-	schedule := source.Spec.Schedule
-	if source.Spec.Timezone != "" {
-		schedule = "CRON_TZ=" + source.Spec.Timezone + " " + schedule
+	schedule, err := source.Spec.CronSchedule()
+	if err != nil {
+		a.Logger.Desugar().Error("Failed to parse schedule",
+			zap.String("name", source.GetName()),
+			zap.String("namespace", source.GetNamespace()),
+			zap.Error(err),
+		)
+		return -1
 	}
 
 	ctx = kncloudevents.ContextWithMetricTag(ctx, metricTag)
@@ -118,7 +124,26 @@ func (a *cronJobsRunner) AddSchedule(source *sourcesv1.PingSource) cron.EntryID
 		return -1
 	}
 
-	id, _ := a.cron.AddFunc(schedule, a.cronTick(ctx, client, source, event))
+	tick := a.cronTick(ctx, client, source, event)
+	if !source.Spec.IsOneShot() {
+		return a.cron.Schedule(schedule, cron.FuncJob(tick))
+	}
+
+	// One-shot schedules are removed once the event has been sent.
+	// The lock guarantees the entry id is known before the tick completes.
+	var (
+		mu sync.Mutex
+		id cron.EntryID
+	)
+	mu.Lock()
+	defer mu.Unlock()
+	id = a.cron.Schedule(schedule, cron.FuncJob(func() {
+		tick()
+
+		mu.Lock()
+		defer mu.Unlock()
+		a.RemoveSchedule(id)
+	}))
 	return id
 }
 
@@ -162,7 +187,9 @@ func (a *cronJobsRunner) cronTick(ctx context.Context, client kncloudevents.Clie
 		ctx = observability.WithMinimalEventLabels(ctx, &event)
 
 		if result := client.Send(ctx, event); !cloudevents.IsACK(result) {
-			// Exhausted number of retries. Event is lost.
+			// Exhausted number of retries. PingSource events aren't buffered while
+			// the sink is unavailable, the event is lost and the next tick sends a
+			// new one.
 			a.Logger.Error("failed to send cloudevent result: ", zap.Any("result", result),
 				zap.String("source", source), zap.String("target", src.Status.SinkURI.String()), zap.String("id", event.ID()))
 		}
@@ -230,6 +257,8 @@ func (a *cronJobsRunner) newPingSourceClient(source *sourcesv1.PingSource) (adap
 		Options:                    a.clientConfig.Options,
 		TrustBundleConfigMapLister: a.clientConfig.TrustBundleConfigMapLister,
 		TokenProvider:              a.clientConfig.TokenProvider,
+		ClientCertificates:         a.clientConfig.ClientCertificates,
+		TLSPolicies:                a.clientConfig.TLSPolicies,
 	}
 
 	return adapter.NewClient(cfg)
@@ -176,7 +176,7 @@ func TestAddRunRemoveSchedules(t *testing.T) {
 	}
 	for n, tc := range testCases {
 		t.Run(n, func(t *testing.T) {
-			ctx, _ := rectesting.SetupFakeContext(t)
+			ctx, _ := rectesting.SetupFakeContext(t, setUpInformerSelector)
 			logger := logging.FromContext(ctx)
 
 			h, events := eventsAccumulator()
@@ -208,9 +208,57 @@ func TestAddRunRemoveSchedules(t *testing.T) {
 	}
 }
 
+func TestRunOneShotSchedule(t *testing.T) {
+	ctx, _ := rectesting.SetupFakeContext(t, setUpInformerSelector)
+	logger := logging.FromContext(ctx)
+
+	h, events := eventsAccumulator()
+
+	s := httptest.NewServer(h)
+	defer s.Close()
+	url, _ := apis.ParseURL(s.URL)
+
+	src := &sourcesv1.PingSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-name",
+			Namespace: "test-ns",
+		},
+		Spec: sourcesv1.PingSourceSpec{
+			SourceSpec: duckv1.SourceSpec{
+				CloudEventOverrides: &duckv1.CloudEventOverrides{},
+			},
+			RunAt:       &metav1.Time{Time: time.Now().Add(time.Hour)},
+			ContentType: cloudevents.TextPlain,
+			Data:        sampleData,
+		},
+		Status: sourcesv1.PingSourceStatus{
+			SourceStatus: duckv1.SourceStatus{
+				SinkURI: url,
+			},
+		},
+	}
+
+	runner := NewCronJobsRunner(adapter.ClientConfig{}, kubeclient.Get(ctx), logger)
+	entryId := runner.AddSchedule(src)
+
+	entry := runner.cron.Entry(entryId)
+	if entry.ID != entryId {
+		t.Fatal("Entry has not been added")
+	}
+
+	entry.Job.Run()
+
+	validateSent(t, *events, []byte(sampleData), cloudevents.TextPlain, nil)
+
+	entry = runner.cron.Entry(entryId)
+	if entry.ID == entryId {
+		t.Error("One-shot entry has not been removed")
+	}
+}
+
 func TestSendEventsTLS(t *testing.T) {
 
-	ctx, _ := rectesting.SetupFakeContext(t)
+	ctx, _ := rectesting.SetupFakeContext(t, setUpInformerSelector)
 	eventsChan := make(chan cloudevents.Event, 10)
 	handler := eventingtlstesting.EventChannelHandler(eventsChan)
 	events := make([]cloudevents.Event, 0, 8)
@@ -310,7 +358,7 @@ func TestSendEventsTLS(t *testing.T) {
 }
 
 func TestStartStopCron(t *testing.T) {
-	ctx, _ := rectesting.SetupFakeContext(t)
+	ctx, _ := rectesting.SetupFakeContext(t, setUpInformerSelector)
 	logger := logging.FromContext(ctx)
 
 	runner := NewCronJobsRunner(adapter.ClientConfig{}, kubeclient.Get(ctx), logger)
@@ -338,7 +386,7 @@ func TestStartStopCronDelayWait(t *testing.T) {
 	if seconds > threeSecondsTillNextMinCronJob {
 		time.Sleep(time.Second * 4) // ward off edge cases
 	}
-	ctx, _ := rectesting.SetupFakeContext(t)
+	ctx, _ := rectesting.SetupFakeContext(t, setUpInformerSelector)
 	logger := logging.FromContext(ctx)
 
 	h, events := eventsAccumulator()
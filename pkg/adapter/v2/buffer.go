@@ -0,0 +1,494 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/cloudevents/sdk-go/v2/protocol/http"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	bufferScopeName = "knative.dev/eventing/pkg/adapter/v2"
+
+	bufferRetryInitialDelay = 100 * time.Millisecond
+	bufferRetryMaxDelay     = 30 * time.Second
+
+	spilledEventSuffix = ".event"
+)
+
+// ErrBuffered is the result of Send when a BufferedClient buffered the event
+// instead of delivering it. It is not an acknowledgement: the event is only
+// held by the adapter, and is lost if it stops without spilling it. Adapters
+// committing checkpoints must wait for the DeliveryCallback instead.
+var ErrBuffered = errors.New("event buffered until the sink is available")
+
+// IsBuffered returns whether result reports an event buffered by a
+// BufferedClient.
+func IsBuffered(result protocol.Result) bool {
+	return errors.Is(result, ErrBuffered)
+}
+
+// DeliveryCallback is called with the result of the delivery of an event
+// buffered by a BufferedClient. Events carrying a DeliveryCallback are never
+// spilled to disk, since the callback can't be persisted: they are only
+// buffered in memory, and are left to the source, for example to be read
+// again from its last checkpoint, when the adapter stops before delivering
+// them. The callback isn't called for them.
+type DeliveryCallback func(result protocol.Result)
+
+type deliveryCallbackKey struct{}
+
+// ContextWithDeliveryCallback returns a context making a BufferedClient call
+// cb once the event sent with it is delivered, when Send returned ErrBuffered.
+func ContextWithDeliveryCallback(ctx context.Context, cb DeliveryCallback) context.Context {
+	return context.WithValue(ctx, deliveryCallbackKey{}, cb)
+}
+
+func deliveryCallbackFrom(ctx context.Context) DeliveryCallback {
+	cb, _ := ctx.Value(deliveryCallbackKey{}).(DeliveryCallback)
+	return cb
+}
+
+// SinkBufferConfigAccessor is implemented by the EnvConfigAccessor of adapters
+// buffering the events sent while the sink is unavailable, such as EnvConfig.
+// MainWithInformers wraps the client of the adapter in a BufferedClient when
+// the buffer size is positive.
+//
+// Adapters started with MainMessageAdapter send messages to the sink on their
+// own and aren't buffered, and neither are the PingSource events, which are
+// superseded by the next tick of the schedule.
+type SinkBufferConfigAccessor interface {
+	// GetSinkBufferConfig returns the configuration of the buffer of the
+	// events sent while the sink is unavailable.
+	GetSinkBufferConfig() SinkBufferConfig
+}
+
+// SinkBufferConfig configures the buffering of the events sent while the sink
+// is unavailable.
+type SinkBufferConfig struct {
+	// Size is the number of events buffered in memory. Buffering is disabled
+	// when it is not positive.
+	Size int
+
+	// SpillDir is the directory events are spilled to once the memory buffer
+	// is full. Events are only buffered in memory when it is empty, or when
+	// they carry a DeliveryCallback.
+	SpillDir string
+
+	// SpillSize is the number of events spilled to SpillDir.
+	SpillSize int
+}
+
+// BufferedClient is a Client buffering the events sent while the sink is
+// unavailable, instead of dropping them.
+type BufferedClient interface {
+	Client
+
+	// Start delivers the buffered events until ctx is done. In-memory events
+	// without a DeliveryCallback are then spilled to disk, if configured, to
+	// be delivered after a restart.
+	Start(ctx context.Context)
+}
+
+// bufferedClient sends events directly to the sink as long as its buffer is
+// empty. Events the sink failed to receive with a retryable error, and every
+// event sent after them, are buffered and delivered in order by Start. Send
+// blocks once the buffer is full, applying backpressure to the source.
+type bufferedClient struct {
+	Client
+
+	// sendLock serializes Send, so that an event sent directly is never
+	// delivered before the events buffered by a concurrent Send.
+	sendLock sync.Mutex
+
+	queue *eventQueue
+	lock  sync.Mutex
+	// changed is closed, and replaced, every time the queue changes.
+	changed chan struct{}
+
+	droppedEvents metric.Int64Counter
+}
+
+var _ BufferedClient = (*bufferedClient)(nil)
+
+// NewBufferedClient returns a BufferedClient sending events with client.
+// Events spilled to cfg.SpillDir by a previous instance are delivered first.
+func NewBufferedClient(client Client, cfg SinkBufferConfig, meterProvider metric.MeterProvider) (BufferedClient, error) {
+	if cfg.Size <= 0 {
+		return nil, fmt.Errorf("buffer size must be positive, got %d", cfg.Size)
+	}
+	queue, err := newEventQueue(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &bufferedClient{
+		Client:  client,
+		queue:   queue,
+		changed: make(chan struct{}),
+	}
+
+	meter := meterProvider.Meter(bufferScopeName)
+
+	_, err = meter.Int64ObservableGauge(
+		"kn.eventing.adapter.buffer.depth",
+		metric.WithDescription("The number of events buffered until the sink is available"),
+		metric.WithUnit("{event}"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			c.lock.Lock()
+			defer c.lock.Unlock()
+			o.Observe(int64(c.queue.len()))
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.droppedEvents, err = meter.Int64Counter(
+		"kn.eventing.adapter.buffer.dropped",
+		metric.WithDescription("The number of buffered events dropped because the sink rejected them"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Send sends out directly when the buffer is empty, and buffers it when the
+// sink is unavailable or when previous events are still buffered. ErrBuffered
+// is returned once out is buffered, the DeliveryCallback of ctx is called
+// when it is delivered.
+func (c *bufferedClient) Send(ctx context.Context, out event.Event) protocol.Result {
+	c.sendLock.Lock()
+	defer c.sendLock.Unlock()
+
+	c.lock.Lock()
+	empty := c.queue.len() == 0
+	c.lock.Unlock()
+
+	if empty {
+		res := c.Client.Send(ctx, out)
+		if !isRetryableResult(res) {
+			return res
+		}
+		logging.FromContext(ctx).Infow("Sink unavailable, buffering event", zap.String("id", out.ID()), zap.Error(res))
+	}
+	if err := c.enqueue(ctx, out, deliveryCallbackFrom(ctx)); err != nil {
+		return err
+	}
+	return ErrBuffered
+}
+
+// enqueue appends out to the buffer, waiting for room when the buffer is full.
+func (c *bufferedClient) enqueue(ctx context.Context, out event.Event, cb DeliveryCallback) error {
+	for {
+		c.lock.Lock()
+		ok, err := c.queue.push(out, cb)
+		changed := c.changed
+		if ok {
+			c.notifyLocked()
+		}
+		c.lock.Unlock()
+
+		if err != nil || ok {
+			return err
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return fmt.Errorf("buffer full: %w", ctx.Err())
+		}
+	}
+}
+
+// notifyLocked wakes up the goroutines waiting for the queue to change.
+func (c *bufferedClient) notifyLocked() {
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+func (c *bufferedClient) Start(ctx context.Context) {
+	logger := logging.FromContext(ctx)
+	delay := bufferRetryInitialDelay
+
+	for {
+		c.lock.Lock()
+		next, cb, err := c.queue.peek()
+		changed := c.changed
+		c.lock.Unlock()
+
+		if err != nil {
+			logger.Errorw("Failed to load buffered event, dropping it", zap.Error(err))
+			c.droppedEvents.Add(ctx, 1)
+			c.pop(logger)
+			continue
+		}
+		if next == nil {
+			select {
+			case <-changed:
+				continue
+			case <-ctx.Done():
+				c.spill(logger)
+				return
+			}
+		}
+
+		res := c.Client.Send(ctx, *next)
+		if isRetryableResult(res) {
+			select {
+			case <-time.After(delay):
+				delay = min(2*delay, bufferRetryMaxDelay)
+				continue
+			case <-ctx.Done():
+				c.spill(logger)
+				return
+			}
+		}
+		if !cloudevents.IsACK(res) {
+			logger.Warnw("Buffered event rejected by the sink, dropping it", zap.String("id", next.ID()), zap.Error(res))
+			c.droppedEvents.Add(ctx, 1)
+		}
+		delay = bufferRetryInitialDelay
+		c.pop(logger)
+		if cb != nil {
+			cb(res)
+		}
+	}
+}
+
+func (c *bufferedClient) pop(logger *zap.SugaredLogger) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.queue.pop(); err != nil {
+		logger.Warnw("Failed to remove delivered event from the spill directory", zap.Error(err))
+	}
+	c.notifyLocked()
+}
+
+func (c *bufferedClient) spill(logger *zap.SugaredLogger) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.queue.spill(); err != nil {
+		logger.Errorw("Failed to spill buffered events, they are lost", zap.Error(err))
+	}
+}
+
+// isRetryableResult returns whether result reports an unavailable sink. Client
+// errors other than 408 and 429 are permanent, retrying them would block the
+// buffer forever.
+func isRetryableResult(result protocol.Result) bool {
+	if cloudevents.IsACK(result) {
+		return false
+	}
+
+	var rres *http.RetriesResult
+	if cloudevents.ResultAs(result, &rres) {
+		result = rres.Result
+	}
+
+	var res *http.Result
+	if !cloudevents.ResultAs(result, &res) {
+		// The sink couldn't be reached.
+		return true
+	}
+	switch res.StatusCode {
+	case 408, 429:
+		return true
+	default:
+		return res.StatusCode >= 500
+	}
+}
+
+// bufferedEvent is an event of an eventQueue. Events are numbered in the
+// order they are queued, and events loaded from the spill directory keep
+// their file until they are delivered.
+type bufferedEvent struct {
+	event   cloudevents.Event
+	seq     uint64
+	spilled bool
+	// onDelivered is only kept in memory, events carrying it are never
+	// spilled.
+	onDelivered DeliveryCallback
+}
+
+// eventQueue is a FIFO of events kept in memory up to size events and then
+// spilled to dir. All spilled events are more recent than the in-memory ones:
+// events are only added to memory while nothing is spilled. Events carrying a
+// DeliveryCallback are only kept in memory.
+type eventQueue struct {
+	memory []bufferedEvent
+	size   int
+
+	dir       string
+	spilled   []uint64
+	spillSize int
+	nextSeq   uint64
+}
+
+func newEventQueue(cfg SinkBufferConfig) (*eventQueue, error) {
+	q := &eventQueue{
+		memory:    make([]bufferedEvent, 0, cfg.Size),
+		size:      cfg.Size,
+		dir:       cfg.SpillDir,
+		spillSize: cfg.SpillSize,
+	}
+	if q.dir == "" {
+		return q, nil
+	}
+
+	if err := os.MkdirAll(q.dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create spill directory %s: %w", q.dir, err)
+	}
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spill directory %s: %w", q.dir, err)
+	}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), spilledEventSuffix)
+		if !ok {
+			continue
+		}
+		if seq, err := strconv.ParseUint(name, 10, 64); err == nil {
+			q.spilled = append(q.spilled, seq)
+		}
+	}
+	sort.Slice(q.spilled, func(i, j int) bool { return q.spilled[i] < q.spilled[j] })
+	if len(q.spilled) > 0 {
+		q.nextSeq = q.spilled[len(q.spilled)-1] + 1
+	}
+	return q, nil
+}
+
+func (q *eventQueue) len() int {
+	return len(q.memory) + len(q.spilled)
+}
+
+// push appends e to the queue, and returns false when the queue is full. An
+// event with a DeliveryCallback waits for room in memory instead of being
+// spilled.
+func (q *eventQueue) push(e cloudevents.Event, cb DeliveryCallback) (bool, error) {
+	if len(q.spilled) == 0 && len(q.memory) < q.size {
+		q.memory = append(q.memory, bufferedEvent{event: e, seq: q.nextSeq, onDelivered: cb})
+		q.nextSeq++
+		return true, nil
+	}
+	if cb != nil || q.dir == "" || len(q.spilled) >= q.spillSize {
+		return false, nil
+	}
+	if err := q.write(e, q.nextSeq); err != nil {
+		return false, fmt.Errorf("failed to spill event: %w", err)
+	}
+	q.spilled = append(q.spilled, q.nextSeq)
+	q.nextSeq++
+	return true, nil
+}
+
+// peek returns the oldest event and its delivery callback, or nil when the
+// queue is empty.
+func (q *eventQueue) peek() (*cloudevents.Event, DeliveryCallback, error) {
+	if len(q.memory) == 0 && len(q.spilled) > 0 {
+		seq := q.spilled[0]
+		file := q.path(seq)
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		e := cloudevents.NewEvent()
+		if err := e.UnmarshalJSON(b); err != nil {
+			return nil, nil, fmt.Errorf("invalid spilled event %s: %w", file, err)
+		}
+		q.spilled = q.spilled[1:]
+		q.memory = append(q.memory, bufferedEvent{event: e, seq: seq, spilled: true})
+	}
+	if len(q.memory) == 0 {
+		return nil, nil, nil
+	}
+	return &q.memory[0].event, q.memory[0].onDelivered, nil
+}
+
+// pop removes the oldest event.
+func (q *eventQueue) pop() error {
+	if len(q.memory) == 0 {
+		if len(q.spilled) == 0 {
+			return nil
+		}
+		// The oldest spilled event couldn't be loaded.
+		seq := q.spilled[0]
+		q.spilled = q.spilled[1:]
+		return os.Remove(q.path(seq))
+	}
+
+	e := q.memory[0]
+	q.memory[0] = bufferedEvent{}
+	q.memory = q.memory[1:]
+	if e.spilled {
+		return os.Remove(q.path(e.seq))
+	}
+	return nil
+}
+
+// spill writes the in-memory events to the spill directory, except the ones
+// carrying a DeliveryCallback, which are left to their source.
+func (q *eventQueue) spill() error {
+	if q.dir == "" {
+		return nil
+	}
+
+	var errs []error
+	for _, e := range q.memory {
+		if e.spilled || e.onDelivered != nil {
+			continue
+		}
+		if err := q.write(e.event, e.seq); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (q *eventQueue) write(e cloudevents.Event, seq uint64) error {
+	b, err := e.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(q.dir, q.path(seq), b)
+}
+
+func (q *eventQueue) path(seq uint64) string {
+	return filepath.Join(q.dir, fmt.Sprintf("%020d%s", seq, spilledEventSuffix))
+}
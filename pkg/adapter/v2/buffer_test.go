@@ -0,0 +1,399 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"github.com/cloudevents/sdk-go/v2/protocol/http"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"knative.dev/eventing/pkg/adapter/v2/test"
+)
+
+// sinkClient is a Client delivering events while the sink is available.
+type sinkClient struct {
+	*test.TestCloudEventsClient
+
+	available atomic.Bool
+	lock      sync.Mutex
+	delivered []string
+}
+
+func (c *sinkClient) Send(ctx context.Context, out cloudevents.Event) protocol.Result {
+	if !c.available.Load() {
+		return errors.New("connection refused")
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.delivered = append(c.delivered, out.ID())
+	return http.NewResult(202, "%w", protocol.ResultACK)
+}
+
+func (c *sinkClient) Delivered() []string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return append([]string(nil), c.delivered...)
+}
+
+func newBufferTestEvent(id string) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetSource("unit/test")
+	event.SetType("unit.type")
+	return event
+}
+
+func waitDelivered(t *testing.T, sink *sinkClient, want ...string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for len(sink.Delivered()) < len(want) && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	got := sink.Delivered()
+	if len(got) != len(want) {
+		t.Fatalf("delivered %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("delivered %v, want %v", got, want)
+		}
+	}
+}
+
+func TestBufferedClientSendsDirectly(t *testing.T) {
+	sink := &sinkClient{}
+	sink.available.Store(true)
+	c, err := NewBufferedClient(sink, SinkBufferConfig{Size: 1}, noop.NewMeterProvider())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Without Start, events can only be delivered directly.
+	for _, id := range []string{"1", "2", "3"} {
+		if res := c.Send(context.Background(), newBufferTestEvent(id)); !cloudevents.IsACK(res) {
+			t.Fatal("Send() =", res)
+		}
+	}
+	waitDelivered(t, sink, "1", "2", "3")
+}
+
+func TestBufferedClientDrainsInOrder(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	sink := &sinkClient{}
+	c, err := NewBufferedClient(sink, SinkBufferConfig{Size: 10}, sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{"1", "2", "3"} {
+		if res := c.Send(context.Background(), newBufferTestEvent(id)); !IsBuffered(res) {
+			t.Fatal("Send() =", res)
+		}
+	}
+	if got := bufferDepth(t, reader); got != 3 {
+		t.Errorf("buffer depth = %d, want 3", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.Start(ctx)
+
+	sink.available.Store(true)
+	waitDelivered(t, sink, "1", "2", "3")
+	if got := bufferDepth(t, reader); got != 0 {
+		t.Errorf("buffer depth = %d, want 0", got)
+	}
+}
+
+func TestBufferedClientBackpressure(t *testing.T) {
+	sink := &sinkClient{}
+	c, err := NewBufferedClient(sink, SinkBufferConfig{Size: 1}, noop.NewMeterProvider())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res := c.Send(context.Background(), newBufferTestEvent("1")); !IsBuffered(res) {
+		t.Fatal("Send() =", res)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if res := c.Send(ctx, newBufferTestEvent("2")); !errors.Is(res, context.DeadlineExceeded) {
+		t.Fatalf("Send() on a full buffer = %v, want %v", res, context.DeadlineExceeded)
+	}
+
+	// Send unblocks once the sink recovers and the buffer is drained.
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	go c.Start(ctx)
+
+	sent := make(chan protocol.Result)
+	go func() { sent <- c.Send(context.Background(), newBufferTestEvent("3")) }()
+	sink.available.Store(true)
+	if res := <-sent; !IsBuffered(res) {
+		t.Fatal("Send() =", res)
+	}
+	waitDelivered(t, sink, "1", "3")
+}
+
+func TestBufferedClientSpillsToDisk(t *testing.T) {
+	dir := t.TempDir()
+	cfg := SinkBufferConfig{Size: 1, SpillDir: dir, SpillSize: 2}
+
+	sink := &sinkClient{}
+	c, err := NewBufferedClient(sink, cfg, noop.NewMeterProvider())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		c.Start(ctx)
+		close(done)
+	}()
+
+	for _, id := range []string{"1", "2", "3"} {
+		if res := c.Send(context.Background(), newBufferTestEvent(id)); !IsBuffered(res) {
+			t.Fatal("Send() =", res)
+		}
+	}
+	full, fullCancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer fullCancel()
+	if res := c.Send(full, newBufferTestEvent("4")); IsBuffered(res) {
+		t.Fatal("Send() on a full buffer succeeded")
+	}
+
+	// Stopping spills the in-memory events, which are delivered in order by
+	// the next client.
+	cancel()
+	<-done
+
+	sink.available.Store(true)
+	c, err = NewBufferedClient(sink, cfg, noop.NewMeterProvider())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	go c.Start(ctx)
+
+	waitDelivered(t, sink, "1", "2", "3")
+}
+
+func TestBufferedClientDeliveryCallback(t *testing.T) {
+	sink := &sinkClient{}
+	c, err := NewBufferedClient(sink, SinkBufferConfig{Size: 10}, noop.NewMeterProvider())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := NewFileCheckpointStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if err := SendAndCommit(ctx, c, store, "key", "1", newBufferTestEvent("1")); err != nil {
+		t.Fatal("SendAndCommit() =", err)
+	}
+	// The event is only buffered, the checkpoint must not be committed yet.
+	if got, err := store.Load(ctx, "key"); err != nil || got != "" {
+		t.Fatalf("Load() = %q, %v, want no checkpoint", got, err)
+	}
+
+	startCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go c.Start(startCtx)
+	sink.available.Store(true)
+	waitDelivered(t, sink, "1")
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if got, _ := store.Load(ctx, "key"); got == "1" {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("checkpoint of the buffered event not committed once delivered")
+}
+
+func TestBufferedClientDoesNotSpillDeliveryCallbacks(t *testing.T) {
+	dir := t.TempDir()
+	cfg := SinkBufferConfig{Size: 1, SpillDir: dir, SpillSize: 2}
+
+	sink := &sinkClient{}
+	c, err := NewBufferedClient(sink, cfg, noop.NewMeterProvider())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := NewFileCheckpointStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		c.Start(ctx)
+		close(done)
+	}()
+
+	if err := SendAndCommit(context.Background(), c, store, "key", "1", newBufferTestEvent("1")); err != nil {
+		t.Fatal("SendAndCommit() =", err)
+	}
+	// The memory buffer is full, an event with a delivery callback waits for
+	// room rather than being spilled.
+	full, fullCancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer fullCancel()
+	if err := SendAndCommit(full, c, store, "key", "2", newBufferTestEvent("2")); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("SendAndCommit() on a full buffer = %v, want %v", err, context.DeadlineExceeded)
+	}
+	// Events without a delivery callback are still spilled.
+	if res := c.Send(context.Background(), newBufferTestEvent("3")); !IsBuffered(res) {
+		t.Fatal("Send() =", res)
+	}
+
+	// Stopping only spills the event without a delivery callback, the other
+	// ones are sent again by their source from their checkpoint.
+	cancel()
+	<-done
+
+	sink.available.Store(true)
+	c, err = NewBufferedClient(sink, cfg, noop.NewMeterProvider())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	go c.Start(ctx)
+
+	waitDelivered(t, sink, "3")
+	if got, err := store.Load(context.Background(), "key"); err != nil || got != "" {
+		t.Errorf("Load() = %q, %v, want no checkpoint", got, err)
+	}
+}
+
+func TestBufferedClientConcurrentSends(t *testing.T) {
+	sink := &sinkClient{}
+	c, err := NewBufferedClient(sink, SinkBufferConfig{Size: 100}, noop.NewMeterProvider())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.Start(ctx)
+
+	// Events sent concurrently while the sink recovers are all delivered,
+	// either directly or from the buffer.
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if res := c.Send(context.Background(), newBufferTestEvent("e")); !cloudevents.IsACK(res) && !IsBuffered(res) {
+				t.Error("Send() =", res)
+			}
+		}()
+		if i == 10 {
+			sink.available.Store(true)
+		}
+	}
+	wg.Wait()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for len(sink.Delivered()) < 20 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := len(sink.Delivered()); got != 20 {
+		t.Fatalf("delivered %d events, want 20", got)
+	}
+}
+
+func TestIsRetryableResult(t *testing.T) {
+	tests := map[string]struct {
+		result protocol.Result
+		want   bool
+	}{
+		"ack": {
+			result: http.NewResult(202, "%w", protocol.ResultACK),
+		},
+		"nil": {},
+		"bad request": {
+			result: http.NewResult(400, "%w", protocol.ResultNACK),
+		},
+		"not found": {
+			result: http.NewResult(404, "%w", protocol.ResultNACK),
+		},
+		"request timeout": {
+			result: http.NewResult(408, "%w", protocol.ResultNACK),
+			want:   true,
+		},
+		"unavailable": {
+			result: http.NewResult(503, "%w", protocol.ResultNACK),
+			want:   true,
+		},
+		"too many requests": {
+			result: http.NewResult(429, "%w", protocol.ResultNACK),
+			want:   true,
+		},
+		"unreachable": {
+			result: errors.New("connection refused"),
+			want:   true,
+		},
+		"retries": {
+			result: http.NewRetriesResult(http.NewResult(502, "%w", protocol.ResultNACK), 3, time.Now(), nil),
+			want:   true,
+		},
+	}
+	for n, tc := range tests {
+		t.Run(n, func(t *testing.T) {
+			if got := isRetryableResult(tc.result); got != tc.want {
+				t.Errorf("isRetryableResult() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func bufferDepth(t *testing.T, reader sdkmetric.Reader) int64 {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatal(err)
+	}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "kn.eventing.adapter.buffer.depth" {
+				continue
+			}
+			if gauge, ok := m.Data.(metricdata.Gauge[int64]); ok && len(gauge.DataPoints) > 0 {
+				return gauge.DataPoints[0].Value
+			}
+		}
+	}
+	t.Fatal("buffer depth metric not found")
+	return 0
+}
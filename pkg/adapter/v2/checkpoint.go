@@ -0,0 +1,267 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+)
+
+// CheckpointStore persists the position, such as an offset or a cursor,
+// a source reached in its upstream system, so that an adapter resumes from
+// the last delivered event after a restart.
+//
+// A committed checkpoint stands for all the events before it, so the events
+// of a key must be sent sequentially: the next event of a key is sent once
+// SendAndCommit returned for the previous one. Concurrent sends for the same
+// key could commit the checkpoint of a later event before an earlier one is
+// acknowledged, losing the earlier event on restart. Events of different keys
+// can be sent concurrently. A BufferedClient delivers events in order, so
+// buffered events keep this guarantee.
+type CheckpointStore interface {
+	// Load returns the checkpoint last committed for key, or an empty
+	// string when none was committed.
+	Load(ctx context.Context, key string) (string, error)
+
+	// Commit stores checkpoint as the position reached for key.
+	Commit(ctx context.Context, key, checkpoint string) error
+}
+
+// SendAndCommit sends event and, once the sink acknowledged it, commits
+// checkpoint for key. The checkpoint is left untouched when the event is not
+// acknowledged, so that the adapter delivers it again: events are delivered
+// at least once. When the event is buffered by a BufferedClient, nil is
+// returned and the checkpoint is committed once the event is delivered.
+// SendAndCommit must not be called concurrently for the same key, see
+// CheckpointStore.
+func SendAndCommit(ctx context.Context, client cloudevents.Client, store CheckpointStore, key, checkpoint string, event cloudevents.Event) error {
+	logger := logging.FromContext(ctx)
+	// The commit of a buffered event outlives the context of Send.
+	commitCtx := context.WithoutCancel(ctx)
+	ctx = ContextWithDeliveryCallback(ctx, func(result protocol.Result) {
+		if err := CommitIfACK(commitCtx, store, key, checkpoint, result); err != nil {
+			logger.Warnw("Failed to commit the checkpoint of a buffered event", zap.String("key", key), zap.Error(err))
+		}
+	})
+
+	result := client.Send(ctx, event)
+	if IsBuffered(result) {
+		return nil
+	}
+	return CommitIfACK(ctx, store, key, checkpoint, result)
+}
+
+// CommitIfACK commits checkpoint for key when result acknowledges the
+// delivery of the corresponding event, and returns result otherwise. It is
+// meant for adapters sending messages on their own, such as the ones started
+// with MainMessageAdapter. ErrBuffered is not an acknowledgement. As with
+// SendAndCommit, the events of a key must be sent sequentially.
+func CommitIfACK(ctx context.Context, store CheckpointStore, key, checkpoint string, result protocol.Result) error {
+	if !cloudevents.IsACK(result) {
+		if result == nil {
+			return errors.New("event not acknowledged")
+		}
+		return result
+	}
+	if err := store.Commit(ctx, key, checkpoint); err != nil {
+		return fmt.Errorf("failed to commit checkpoint %q for %q: %w", checkpoint, key, err)
+	}
+	return nil
+}
+
+// NewCheckpointStoreFromEnv returns the checkpoint store configured in env, or
+// nil when none is configured.
+func NewCheckpointStoreFromEnv(ctx context.Context, env EnvConfigAccessor) (CheckpointStore, error) {
+	configMap, dir := env.GetCheckpointConfigMap(), env.GetCheckpointDir()
+	switch {
+	case configMap != "" && dir != "":
+		return nil, fmt.Errorf("only one of %s and %s can be set", EnvConfigCheckpointConfigMap, EnvConfigCheckpointDir)
+	case dir != "":
+		return NewFileCheckpointStore(dir)
+	case configMap == "":
+		return nil, nil
+	}
+
+	cfg := injection.GetConfig(ctx)
+	if cfg == nil {
+		var err error
+		if cfg, err = rest.InClusterConfig(); err != nil {
+			return nil, fmt.Errorf("failed to get the cluster config: %w", err)
+		}
+	}
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the kube client: %w", err)
+	}
+	return NewConfigMapCheckpointStore(kubeClient, env.GetNamespace(), configMap), nil
+}
+
+// fileCheckpointStore stores each checkpoint in its own file, for adapters
+// mounting a persistent volume.
+type fileCheckpointStore struct {
+	dir  string
+	lock sync.Mutex
+}
+
+var _ CheckpointStore = (*fileCheckpointStore)(nil)
+
+// NewFileCheckpointStore returns a CheckpointStore keeping checkpoints in
+// files of dir, which is created if it does not exist.
+func NewFileCheckpointStore(dir string) (CheckpointStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create checkpoint directory %s: %w", dir, err)
+	}
+	return &fileCheckpointStore{dir: dir}, nil
+}
+
+func (s *fileCheckpointStore) Load(_ context.Context, key string) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	b, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read checkpoint for %q: %w", key, err)
+	}
+	return string(b), nil
+}
+
+func (s *fileCheckpointStore) Commit(_ context.Context, key, checkpoint string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return writeFileAtomic(s.dir, s.path(key), []byte(checkpoint))
+}
+
+// writeFileAtomic writes data to a temporary file of dir first and renames it
+// to path, so that a crash never leaves a partially written file behind.
+func writeFileAtomic(dir, path string, data []byte) error {
+	f, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func (s *fileCheckpointStore) path(key string) string {
+	return filepath.Join(s.dir, strings.ReplaceAll(url.PathEscape(key), ".", "%2E"))
+}
+
+// configMapCheckpointStore stores checkpoints as the data of a ConfigMap.
+type configMapCheckpointStore struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+	name       string
+}
+
+var _ CheckpointStore = (*configMapCheckpointStore)(nil)
+
+// NewConfigMapCheckpointStore returns a CheckpointStore keeping checkpoints
+// in the data of the ConfigMap namespace/name, which is created on the first
+// commit. Keys must be valid ConfigMap keys.
+func NewConfigMapCheckpointStore(kubeClient kubernetes.Interface, namespace, name string) CheckpointStore {
+	return &configMapCheckpointStore{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		name:       name,
+	}
+}
+
+func (s *configMapCheckpointStore) Load(ctx context.Context, key string) (string, error) {
+	cm, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).Get(ctx, s.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get checkpoint ConfigMap %s/%s: %w", s.namespace, s.name, err)
+	}
+	return cm.Data[key], nil
+}
+
+func (s *configMapCheckpointStore) Commit(ctx context.Context, key, checkpoint string) error {
+	if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+		return fmt.Errorf("invalid checkpoint key %q: %s", key, strings.Join(errs, ", "))
+	}
+
+	configMaps := s.kubeClient.CoreV1().ConfigMaps(s.namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := configMaps.Get(ctx, s.name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: s.namespace,
+					Name:      s.name,
+				},
+				Data: map[string]string{key: checkpoint},
+			}
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+			if apierrors.IsAlreadyExists(err) {
+				// Created concurrently, retry as an update.
+				return apierrors.NewConflict(corev1.Resource("configmaps"), s.name, err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		if cm.Data[key] == checkpoint {
+			return nil
+		}
+		cm = cm.DeepCopy()
+		if cm.Data == nil {
+			cm.Data = make(map[string]string, 1)
+		}
+		cm.Data[key] = checkpoint
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"knative.dev/eventing/pkg/adapter/v2/test"
+)
+
+func TestFileCheckpointStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := NewFileCheckpointStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCheckpointStore(t, store, "../partition/0")
+
+	// A new store on the same directory resumes from the committed checkpoint.
+	store, err = NewFileCheckpointStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := store.Load(ctx, "../partition/0"); err != nil || got != "43" {
+		t.Fatalf("Load() = %q, %v, want 43", got, err)
+	}
+}
+
+func TestConfigMapCheckpointStore(t *testing.T) {
+	ctx := context.Background()
+	kubeClient := fake.NewSimpleClientset()
+
+	store := NewConfigMapCheckpointStore(kubeClient, "ns", "checkpoints")
+	testCheckpointStore(t, store, "partition-0")
+
+	cm, err := kubeClient.CoreV1().ConfigMaps("ns").Get(ctx, "checkpoints", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cm.Data["partition-0"]; got != "43" {
+		t.Errorf("ConfigMap data = %q, want 43", got)
+	}
+
+	if err := store.Commit(ctx, "partition/0", "1"); err == nil {
+		t.Error("Commit() with an invalid key succeeded")
+	}
+}
+
+func testCheckpointStore(t *testing.T, store CheckpointStore, key string) {
+	t.Helper()
+	ctx := context.Background()
+
+	if got, err := store.Load(ctx, key); err != nil || got != "" {
+		t.Fatalf("Load() = %q, %v, want empty checkpoint", got, err)
+	}
+	for _, checkpoint := range []string{"42", "43"} {
+		if err := store.Commit(ctx, key, checkpoint); err != nil {
+			t.Fatal("Commit() =", err)
+		}
+		if got, err := store.Load(ctx, key); err != nil || got != checkpoint {
+			t.Fatalf("Load() = %q, %v, want %s", got, err, checkpoint)
+		}
+	}
+	if got, err := store.Load(ctx, key+"-other"); err != nil || got != "" {
+		t.Fatalf("Load() of another key = %q, %v, want empty checkpoint", got, err)
+	}
+}
+
+func TestSendAndCommit(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileCheckpointStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := test.NewTestClient()
+
+	event := cloudevents.NewEvent()
+	event.SetID("abc-123")
+	event.SetSource("unit/test")
+	event.SetType("unit.type")
+	if err := SendAndCommit(ctx, client, store, "key", "1", event); err != nil {
+		t.Fatal("SendAndCommit() =", err)
+	}
+
+	event.SetType("unit.sendFail")
+	if err := SendAndCommit(ctx, client, store, "key", "2", event); err == nil {
+		t.Fatal("SendAndCommit() of a rejected event succeeded")
+	}
+
+	if got, err := store.Load(ctx, "key"); err != nil || got != "1" {
+		t.Fatalf("Load() = %q, %v, want 1", got, err)
+	}
+	if got := len(client.Sent()); got != 2 {
+		t.Errorf("sent %d events, want 2", got)
+	}
+}
+
+func TestCommitIfACK(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileCheckpointStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nack := errors.New("sink unavailable")
+	if err := CommitIfACK(ctx, store, "key", "1", nack); !errors.Is(err, nack) {
+		t.Fatalf("CommitIfACK() = %v, want %v", err, nack)
+	}
+	if err := CommitIfACK(ctx, store, "key", "2", cloudevents.ResultACK); err != nil {
+		t.Fatal("CommitIfACK() =", err)
+	}
+	if got, err := store.Load(ctx, "key"); err != nil || got != "2" {
+		t.Fatalf("Load() = %q, %v, want 2", got, err)
+	}
+}
+
+func TestNewCheckpointStoreFromEnv(t *testing.T) {
+	ctx := context.Background()
+
+	if store, err := NewCheckpointStoreFromEnv(ctx, &EnvConfig{}); err != nil || store != nil {
+		t.Errorf("NewCheckpointStoreFromEnv() = %v, %v, want no store", store, err)
+	}
+	if store, err := NewCheckpointStoreFromEnv(ctx, &EnvConfig{CheckpointDir: t.TempDir()}); err != nil || store == nil {
+		t.Errorf("NewCheckpointStoreFromEnv() = %v, %v, want a file store", store, err)
+	}
+	if _, err := NewCheckpointStoreFromEnv(ctx, &EnvConfig{CheckpointDir: t.TempDir(), CheckpointConfigMap: "checkpoints"}); err == nil {
+		t.Error("NewCheckpointStoreFromEnv() with both stores succeeded")
+	}
+}
@@ -128,12 +128,20 @@ type ClientConfig struct {
 	CeOverrides         *duckv1.CloudEventOverrides
 	Reporter            source.StatsReporter
 	CrStatusEventClient *crstatusevent.CRStatusEventClient
+	LivenessReporter    *crstatusevent.LivenessReporter
 	Options             []http.Option
 	TokenProvider       *auth.OIDCTokenProvider
 	MeterProvider       metric.MeterProvider
 	TraceProvider       trace.TracerProvider
 
 	TrustBundleConfigMapLister corev1listers.ConfigMapNamespaceLister
+
+	// ClientCertificates provides the client certificate of the OIDC identity of the
+	// adapter, it takes precedence over the client certificate directory.
+	ClientCertificates *eventingtls.ClientCertificates
+
+	// TLSPolicies are the TLS policies applied to the sink.
+	TLSPolicies *eventingtls.TLSPolicies
 }
 
 type clientConfigKey struct{}
@@ -164,10 +172,22 @@ func NewClient(cfg ClientConfig) (Client, error) {
 			pOpts = append(pOpts, setTimeOut(time.Duration(sinkWait)*time.Second))
 		}
 
+		// A TLS policy matching a plain http sink can't be enforced.
+		tlsPolicy, err := cfg.TLSPolicies.ForURL(cfg.Env.GetSink())
+		if err != nil {
+			return nil, err
+		}
+
 		if eventingtls.IsHttpsSink(cfg.Env.GetSink()) {
 			clientConfig := eventingtls.NewDefaultClientConfig()
 			clientConfig.CACerts = cfg.Env.GetCACerts()
+			clientConfig.TLSPolicy = tlsPolicy
 			clientConfig.TrustBundleConfigMapLister = cfg.TrustBundleConfigMapLister
+			if sa := cfg.Env.GetOIDCServiceAccountName(); sa != nil && cfg.ClientCertificates != nil {
+				clientConfig.ClientCertificate = cfg.ClientCertificates.Get(*sa)
+			} else if certPath := cfg.Env.GetClientCertPath(); certPath != "" {
+				clientConfig.ClientCertificate = eventingtls.GetClientCertificateFromDir(certPath)
+			}
 
 			base.DialTLSContext = func(ctx context.Context, net, addr string) (net.Conn, error) {
 				tlsConfig, err := eventingtls.GetTLSClientConfig(clientConfig)
@@ -231,6 +251,7 @@ func NewClient(cfg ClientConfig) (Client, error) {
 		ceOverrides:         ceOverrides,
 		reporter:            cfg.Reporter,
 		crStatusEventClient: cfg.CrStatusEventClient,
+		livenessReporter:    cfg.LivenessReporter,
 		oidcTokenProvider:   cfg.TokenProvider,
 		scheme:              "http",
 	}
@@ -268,6 +289,7 @@ type client struct {
 	ceOverrides            *duckv1.CloudEventOverrides
 	reporter               source.StatsReporter
 	crStatusEventClient    *crstatusevent.CRStatusEventClient
+	livenessReporter       *crstatusevent.LivenessReporter
 	closeIdler             closeIdler
 	scheme                 string
 	oidcTokenProvider      *auth.OIDCTokenProvider
@@ -295,6 +317,7 @@ func (c *client) Send(ctx context.Context, out event.Event) protocol.Result {
 
 	res := c.ceClient.Send(ctx, out)
 	c.reportMetrics(ctx, out, res)
+	c.reportLiveness(res)
 	return res
 }
 
@@ -312,9 +335,17 @@ func (c *client) Request(ctx context.Context, out event.Event) (*event.Event, pr
 
 	resp, res := c.ceClient.Request(ctx, out)
 	c.reportMetrics(ctx, out, res)
+	c.reportLiveness(res)
 	return resp, res
 }
 
+// reportLiveness records delivered events on the liveness reporter.
+func (c *client) reportLiveness(result protocol.Result) {
+	if cloudevents.IsACK(result) {
+		c.livenessReporter.EventEmitted()
+	}
+}
+
 // StartReceiver implements client.StartReceiver
 func (c *client) StartReceiver(ctx context.Context, fn interface{}) error {
 	return c.ceClient.StartReceiver(ctx, fn)
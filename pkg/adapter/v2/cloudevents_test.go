@@ -18,10 +18,12 @@ package adapter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	nethttp "net/http"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -30,12 +32,16 @@ import (
 	"github.com/cloudevents/sdk-go/v2/protocol/http"
 	cetest "github.com/cloudevents/sdk-go/v2/test"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	. "knative.dev/pkg/reconciler/testing"
 
 	"knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/eventing/pkg/adapter/v2/util/crstatusevent"
+	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/eventing/pkg/eventingtls/eventingtlstesting"
 	"knative.dev/eventing/pkg/metrics/source"
 )
@@ -377,6 +383,44 @@ func TestTLS(t *testing.T) {
 	}
 }
 
+func TestNewClientTLSPolicies(t *testing.T) {
+	policies := &eventingtls.TLSPolicies{Policies: []eventingtls.TLSPolicy{
+		{Name: "tls13", Hosts: []string{"sink.example.com"}, MinVersion: "1.3"},
+	}}
+
+	tt := []struct {
+		name    string
+		sink    string
+		wantErr error
+	}{
+		{
+			name: "https sink URL matching a policy",
+			sink: "https://sink.example.com",
+		},
+		{
+			name: "http sink URL not matching a policy",
+			sink: "http://other.example.com",
+		},
+		{
+			name:    "http sink URL matching a policy",
+			sink:    "http://sink.example.com",
+			wantErr: eventingtls.ErrTLSPolicyPlainHTTP,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewClient(ClientConfig{
+				Env:         &EnvConfig{Sink: tc.sink},
+				TLSPolicies: policies,
+			})
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("NewClient() = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
 func validateSent(t *testing.T, ce *test.TestCloudEventsClient, want string) {
 	if got := len(ce.Sent()); got != 1 {
 		t.Error("Expected 1 event to be sent, got", got)
@@ -402,3 +446,32 @@ func validateMetric(t *testing.T, reporter source.StatsReporter, want int, wantR
 		t.Errorf("Expected %d for metric, got %d", want, mockReporter.retryEventCount)
 	}
 }
+
+func TestClientReportsLiveness(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	liveness := crstatusevent.NewLivenessReporter(recorder, &corev1.ObjectReference{Name: "source"}, time.Nanosecond)
+	c := &client{
+		ceClient:         &test.TestCloudEventsClient{},
+		livenessReporter: liveness,
+	}
+
+	// Let the reporter flag the source as idle.
+	time.Sleep(time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+	defer cancel()
+	liveness.Start(ctx)
+	if got := <-recorder.Events; !strings.HasPrefix(got, "Warning NoEventsEmitted") {
+		t.Fatalf("unexpected event %q", got)
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID("abc-123")
+	event.SetSource("unit/test")
+	event.SetType("unit.type")
+	if res := c.Send(context.Background(), event); !cloudevents.IsACK(res) {
+		t.Fatal(res)
+	}
+	if got := <-recorder.Events; !strings.HasPrefix(got, "Normal EventsEmitted") {
+		t.Fatalf("unexpected event %q", got)
+	}
+}
@@ -17,14 +17,17 @@ package adapter
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"strconv"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	"go.uber.org/zap"
 
+	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/eventing/pkg/observability"
 	pkgutils "knative.dev/eventing/pkg/utils"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -43,12 +46,21 @@ const (
 	EnvConfigAudience             = "K_AUDIENCE"
 	EnvConfigOIDCServiceAccount   = "K_OIDC_SERVICE_ACCOUNT"
 	EnvConfigCACert               = "K_CA_CERTS"
+	EnvConfigClientCertPath       = "K_CLIENT_CERT_PATH"
 	EnvConfigCEOverrides          = "K_CE_OVERRIDES"
 	EnvConfigLoggingConfig        = "K_LOGGING_CONFIG"
 	EnvConfigObservabilityConfig  = "K_OBSERVABILITY_CONFIG"
 	EnvConfigLeaderElectionConfig = "K_LEADER_ELECTION_CONFIG"
 	EnvSinkTimeout                = "K_SINK_TIMEOUT"
 	EnvConfigKlogVerbosity        = "K_KLOG_VERBOSITY"
+	EnvConfigEventLivenessTimeout = "K_EVENT_LIVENESS_TIMEOUT"
+	EnvConfigEventLivenessSource  = "K_EVENT_LIVENESS_SOURCE"
+	EnvConfigCheckpointConfigMap  = "K_CHECKPOINT_CONFIGMAP"
+	EnvConfigCheckpointDir        = "K_CHECKPOINT_DIR"
+	EnvConfigSinkBufferSize       = "K_SINK_BUFFER_SIZE"
+	EnvConfigSinkBufferSpillDir   = "K_SINK_BUFFER_SPILL_DIR"
+	EnvConfigSinkBufferSpillSize  = "K_SINK_BUFFER_SPILL_SIZE"
+	EnvConfigTLSPoliciesConfig    = "K_TLS_POLICIES_CONFIG"
 )
 
 // EnvConfig is the minimal set of configuration parameters
@@ -80,6 +92,11 @@ type EnvConfig struct {
 	// +optional
 	CACerts *string `envconfig:"K_CA_CERTS"`
 
+	// ClientCertPath is the directory containing the tls.crt and tls.key files of
+	// the client certificate presented to sinks requesting TLS client authentication.
+	// +optional
+	ClientCertPath string `envconfig:"K_CLIENT_CERT_PATH"`
+
 	// CEOverrides are the CloudEvents overrides to be applied to the outbound event.
 	CEOverrides string `envconfig:"K_CE_OVERRIDES"`
 
@@ -102,6 +119,50 @@ type EnvConfig struct {
 	// Time in seconds to wait for sink to respond
 	EnvSinkTimeout string `envconfig:"K_SINK_TIMEOUT"`
 
+	// EventLivenessTimeout is the duration within which the adapter is
+	// expected to deliver an event.
+	// +optional
+	EventLivenessTimeout string `envconfig:"K_EVENT_LIVENESS_TIMEOUT"`
+
+	// EventLivenessSource is the JSON encoded reference of the source on
+	// which the liveness Kubernetes events are emitted.
+	// +optional
+	EventLivenessSource string `envconfig:"K_EVENT_LIVENESS_SOURCE"`
+
+	// CheckpointConfigMap is the name of the ConfigMap, in the namespace of
+	// the adapter, checkpoints are stored in.
+	// +optional
+	CheckpointConfigMap string `envconfig:"K_CHECKPOINT_CONFIGMAP"`
+
+	// CheckpointDir is the directory checkpoints are stored in, typically
+	// a mounted persistent volume.
+	// +optional
+	CheckpointDir string `envconfig:"K_CHECKPOINT_DIR"`
+
+	// SinkBufferSize is the number of events buffered in memory while the
+	// sink is unavailable. Events are dropped when the sink is unavailable
+	// if it is not set. Buffered events are reported with ErrBuffered, and
+	// are lost on a crash unless they were spilled to disk. It is ignored by
+	// the adapters started with MainMessageAdapter and by PingSources, see
+	// SinkBufferConfigAccessor.
+	// +optional
+	SinkBufferSize int `envconfig:"K_SINK_BUFFER_SIZE"`
+
+	// SinkBufferSpillDir is the directory events are spilled to once the
+	// memory buffer is full, typically a mounted persistent volume.
+	// +optional
+	SinkBufferSpillDir string `envconfig:"K_SINK_BUFFER_SPILL_DIR"`
+
+	// SinkBufferSpillSize is the number of events spilled to
+	// SinkBufferSpillDir.
+	// +optional
+	SinkBufferSpillSize int `envconfig:"K_SINK_BUFFER_SPILL_SIZE" default:"10000"`
+
+	// TLSPoliciesConfigJson is the JSON list of the TLS policies applied to
+	// the sink, see eventingtls.TLSPolicy.
+	// +optional
+	TLSPoliciesConfigJson string `envconfig:"K_TLS_POLICIES_CONFIG"`
+
 	// cached zap logger
 	logger *zap.SugaredLogger
 }
@@ -118,6 +179,9 @@ type EnvConfigAccessor interface {
 	// GetCACerts gets the CACerts of the Sink.
 	GetCACerts() *string
 
+	// GetClientCertPath gets the directory of the client certificate presented to the Sink.
+	GetClientCertPath() string
+
 	// GetAudience gets the audience of the target sink.
 	GetAudience() *string
 
@@ -142,9 +206,32 @@ type EnvConfigAccessor interface {
 
 	// Get the timeout to apply on a request to a sink
 	GetSinktimeout() int
+
+	// GetEventLiveness returns the duration within which the adapter is
+	// expected to deliver an event and the source the liveness is reported
+	// on. It returns a nil reference when event liveness is not configured.
+	GetEventLiveness() (time.Duration, *corev1.ObjectReference, error)
+
+	// GetCheckpointConfigMap returns the name of the ConfigMap checkpoints
+	// are stored in, if any.
+	GetCheckpointConfigMap() string
+
+	// GetCheckpointDir returns the directory checkpoints are stored in, if any.
+	GetCheckpointDir() string
 }
 
-var _ EnvConfigAccessor = (*EnvConfig)(nil)
+var (
+	_ EnvConfigAccessor        = (*EnvConfig)(nil)
+	_ SinkBufferConfigAccessor = (*EnvConfig)(nil)
+	_ TLSPoliciesAccessor      = (*EnvConfig)(nil)
+)
+
+// TLSPoliciesAccessor is implemented by the EnvConfigAccessor of adapters
+// applying TLS policies to the sink, such as EnvConfig.
+type TLSPoliciesAccessor interface {
+	// GetTLSPolicies returns the TLS policies applied to the sink.
+	GetTLSPolicies() (*eventingtls.TLSPolicies, error)
+}
 
 func (e *EnvConfig) SetComponent(component string) {
 	e.Component = component
@@ -190,6 +277,10 @@ func (e *EnvConfig) GetCACerts() *string {
 	return e.CACerts
 }
 
+func (e *EnvConfig) GetClientCertPath() string {
+	return e.ClientCertPath
+}
+
 func (e *EnvConfig) GetAudience() *string {
 	return e.Audience
 }
@@ -210,6 +301,50 @@ func (e *EnvConfig) GetSinktimeout() int {
 	return -1
 }
 
+func (e *EnvConfig) GetEventLiveness() (time.Duration, *corev1.ObjectReference, error) {
+	if e.EventLivenessTimeout == "" || e.EventLivenessSource == "" {
+		return 0, nil, nil
+	}
+
+	timeout, err := time.ParseDuration(e.EventLivenessTimeout)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to parse %s: %w", EnvConfigEventLivenessTimeout, err)
+	}
+	if timeout <= 0 {
+		return 0, nil, fmt.Errorf("%s must be positive, got %s", EnvConfigEventLivenessTimeout, e.EventLivenessTimeout)
+	}
+
+	ref := &corev1.ObjectReference{}
+	if err := json.Unmarshal([]byte(e.EventLivenessSource), ref); err != nil {
+		return 0, nil, fmt.Errorf("failed to parse %s: %w", EnvConfigEventLivenessSource, err)
+	}
+	return timeout, ref, nil
+}
+
+func (e *EnvConfig) GetCheckpointConfigMap() string {
+	return e.CheckpointConfigMap
+}
+
+func (e *EnvConfig) GetCheckpointDir() string {
+	return e.CheckpointDir
+}
+
+func (e *EnvConfig) GetSinkBufferConfig() SinkBufferConfig {
+	return SinkBufferConfig{
+		Size:      e.SinkBufferSize,
+		SpillDir:  e.SinkBufferSpillDir,
+		SpillSize: e.SinkBufferSpillSize,
+	}
+}
+
+func (e *EnvConfig) GetTLSPolicies() (*eventingtls.TLSPolicies, error) {
+	policies, err := eventingtls.ParseTLSPolicies(e.TLSPoliciesConfigJson)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", EnvConfigTLSPoliciesConfig, err)
+	}
+	return policies, nil
+}
+
 func (e *EnvConfig) GetObservabilityConfig() (*observability.Config, error) {
 	cfg := &observability.Config{}
 	err := json.Unmarshal([]byte(e.ObservabilityConfigJson), cfg)
@@ -24,6 +24,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/kelseyhightower/envconfig"
+	corev1 "k8s.io/api/core/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	kle "knative.dev/pkg/leaderelection"
 )
@@ -181,6 +182,80 @@ func TestGetCloudEventOverrides_BadJson(t *testing.T) {
 	}
 }
 
+func TestGetEventLiveness(t *testing.T) {
+	want := &corev1.ObjectReference{
+		APIVersion: "sources.knative.dev/v1",
+		Kind:       "ContainerSource",
+		Namespace:  "ns",
+		Name:       "source",
+		UID:        "1234",
+	}
+	wantJson, _ := json.Marshal(want)
+
+	t.Setenv("K_EVENT_LIVENESS_TIMEOUT", "5m")
+	t.Setenv("K_EVENT_LIVENESS_SOURCE", string(wantJson))
+
+	var env myEnvConfig
+	err := envconfig.Process("", &env)
+	if err != nil {
+		t.Error("Expected no error:", err)
+	}
+
+	timeout, got, err := env.GetEventLiveness()
+	if err != nil {
+		t.Error("Expected no error:", err)
+	}
+	if timeout != 5*time.Minute {
+		t.Errorf("Expected timeout 5m, got %v", timeout)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetEventLiveness (-want, +got) = %v", diff)
+	}
+}
+
+func TestGetEventLiveness_NotConfigured(t *testing.T) {
+	var env myEnvConfig
+	err := envconfig.Process("", &env)
+	if err != nil {
+		t.Error("Expected no error:", err)
+	}
+
+	if _, ref, err := env.GetEventLiveness(); err != nil || ref != nil {
+		t.Errorf("Expected no liveness configuration, got %v, %v", ref, err)
+	}
+}
+
+func TestGetEventLiveness_BadTimeout(t *testing.T) {
+	t.Setenv("K_EVENT_LIVENESS_TIMEOUT", "-5m")
+	t.Setenv("K_EVENT_LIVENESS_SOURCE", "{}")
+
+	var env myEnvConfig
+	err := envconfig.Process("", &env)
+	if err != nil {
+		t.Error("Expected no error:", err)
+	}
+
+	if _, _, err := env.GetEventLiveness(); err == nil {
+		t.Error("Expected error")
+	}
+}
+
+func TestGetSinkBufferConfig(t *testing.T) {
+	t.Setenv("K_SINK_BUFFER_SIZE", "100")
+	t.Setenv("K_SINK_BUFFER_SPILL_DIR", "/var/buffer")
+
+	var env myEnvConfig
+	err := envconfig.Process("", &env)
+	if err != nil {
+		t.Error("Expected no error:", err)
+	}
+
+	want := SinkBufferConfig{Size: 100, SpillDir: "/var/buffer", SpillSize: 10000}
+	if got := env.GetSinkBufferConfig(); got != want {
+		t.Errorf("Expected env.GetSinkBufferConfig() to be %+v, got: %+v", want, got)
+	}
+}
+
 func TestGetLeaderElectionConfig(t *testing.T) {
 	t.Setenv("K_COMPONENT", "Gotham")
 
@@ -135,3 +135,21 @@ func WithHealthProbesDisabled(ctx context.Context) context.Context {
 func HealthProbesDisabled(ctx context.Context) bool {
 	return ctx.Value(healthProbesDisabledKey{}) != nil
 }
+
+type checkpointStoreKey struct{}
+
+// WithCheckpointStore makes store the CheckpointStore of the adapter. When no
+// store is set, MainWithInformers and MainMessageAdapter set the one
+// configured in the environment, if any.
+func WithCheckpointStore(ctx context.Context, store CheckpointStore) context.Context {
+	return context.WithValue(ctx, checkpointStoreKey{}, store)
+}
+
+// CheckpointStoreFromContext returns the CheckpointStore of the adapter, or
+// nil when none is configured.
+func CheckpointStoreFromContext(ctx context.Context) CheckpointStore {
+	if v := ctx.Value(checkpointStoreKey{}); v != nil {
+		return v.(CheckpointStore)
+	}
+	return nil
+}
@@ -76,3 +76,17 @@ func TestWithConfigWatcher(t *testing.T) {
 		t.Error("Expected ConfigWatcher to match the one set previously")
 	}
 }
+
+func TestWithCheckpointStore(t *testing.T) {
+	ctx := context.Background()
+
+	if store := CheckpointStoreFromContext(ctx); store != nil {
+		t.Error("Expected no CheckpointStore by default, got", store)
+	}
+
+	store := NewConfigMapCheckpointStore(nil, "ns", "checkpoints")
+	ctx = WithCheckpointStore(ctx, store)
+	if got := CheckpointStoreFromContext(ctx); got != store {
+		t.Error("Expected CheckpointStore to match the one set previously")
+	}
+}
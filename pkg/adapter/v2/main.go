@@ -29,11 +29,17 @@ import (
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/kelseyhightower/envconfig"
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/eventing/pkg/eventingtls"
@@ -184,6 +190,7 @@ func MainWithInformers(ctx context.Context, component string, env EnvConfigAcces
 	_, _ = configurator.SetupObservabilityOrDie(ctx, component, logger, pprof)
 
 	crStatusEventClient := configurator.CreateCloudEventsStatusReporter(ctx)
+	livenessReporter := newLivenessReporter(ctx, component, env)
 
 	reporter, err := source.NewStatsReporter()
 	if err != nil {
@@ -225,9 +232,16 @@ func MainWithInformers(ctx context.Context, component string, env EnvConfigAcces
 		Env:                        env,
 		Reporter:                   reporter,
 		CrStatusEventClient:        crStatusEventClient,
+		LivenessReporter:           livenessReporter,
 		TokenProvider:              auth.NewOIDCTokenProvider(ctx),
 		TrustBundleConfigMapLister: trustBundleConfigMapLister,
 	}
+	if accessor, ok := env.(TLSPoliciesAccessor); ok {
+		clientConfig.TLSPolicies, err = accessor.GetTLSPolicies()
+		if err != nil {
+			logger.Fatalw("Error loading the TLS policies", zap.Error(err))
+		}
+	}
 	ctx = withClientConfig(ctx, clientConfig)
 
 	eventsClient, err := NewClient(clientConfig)
@@ -235,6 +249,27 @@ func MainWithInformers(ctx context.Context, component string, env EnvConfigAcces
 		logger.Fatalw("Error building cloud event client", zap.Error(err))
 	}
 
+	var bufferedClient BufferedClient
+	if accessor, ok := env.(SinkBufferConfigAccessor); ok {
+		if bufferConfig := accessor.GetSinkBufferConfig(); bufferConfig.Size > 0 {
+			bufferedClient, err = NewBufferedClient(eventsClient, bufferConfig, otel.GetMeterProvider())
+			if err != nil {
+				logger.Fatalw("Error building buffered cloud event client", zap.Error(err))
+			}
+			eventsClient = bufferedClient
+		}
+	}
+
+	if CheckpointStoreFromContext(ctx) == nil {
+		store, err := NewCheckpointStoreFromEnv(ctx, env)
+		if err != nil {
+			logger.Fatalw("Error building the checkpoint store", zap.Error(err))
+		}
+		if store != nil {
+			ctx = WithCheckpointStore(ctx, store)
+		}
+	}
+
 	// Configuring the adapter
 	adapter := ctor(ctx, env, eventsClient)
 
@@ -285,6 +320,22 @@ func MainWithInformers(ctx context.Context, component string, env EnvConfigAcces
 		}()
 	}
 
+	if livenessReporter != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			livenessReporter.Start(ctx)
+		}()
+	}
+
+	if bufferedClient != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bufferedClient.Start(ctx)
+		}()
+	}
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -302,6 +353,44 @@ func MainWithInformers(ctx context.Context, component string, env EnvConfigAcces
 	wg.Wait()
 }
 
+// newLivenessReporter returns the event liveness reporter configured by the
+// environment, or nil when event liveness is not configured.
+func newLivenessReporter(ctx context.Context, component string, env EnvConfigAccessor) *crstatusevent.LivenessReporter {
+	logger := logging.FromContext(ctx)
+
+	timeout, ref, err := env.GetEventLiveness()
+	if err != nil {
+		logger.Warnw("Event liveness not configured", zap.Error(err))
+		return nil
+	}
+	if ref == nil {
+		return nil
+	}
+
+	cfg := injection.GetConfig(ctx)
+	if cfg == nil {
+		if cfg, err = rest.InClusterConfig(); err != nil {
+			logger.Warnw("Event liveness not configured", zap.Error(err))
+			return nil
+		}
+	}
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		logger.Warnw("Event liveness not configured", zap.Error(err))
+		return nil
+	}
+
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: kubeClient.CoreV1().Events(ref.Namespace)})
+	go func() {
+		<-ctx.Done()
+		broadcaster.Shutdown()
+	}()
+	recorder := broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: component})
+
+	return crstatusevent.NewLivenessReporter(recorder, ref, timeout)
+}
+
 func ConstructEnvOrDie(ector EnvConfigConstructor) EnvConfigAccessor {
 	env := ector()
 	if err := envconfig.Process("", env); err != nil {
@@ -39,6 +39,9 @@ type MessageAdapter interface {
 
 type MessageAdapterConstructor func(ctx context.Context, env EnvConfigAccessor, sink duckv1.Addressable, reporter source.StatsReporter) MessageAdapter
 
+// MainMessageAdapter starts an adapter sending messages to the sink on its own.
+// The events of these adapters aren't buffered while the sink is unavailable,
+// see SinkBufferConfigAccessor.
 func MainMessageAdapter(component string, ector EnvConfigConstructor, ctor MessageAdapterConstructor) {
 	MainMessageAdapterWithContext(signals.NewContext(), component, ector, ctor)
 }
@@ -82,6 +85,16 @@ func MainMessageAdapterWithContext(ctx context.Context, component string, ector
 		CACerts: env.GetCACerts(),
 	}
 
+	if CheckpointStoreFromContext(ctx) == nil {
+		store, err := NewCheckpointStoreFromEnv(ctx, env)
+		if err != nil {
+			logger.Fatalw("Error building the checkpoint store", zap.Error(err))
+		}
+		if store != nil {
+			ctx = WithCheckpointStore(ctx, store)
+		}
+	}
+
 	// Configuring the adapter
 	adapter := ctor(ctx, env, sink, reporter)
 
@@ -43,6 +43,7 @@ func TestMainMessageAdapterWithContext(t *testing.T) {
 	os.Setenv("K_OBSERVABILITY_CONFIG", string(obsJson))
 	os.Setenv("K_LOGGING_CONFIG", "logging")
 	os.Setenv("MODE", "mymode")
+	os.Setenv("K_CHECKPOINT_DIR", t.TempDir())
 
 	defer func() {
 		os.Unsetenv("K_SINK")
@@ -51,6 +52,7 @@ func TestMainMessageAdapterWithContext(t *testing.T) {
 		os.Unsetenv("K_OBSERVABILITY_CONFIG")
 		os.Unsetenv("K_LOGGING_CONFIG")
 		os.Unsetenv("MODE")
+		os.Unsetenv("K_CHECKPOINT_DIR")
 	}()
 
 	ctx, cancel := context.WithCancel(context.TODO())
@@ -75,6 +77,10 @@ func TestMainMessageAdapterWithContext(t *testing.T) {
 			if leaderelection.HasLeaderElection(ctx) {
 				t.Error("Expected no leader election, but got leader election")
 			}
+
+			if CheckpointStoreFromContext(ctx) == nil {
+				t.Error("Expected the CheckpointStore configured in the environment")
+			}
 			return &myAdapterBindings{}
 		})
 
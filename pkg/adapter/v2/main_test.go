@@ -57,6 +57,7 @@ func TestMainWithContext(t *testing.T) {
 	os.Setenv("K_OBSERVABILITY_CONFIG", "error config")
 	os.Setenv("K_LOGGING_CONFIG", "error config")
 	os.Setenv("MODE", "mymode")
+	os.Setenv("K_CHECKPOINT_DIR", t.TempDir())
 
 	defer func() {
 		os.Unsetenv("K_SINK")
@@ -64,6 +65,7 @@ func TestMainWithContext(t *testing.T) {
 		os.Unsetenv("K_OBSERVABILITY_CONFIG")
 		os.Unsetenv("K_LOGGING_CONFIG")
 		os.Unsetenv("MODE")
+		os.Unsetenv("K_CHECKPOINT_DIR")
 	}()
 
 	ctx := context.TODO()
@@ -86,6 +88,10 @@ func TestMainWithContext(t *testing.T) {
 			if leaderelection.HasLeaderElection(ctx) {
 				t.Error("Expected no leader election, but got leader election")
 			}
+
+			if CheckpointStoreFromContext(ctx) == nil {
+				t.Error("Expected the CheckpointStore configured in the environment")
+			}
 			return &myAdapter{}
 		})
 }
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crstatusevent
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// NoEventsEmittedReason is the reason of the Warning event emitted on a
+	// source which did not emit any event within its liveness timeout.
+	NoEventsEmittedReason = "NoEventsEmitted"
+
+	// EventsEmittedReason is the reason of the Normal event emitted on a
+	// source which emits events again after having been reported idle.
+	EventsEmittedReason = "EventsEmitted"
+)
+
+// LivenessReporter reports, as Kubernetes events on a source, whether the
+// source emitted an event within a timeout.
+type LivenessReporter struct {
+	recorder record.EventRecorder
+	source   runtime.Object
+	timeout  time.Duration
+	now      func() time.Time
+
+	m    sync.Mutex
+	last time.Time
+	idle bool
+}
+
+// NewLivenessReporter returns a LivenessReporter recording the events on the
+// given source. The timeout starts when the reporter is created.
+func NewLivenessReporter(recorder record.EventRecorder, source runtime.Object, timeout time.Duration) *LivenessReporter {
+	return &LivenessReporter{
+		recorder: recorder,
+		source:   source,
+		timeout:  timeout,
+		now:      time.Now,
+		last:     time.Now(),
+	}
+}
+
+// EventEmitted records that the source emitted an event. It is a no-op on a
+// nil reporter.
+func (r *LivenessReporter) EventEmitted() {
+	if r == nil {
+		return
+	}
+
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	r.last = r.now()
+	if r.idle {
+		r.idle = false
+		r.recorder.Event(r.source, corev1.EventTypeNormal, EventsEmittedReason, "Events are emitted again")
+	}
+}
+
+// Start checks the liveness of the source until the context is done.
+func (r *LivenessReporter) Start(ctx context.Context) {
+	period := r.timeout / 4
+	if period < time.Second {
+		period = time.Second
+	}
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.check()
+		}
+	}
+}
+
+func (r *LivenessReporter) check() {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if !r.idle && r.now().Sub(r.last) >= r.timeout {
+		r.idle = true
+		r.recorder.Eventf(r.source, corev1.EventTypeWarning, NoEventsEmittedReason, "No event emitted in the last %s", r.timeout)
+	}
+}
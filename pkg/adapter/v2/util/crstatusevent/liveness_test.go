@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crstatusevent
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/client-go/tools/record"
+)
+
+func TestLivenessReporter(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	r := NewLivenessReporter(recorder, src, time.Minute)
+
+	now := time.Now()
+	r.now = func() time.Time { return now }
+	r.last = now
+
+	assertEvent := func(want string) {
+		t.Helper()
+		select {
+		case got := <-recorder.Events:
+			if got != want {
+				t.Errorf("want event %q, got %q", want, got)
+			}
+		default:
+			if want != "" {
+				t.Errorf("want event %q, got none", want)
+			}
+			return
+		}
+		if want == "" {
+			t.Error("want no event")
+		}
+	}
+
+	now = now.Add(30 * time.Second)
+	r.check()
+	assertEvent("")
+
+	now = now.Add(30 * time.Second)
+	r.check()
+	assertEvent("Warning NoEventsEmitted No event emitted in the last 1m0s")
+
+	// The idle source is reported once.
+	now = now.Add(time.Minute)
+	r.check()
+	assertEvent("")
+
+	r.EventEmitted()
+	assertEvent("Normal EventsEmitted Events are emitted again")
+
+	r.EventEmitted()
+	now = now.Add(59 * time.Second)
+	r.check()
+	assertEvent("")
+}
+
+func TestLivenessReporterNil(t *testing.T) {
+	var r *LivenessReporter
+	r.EventEmitted()
+}
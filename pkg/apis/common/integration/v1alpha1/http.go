@@ -0,0 +1,28 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+const (
+	// HTTPUsername is the name of the expected key on the secret for accessing the HTTP basic authentication username.
+	HTTPUsername = "http.username"
+	// HTTPPassword is the name of the expected key on the secret for accessing the HTTP basic authentication password.
+	HTTPPassword = "http.password"
+	// HTTPToken is the name of the expected key on the secret for accessing the HTTP bearer token.
+	HTTPToken = "http.token"
+	// WebhookHMACKey is the name of the expected key on the secret for accessing the key verifying the webhook signatures.
+	WebhookHMACKey = "webhook.hmacKey"
+)
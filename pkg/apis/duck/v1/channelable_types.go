@@ -50,6 +50,12 @@ type ChannelableSpec struct {
 	// global delivery spec.
 	// +optional
 	Delivery *DeliverySpec `json:"delivery,omitempty"`
+
+	// MaxEventSize is the maximum size in bytes of the request body of the
+	// events accepted by this Channelable. Larger events are rejected with a
+	// 413 (Payload Too Large) response.
+	// +optional
+	MaxEventSize *int64 `json:"maxEventSize,omitempty"`
 }
 
 // ChannelableStatus contains the Status of a Channelable object.
@@ -89,6 +89,26 @@ type DeliverySpec struct {
 	// - "binary": indicates the event should be in binary mode.
 	//+optional
 	Format *FormatType `json:"format,omitempty"`
+
+	// DeadLetterFormat specifies how the delivery attempts are attached to the
+	// events sent to the dead letter sink.
+	// It can be one of the following values:
+	// - nil: default value, the last attempt is attached with the knativeerror* extensions.
+	// - "attempts": the history of the attempts is also attached with the
+	//   knativeerrorattempts extension.
+	// - "envelope": the event is sent in the data of an envelope event, along with
+	//   the history of the attempts.
+	// +optional
+	DeadLetterFormat *DeadLetterFormatType `json:"deadLetterFormat,omitempty"`
+
+	// DataRef specifies how events whose data has been offloaded by a claim
+	// check are delivered.
+	// It can be one of the following values:
+	// - nil or "resolve": the data is resolved and sent with the event.
+	// - "reference": the event is sent with the dataref extension referencing the data.
+	// It is only supported by Triggers.
+	// +optional
+	DataRef *DataRefPolicyType `json:"dataRef,omitempty"`
 }
 
 func (ds *DeliverySpec) Validate(ctx context.Context) *apis.FieldError {
@@ -140,6 +160,24 @@ func (ds *DeliverySpec) Validate(ctx context.Context) *apis.FieldError {
 		}
 	}
 
+	if ds.DeadLetterFormat != nil {
+		switch *ds.DeadLetterFormat {
+		case DeadLetterFormatAttempts, DeadLetterFormatEnvelope:
+			// nothing
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*ds.DeadLetterFormat, "deadLetterFormat"))
+		}
+	}
+
+	if ds.DataRef != nil {
+		switch *ds.DataRef {
+		case DataRefPolicyResolve, DataRefPolicyReference:
+			// nothing
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*ds.DataRef, "dataRef"))
+		}
+	}
+
 	if ds.RetryAfterMax != nil {
 		if feature.FromContext(ctx).IsEnabled(feature.DeliveryRetryAfter) {
 			p, me := period.Parse(*ds.RetryAfterMax)
@@ -173,6 +211,40 @@ const (
 	DeliveryFormatBinary FormatType = "binary"
 )
 
+// DeadLetterFormatType is the type for the format of the events sent to dead letter sinks
+type DeadLetterFormatType string
+
+const (
+	// DeadLetterFormatAttempts attaches the history of the delivery attempts with an extension.
+	DeadLetterFormatAttempts DeadLetterFormatType = "attempts"
+
+	// DeadLetterFormatEnvelope sends the event in the data of an envelope event.
+	DeadLetterFormatEnvelope DeadLetterFormatType = "envelope"
+)
+
+// ValidateDataRefUnsupported returns an error when DataRef is set, for the resources whose
+// deliveries don't resolve claim checks.
+func (ds *DeliverySpec) ValidateDataRefUnsupported() *apis.FieldError {
+	if ds == nil || ds.DataRef == nil {
+		return nil
+	}
+	return &apis.FieldError{
+		Message: "dataRef is only supported by Triggers",
+		Paths:   []string{"dataRef"},
+	}
+}
+
+// DataRefPolicyType is the type for the delivery of events whose data has been offloaded
+type DataRefPolicyType string
+
+const (
+	// DataRefPolicyResolve sends the offloaded data with the event.
+	DataRefPolicyResolve DataRefPolicyType = "resolve"
+
+	// DataRefPolicyReference sends the event with the reference to the offloaded data.
+	DataRefPolicyReference DataRefPolicyType = "reference"
+)
+
 // DeliveryStatus contains the Status of an object supporting delivery options. This type is intended to be embedded into a status struct.
 type DeliveryStatus struct {
 	// DeadLetterSink is a KReference that is the reference to the native, platform specific channel
@@ -158,6 +158,34 @@ func TestDeliverySpecValidation(t *testing.T) {
 			want: func() *apis.FieldError {
 				return apis.ErrInvalidValue("invalid", "format")
 			}(),
+		}, {
+			name: "valid dead letter format attempts",
+			spec: &DeliverySpec{DeadLetterFormat: ptr.To(DeadLetterFormatAttempts)},
+			want: nil,
+		}, {
+			name: "valid dead letter format envelope",
+			spec: &DeliverySpec{DeadLetterFormat: ptr.To(DeadLetterFormatEnvelope)},
+			want: nil,
+		}, {
+			name: "invalid dead letter format",
+			spec: &DeliverySpec{DeadLetterFormat: ptr.To(DeadLetterFormatType("invalid"))},
+			want: func() *apis.FieldError {
+				return apis.ErrInvalidValue("invalid", "deadLetterFormat")
+			}(),
+		}, {
+			name: "valid data ref resolve",
+			spec: &DeliverySpec{DataRef: ptr.To(DataRefPolicyResolve)},
+			want: nil,
+		}, {
+			name: "valid data ref reference",
+			spec: &DeliverySpec{DataRef: ptr.To(DataRefPolicyReference)},
+			want: nil,
+		}, {
+			name: "invalid data ref",
+			spec: &DeliverySpec{DataRef: ptr.To(DataRefPolicyType("invalid"))},
+			want: func() *apis.FieldError {
+				return apis.ErrInvalidValue("invalid", "dataRef")
+			}(),
 		}}
 
 	for _, test := range tests {
@@ -70,6 +70,10 @@ type SubscriberSpec struct {
 	// DeliverySpec contains options controlling the event delivery
 	// +optional
 	Delivery *DeliverySpec `json:"delivery,omitempty"`
+	// Transform is the transformation applied to the events delivered to
+	// the subscriber
+	// +optional
+	Transform *TransformSpec `json:"transform,omitempty"`
 	// Auth contains the service account name for the subscription
 	// +optional
 	Auth *duckv1.AuthStatus `json:"auth,omitempty"`
@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"knative.dev/pkg/apis"
+
+	"knative.dev/eventing/pkg/utils/jsonpath"
+)
+
+var (
+	// extensionName is the format of the CloudEvents extension names.
+	extensionName = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+	// contextAttributes are the CloudEvents context attributes and the members
+	// holding the data in the JSON format, which can't be used as extensions.
+	contextAttributes = sets.New("specversion", "id", "source", "type", "datacontenttype", "dataschema", "subject", "time", "data", "data_base64")
+
+	// requiredAttributes are the CloudEvents context attributes that can't be removed.
+	requiredAttributes = sets.New("specversion", "id", "source", "type")
+)
+
+// TransformSpec is a lightweight transformation applied to the events
+// delivered to a subscriber. The operations are applied in the order
+// Rename, Remove, Set and Data.
+type TransformSpec struct {
+	// Rename renames extensions. The keys are the names of the extensions
+	// and the values their new names.
+	// +optional
+	Rename map[string]string `json:"rename,omitempty"`
+
+	// Remove lists the extensions and optional context attributes removed
+	// from the events.
+	// +optional
+	Remove []string `json:"remove,omitempty"`
+
+	// Set sets attributes to the given values. The keys are the names of the
+	// extensions or of the context attributes, except specversion and
+	// datacontenttype.
+	// +optional
+	Set map[string]string `json:"set,omitempty"`
+
+	// Data is a JSONPath expression selecting the part of the JSON data of the
+	// events that is delivered, for example "$.order". Events without JSON
+	// data are delivered untouched and events without a match are delivered
+	// without data.
+	// +optional
+	Data string `json:"data,omitempty"`
+}
+
+func (ts *TransformSpec) Validate(ctx context.Context) *apis.FieldError {
+	if ts == nil {
+		return nil
+	}
+	var errs *apis.FieldError
+
+	for from, to := range ts.Rename {
+		if fe := validateExtensionName(from); fe != nil {
+			errs = errs.Also(fe.ViaKey(from).ViaField("rename"))
+		}
+		if fe := validateExtensionName(to); fe != nil {
+			errs = errs.Also(fe.ViaKey(from).ViaField("rename"))
+		}
+	}
+
+	for i, name := range ts.Remove {
+		if requiredAttributes.Has(name) || name == "datacontenttype" {
+			errs = errs.Also(apis.ErrInvalidArrayValue(name, "remove", i))
+		} else if !contextAttributes.Has(name) && !extensionName.MatchString(name) {
+			errs = errs.Also(apis.ErrInvalidArrayValue(name, "remove", i))
+		}
+	}
+
+	for name, value := range ts.Set {
+		switch {
+		case name == "specversion" || name == "datacontenttype":
+			errs = errs.Also(apis.ErrInvalidKeyName(name, "set", "specversion and datacontenttype can't be set"))
+		case requiredAttributes.Has(name) && value == "":
+			errs = errs.Also(apis.ErrInvalidValue(value, apis.CurrentField).ViaKey(name).ViaField("set"))
+		case name == "time":
+			if _, err := time.Parse(time.RFC3339, value); err != nil {
+				errs = errs.Also(apis.ErrInvalidValue(value, apis.CurrentField, err.Error()).ViaKey(name).ViaField("set"))
+			}
+		case !contextAttributes.Has(name) && !extensionName.MatchString(name):
+			errs = errs.Also(apis.ErrInvalidKeyName(name, "set", "Attribute name must start with a letter and can only contain lowercase alphanumeric"))
+		}
+	}
+
+	if ts.Data != "" {
+		if _, err := jsonpath.Parse(ts.Data); err != nil {
+			errs = errs.Also(apis.ErrInvalidValue(ts.Data, "data", err.Error()))
+		}
+	}
+	return errs
+}
+
+// IsContextAttribute returns whether the name is the name of a CloudEvents
+// context attribute, as opposed to the name of an extension.
+func IsContextAttribute(name string) bool {
+	return contextAttributes.Has(name)
+}
+
+func validateExtensionName(name string) *apis.FieldError {
+	if contextAttributes.Has(name) {
+		return apis.ErrInvalidValue(name, apis.CurrentField, "CloudEvents context attributes can't be renamed")
+	}
+	if !extensionName.MatchString(name) {
+		return apis.ErrInvalidValue(name, apis.CurrentField, "Extension name must start with a letter and can only contain lowercase alphanumeric")
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"knative.dev/pkg/apis"
+)
+
+func TestTransformSpecValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		spec *TransformSpec
+		want *apis.FieldError
+	}{{
+		name: "nil is valid",
+	}, {
+		name: "valid",
+		spec: &TransformSpec{
+			Rename: map[string]string{"tenant": "customer"},
+			Remove: []string{"subject", "traceparent"},
+			Set:    map[string]string{"type": "order.received", "time": "2026-01-02T03:04:05Z", "priority": "high"},
+			Data:   "$.order",
+		},
+	}, {
+		name: "invalid rename",
+		spec: &TransformSpec{
+			Rename: map[string]string{"subject": "Customer"},
+		},
+		want: apis.ErrInvalidValue("subject", "rename[subject]", "CloudEvents context attributes can't be renamed").Also(
+			apis.ErrInvalidValue("Customer", "rename[subject]", "Extension name must start with a letter and can only contain lowercase alphanumeric")),
+	}, {
+		name: "invalid remove",
+		spec: &TransformSpec{
+			Remove: []string{"id", "datacontenttype", "Invalid"},
+		},
+		want: apis.ErrInvalidArrayValue("id", "remove", 0).Also(
+			apis.ErrInvalidArrayValue("datacontenttype", "remove", 1)).Also(
+			apis.ErrInvalidArrayValue("Invalid", "remove", 2)),
+	}, {
+		name: "invalid set",
+		spec: &TransformSpec{
+			Set: map[string]string{"specversion": "0.3", "type": "", "time": "yesterday", "Invalid": "value"},
+		},
+		want: apis.ErrInvalidKeyName("specversion", "set", "specversion and datacontenttype can't be set").Also(
+			apis.ErrInvalidValue("", "set[type]")).Also(
+			apis.ErrInvalidValue("yesterday", "set[time]", `parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006"`)).Also(
+			apis.ErrInvalidKeyName("Invalid", "set", "Attribute name must start with a letter and can only contain lowercase alphanumeric")),
+	}, {
+		name: "invalid data",
+		spec: &TransformSpec{
+			Data: "order",
+		},
+		want: apis.ErrInvalidValue("order", "data", `expression "order" must start with $`),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.spec.Validate(context.Background())
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Error("TransformSpec.Validate (-want, +got) =", diff)
+			}
+		})
+	}
+}
@@ -134,6 +134,11 @@ func (in *ChannelableSpec) DeepCopyInto(out *ChannelableSpec) {
 		*out = new(DeliverySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaxEventSize != nil {
+		in, out := &in.MaxEventSize, &out.MaxEventSize
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -206,6 +211,16 @@ func (in *DeliverySpec) DeepCopyInto(out *DeliverySpec) {
 		*out = new(FormatType)
 		**out = **in
 	}
+	if in.DeadLetterFormat != nil {
+		in, out := &in.DeadLetterFormat, &out.DeadLetterFormat
+		*out = new(DeadLetterFormatType)
+		**out = **in
+	}
+	if in.DataRef != nil {
+		in, out := &in.DataRef, &out.DataRef
+		*out = new(DataRefPolicyType)
+		**out = **in
+	}
 	return
 }
 
@@ -400,6 +415,11 @@ func (in *SubscriberSpec) DeepCopyInto(out *SubscriberSpec) {
 		*out = new(DeliverySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(TransformSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Auth != nil {
 		in, out := &in.Auth, &out.Auth
 		*out = new(duckv1.AuthStatus)
@@ -438,3 +458,38 @@ func (in *SubscriberStatus) DeepCopy() *SubscriberStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransformSpec) DeepCopyInto(out *TransformSpec) {
+	*out = *in
+	if in.Rename != nil {
+		in, out := &in.Rename, &out.Rename
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Remove != nil {
+		in, out := &in.Remove, &out.Remove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Set != nil {
+		in, out := &in.Set, &out.Set
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransformSpec.
+func (in *TransformSpec) DeepCopy() *TransformSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransformSpec)
+	in.DeepCopyInto(out)
+	return out
+}
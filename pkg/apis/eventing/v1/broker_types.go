@@ -79,8 +79,115 @@ type BrokerSpec struct {
 	// global delivery spec.
 	// +optional
 	Delivery *eventingduckv1.DeliverySpec `json:"delivery,omitempty"`
+
+	// MaxEventSize is the maximum size in bytes of the request body of the
+	// events accepted by this Broker. Larger events are rejected with a 413
+	// (Payload Too Large) response.
+	// +optional
+	MaxEventSize *int64 `json:"maxEventSize,omitempty"`
+
+	// ClaimCheck enables offloading the data of large events to the blob
+	// store configured for the Broker data plane. The data is replaced by a
+	// dataref extension and resolved again for the subscribers of Triggers,
+	// unless their delivery spec requests the reference.
+	// +optional
+	ClaimCheck *ClaimCheckSpec `json:"claimCheck,omitempty"`
+
+	// Ingress contains the rules applied to the events received by this
+	// Broker before they are forwarded to the Triggers.
+	// +optional
+	Ingress *BrokerIngressSpec `json:"ingress,omitempty"`
+}
+
+// ClaimCheckSpec configures the offloading of the data of large events.
+type ClaimCheckSpec struct {
+	// Threshold is the size in bytes of the data above which it is offloaded.
+	Threshold int64 `json:"threshold"`
+}
+
+// BrokerIngressSpec contains the rules applied to the events received by a Broker.
+type BrokerIngressSpec struct {
+	// Rules are applied in order to every event received by the Broker.
+	// +optional
+	Rules []BrokerIngressRule `json:"rules,omitempty"`
+}
+
+// BrokerIngressRule enriches and redacts the events matching its condition.
+type BrokerIngressRule struct {
+	// Condition is a CESQL expression, the rule is only applied to the events
+	// for which it evaluates to true. An empty condition matches every event.
+	// +optional
+	Condition string `json:"condition,omitempty"`
+
+	// Enrich lists the extensions added to the event.
+	// +optional
+	Enrich []BrokerIngressEnrichment `json:"enrich,omitempty"`
+
+	// Redact lists the extensions and data fields removed or hashed from the event.
+	// +optional
+	Redact []BrokerIngressRedaction `json:"redact,omitempty"`
 }
 
+// BrokerIngressEnrichment sets an extension from a value known by the Broker.
+type BrokerIngressEnrichment struct {
+	// Extension is the name of the extension to set. An existing value is
+	// overwritten, or removed when the source has no value for the event.
+	Extension string `json:"extension"`
+
+	// Source is the value of the extension. It can be one of the following values:
+	// - "subject": the authenticated OIDC or client certificate subject of the sender.
+	// - "receiveTime": the time at which the event was received by the Broker.
+	// - "clusterName": the name of the cluster the Broker runs in.
+	Source EnrichmentSourceType `json:"source"`
+}
+
+// EnrichmentSourceType is the type for the values added to events by Broker ingress rules
+type EnrichmentSourceType string
+
+const (
+	// EnrichmentSourceSubject is the authenticated subject of the sender.
+	EnrichmentSourceSubject EnrichmentSourceType = "subject"
+
+	// EnrichmentSourceReceiveTime is the time at which the event was received.
+	EnrichmentSourceReceiveTime EnrichmentSourceType = "receiveTime"
+
+	// EnrichmentSourceClusterName is the name of the cluster.
+	EnrichmentSourceClusterName EnrichmentSourceType = "clusterName"
+)
+
+// BrokerIngressRedaction removes or hashes an extension or a field of the
+// JSON data of an event. Exactly one of Extension and DataPath must be set.
+type BrokerIngressRedaction struct {
+	// Extension is the name of the extension to redact.
+	// +optional
+	Extension string `json:"extension,omitempty"`
+
+	// DataPath is a JSONPath expression selecting the fields of the JSON data
+	// to redact, for example "$.user.email" or "$.items[*].card". Events
+	// without JSON data are left untouched.
+	// +optional
+	DataPath string `json:"dataPath,omitempty"`
+
+	// Action is the redaction applied. It can be one of the following values:
+	// - nil or "remove": the extension or field is removed.
+	// - "hash": the value is replaced by its hex encoded HMAC-SHA256, keyed by
+	//   the secret key of the Broker ingress. The value is removed when the
+	//   ingress has no key.
+	// +optional
+	Action *RedactionActionType `json:"action,omitempty"`
+}
+
+// RedactionActionType is the type for the redactions applied by Broker ingress rules
+type RedactionActionType string
+
+const (
+	// RedactionActionRemove removes the value.
+	RedactionActionRemove RedactionActionType = "remove"
+
+	// RedactionActionHash replaces the value by its hash.
+	RedactionActionHash RedactionActionType = "hash"
+)
+
 // BrokerStatus represents the current state of a Broker.
 type BrokerStatus struct {
 	// inherits duck/v1 Status, which currently provides:
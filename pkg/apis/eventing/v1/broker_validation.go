@@ -18,6 +18,7 @@ package v1
 
 import (
 	"context"
+	"math"
 
 	"github.com/google/go-cmp/cmp/cmpopts"
 
@@ -25,6 +26,8 @@ import (
 	"knative.dev/pkg/kmp"
 
 	"knative.dev/eventing/pkg/apis/config"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/utils/jsonpath"
 )
 
 const (
@@ -90,16 +93,85 @@ func (bs *BrokerSpec) Validate(ctx context.Context) *apis.FieldError {
 			errs = errs.Also(de.ViaField("delivery"))
 		}
 	}
+
+	if bs.MaxEventSize != nil && *bs.MaxEventSize < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*bs.MaxEventSize, 1, math.MaxInt64, "maxEventSize"))
+	}
+
+	if bs.ClaimCheck != nil && bs.ClaimCheck.Threshold < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(bs.ClaimCheck.Threshold, 1, math.MaxInt64, "threshold").ViaField("claimCheck"))
+	}
+
+	if bs.Ingress != nil {
+		for i, rule := range bs.Ingress.Rules {
+			errs = errs.Also(rule.Validate(ctx).ViaFieldIndex("rules", i).ViaField("ingress"))
+		}
+	}
 	return errs
 }
 
+func (r *BrokerIngressRule) Validate(ctx context.Context) *apis.FieldError {
+	errs := ValidateCESQLExpression(ctx, r.Condition).ViaField("condition")
+
+	for i, e := range r.Enrich {
+		errs = errs.Also(validateRuleExtensionName(e.Extension).ViaField("extension").ViaFieldIndex("enrich", i))
+		switch e.Source {
+		case EnrichmentSourceSubject, EnrichmentSourceReceiveTime, EnrichmentSourceClusterName:
+			// nothing
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(e.Source, "source").ViaFieldIndex("enrich", i))
+		}
+	}
+
+	for i, rd := range r.Redact {
+		var fe *apis.FieldError
+		switch {
+		case rd.Extension != "" && rd.DataPath != "":
+			fe = apis.ErrMultipleOneOf("extension", "dataPath")
+		case rd.Extension != "":
+			fe = validateRuleExtensionName(rd.Extension).ViaField("extension")
+		case rd.DataPath != "":
+			if _, err := jsonpath.Parse(rd.DataPath); err != nil {
+				fe = apis.ErrInvalidValue(rd.DataPath, "dataPath", err.Error())
+			} else if rd.DataPath == "$" {
+				fe = apis.ErrInvalidValue(rd.DataPath, "dataPath", "the whole data can't be redacted")
+			}
+		default:
+			fe = apis.ErrMissingOneOf("extension", "dataPath")
+		}
+		if rd.Action != nil {
+			switch *rd.Action {
+			case RedactionActionRemove, RedactionActionHash:
+				// nothing
+			default:
+				fe = fe.Also(apis.ErrInvalidValue(*rd.Action, "action"))
+			}
+		}
+		errs = errs.Also(fe.ViaFieldIndex("redact", i))
+	}
+	return errs
+}
+
+func validateRuleExtensionName(name string) *apis.FieldError {
+	if name == "" {
+		return apis.ErrMissingField(apis.CurrentField)
+	}
+	if !validAttributeName.MatchString(name) {
+		return apis.ErrInvalidValue(name, apis.CurrentField, "Extension name must start with a letter and can only contain lowercase alphanumeric")
+	}
+	if eventingduckv1.IsContextAttribute(name) {
+		return apis.ErrInvalidValue(name, apis.CurrentField, "CloudEvents context attributes can't be modified")
+	}
+	return nil
+}
+
 func (b *Broker) CheckImmutableFields(ctx context.Context, original *Broker) *apis.FieldError {
 	if original == nil {
 		return nil
 	}
 
-	// Only Delivery options are mutable.
-	ignoreArguments := cmpopts.IgnoreFields(BrokerSpec{}, "Delivery")
+	// Only Delivery, event size, claim check and ingress options are mutable.
+	ignoreArguments := cmpopts.IgnoreFields(BrokerSpec{}, "Delivery", "MaxEventSize", "ClaimCheck", "Ingress")
 	if diff, err := kmp.ShortDiff(original.Spec, b.Spec, ignoreArguments); err != nil {
 		return &apis.FieldError{
 			Message: "Failed to diff Broker",
@@ -19,11 +19,13 @@ package v1
 import (
 	"context"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
@@ -405,6 +407,84 @@ func TestValidSpec(t *testing.T) {
 			},
 			Delivery: &eventingduckv1.DeliverySpec{BackoffPolicy: &bop},
 		},
+	}, {
+		name: "valid max event size and claim check",
+		spec: BrokerSpec{
+			MaxEventSize: ptr.To[int64](1024 * 1024),
+			ClaimCheck:   &ClaimCheckSpec{Threshold: 64 * 1024},
+		},
+	}, {
+		name: "invalid max event size",
+		spec: BrokerSpec{
+			MaxEventSize: ptr.To[int64](0),
+		},
+		want: apis.ErrOutOfBoundsValue(0, 1, math.MaxInt64, "maxEventSize"),
+	}, {
+		name: "invalid claim check threshold",
+		spec: BrokerSpec{
+			ClaimCheck: &ClaimCheckSpec{},
+		},
+		want: apis.ErrOutOfBoundsValue(0, 1, math.MaxInt64, "claimCheck.threshold"),
+	}, {
+		name: "valid ingress rules",
+		spec: BrokerSpec{
+			Ingress: &BrokerIngressSpec{
+				Rules: []BrokerIngressRule{{
+					Condition: "type = 'order.created'",
+					Enrich: []BrokerIngressEnrichment{
+						{Extension: "authsubject", Source: EnrichmentSourceSubject},
+						{Extension: "receivetime", Source: EnrichmentSourceReceiveTime},
+						{Extension: "cluster", Source: EnrichmentSourceClusterName},
+					},
+					Redact: []BrokerIngressRedaction{
+						{Extension: "email"},
+						{DataPath: "$.user.ssn", Action: ptr.To(RedactionActionHash)},
+					},
+				}},
+			},
+		},
+	}, {
+		name: "invalid ingress rule condition",
+		spec: BrokerSpec{
+			Ingress: &BrokerIngressSpec{
+				Rules: []BrokerIngressRule{{
+					Condition: "type = ",
+				}},
+			},
+		},
+		want: apis.ErrInvalidValue("type = ", "ingress.rules[0].condition"),
+	}, {
+		name: "invalid ingress rule enrichment",
+		spec: BrokerSpec{
+			Ingress: &BrokerIngressSpec{
+				Rules: []BrokerIngressRule{{
+					Enrich: []BrokerIngressEnrichment{
+						{Extension: "Subject", Source: EnrichmentSourceSubject},
+						{Extension: "source", Source: "pod"},
+					},
+				}},
+			},
+		},
+		want: apis.ErrInvalidValue("Subject", "ingress.rules[0].enrich[0].extension", "Extension name must start with a letter and can only contain lowercase alphanumeric").Also(
+			apis.ErrInvalidValue("source", "ingress.rules[0].enrich[1].extension", "CloudEvents context attributes can't be modified")).Also(
+			apis.ErrInvalidValue("pod", "ingress.rules[0].enrich[1].source")),
+	}, {
+		name: "invalid ingress rule redaction",
+		spec: BrokerSpec{
+			Ingress: &BrokerIngressSpec{
+				Rules: []BrokerIngressRule{{
+					Redact: []BrokerIngressRedaction{
+						{},
+						{Extension: "email", DataPath: "$.email"},
+						{DataPath: "$.user..email", Action: ptr.To(RedactionActionType("mask"))},
+					},
+				}},
+			},
+		},
+		want: apis.ErrMissingOneOf("ingress.rules[0].redact[0].extension", "ingress.rules[0].redact[0].dataPath").Also(
+			apis.ErrMultipleOneOf("ingress.rules[0].redact[1].extension", "ingress.rules[0].redact[1].dataPath")).Also(
+			apis.ErrInvalidValue("$.user..email", "ingress.rules[0].redact[2].dataPath", `expression "$.user..email" contains an empty name`)).Also(
+			apis.ErrInvalidValue("mask", "ingress.rules[0].redact[2].action")),
 	}, {}}
 
 	for _, test := range tests {
@@ -107,6 +107,11 @@ type TriggerSpec struct {
 	// Delivery contains the delivery spec for this specific trigger.
 	// +optional
 	Delivery *eventingduckv1.DeliverySpec `json:"delivery,omitempty"`
+
+	// Transform is the transformation applied to the events that pass the
+	// filters before they are delivered to the Subscriber.
+	// +optional
+	Transform *eventingduckv1.TransformSpec `json:"transform,omitempty"`
 }
 
 type TriggerFilter struct {
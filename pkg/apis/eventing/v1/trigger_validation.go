@@ -80,6 +80,8 @@ func (ts *TriggerSpec) Validate(ctx context.Context) (errs *apis.FieldError) {
 		ts.Subscriber.Validate(ctx).ViaField("subscriber"),
 	).Also(
 		ts.Delivery.Validate(ctx).ViaField("delivery"),
+	).Also(
+		ts.Transform.Validate(ctx).ViaField("transform"),
 	)
 }
 
@@ -391,6 +391,14 @@ func TestTriggerSpecValidation(t *testing.T) {
 			Subscriber: validSubscriber,
 		},
 		want: &apis.FieldError{},
+	}, {
+		name: "invalid transform",
+		ts: &TriggerSpec{
+			Broker:     "test_broker",
+			Subscriber: validSubscriber,
+			Transform:  &eventingduckv1.TransformSpec{Remove: []string{"id"}},
+		},
+		want: apis.ErrInvalidArrayValue("id", "transform.remove", 0),
 	}, {
 		name: "invalid attribute name, start with number",
 		ts: &TriggerSpec{
@@ -56,6 +56,94 @@ func (in *Broker) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerIngressEnrichment) DeepCopyInto(out *BrokerIngressEnrichment) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerIngressEnrichment.
+func (in *BrokerIngressEnrichment) DeepCopy() *BrokerIngressEnrichment {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerIngressEnrichment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerIngressRedaction) DeepCopyInto(out *BrokerIngressRedaction) {
+	*out = *in
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = new(RedactionActionType)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerIngressRedaction.
+func (in *BrokerIngressRedaction) DeepCopy() *BrokerIngressRedaction {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerIngressRedaction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerIngressRule) DeepCopyInto(out *BrokerIngressRule) {
+	*out = *in
+	if in.Enrich != nil {
+		in, out := &in.Enrich, &out.Enrich
+		*out = make([]BrokerIngressEnrichment, len(*in))
+		copy(*out, *in)
+	}
+	if in.Redact != nil {
+		in, out := &in.Redact, &out.Redact
+		*out = make([]BrokerIngressRedaction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerIngressRule.
+func (in *BrokerIngressRule) DeepCopy() *BrokerIngressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerIngressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrokerIngressSpec) DeepCopyInto(out *BrokerIngressSpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]BrokerIngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrokerIngressSpec.
+func (in *BrokerIngressSpec) DeepCopy() *BrokerIngressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BrokerIngressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BrokerList) DeepCopyInto(out *BrokerList) {
 	*out = *in
@@ -102,6 +190,21 @@ func (in *BrokerSpec) DeepCopyInto(out *BrokerSpec) {
 		*out = new(apisduckv1.DeliverySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MaxEventSize != nil {
+		in, out := &in.MaxEventSize, &out.MaxEventSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ClaimCheck != nil {
+		in, out := &in.ClaimCheck, &out.ClaimCheck
+		*out = new(ClaimCheckSpec)
+		**out = **in
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(BrokerIngressSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -135,6 +238,22 @@ func (in *BrokerStatus) DeepCopy() *BrokerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClaimCheckSpec) DeepCopyInto(out *ClaimCheckSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClaimCheckSpec.
+func (in *ClaimCheckSpec) DeepCopy() *ClaimCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClaimCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubscriptionsAPIFilter) DeepCopyInto(out *SubscriptionsAPIFilter) {
 	*out = *in
@@ -323,6 +442,11 @@ func (in *TriggerSpec) DeepCopyInto(out *TriggerSpec) {
 		*out = new(apisduckv1.DeliverySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(apisduckv1.TransformSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
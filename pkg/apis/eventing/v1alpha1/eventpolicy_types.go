@@ -100,6 +100,8 @@ type EventPolicySpecFrom struct {
 	Ref *EventPolicyFromReference `json:"ref,omitempty"`
 
 	// Sub sets the OIDC identity name to be allowed to send events to the target.
+	// When TLS client certificate authentication is enabled, it can also be the
+	// URI or DNS SAN of a client certificate.
 	// It is also possible to set a glob-like pattern to match any suffix.
 	// +optional
 	Sub *string `json:"sub,omitempty"`
@@ -85,11 +85,13 @@ func newDefaults() Flags {
 		KReferenceMapping:          Disabled,
 		TransportEncryption:        Disabled,
 		OIDCAuthentication:         Disabled,
+		ClientCertAuthentication:   Disabled,
 		EvenTypeAutoCreate:         Disabled,
 		NewAPIServerFilters:        Disabled,
 		AuthorizationDefaultMode:   AuthorizationAllowSameNamespace,
 		OIDCDiscoveryBaseURL:       DefaultOIDCDiscoveryBaseURL,
 		RequestReplyDefaultTimeout: DefaultRequestReplyTimeout,
+		DelayedDelivery:            Disabled,
 	}
 }
 
@@ -127,6 +129,12 @@ func (e Flags) IsOIDCAuthentication() bool {
 	return e != nil && e[OIDCAuthentication] == Enabled
 }
 
+// IsClientCertAuthentication returns true if senders are authenticated by their TLS client certificate.
+// It requires TransportEncryption to be in Strict mode.
+func (e Flags) IsClientCertAuthentication() bool {
+	return e != nil && e[ClientCertAuthentication] == Enabled && e.IsStrictTransportEncryption()
+}
+
 func (e Flags) IsCrossNamespaceEventLinks() bool {
 	return e != nil && e[CrossNamespaceEventLinks] == Enabled
 }
@@ -227,6 +235,10 @@ func NewFlagsConfigFromMap(data map[string]string) (Flags, error) {
 		}
 	}
 
+	if flags[ClientCertAuthentication] == Enabled && !flags.IsStrictTransportEncryption() {
+		return nil, fmt.Errorf("%s requires %s to be %q", ClientCertAuthentication, TransportEncryption, Strict)
+	}
+
 	return flags, nil
 }
 
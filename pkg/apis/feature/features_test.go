@@ -74,3 +74,20 @@ func TestShouldNotOverrideDefaults(t *testing.T) {
 		t.Errorf("Expected default value for %s in flags %+v", KReferenceGroup, f)
 	}
 }
+
+func TestClientCertAuthenticationRequiresStrictTransportEncryption(t *testing.T) {
+	for _, te := range []Flag{Disabled, Permissive} {
+		_, err := NewFlagsConfigFromMap(map[string]string{
+			TransportEncryption:      string(te),
+			ClientCertAuthentication: string(Enabled),
+		})
+		require.Error(t, err, "transport encryption %s", te)
+	}
+
+	f, err := NewFlagsConfigFromMap(map[string]string{
+		TransportEncryption:      string(Strict),
+		ClientCertAuthentication: string(Enabled),
+	})
+	require.NoError(t, err)
+	require.True(t, f.IsClientCertAuthentication())
+}
@@ -24,10 +24,12 @@ const (
 	TransportEncryption        = "transport-encryption"
 	EvenTypeAutoCreate         = "eventtype-auto-create"
 	OIDCAuthentication         = "authentication-oidc"
+	ClientCertAuthentication   = "authentication-client-certificate"
 	NodeSelectorLabel          = "apiserversources-nodeselector-"
 	CrossNamespaceEventLinks   = "cross-namespace-event-links"
 	NewAPIServerFilters        = "new-apiserversource-filters"
 	AuthorizationDefaultMode   = "default-authorization-mode"
 	OIDCDiscoveryBaseURL       = "oidc-discovery-base-url"
 	RequestReplyDefaultTimeout = "requestreply-default-timeout"
+	DelayedDelivery            = "delayed-delivery"
 )
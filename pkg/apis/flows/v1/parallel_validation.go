@@ -45,6 +45,10 @@ func (ps *ParallelSpec) Validate(ctx context.Context) *apis.FieldError {
 		if e := s.Reply.Validate(ctx); e != nil {
 			errs = errs.Also(apis.ErrInvalidArrayValue(s, "branches.reply", i))
 		}
+
+		if e := s.Delivery.ValidateDataRefUnsupported(); e != nil {
+			errs = errs.Also(e.ViaField("delivery").ViaFieldIndex("branches", i))
+		}
 	}
 
 	if ps.ChannelTemplate == nil {
@@ -62,6 +62,7 @@ func (ss *SequenceStep) Validate(ctx context.Context) *apis.FieldError {
 		if de := ss.Delivery.Validate(ctx); de != nil {
 			errs = errs.Also(de.ViaField("delivery"))
 		}
+		errs = errs.Also(ss.Delivery.ValidateDataRefUnsupported().ViaField("delivery"))
 	}
 
 	return errs
@@ -18,6 +18,7 @@ package v1
 
 import (
 	"context"
+	"math"
 
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"knative.dev/pkg/apis"
@@ -54,6 +55,11 @@ func (cs *ChannelSpec) Validate(ctx context.Context) *apis.FieldError {
 		if fe := cs.Delivery.Validate(ctx); fe != nil {
 			errs = errs.Also(fe.ViaField("delivery"))
 		}
+		errs = errs.Also(cs.Delivery.ValidateDataRefUnsupported().ViaField("delivery"))
+	}
+
+	if cs.MaxEventSize != nil && *cs.MaxEventSize < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*cs.MaxEventSize, 1, math.MaxInt64, "maxEventSize"))
 	}
 
 	return errs
@@ -18,11 +18,13 @@ package v1
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
 
 	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
@@ -127,6 +129,38 @@ func TestChannelValidation(t *testing.T) {
 			},
 		},
 		want: nil,
+	}, {
+		name: "invalid maxEventSize",
+		cr: &Channel{
+			Spec: ChannelSpec{
+				ChannelTemplate: &ChannelTemplateSpec{
+					TypeMeta: v1.TypeMeta{
+						Kind:       "Channel",
+						APIVersion: SchemeGroupVersion.String(),
+					},
+				},
+				ChannelableSpec: eventingduck.ChannelableSpec{
+					MaxEventSize: ptr.To[int64](0),
+				},
+			},
+		},
+		want: apis.ErrOutOfBoundsValue(0, 1, math.MaxInt64, "spec.maxEventSize"),
+	}, {
+		name: "valid maxEventSize",
+		cr: &Channel{
+			Spec: ChannelSpec{
+				ChannelTemplate: &ChannelTemplateSpec{
+					TypeMeta: v1.TypeMeta{
+						Kind:       "Channel",
+						APIVersion: SchemeGroupVersion.String(),
+					},
+				},
+				ChannelableSpec: eventingduck.ChannelableSpec{
+					MaxEventSize: ptr.To[int64](1024),
+				},
+			},
+		},
+		want: nil,
 	}, {
 		name: "valid minimal spec",
 		cr: &Channel{
@@ -19,6 +19,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"knative.dev/pkg/apis"
 	"knative.dev/pkg/kmp"
@@ -63,6 +64,12 @@ func (imcs *InMemoryChannelSpec) Validate(ctx context.Context) *apis.FieldError
 		}
 	}
 
+	errs = errs.Also(imcs.Delivery.ValidateDataRefUnsupported().ViaField("delivery"))
+
+	if imcs.MaxEventSize != nil && *imcs.MaxEventSize < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*imcs.MaxEventSize, 1, math.MaxInt64, "maxEventSize"))
+	}
+
 	return errs
 }
 
@@ -104,6 +104,11 @@ type SubscriptionSpec struct {
 	// Delivery configuration
 	// +optional
 	Delivery *eventingduckv1.DeliverySpec `json:"delivery,omitempty"`
+
+	// Transform is the transformation applied to the events before they are
+	// delivered to the Subscriber.
+	// +optional
+	Transform *eventingduckv1.TransformSpec `json:"transform,omitempty"`
 }
 
 // SubscriptionStatus (computed) for a subscription
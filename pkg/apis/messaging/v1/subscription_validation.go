@@ -78,8 +78,12 @@ func (ss *SubscriptionSpec) Validate(ctx context.Context) *apis.FieldError {
 		if fe := ss.Delivery.Validate(ctx); fe != nil {
 			errs = errs.Also(fe.ViaField("delivery"))
 		}
+		// Channels don't resolve claim checks, only Brokers offload event data.
+		errs = errs.Also(ss.Delivery.ValidateDataRefUnsupported().ViaField("delivery"))
 	}
 
+	errs = errs.Also(ss.Transform.Validate(ctx).ViaField("transform"))
+
 	return errs
 }
 
@@ -92,8 +96,8 @@ func (s *Subscription) CheckImmutableFields(ctx context.Context, original *Subsc
 		return nil
 	}
 
-	// Only Subscriber and Reply are mutable.
-	ignoreArguments := cmpopts.IgnoreFields(SubscriptionSpec{}, "Subscriber", "Reply", "Delivery")
+	// Only Subscriber, Reply, Delivery and Transform are mutable.
+	ignoreArguments := cmpopts.IgnoreFields(SubscriptionSpec{}, "Subscriber", "Reply", "Delivery", "Transform")
 	if diff, err := kmp.ShortDiff(original.Spec, s.Spec, ignoreArguments); err != nil {
 		return &apis.FieldError{
 			Message: "Failed to diff Subscription",
@@ -21,6 +21,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
@@ -134,6 +135,33 @@ func TestSubscriptionSpecValidation(t *testing.T) {
 			Delivery:   getDelivery(backoffDelayValid),
 		},
 		want: nil,
+	}, {
+		name: "data ref not supported",
+		c: &SubscriptionSpec{
+			Channel:    getValidChannelRef(),
+			Subscriber: getValidDestination(),
+			Delivery:   &eventingduckv1.DeliverySpec{DataRef: ptr.To(eventingduckv1.DataRefPolicyReference)},
+		},
+		want: &apis.FieldError{
+			Message: "dataRef is only supported by Triggers",
+			Paths:   []string{"delivery.dataRef"},
+		},
+	}, {
+		name: "valid with transform",
+		c: &SubscriptionSpec{
+			Channel:    getValidChannelRef(),
+			Subscriber: getValidDestination(),
+			Transform:  &eventingduckv1.TransformSpec{Set: map[string]string{"type": "order.received"}, Data: "$.order"},
+		},
+		want: nil,
+	}, {
+		name: "invalid transform",
+		c: &SubscriptionSpec{
+			Channel:    getValidChannelRef(),
+			Subscriber: getValidDestination(),
+			Transform:  &eventingduckv1.TransformSpec{Data: "order"},
+		},
+		want: apis.ErrInvalidValue("order", "transform.data", `expression "order" must start with $`),
 	}, {
 		name: "empty Channel",
 		c: &SubscriptionSpec{
@@ -338,6 +338,11 @@ func (in *SubscriptionSpec) DeepCopyInto(out *SubscriptionSpec) {
 		*out = new(apisduckv1.DeliverySpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Transform != nil {
+		in, out := &in.Transform, &out.Transform
+		*out = new(apisduckv1.TransformSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -17,7 +17,33 @@ limitations under the License.
 package sinks
 
 const (
-	JobSinkJobsLabelSelector = "sinks.knative.dev/job-sink=true"
+	JobSinkJobsLabelSelector = JobSinkJobLabel + "=true"
+	JobSinkJobLabel          = "sinks.knative.dev/job-sink"
 	JobSinkNameLabel         = "sinks.knative.dev/job-sink-name"
 	JobSinkIDLabel           = "sinks.knative.dev/job-sink-id"
+
+	// JobSinkConcurrencyKeyLabel is the label holding the hashed concurrency key of the
+	// event a Job was created for.
+	JobSinkConcurrencyKeyLabel = "sinks.knative.dev/job-sink-concurrency-key"
+
+	// JobSinkDeadLetteredAnnotation is the annotation set on failed Jobs once their
+	// event has been sent to the dead letter sink.
+	JobSinkDeadLetteredAnnotation = "sinks.knative.dev/dead-lettered"
+
+	// JobSinkResultReportedAnnotation is the annotation set on finished Jobs once their
+	// result has been sent to the JobSink sink.
+	JobSinkResultReportedAnnotation = "sinks.knative.dev/result-reported"
+
+	// JobSinkEventIDAnnotation and JobSinkEventSourceAnnotation are the annotations
+	// holding the id and source of the event a Job was created for.
+	JobSinkEventIDAnnotation     = "sinks.knative.dev/event-id"
+	JobSinkEventSourceAnnotation = "sinks.knative.dev/event-source"
+
+	// JobSinkEventStorageAnnotation is the annotation holding the type of storage of the
+	// event a Job was created for, the JobSink event storage might change afterwards.
+	JobSinkEventStorageAnnotation = "sinks.knative.dev/event-storage"
+
+	// JobSinkEventKey is the key of the event in the Secret or ConfigMap storing it,
+	// and the name of the event file in the directory mounted into the Job.
+	JobSinkEventKey = "event"
 )
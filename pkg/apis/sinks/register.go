@@ -39,6 +39,12 @@ var (
 		Group:    GroupName,
 		Resource: "integrationsinks",
 	}
+
+	// DeadLetterSinkResource represents a Knative Eventing sink DeadLetterSink
+	DeadLetterSinkResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "deadlettersinks",
+	}
 )
 
 type Config struct {
@@ -0,0 +1,33 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"k8s.io/utils/ptr"
+)
+
+func (sink *DeadLetterSink) SetDefaults(ctx context.Context) {
+	sink.Spec.SetDefaults(ctx)
+}
+
+func (sink *DeadLetterSinkSpec) SetDefaults(ctx context.Context) {
+	if sink.MaxEvents == nil {
+		sink.MaxEvents = ptr.To(DefaultDeadLetterSinkMaxEvents)
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+const (
+	// DeadLetterSinkConditionReady has status True when the DeadLetterSink is ready to receive events.
+	DeadLetterSinkConditionReady = apis.ConditionReady
+
+	// DeadLetterSinkConditionAddressable has status True when the DeadLetterSink has an address.
+	DeadLetterSinkConditionAddressable apis.ConditionType = "Addressable"
+
+	// DeadLetterSinkConditionEventPoliciesReady has status True when all the applying EventPolicies
+	// for this DeadLetterSink are ready.
+	DeadLetterSinkConditionEventPoliciesReady apis.ConditionType = "EventPoliciesReady"
+)
+
+var DeadLetterSinkCondSet = apis.NewLivingConditionSet(
+	DeadLetterSinkConditionAddressable,
+	DeadLetterSinkConditionEventPoliciesReady,
+)
+
+// GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
+func (*DeadLetterSink) GetConditionSet() apis.ConditionSet {
+	return DeadLetterSinkCondSet
+}
+
+// GetGroupVersionKind returns the GroupVersionKind.
+func (*DeadLetterSink) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("DeadLetterSink")
+}
+
+// GetCondition returns the condition currently associated with the given type, or nil.
+func (s *DeadLetterSinkStatus) GetCondition(t apis.ConditionType) *apis.Condition {
+	return DeadLetterSinkCondSet.Manage(s).GetCondition(t)
+}
+
+// GetTopLevelCondition returns the top level Condition.
+func (s *DeadLetterSinkStatus) GetTopLevelCondition() *apis.Condition {
+	return DeadLetterSinkCondSet.Manage(s).GetTopLevelCondition()
+}
+
+// IsReady returns true if the resource is ready overall.
+func (s *DeadLetterSinkStatus) IsReady() bool {
+	return DeadLetterSinkCondSet.Manage(s).IsHappy()
+}
+
+// InitializeConditions sets relevant unset conditions to Unknown state.
+func (s *DeadLetterSinkStatus) InitializeConditions() {
+	DeadLetterSinkCondSet.Manage(s).InitializeConditions()
+}
+
+// MarkEventPoliciesFailed marks the EventPoliciesReady condition to False with the given reason and message.
+func (s *DeadLetterSinkStatus) MarkEventPoliciesFailed(reason, messageFormat string, messageA ...interface{}) {
+	DeadLetterSinkCondSet.Manage(s).MarkFalse(DeadLetterSinkConditionEventPoliciesReady, reason, messageFormat, messageA...)
+}
+
+// MarkEventPoliciesUnknown marks the EventPoliciesReady condition to Unknown with the given reason and message.
+func (s *DeadLetterSinkStatus) MarkEventPoliciesUnknown(reason, messageFormat string, messageA ...interface{}) {
+	DeadLetterSinkCondSet.Manage(s).MarkUnknown(DeadLetterSinkConditionEventPoliciesReady, reason, messageFormat, messageA...)
+}
+
+// MarkEventPoliciesTrue marks the EventPoliciesReady condition to True.
+func (s *DeadLetterSinkStatus) MarkEventPoliciesTrue() {
+	DeadLetterSinkCondSet.Manage(s).MarkTrue(DeadLetterSinkConditionEventPoliciesReady)
+}
+
+// MarkEventPoliciesTrueWithReason marks the EventPoliciesReady condition to True with the given reason and message.
+func (s *DeadLetterSinkStatus) MarkEventPoliciesTrueWithReason(reason, messageFormat string, messageA ...interface{}) {
+	DeadLetterSinkCondSet.Manage(s).MarkTrueWithReason(DeadLetterSinkConditionEventPoliciesReady, reason, messageFormat, messageA...)
+}
+
+// SetAddress sets the address of the DeadLetterSink and marks the Addressable condition accordingly.
+func (s *DeadLetterSinkStatus) SetAddress(address *duckv1.Addressable) {
+	s.Address = address
+	if address == nil || address.URL.IsEmpty() {
+		DeadLetterSinkCondSet.Manage(s).MarkFalse(DeadLetterSinkConditionAddressable, "EmptyHostname", "hostname is the empty string")
+	} else {
+		DeadLetterSinkCondSet.Manage(s).MarkTrue(DeadLetterSinkConditionAddressable)
+	}
+}
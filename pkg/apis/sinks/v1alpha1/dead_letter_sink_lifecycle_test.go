@@ -0,0 +1,103 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestDeadLetterSinkGetConditionSet(t *testing.T) {
+	r := &DeadLetterSink{}
+
+	if got, want := r.GetConditionSet().GetTopLevelConditionType(), apis.ConditionReady; got != want {
+		t.Errorf("GetTopLevelCondition=%v, want=%v", got, want)
+	}
+}
+
+func TestDeadLetterSinkGetStatus(t *testing.T) {
+	r := &DeadLetterSink{}
+	if got, want := r.GetStatus(), &r.Status.Status; got != want {
+		t.Errorf("GetStatus=%v, want=%v", got, want)
+	}
+}
+
+func TestDeadLetterSinkInitializeConditions(t *testing.T) {
+	s := &DeadLetterSinkStatus{}
+	s.InitializeConditions()
+
+	want := &DeadLetterSinkStatus{
+		Status: duckv1.Status{
+			Conditions: []apis.Condition{{
+				Type:   DeadLetterSinkConditionAddressable,
+				Status: corev1.ConditionUnknown,
+			}, {
+				Type:   DeadLetterSinkConditionEventPoliciesReady,
+				Status: corev1.ConditionUnknown,
+			}, {
+				Type:   DeadLetterSinkConditionReady,
+				Status: corev1.ConditionUnknown,
+			}},
+		},
+	}
+	ignoreAllButTypeAndStatus := cmp.FilterPath(func(p cmp.Path) bool {
+		return p.String() == "Status.Conditions.LastTransitionTime"
+	}, cmp.Ignore())
+	if diff := cmp.Diff(want, s, ignoreAllButTypeAndStatus); diff != "" {
+		t.Error("unexpected conditions (-want, +got) =", diff)
+	}
+}
+
+func TestDeadLetterSinkIsReady(t *testing.T) {
+	tests := []struct {
+		name          string
+		address       *duckv1.Addressable
+		policiesReady bool
+		want          bool
+	}{{
+		name:          "ready",
+		address:       &duckv1.Addressable{URL: apis.HTTP("dead-letter-sink.knative-eventing.svc.cluster.local")},
+		policiesReady: true,
+		want:          true,
+	}, {
+		name:          "no address",
+		policiesReady: true,
+	}, {
+		name:    "event policies not ready",
+		address: &duckv1.Addressable{URL: apis.HTTP("dead-letter-sink.knative-eventing.svc.cluster.local")},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &DeadLetterSinkStatus{}
+			s.InitializeConditions()
+			s.SetAddress(tt.address)
+			if tt.policiesReady {
+				s.MarkEventPoliciesTrue()
+			} else {
+				s.MarkEventPoliciesFailed("Failed", "")
+			}
+			if got := s.IsReady(); got != tt.want {
+				t.Errorf("IsReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+)
+
+const (
+	// DefaultDeadLetterSinkMaxEvents is the default maximum number of events stored by
+	// a DeadLetterSink.
+	DefaultDeadLetterSinkMaxEvents int32 = 1000
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:defaulter-gen=true
+
+// DeadLetterSink is a sink storing the events it receives, typically events which could
+// not be delivered, along with their knativeerror* extensions.
+//
+// The stored events can be listed, inspected, deleted and redriven to the destination
+// they could not be delivered to with the API served under the `events` path of the
+// DeadLetterSink address, for example:
+//   - GET <address>/events lists the stored events.
+//   - GET <address>/events/<id> returns a stored event.
+//   - DELETE <address>/events/<id> deletes a stored event.
+//   - POST <address>/events/<id>/redrive sends a stored event to the subscriber of the
+//     Trigger or Subscription which sent it, and deletes it once delivered.
+//
+// The API requests are authenticated with Kubernetes bearer tokens, which must be
+// allowed to get, list, delete or redrive the `deadlettersinks/events` subresource.
+// Only events sent by a Trigger or Subscription of the namespace authenticated with its
+// OIDC identity or client certificate can be redriven. They are redriven with the OIDC
+// identity of the Trigger or Subscription, and only when they pass the Trigger filter.
+//
+// The events are kept in the event store of the dead letter sink data plane, rather
+// than in the cluster, and are deleted along with the DeadLetterSink.
+type DeadLetterSink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeadLetterSinkSpec   `json:"spec,omitempty"`
+	Status DeadLetterSinkStatus `json:"status,omitempty"`
+}
+
+// Check the interfaces that DeadLetterSink should be implementing.
+var (
+	_ runtime.Object     = (*DeadLetterSink)(nil)
+	_ kmeta.OwnerRefable = (*DeadLetterSink)(nil)
+	_ apis.Validatable   = (*DeadLetterSink)(nil)
+	_ apis.Defaultable   = (*DeadLetterSink)(nil)
+	_ apis.HasSpec       = (*DeadLetterSink)(nil)
+	_ duckv1.KRShaped    = (*DeadLetterSink)(nil)
+	_ apis.Convertible   = (*DeadLetterSink)(nil)
+)
+
+// DeadLetterSinkSpec defines the desired state of the DeadLetterSink.
+type DeadLetterSinkSpec struct {
+	// MaxEvents is the maximum number of stored events, the oldest events are
+	// deleted once it is exceeded. Defaults to 1000.
+	// +optional
+	MaxEvents *int32 `json:"maxEvents,omitempty"`
+
+	// Retention is the duration the events are stored for, after which they are
+	// deleted. By default, events are stored until they are deleted or redriven.
+	// More information on Duration format:
+	//  - https://www.iso.org/iso-8601-date-and-time-format.html
+	//  - https://en.wikipedia.org/wiki/ISO_8601
+	// +optional
+	Retention *string `json:"retention,omitempty"`
+}
+
+// DeadLetterSinkStatus defines the observed state of DeadLetterSink.
+type DeadLetterSinkStatus struct {
+	duckv1.Status `json:",inline"`
+
+	// AddressStatus is the part where the DeadLetterSink fulfills the Addressable contract.
+	// It exposes the endpoint as an URI to get events delivered.
+	// +optional
+	duckv1.AddressStatus `json:",inline"`
+
+	// AppliedEventPoliciesStatus contains the list of EventPolicies which apply to this DeadLetterSink
+	// +optional
+	eventingduckv1.AppliedEventPoliciesStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeadLetterSinkList contains a list of DeadLetterSink
+type DeadLetterSinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeadLetterSink `json:"items"`
+}
+
+// GetUntypedSpec returns the spec of the DeadLetterSink.
+func (sink *DeadLetterSink) GetUntypedSpec() interface{} {
+	return sink.Spec
+}
+
+// GetStatus retrieves the status of the DeadLetterSink. Implements the KRShaped interface.
+func (sink *DeadLetterSink) GetStatus() *duckv1.Status {
+	return &sink.Status.Status
+}
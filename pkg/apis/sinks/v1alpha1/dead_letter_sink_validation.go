@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"math"
+
+	"github.com/rickb777/date/period"
+	"knative.dev/pkg/apis"
+)
+
+func (sink *DeadLetterSink) Validate(ctx context.Context) *apis.FieldError {
+	ctx = apis.WithinParent(ctx, sink.ObjectMeta)
+	return sink.Spec.Validate(ctx).ViaField("spec")
+}
+
+func (sink *DeadLetterSinkSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if sink.MaxEvents != nil && *sink.MaxEvents < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*sink.MaxEvents, 1, math.MaxInt32, "maxEvents"))
+	}
+	if sink.Retention != nil {
+		p, err := period.Parse(*sink.Retention)
+		if err != nil || p.IsZero() || p.IsNegative() {
+			errs = errs.Also(apis.ErrInvalidValue(*sink.Retention, "retention"))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+)
+
+func TestDeadLetterSinkValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		sink DeadLetterSink
+		want *apis.FieldError
+	}{{
+		name: "empty",
+		sink: DeadLetterSink{},
+	}, {
+		name: "valid",
+		sink: DeadLetterSink{
+			Spec: DeadLetterSinkSpec{
+				MaxEvents: ptr.To[int32](100),
+				Retention: ptr.To("PT12H"),
+			},
+		},
+	}, {
+		name: "invalid max events",
+		sink: DeadLetterSink{
+			Spec: DeadLetterSinkSpec{
+				MaxEvents: ptr.To[int32](0),
+			},
+		},
+		want: apis.ErrOutOfBoundsValue(0, 1, math.MaxInt32, "spec.maxEvents"),
+	}, {
+		name: "invalid retention",
+		sink: DeadLetterSink{
+			Spec: DeadLetterSinkSpec{
+				Retention: ptr.To("12h"),
+			},
+		},
+		want: apis.ErrInvalidValue("12h", "spec.retention"),
+	}, {
+		name: "zero retention",
+		sink: DeadLetterSink{
+			Spec: DeadLetterSinkSpec{
+				Retention: ptr.To("PT0S"),
+			},
+		},
+		want: apis.ErrInvalidValue("PT0S", "spec.retention"),
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.sink.Validate(context.Background())
+			if diff := cmp.Diff(tt.want.Error(), got.Error()); diff != "" {
+				t.Error("DeadLetterSink.Validate (-want, +got) =", diff)
+			}
+		})
+	}
+}
@@ -30,6 +30,8 @@ func TestTypesImplements(t *testing.T) {
 	}{
 		{instance: &JobSink{}, iface: &duckv1.Conditions{}},
 		{instance: &JobSink{}, iface: &duckv1.Addressable{}},
+		{instance: &DeadLetterSink{}, iface: &duckv1.Conditions{}},
+		{instance: &DeadLetterSink{}, iface: &duckv1.Addressable{}},
 	}
 	for _, tc := range testCases {
 		if err := duck.VerifyType(tc.instance, tc.iface); err != nil {
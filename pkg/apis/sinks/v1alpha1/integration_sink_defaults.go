@@ -16,11 +16,38 @@ limitations under the License.
 
 package v1alpha1
 
-import "context"
+import (
+	"context"
+	"net/http"
+)
+
+const (
+	// DatabaseTypePostgreSQL is the type of the PostgreSQL database sinks.
+	DatabaseTypePostgreSQL = "postgresql"
+	// DatabaseTypeMySQL is the type of the MySQL database sinks.
+	DatabaseTypeMySQL = "mysql"
+
+	// DefaultFileSinkFileName is the name of the file written by the file sinks by default.
+	DefaultFileSinkFileName = "events.ndjson"
+)
+
+var defaultDatabasePorts = map[string]int{
+	DatabaseTypePostgreSQL: 5432,
+	DatabaseTypeMySQL:      3306,
+}
 
 func (sink *IntegrationSink) SetDefaults(ctx context.Context) {
 	sink.Spec.SetDefaults(ctx)
 }
 
 func (sink *IntegrationSinkSpec) SetDefaults(ctx context.Context) {
+	if sink.HTTP != nil && sink.HTTP.Method == "" {
+		sink.HTTP.Method = http.MethodPost
+	}
+	if sink.Database != nil && sink.Database.Port == 0 {
+		sink.Database.Port = defaultDatabasePorts[sink.Database.Type]
+	}
+	if sink.File != nil && sink.File.FileName == "" {
+		sink.File.FileName = DefaultFileSinkFileName
+	}
 }
@@ -27,7 +27,49 @@ func TestIntegrationSinkSetDefaults(t *testing.T) {
 	testCases := map[string]struct {
 		initial  IntegrationSink
 		expected IntegrationSink
-	}{}
+	}{
+		"no sink": {},
+		"http sink": {
+			initial: IntegrationSink{Spec: IntegrationSinkSpec{
+				HTTP: &HTTP{URL: "https://example.com"},
+			}},
+			expected: IntegrationSink{Spec: IntegrationSinkSpec{
+				HTTP: &HTTP{URL: "https://example.com", Method: "POST"},
+			}},
+		},
+		"http sink with method": {
+			initial: IntegrationSink{Spec: IntegrationSinkSpec{
+				HTTP: &HTTP{URL: "https://example.com", Method: "PUT"},
+			}},
+			expected: IntegrationSink{Spec: IntegrationSinkSpec{
+				HTTP: &HTTP{URL: "https://example.com", Method: "PUT"},
+			}},
+		},
+		"postgresql sink": {
+			initial: IntegrationSink{Spec: IntegrationSinkSpec{
+				Database: &Database{Type: DatabaseTypePostgreSQL},
+			}},
+			expected: IntegrationSink{Spec: IntegrationSinkSpec{
+				Database: &Database{Type: DatabaseTypePostgreSQL, Port: 5432},
+			}},
+		},
+		"mysql sink with port": {
+			initial: IntegrationSink{Spec: IntegrationSinkSpec{
+				Database: &Database{Type: DatabaseTypeMySQL, Port: 3307},
+			}},
+			expected: IntegrationSink{Spec: IntegrationSinkSpec{
+				Database: &Database{Type: DatabaseTypeMySQL, Port: 3307},
+			}},
+		},
+		"file sink": {
+			initial: IntegrationSink{Spec: IntegrationSinkSpec{
+				File: &File{},
+			}},
+			expected: IntegrationSink{Spec: IntegrationSinkSpec{
+				File: &File{FileName: "events.ndjson"},
+			}},
+		},
+	}
 	for n, tc := range testCases {
 		t.Run(n, func(t *testing.T) {
 			tc.initial.SetDefaults(context.TODO())
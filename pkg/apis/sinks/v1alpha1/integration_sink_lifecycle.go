@@ -106,6 +106,12 @@ func (s *IntegrationSinkStatus) MarkEventPoliciesTrueWithReason(reason, messageF
 	IntegrationSinkCondSet.Manage(s).MarkTrueWithReason(IntegrationSinkConditionEventPoliciesReady, reason, messageFormat, messageA...)
 }
 
+// MarkImageNotConfigured sets the condition that the Deployment can't be created because
+// the image of the sink kind isn't configured.
+func (s *IntegrationSinkStatus) MarkImageNotConfigured(messageFormat string, messageA ...interface{}) {
+	IntegrationSinkCondSet.Manage(s).MarkFalse(IntegrationSinkConditionDeploymentReady, "ImageNotConfigured", messageFormat, messageA...)
+}
+
 func (s *IntegrationSinkStatus) PropagateDeploymentStatus(d *appsv1.Deployment) {
 	// A deployment is fully rolled out when:
 	// 1. ObservedGeneration == Generation: controller has observed the latest spec
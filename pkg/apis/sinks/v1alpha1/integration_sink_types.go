@@ -53,8 +53,11 @@ var (
 )
 
 type IntegrationSinkSpec struct {
-	Aws *Aws `json:"aws,omitempty"` // AWS sink configuration
-	Log *Log `json:"log,omitempty"` // Log sink configuration
+	Aws      *Aws      `json:"aws,omitempty"`      // AWS sink configuration
+	Log      *Log      `json:"log,omitempty"`      // Log sink configuration
+	HTTP     *HTTP     `json:"http,omitempty"`     // HTTP forwarder configuration
+	Database *Database `json:"database,omitempty"` // Database sink configuration
+	File     *File     `json:"file,omitempty"`     // File sink configuration
 }
 
 type Log struct {
@@ -73,6 +76,42 @@ type Log struct {
 	ShowCachedStreams   bool   `json:"showCachedStreams,omitempty" default:"true"`   // Show cached stream bodies
 }
 
+// HTTP forwards the events to an HTTP endpoint.
+type HTTP struct {
+	URL     string            `json:"url"`                               // URL the events are forwarded to
+	Method  string            `json:"method,omitempty" default:"POST"`   // HTTP method of the requests
+	Headers map[string]string `json:"headers,omitempty"`                 // Headers of the requests, values may reference event attributes as ${ce.<attribute>}
+	Timeout int               `json:"timeout,omitempty" default:"30000"` // Request timeout in milliseconds
+	Auth    *v1alpha1.Auth    `json:"auth,omitempty"`                    // Basic (http.username/http.password) or bearer (http.token) authentication secret
+}
+
+// Database inserts a row in a database table for each event.
+type Database struct {
+	Type    string            `json:"type"`                              // Database type, one of postgresql or mysql
+	Host    string            `json:"host" camel:"SERVERNAME"`           // Database server host
+	Port    int               `json:"port,omitempty" camel:"SERVERPORT"` // Database server port, defaults to the port of the database type
+	Name    string            `json:"name" camel:"DATABASENAME"`         // Database name
+	Table   string            `json:"table"`                             // Table the rows are inserted in
+	Columns map[string]string `json:"columns"`                           // Value of each column: ce.<attribute>, data or data.<field>
+	Auth    *v1alpha1.Auth    `json:"auth,omitempty"`                    // Secret holding the credentials (db.username/db.password)
+}
+
+// File appends the events to a file, one JSON encoded event per line, rotating
+// the file when it grows too big or too old.
+type File struct {
+	FileName    string      `json:"fileName,omitempty" default:"events.ndjson"` // Name of the written file
+	MaxFileSize int64       `json:"maxFileSize,omitempty"`                      // Size (in bytes) above which the file is rotated, 0 disables size based rotation
+	MaxFileAge  int         `json:"maxFileAge,omitempty"`                       // Age (in seconds) above which the file is rotated, 0 disables time based rotation
+	MaxFiles    int         `json:"maxFiles,omitempty"`                         // Number of rotated files kept, 0 keeps all of them
+	Volume      *FileVolume `json:"volume,omitempty"`                           // Volume the files are written to, an emptyDir volume when unset
+}
+
+// FileVolume is the PersistentVolumeClaim the files of a File sink are written to.
+type FileVolume struct {
+	ClaimName string `json:"claimName"`         // Name of the PersistentVolumeClaim
+	SubPath   string `json:"subPath,omitempty"` // Directory of the volume the files are written to
+}
+
 type Aws struct {
 	S3   *v1alpha1.AWSS3  `json:"s3,omitempty"`  // S3 sink configuration
 	SQS  *v1alpha1.AWSSQS `json:"sqs,omitempty"` // SQS sink configuration
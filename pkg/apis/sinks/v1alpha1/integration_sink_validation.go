@@ -18,10 +18,27 @@ package v1alpha1
 
 import (
 	"context"
+	"net/http"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
 
+	"golang.org/x/net/http/httpguts"
 	"knative.dev/pkg/apis"
 )
 
+var (
+	httpSinkMethods = []string{http.MethodPost, http.MethodPut, http.MethodPatch}
+
+	headerTemplateRegexp = regexp.MustCompile(`\$\{([^}]*)\}`)
+	ceAttributeRegexp    = regexp.MustCompile(`^ce\.[a-z0-9]+$`)
+
+	tableNameRegexp   = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*\.)?[A-Za-z_][A-Za-z0-9_]*$`)
+	columnNameRegexp  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	columnValueRegexp = regexp.MustCompile(`^(ce\.[a-z0-9]+|data|data\.[A-Za-z0-9_]+)$`)
+)
+
 func (sink *IntegrationSink) Validate(ctx context.Context) *apis.FieldError {
 	ctx = apis.WithinParent(ctx, sink.ObjectMeta)
 	return sink.Spec.Validate(ctx).ViaField("spec")
@@ -35,6 +52,15 @@ func (spec *IntegrationSinkSpec) Validate(ctx context.Context) *apis.FieldError
 	if spec.Log != nil {
 		sinkSetCount++
 	}
+	if spec.HTTP != nil {
+		sinkSetCount++
+	}
+	if spec.Database != nil {
+		sinkSetCount++
+	}
+	if spec.File != nil {
+		sinkSetCount++
+	}
 	if spec.Aws != nil {
 		if spec.Aws.S3 != nil {
 			sinkSetCount++
@@ -93,5 +119,111 @@ func (spec *IntegrationSinkSpec) Validate(ctx context.Context) *apis.FieldError
 		}
 	}
 
+	if sinkSetCount == 1 && spec.HTTP != nil {
+		errs = errs.Also(spec.HTTP.Validate(ctx).ViaField("http"))
+	}
+	if sinkSetCount == 1 && spec.Database != nil {
+		errs = errs.Also(spec.Database.Validate(ctx).ViaField("database"))
+	}
+	if sinkSetCount == 1 && spec.File != nil {
+		errs = errs.Also(spec.File.Validate(ctx).ViaField("file"))
+	}
+
+	return errs
+}
+
+func (h *HTTP) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if h.URL == "" {
+		errs = errs.Also(apis.ErrMissingField("url"))
+	} else if u, err := apis.ParseURL(h.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = errs.Also(apis.ErrInvalidValue(h.URL, "url", "must be an absolute http or https URL"))
+	}
+	if h.Method != "" && !slices.Contains(httpSinkMethods, h.Method) {
+		errs = errs.Also(apis.ErrInvalidValue(h.Method, "method", "must be one of "+strings.Join(httpSinkMethods, ", ")))
+	}
+	for name, value := range h.Headers {
+		if !httpguts.ValidHeaderFieldName(name) {
+			errs = errs.Also(apis.ErrInvalidKeyName(name, "headers"))
+		}
+		for _, m := range headerTemplateRegexp.FindAllStringSubmatch(value, -1) {
+			if !ceAttributeRegexp.MatchString(m[1]) {
+				errs = errs.Also(apis.ErrInvalidValue(value, "headers["+name+"]", "templates must reference an event attribute as ${ce.<attribute>}"))
+				break
+			}
+		}
+	}
+	if h.Timeout < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(h.Timeout, "timeout", "must not be negative"))
+	}
+	if h.Auth != nil && (h.Auth.Secret == nil || h.Auth.Secret.Ref == nil || h.Auth.Secret.Ref.Name == "") {
+		errs = errs.Also(apis.ErrMissingField("auth.secret.ref.name"))
+	}
+	return errs
+}
+
+func (d *Database) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if d.Type == "" {
+		errs = errs.Also(apis.ErrMissingField("type"))
+	} else if _, ok := defaultDatabasePorts[d.Type]; !ok {
+		errs = errs.Also(apis.ErrInvalidValue(d.Type, "type", "must be one of "+DatabaseTypePostgreSQL+", "+DatabaseTypeMySQL))
+	}
+	if d.Host == "" {
+		errs = errs.Also(apis.ErrMissingField("host"))
+	}
+	if d.Port < 0 || d.Port > 65535 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(d.Port, 0, 65535, "port"))
+	}
+	if d.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	}
+	if d.Table == "" {
+		errs = errs.Also(apis.ErrMissingField("table"))
+	} else if !tableNameRegexp.MatchString(d.Table) {
+		errs = errs.Also(apis.ErrInvalidValue(d.Table, "table", "must be an SQL identifier, optionally qualified by a schema"))
+	}
+	if len(d.Columns) == 0 {
+		errs = errs.Also(apis.ErrMissingField("columns"))
+	}
+	for column, value := range d.Columns {
+		if !columnNameRegexp.MatchString(column) {
+			errs = errs.Also(apis.ErrInvalidKeyName(column, "columns", "must be an SQL identifier"))
+		}
+		if !columnValueRegexp.MatchString(value) {
+			errs = errs.Also(apis.ErrInvalidValue(value, "columns["+column+"]", "must be ce.<attribute>, data or data.<field>"))
+		}
+	}
+	if d.Auth == nil || d.Auth.Secret == nil || d.Auth.Secret.Ref == nil || d.Auth.Secret.Ref.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("auth.secret.ref.name"))
+	}
+	return errs
+}
+
+func (f *File) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if f.FileName != "" && (strings.ContainsRune(f.FileName, '/') || f.FileName == "." || f.FileName == "..") {
+		errs = errs.Also(apis.ErrInvalidValue(f.FileName, "fileName", "must be a file name"))
+	}
+	if f.MaxFileSize < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(f.MaxFileSize, "maxFileSize", "must not be negative"))
+	}
+	if f.MaxFileAge < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(f.MaxFileAge, "maxFileAge", "must not be negative"))
+	}
+	if f.MaxFiles < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(f.MaxFiles, "maxFiles", "must not be negative"))
+	}
+	if f.Volume != nil {
+		if f.Volume.ClaimName == "" {
+			errs = errs.Also(apis.ErrMissingField("volume.claimName"))
+		}
+		if p := f.Volume.SubPath; p != "" && (path.IsAbs(p) || slices.Contains(strings.Split(p, "/"), "..")) {
+			errs = errs.Also(apis.ErrInvalidValue(p, "volume.subPath", "must be a relative path within the volume"))
+		}
+	}
 	return errs
 }
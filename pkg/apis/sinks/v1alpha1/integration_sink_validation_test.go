@@ -255,6 +255,146 @@ func TestIntegrationSinkSpecValidation(t *testing.T) {
 			},
 			want: apis.ErrMissingField("aws.s3.region"),
 		},
+		{
+			name: "valid HTTP sink",
+			spec: IntegrationSinkSpec{
+				HTTP: &HTTP{
+					URL:    "https://example.com/events",
+					Method: "PUT",
+					Headers: map[string]string{
+						"X-Event-Id":   "${ce.id}",
+						"X-Event-Info": "${ce.type} from ${ce.source}",
+					},
+					Auth: &v1alpha1.Auth{
+						Secret: &v1alpha1.Secret{
+							Ref: &v1alpha1.SecretReference{
+								Name: "http-secret",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "HTTP sink with invalid URL and method",
+			spec: IntegrationSinkSpec{
+				HTTP: &HTTP{URL: "/events", Method: "GET"},
+			},
+			want: apis.ErrInvalidValue("/events", "http.url", "must be an absolute http or https URL").
+				Also(apis.ErrInvalidValue("GET", "http.method", "must be one of POST, PUT, PATCH")),
+		},
+		{
+			name: "HTTP sink with invalid headers",
+			spec: IntegrationSinkSpec{
+				HTTP: &HTTP{
+					URL: "https://example.com/events",
+					Headers: map[string]string{
+						"X Event": "value",
+						"X-Event": "${data}",
+					},
+				},
+			},
+			want: apis.ErrInvalidKeyName("X Event", "http.headers").
+				Also(apis.ErrInvalidValue("${data}", "http.headers[X-Event]", "templates must reference an event attribute as ${ce.<attribute>}")),
+		},
+		{
+			name: "valid database sink",
+			spec: IntegrationSinkSpec{
+				Database: &Database{
+					Type:  DatabaseTypePostgreSQL,
+					Host:  "db.example.com",
+					Name:  "events",
+					Table: "public.events",
+					Columns: map[string]string{
+						"id":      "ce.id",
+						"payload": "data",
+						"amount":  "data.amount",
+					},
+					Auth: &v1alpha1.Auth{
+						Secret: &v1alpha1.Secret{
+							Ref: &v1alpha1.SecretReference{
+								Name: "db-secret",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "database sink missing fields",
+			spec: IntegrationSinkSpec{
+				Database: &Database{Type: "oracle"},
+			},
+			want: apis.ErrInvalidValue("oracle", "database.type", "must be one of postgresql, mysql").
+				Also(apis.ErrMissingField("database.host", "database.name", "database.table", "database.columns", "database.auth.secret.ref.name")),
+		},
+		{
+			name: "database sink with invalid table and columns",
+			spec: IntegrationSinkSpec{
+				Database: &Database{
+					Type:  DatabaseTypeMySQL,
+					Host:  "db.example.com",
+					Name:  "events",
+					Table: "events; DROP TABLE users",
+					Columns: map[string]string{
+						"id;": "ce.id",
+						"raw": "body",
+					},
+					Auth: &v1alpha1.Auth{
+						Secret: &v1alpha1.Secret{
+							Ref: &v1alpha1.SecretReference{
+								Name: "db-secret",
+							},
+						},
+					},
+				},
+			},
+			want: apis.ErrInvalidValue("events; DROP TABLE users", "database.table", "must be an SQL identifier, optionally qualified by a schema").
+				Also(apis.ErrInvalidKeyName("id;", "database.columns", "must be an SQL identifier")).
+				Also(apis.ErrInvalidValue("body", "database.columns[raw]", "must be ce.<attribute>, data or data.<field>")),
+		},
+		{
+			name: "valid file sink",
+			spec: IntegrationSinkSpec{
+				File: &File{
+					FileName:    "events.ndjson",
+					MaxFileSize: 1024 * 1024,
+					MaxFileAge:  3600,
+					MaxFiles:    5,
+					Volume: &FileVolume{
+						ClaimName: "events",
+						SubPath:   "sink/events",
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "file sink with invalid fields",
+			spec: IntegrationSinkSpec{
+				File: &File{
+					FileName: "../events.ndjson",
+					MaxFiles: -1,
+					Volume: &FileVolume{
+						SubPath: "../other",
+					},
+				},
+			},
+			want: apis.ErrInvalidValue("../events.ndjson", "file.fileName", "must be a file name").
+				Also(apis.ErrInvalidValue(-1, "file.maxFiles", "must not be negative")).
+				Also(apis.ErrMissingField("file.volume.claimName")).
+				Also(apis.ErrInvalidValue("../other", "file.volume.subPath", "must be a relative path within the volume")),
+		},
+		{
+			name: "HTTP and file sinks set (invalid)",
+			spec: IntegrationSinkSpec{
+				HTTP: &HTTP{URL: "https://example.com/events"},
+				File: &File{},
+			},
+			want: apis.ErrGeneric("only one sink type can be set", "spec"),
+		},
 	}
 
 	for _, test := range tests {
@@ -21,12 +21,37 @@ import (
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+)
+
+const (
+	// DefaultConcurrencyKey is the CloudEvent attribute grouping events for the
+	// ConcurrencyPolicy when no ConcurrencyKey is set.
+	DefaultConcurrencyKey = "subject"
 )
 
 func (sink *JobSink) SetDefaults(ctx context.Context) {
 	if sink.Spec.Job != nil {
 		setBatchJobDefaults(sink.Spec.Job)
 	}
+	if sink.Spec.ConcurrencyPolicy == "" {
+		sink.Spec.ConcurrencyPolicy = AllowConcurrent
+	}
+	if sink.Spec.ConcurrencyKey == "" && sink.Spec.ConcurrencyPolicy != AllowConcurrent {
+		sink.Spec.ConcurrencyKey = DefaultConcurrencyKey
+	}
+	if sink.Spec.EventStorage == nil {
+		sink.Spec.EventStorage = &JobSinkEventStorage{}
+	}
+	if sink.Spec.EventStorage.Type == "" {
+		sink.Spec.EventStorage.Type = SecretEventStorage
+	}
+	if sink.Spec.Delivery != nil {
+		sink.Spec.Delivery.SetDefaults(apis.WithinParent(ctx, sink.ObjectMeta))
+	}
+	if sink.Spec.Sink != nil {
+		sink.Spec.Sink.SetDefaults(apis.WithinParent(ctx, sink.ObjectMeta))
+	}
 }
 
 func setBatchJobDefaults(job *batchv1.Job) {
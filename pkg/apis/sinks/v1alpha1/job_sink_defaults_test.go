@@ -122,6 +122,50 @@ func TestSetDefaults(t *testing.T) {
 							},
 						},
 					},
+					ConcurrencyPolicy: AllowConcurrent,
+					EventStorage:      &JobSinkEventStorage{Type: SecretEventStorage},
+				},
+			},
+		},
+		"concurrency key": {
+			initial: JobSink{
+				Spec: JobSinkSpec{
+					ConcurrencyPolicy: ForbidConcurrent,
+				},
+			},
+			expected: JobSink{
+				Spec: JobSinkSpec{
+					ConcurrencyPolicy: ForbidConcurrent,
+					ConcurrencyKey:    DefaultConcurrencyKey,
+					EventStorage:      &JobSinkEventStorage{Type: SecretEventStorage},
+				},
+			},
+		},
+		"custom concurrency key": {
+			initial: JobSink{
+				Spec: JobSinkSpec{
+					ConcurrencyPolicy: ReplaceConcurrent,
+					ConcurrencyKey:    "source",
+				},
+			},
+			expected: JobSink{
+				Spec: JobSinkSpec{
+					ConcurrencyPolicy: ReplaceConcurrent,
+					ConcurrencyKey:    "source",
+					EventStorage:      &JobSinkEventStorage{Type: SecretEventStorage},
+				},
+			},
+		},
+		"custom event storage": {
+			initial: JobSink{
+				Spec: JobSinkSpec{
+					EventStorage: &JobSinkEventStorage{Type: EmptyDirEventStorage},
+				},
+			},
+			expected: JobSink{
+				Spec: JobSinkSpec{
+					ConcurrencyPolicy: AllowConcurrent,
+					EventStorage:      &JobSinkEventStorage{Type: EmptyDirEventStorage},
 				},
 			},
 		},
@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/pkg/apis"
 
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/eventing/pkg/apis/sinks"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
@@ -35,11 +36,25 @@ const (
 	// JobSinkConditionEventPoliciesReady has status True when all the applying EventPolicies for this
 	// JobSink are ready.
 	JobSinkConditionEventPoliciesReady apis.ConditionType = "EventPoliciesReady"
+
+	// JobSinkConditionDeadLetterSinkResolved has status True when the dead letter sink
+	// is resolved or not configured.
+	JobSinkConditionDeadLetterSinkResolved apis.ConditionType = "DeadLetterSinkResolved"
+
+	// JobSinkConditionSinkResolved has status True when the sink receiving the Job
+	// results is resolved or not configured.
+	JobSinkConditionSinkResolved apis.ConditionType = "SinkResolved"
+
+	// JobSinkConditionOIDCIdentityCreated has status True when the JobSink has had its OIDC identity created.
+	JobSinkConditionOIDCIdentityCreated apis.ConditionType = "OIDCIdentityCreated"
 )
 
 var JobSinkCondSet = apis.NewLivingConditionSet(
 	JobSinkConditionAddressable,
 	JobSinkConditionEventPoliciesReady,
+	JobSinkConditionDeadLetterSinkResolved,
+	JobSinkConditionSinkResolved,
+	JobSinkConditionOIDCIdentityCreated,
 )
 
 // GetConditionSet retrieves the condition set for this resource. Implements the KRShaped interface.
@@ -102,6 +117,65 @@ func (s *JobSinkStatus) MarkEventPoliciesTrueWithReason(reason, messageFormat st
 	JobSinkCondSet.Manage(s).MarkTrueWithReason(JobSinkConditionEventPoliciesReady, reason, messageFormat, messageA...)
 }
 
+// MarkDeadLetterSinkResolvedSucceeded marks the DeadLetterSinkResolved condition to True
+// and sets the resolved dead letter sink.
+func (s *JobSinkStatus) MarkDeadLetterSinkResolvedSucceeded(deliveryStatus eventingduckv1.DeliveryStatus) {
+	s.DeliveryStatus = deliveryStatus
+	JobSinkCondSet.Manage(s).MarkTrue(JobSinkConditionDeadLetterSinkResolved)
+}
+
+// MarkDeadLetterSinkNotConfigured marks the DeadLetterSinkResolved condition to True
+// as no dead letter sink is configured.
+func (s *JobSinkStatus) MarkDeadLetterSinkNotConfigured() {
+	s.DeliveryStatus = eventingduckv1.DeliveryStatus{}
+	JobSinkCondSet.Manage(s).MarkTrueWithReason(JobSinkConditionDeadLetterSinkResolved, "DeadLetterSinkNotConfigured", "No dead letter sink is configured.")
+}
+
+// MarkDeadLetterSinkResolvedFailed marks the DeadLetterSinkResolved condition to False with the given reason and message.
+func (s *JobSinkStatus) MarkDeadLetterSinkResolvedFailed(reason, messageFormat string, messageA ...interface{}) {
+	s.DeliveryStatus = eventingduckv1.DeliveryStatus{}
+	JobSinkCondSet.Manage(s).MarkFalse(JobSinkConditionDeadLetterSinkResolved, reason, messageFormat, messageA...)
+}
+
+// MarkSinkResolved marks the SinkResolved condition to True and sets the resolved sink.
+func (s *JobSinkStatus) MarkSinkResolved(addr *duckv1.Addressable) {
+	s.SinkURI = addr.URL
+	s.SinkCACerts = addr.CACerts
+	s.SinkAudience = addr.Audience
+	JobSinkCondSet.Manage(s).MarkTrue(JobSinkConditionSinkResolved)
+}
+
+// MarkSinkNotConfigured marks the SinkResolved condition to True as no sink is configured.
+func (s *JobSinkStatus) MarkSinkNotConfigured() {
+	s.SinkURI = nil
+	s.SinkCACerts = nil
+	s.SinkAudience = nil
+	JobSinkCondSet.Manage(s).MarkTrueWithReason(JobSinkConditionSinkResolved, "SinkNotConfigured", "No sink is configured.")
+}
+
+// MarkSinkResolvedFailed marks the SinkResolved condition to False with the given reason and message.
+func (s *JobSinkStatus) MarkSinkResolvedFailed(reason, messageFormat string, messageA ...interface{}) {
+	s.SinkURI = nil
+	s.SinkCACerts = nil
+	s.SinkAudience = nil
+	JobSinkCondSet.Manage(s).MarkFalse(JobSinkConditionSinkResolved, reason, messageFormat, messageA...)
+}
+
+// MarkOIDCIdentityCreatedSucceeded marks the OIDCIdentityCreated condition to True.
+func (s *JobSinkStatus) MarkOIDCIdentityCreatedSucceeded() {
+	JobSinkCondSet.Manage(s).MarkTrue(JobSinkConditionOIDCIdentityCreated)
+}
+
+// MarkOIDCIdentityCreatedSucceededWithReason marks the OIDCIdentityCreated condition to True with the given reason and message.
+func (s *JobSinkStatus) MarkOIDCIdentityCreatedSucceededWithReason(reason, messageFormat string, messageA ...interface{}) {
+	JobSinkCondSet.Manage(s).MarkTrueWithReason(JobSinkConditionOIDCIdentityCreated, reason, messageFormat, messageA...)
+}
+
+// MarkOIDCIdentityCreatedFailed marks the OIDCIdentityCreated condition to False with the given reason and message.
+func (s *JobSinkStatus) MarkOIDCIdentityCreatedFailed(reason, messageFormat string, messageA ...interface{}) {
+	JobSinkCondSet.Manage(s).MarkFalse(JobSinkConditionOIDCIdentityCreated, reason, messageFormat, messageA...)
+}
+
 func (e *JobSink) SetJobStatusSelector() {
 	if e.Spec.Job != nil {
 		e.Status.JobStatus.Selector = fmt.Sprintf("%s=%s", sinks.JobSinkNameLabel, e.GetName())
@@ -46,12 +46,21 @@ func TestJobSinkInitializeConditions(t *testing.T) {
 				Conditions: []apis.Condition{{
 					Type:   JobSinkConditionAddressable,
 					Status: corev1.ConditionUnknown,
+				}, {
+					Type:   JobSinkConditionDeadLetterSinkResolved,
+					Status: corev1.ConditionUnknown,
 				}, {
 					Type:   JobSinkConditionEventPoliciesReady,
 					Status: corev1.ConditionUnknown,
+				}, {
+					Type:   JobSinkConditionOIDCIdentityCreated,
+					Status: corev1.ConditionUnknown,
 				}, {
 					Type:   JobSinkConditionReady,
 					Status: corev1.ConditionUnknown,
+				}, {
+					Type:   JobSinkConditionSinkResolved,
+					Status: corev1.ConditionUnknown,
 				}},
 			},
 		},
@@ -70,12 +79,21 @@ func TestJobSinkInitializeConditions(t *testing.T) {
 				Conditions: []apis.Condition{{
 					Type:   JobSinkConditionAddressable,
 					Status: corev1.ConditionFalse,
+				}, {
+					Type:   JobSinkConditionDeadLetterSinkResolved,
+					Status: corev1.ConditionUnknown,
 				}, {
 					Type:   JobSinkConditionEventPoliciesReady,
 					Status: corev1.ConditionUnknown,
+				}, {
+					Type:   JobSinkConditionOIDCIdentityCreated,
+					Status: corev1.ConditionUnknown,
 				}, {
 					Type:   JobSinkConditionReady,
 					Status: corev1.ConditionUnknown,
+				}, {
+					Type:   JobSinkConditionSinkResolved,
+					Status: corev1.ConditionUnknown,
 				}},
 			},
 		},
@@ -94,12 +112,21 @@ func TestJobSinkInitializeConditions(t *testing.T) {
 				Conditions: []apis.Condition{{
 					Type:   JobSinkConditionAddressable,
 					Status: corev1.ConditionTrue,
+				}, {
+					Type:   JobSinkConditionDeadLetterSinkResolved,
+					Status: corev1.ConditionUnknown,
 				}, {
 					Type:   JobSinkConditionEventPoliciesReady,
 					Status: corev1.ConditionUnknown,
+				}, {
+					Type:   JobSinkConditionOIDCIdentityCreated,
+					Status: corev1.ConditionUnknown,
 				}, {
 					Type:   JobSinkConditionReady,
 					Status: corev1.ConditionUnknown,
+				}, {
+					Type:   JobSinkConditionSinkResolved,
+					Status: corev1.ConditionUnknown,
 				}},
 			},
 		},
@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+
 	batchv1 "k8s.io/api/batch/v1"
 	"knative.dev/pkg/apis"
 
@@ -38,6 +40,60 @@ const (
 	ExecutionModeBatch ExecutionMode = "batch"
 )
 
+const (
+	// JobSinkJobSucceededEventType is the CloudEvent type sent to the sink when a Job succeeded.
+	JobSinkJobSucceededEventType = "dev.knative.sinks.jobsink.succeeded"
+	// JobSinkJobFailedEventType is the CloudEvent type sent to the sink when a Job failed.
+	JobSinkJobFailedEventType = "dev.knative.sinks.jobsink.failed"
+
+	// JobSinkEventIDExtension is the CloudEvent extension holding the id of the event
+	// a Job was created for.
+	JobSinkEventIDExtension = "jobsinkeventid"
+	// JobSinkEventSourceExtension is the CloudEvent extension holding the source of the
+	// event a Job was created for.
+	JobSinkEventSourceExtension = "jobsinkeventsource"
+)
+
+// JobSinkSource returns the CloudEvent source of the events sent by the JobSink.
+func JobSinkSource(namespace, name string) string {
+	return fmt.Sprintf("/apis/v1alpha1/namespaces/%s/jobsinks/%s", namespace, name)
+}
+
+// EventStorageType describes how the event is made available to the Job.
+type EventStorageType string
+
+const (
+	// SecretEventStorage stores the event in a Secret mounted into the Job.
+	SecretEventStorage EventStorageType = "Secret"
+
+	// ConfigMapEventStorage stores the event in a ConfigMap mounted into the Job.
+	ConfigMapEventStorage EventStorageType = "ConfigMap"
+
+	// EmptyDirEventStorage stores the event in an emptyDir volume populated by an
+	// init container pulling the event from the JobSink service, which removes the
+	// Secret and ConfigMap size limits. The JobSink service keeps the event in the
+	// event store configured on the job-sink deployment until it is pulled or the Job
+	// finishes, events are rejected when no event store is configured.
+	EmptyDirEventStorage EventStorageType = "EmptyDir"
+)
+
+// ConcurrencyPolicy describes how a Job is handled while a Job created for
+// an event sharing the same concurrency key is still running.
+type ConcurrencyPolicy string
+
+const (
+	// AllowConcurrent allows Jobs to run concurrently.
+	AllowConcurrent ConcurrencyPolicy = "Allow"
+
+	// ForbidConcurrent rejects the event with 429 Too Many Requests, so that
+	// it is retried later, while a Job with the same key is running.
+	ForbidConcurrent ConcurrencyPolicy = "Forbid"
+
+	// ReplaceConcurrent deletes the running Jobs with the same key before
+	// creating the new Job.
+	ReplaceConcurrent ConcurrencyPolicy = "Replace"
+)
+
 // +genclient
 // +genreconciler
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -68,6 +124,84 @@ type JobSinkSpec struct {
 	// Job to run when an event occur.
 	// +optional
 	Job *batchv1.Job `json:"job,omitempty"`
+
+	// ConcurrencyPolicy specifies how to treat the Job for an event while a
+	// Job for an event with the same ConcurrencyKey is running.
+	// Valid values are Allow, Forbid and Replace. Defaults to Allow.
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// ConcurrencyKey is the name of the CloudEvent attribute, or extension,
+	// whose value groups events for the ConcurrencyPolicy.
+	// Events without the attribute are not subject to the ConcurrencyPolicy.
+	// Defaults to `subject`.
+	// +optional
+	ConcurrencyKey string `json:"concurrencyKey,omitempty"`
+
+	// MaxRunningJobs is the maximum number of Jobs running at the same time.
+	// Events received while the limit is reached are rejected with
+	// 429 Too Many Requests so that they are retried later.
+	// +optional
+	MaxRunningJobs *int32 `json:"maxRunningJobs,omitempty"`
+
+	// SuccessfulJobsHistoryLimit is the number of successfully finished Jobs,
+	// and their events, to retain.
+	// +optional
+	SuccessfulJobsHistoryLimit *int32 `json:"successfulJobsHistoryLimit,omitempty"`
+
+	// FailedJobsHistoryLimit is the number of failed Jobs, and their events,
+	// to retain.
+	// +optional
+	FailedJobsHistoryLimit *int32 `json:"failedJobsHistoryLimit,omitempty"`
+
+	// TTLSecondsAfterFinished is the number of seconds after which finished Jobs,
+	// and their events, are deleted, once their result has been sent to the sink
+	// and their event to the dead letter sink.
+	// Unlike `job.spec.ttlSecondsAfterFinished`, it never deletes a Job before then.
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// Delivery contains the delivery options for the events whose Job
+	// failed. When a dead letter sink is set, the event is forwarded to it
+	// once the Job failed.
+	// +optional
+	Delivery *eventingduckv1.DeliverySpec `json:"delivery,omitempty"`
+
+	// EventStorage specifies how the event is made available to the Job.
+	// The event is always available in the `event` file of the directory
+	// referenced by the `K_EVENT_PATH` environment variable.
+	// +optional
+	EventStorage *JobSinkEventStorage `json:"eventStorage,omitempty"`
+
+	// EventAttributes lists the CloudEvent attributes, or extensions, to set as
+	// environment variables named `K_CE_<ATTRIBUTE>`, in upper case, on the
+	// Job containers.
+	// +optional
+	EventAttributes []string `json:"eventAttributes,omitempty"`
+
+	// Sink is a reference to an object that will resolve to a uri to use as the sink.
+	// Once a Job finishes, a JobSinkJobSucceededEventType or JobSinkJobFailedEventType
+	// CloudEvent is sent to the sink, correlated with the event the Job was created for.
+	// Sends are retried independently of Delivery until the sink accepts the event.
+	// +optional
+	Sink *duckv1.Destination `json:"sink,omitempty"`
+}
+
+// JobSinkEventStorage defines how the event is made available to the Job.
+type JobSinkEventStorage struct {
+	// Type is the type of storage of the event.
+	// Valid values are Secret, ConfigMap and EmptyDir. Defaults to Secret.
+	// EmptyDir requires an event store configured on the job-sink deployment.
+	// +optional
+	Type EventStorageType `json:"type,omitempty"`
+}
+
+// GetEventStorageType returns the type of storage of the event, defaulting to Secret.
+func (sink *JobSinkSpec) GetEventStorageType() EventStorageType {
+	if sink.EventStorage == nil || sink.EventStorage.Type == "" {
+		return SecretEventStorage
+	}
+	return sink.EventStorage.Type
 }
 
 // JobSinkStatus defines the observed state of JobSink.
@@ -85,6 +219,29 @@ type JobSinkStatus struct {
 	// AppliedEventPoliciesStatus contains the list of EventPolicies which apply to this JobSink
 	// +optional
 	eventingduckv1.AppliedEventPoliciesStatus `json:",inline"`
+
+	// DeliveryStatus contains a resolved URL to the dead letter sink address, and any other
+	// resolved delivery options.
+	// +optional
+	eventingduckv1.DeliveryStatus `json:",inline"`
+
+	// SinkURI is the current active sink URI that has been configured for the JobSink.
+	// +optional
+	SinkURI *apis.URL `json:"sinkUri,omitempty"`
+
+	// SinkCACerts are Certification Authority (CA) certificates in PEM format
+	// according to https://www.rfc-editor.org/rfc/rfc7468.
+	// +optional
+	SinkCACerts *string `json:"sinkCACerts,omitempty"`
+
+	// SinkAudience is the OIDC audience of the sink.
+	// +optional
+	SinkAudience *string `json:"sinkAudience,omitempty"`
+
+	// Auth provides the relevant information for OIDC authentication of the events
+	// sent to the sink and to the dead letter sink.
+	// +optional
+	Auth *duckv1.AuthStatus `json:"auth,omitempty"`
 }
 
 type JobStatus struct {
@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	"context"
+	"math"
+	"regexp"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/storage/names"
@@ -26,6 +28,9 @@ import (
 	"knative.dev/eventing/pkg/apis/sinks"
 )
 
+// attributeNameRegexp matches valid CloudEvent attribute names.
+var attributeNameRegexp = regexp.MustCompile("^[a-z0-9]+$")
+
 func (sink *JobSink) Validate(ctx context.Context) *apis.FieldError {
 	ctx = apis.WithinParent(ctx, sink.ObjectMeta)
 	return sink.Spec.Validate(ctx).ViaField("spec")
@@ -34,6 +39,49 @@ func (sink *JobSink) Validate(ctx context.Context) *apis.FieldError {
 func (sink *JobSinkSpec) Validate(ctx context.Context) *apis.FieldError {
 	var errs *apis.FieldError
 
+	switch sink.ConcurrencyPolicy {
+	case "", AllowConcurrent, ForbidConcurrent, ReplaceConcurrent:
+	default:
+		errs = errs.Also(apis.ErrInvalidValue(sink.ConcurrencyPolicy, "concurrencyPolicy"))
+	}
+	if sink.ConcurrencyKey != "" && !attributeNameRegexp.MatchString(sink.ConcurrencyKey) {
+		errs = errs.Also(apis.ErrInvalidValue(sink.ConcurrencyKey, "concurrencyKey", "expected a CloudEvent attribute name"))
+	}
+	if sink.MaxRunningJobs != nil && *sink.MaxRunningJobs < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*sink.MaxRunningJobs, 1, math.MaxInt32, "maxRunningJobs"))
+	}
+	if sink.SuccessfulJobsHistoryLimit != nil && *sink.SuccessfulJobsHistoryLimit < 0 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*sink.SuccessfulJobsHistoryLimit, 0, math.MaxInt32, "successfulJobsHistoryLimit"))
+	}
+	if sink.FailedJobsHistoryLimit != nil && *sink.FailedJobsHistoryLimit < 0 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*sink.FailedJobsHistoryLimit, 0, math.MaxInt32, "failedJobsHistoryLimit"))
+	}
+	if sink.TTLSecondsAfterFinished != nil && *sink.TTLSecondsAfterFinished < 0 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*sink.TTLSecondsAfterFinished, 0, math.MaxInt32, "ttlSecondsAfterFinished"))
+	}
+	if fe := sink.Delivery.Validate(ctx); fe != nil {
+		errs = errs.Also(fe.ViaField("delivery"))
+	}
+	if sink.EventStorage != nil {
+		switch sink.EventStorage.Type {
+		case "", SecretEventStorage, ConfigMapEventStorage:
+		case EmptyDirEventStorage:
+			if sink.Delivery != nil && sink.Delivery.DeadLetterSink != nil {
+				errs = errs.Also(apis.ErrGeneric("dead letter sink requires Secret or ConfigMap event storage", "delivery.deadLetterSink", "eventStorage.type"))
+			}
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(sink.EventStorage.Type, "eventStorage.type"))
+		}
+	}
+	for i, attr := range sink.EventAttributes {
+		if !attributeNameRegexp.MatchString(attr) {
+			errs = errs.Also(apis.ErrInvalidArrayValue(attr, "eventAttributes", i))
+		}
+	}
+	if sink.Sink != nil {
+		errs = errs.Also(sink.Sink.Validate(ctx).ViaField("sink"))
+	}
+
 	if sink.Job == nil {
 		return errs.Also(apis.ErrMissingOneOf("job"))
 	}
@@ -49,7 +97,7 @@ func (sink *JobSinkSpec) Validate(ctx context.Context) *apis.FieldError {
 				FieldValidation: metav1.FieldValidationStrict,
 			})
 		if err != nil {
-			return apis.ErrGeneric(err.Error(), "job")
+			return errs.Also(apis.ErrGeneric(err.Error(), "job"))
 		}
 	}
 
@@ -18,10 +18,18 @@ package v1alpha1
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/apis/sinks"
 )
 
 func TestValidation(t *testing.T) {
@@ -30,11 +38,88 @@ func TestValidation(t *testing.T) {
 		source JobSink
 		ctx    func(ctx context.Context) context.Context
 		want   *apis.FieldError
-	}{}
+	}{{
+		name: "valid",
+		source: JobSink{
+			Spec: JobSinkSpec{
+				Job:                        &batchv1.Job{},
+				ConcurrencyPolicy:          ForbidConcurrent,
+				ConcurrencyKey:             "subject",
+				MaxRunningJobs:             ptr.To[int32](10),
+				SuccessfulJobsHistoryLimit: ptr.To[int32](0),
+				FailedJobsHistoryLimit:     ptr.To[int32](3),
+				TTLSecondsAfterFinished:    ptr.To[int32](3600),
+			},
+		},
+	}, {
+		name: "invalid concurrency policy and key",
+		source: JobSink{
+			Spec: JobSinkSpec{
+				Job:               &batchv1.Job{},
+				ConcurrencyPolicy: "Sometimes",
+				ConcurrencyKey:    "my-key",
+			},
+		},
+		want: apis.ErrInvalidValue("Sometimes", "spec.concurrencyPolicy").
+			Also(apis.ErrInvalidValue("my-key", "spec.concurrencyKey", "expected a CloudEvent attribute name")),
+	}, {
+		name: "invalid limits",
+		source: JobSink{
+			Spec: JobSinkSpec{
+				Job:                        &batchv1.Job{},
+				MaxRunningJobs:             ptr.To[int32](0),
+				SuccessfulJobsHistoryLimit: ptr.To[int32](-1),
+				FailedJobsHistoryLimit:     ptr.To[int32](-1),
+				TTLSecondsAfterFinished:    ptr.To[int32](-1),
+			},
+		},
+		want: apis.ErrOutOfBoundsValue(0, 1, math.MaxInt32, "spec.maxRunningJobs").
+			Also(apis.ErrOutOfBoundsValue(-1, 0, math.MaxInt32, "spec.successfulJobsHistoryLimit")).
+			Also(apis.ErrOutOfBoundsValue(-1, 0, math.MaxInt32, "spec.failedJobsHistoryLimit")).
+			Also(apis.ErrOutOfBoundsValue(-1, 0, math.MaxInt32, "spec.ttlSecondsAfterFinished")),
+	}, {
+		name: "invalid sink",
+		source: JobSink{
+			Spec: JobSinkSpec{
+				Job:  &batchv1.Job{},
+				Sink: &duckv1.Destination{},
+			},
+		},
+		want: apis.ErrGeneric("expected at least one, got none", "spec.sink.ref", "spec.sink.uri"),
+	}, {
+		name: "invalid event storage and attributes",
+		source: JobSink{
+			Spec: JobSinkSpec{
+				Job:             &batchv1.Job{},
+				EventStorage:    &JobSinkEventStorage{Type: "Volume"},
+				EventAttributes: []string{"subject", "Invalid-Attr"},
+			},
+		},
+		want: apis.ErrInvalidValue("Volume", "spec.eventStorage.type").
+			Also(apis.ErrInvalidArrayValue("Invalid-Attr", "spec.eventAttributes", 1)),
+	}, {
+		name: "emptyDir event storage with dead letter sink",
+		source: JobSink{
+			Spec: JobSinkSpec{
+				Job:          &batchv1.Job{},
+				EventStorage: &JobSinkEventStorage{Type: EmptyDirEventStorage},
+				Delivery: &eventingduckv1.DeliverySpec{
+					DeadLetterSink: &duckv1.Destination{URI: apis.HTTP("dls.example.com")},
+				},
+			},
+		},
+		want: apis.ErrGeneric("dead letter sink requires Secret or ConfigMap event storage", "spec.delivery.deadLetterSink", "spec.eventStorage.type"),
+	}, {
+		name: "missing job",
+		source: JobSink{
+			Spec: JobSinkSpec{},
+		},
+		want: apis.ErrMissingOneOf("spec.job"),
+	}}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			ctx := context.TODO()
+			ctx := sinks.WithConfig(context.TODO(), &sinks.Config{KubeClient: fake.NewSimpleClientset()})
 			if test.ctx != nil {
 				ctx = test.ctx(ctx)
 			}
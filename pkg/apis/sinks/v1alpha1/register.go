@@ -49,6 +49,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&JobSinkList{},
 		&IntegrationSink{},
 		&IntegrationSinkList{},
+		&DeadLetterSink{},
+		&DeadLetterSinkList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
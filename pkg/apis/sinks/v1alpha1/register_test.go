@@ -64,6 +64,8 @@ func TestKnownTypes(t *testing.T) {
 		"JobSinkList",
 		"IntegrationSink",
 		"IntegrationSinkList",
+		"DeadLetterSink",
+		"DeadLetterSinkList",
 	} {
 		if _, ok := types[name]; !ok {
 			t.Errorf("Did not find %q as registered type", name)
@@ -17,8 +17,12 @@ limitations under the License.
 package v1
 
 import (
+	"fmt"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"knative.dev/pkg/apis"
 )
 
@@ -109,5 +113,82 @@ func (s *ContainerSourceStatus) PropagateReceiveAdapterStatus(d *appsv1.Deployme
 	}
 	if !deploymentAvailableFound {
 		containerCondSet.Manage(s).MarkUnknown(ContainerSourceConditionReceiveAdapterReady, "DeploymentUnavailable", "The Deployment '%s' is unavailable.", d.Name)
+		return
+	}
+	if !containerCondSet.Manage(s).GetCondition(ContainerSourceConditionReceiveAdapterReady).IsTrue() {
+		return
+	}
+
+	// An available Deployment may still be rolling out, or be stuck rolling
+	// out, a new revision of the template.
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Status == corev1.ConditionFalse {
+			containerCondSet.Manage(s).MarkFalse(ContainerSourceConditionReceiveAdapterReady, cond.Reason, cond.Message)
+			return
+		}
+	}
+	if d.Generation > d.Status.ObservedGeneration ||
+		(d.Spec.Replicas != nil && d.Status.UpdatedReplicas < *d.Spec.Replicas) {
+		containerCondSet.Manage(s).MarkUnknown(ContainerSourceConditionReceiveAdapterReady, "RolloutInProgress", "The Deployment '%s' is rolling out.", d.Name)
+	}
+}
+
+// crashWaitingReasons are the waiting reasons of containers which cannot run
+// without a change of their template or of their environment.
+var crashWaitingReasons = sets.New(
+	"CrashLoopBackOff",
+	"ImagePullBackOff",
+	"ErrImagePull",
+	"InvalidImageName",
+	"CreateContainerConfigError",
+	"CreateContainerError",
+	"RunContainerError",
+)
+
+// PropagateContainerStatuses summarizes the container statuses of the given
+// pods, and marks ContainerSourceConditionReceiveAdapterReady as false when
+// one of the containers is crash-looping or cannot be started.
+func (s *ContainerSourceStatus) PropagateContainerStatuses(pods []corev1.Pod) {
+	var statuses []ContainerSourceContainerStatus
+	index := make(map[string]int)
+	lastTerminations := make(map[string]metav1.Time)
+	crashed := ""
+
+	for i := range pods {
+		for _, cs := range pods[i].Status.ContainerStatuses {
+			idx, ok := index[cs.Name]
+			if !ok {
+				idx = len(statuses)
+				index[cs.Name] = idx
+				statuses = append(statuses, ContainerSourceContainerStatus{Name: cs.Name})
+			}
+			status := &statuses[idx]
+			status.RestartCount += cs.RestartCount
+			if w := cs.State.Waiting; w != nil && w.Reason != "" && !crashWaitingReasons.Has(status.WaitingReason) {
+				// Crash reasons take precedence over transient ones.
+				status.WaitingReason = w.Reason
+				if crashWaitingReasons.Has(w.Reason) && crashed == "" {
+					crashed = cs.Name
+				}
+			}
+			if t := cs.LastTerminationState.Terminated; t != nil {
+				if last, ok := lastTerminations[cs.Name]; !ok || last.Before(&t.FinishedAt) {
+					lastTerminations[cs.Name] = t.FinishedAt
+					status.LastTerminationReason = t.Reason
+					status.LastTerminationMessage = t.Message
+					status.LastTerminationExitCode = t.ExitCode
+				}
+			}
+		}
+	}
+	s.ContainerStatuses = statuses
+
+	if crashed != "" {
+		status := statuses[index[crashed]]
+		msg := fmt.Sprintf("The container '%s' is in %s.", status.Name, status.WaitingReason)
+		if status.LastTerminationReason != "" {
+			msg += fmt.Sprintf(" It last terminated with reason %s and exit code %d.", status.LastTerminationReason, status.LastTerminationExitCode)
+		}
+		containerCondSet.Manage(s).MarkFalse(ContainerSourceConditionReceiveAdapterReady, status.WaitingReason, "%s", msg)
 	}
 }
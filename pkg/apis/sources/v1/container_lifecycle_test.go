@@ -18,11 +18,14 @@ package v1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
@@ -336,3 +339,150 @@ func TestContainerSourceStatusGetCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestContainerSourceStatusPropagateReceiveAdapterRollout(t *testing.T) {
+	tests := []struct {
+		name       string
+		d          *appsv1.Deployment
+		wantStatus corev1.ConditionStatus
+		wantReason string
+	}{{
+		name:       "rolled out",
+		d:          availableDeployment,
+		wantStatus: corev1.ConditionTrue,
+	}, {
+		name: "generation not observed",
+		d: func() *appsv1.Deployment {
+			d := availableDeployment.DeepCopy()
+			d.Generation = 2
+			d.Status.ObservedGeneration = 1
+			return d
+		}(),
+		wantStatus: corev1.ConditionUnknown,
+		wantReason: "RolloutInProgress",
+	}, {
+		name: "replicas not updated",
+		d: func() *appsv1.Deployment {
+			d := availableDeployment.DeepCopy()
+			d.Spec.Replicas = ptr.To[int32](2)
+			d.Status.UpdatedReplicas = 1
+			return d
+		}(),
+		wantStatus: corev1.ConditionUnknown,
+		wantReason: "RolloutInProgress",
+	}, {
+		name: "progress deadline exceeded",
+		d: func() *appsv1.Deployment {
+			d := availableDeployment.DeepCopy()
+			d.Status.Conditions = append(d.Status.Conditions, appsv1.DeploymentCondition{
+				Type:   appsv1.DeploymentProgressing,
+				Status: corev1.ConditionFalse,
+				Reason: "ProgressDeadlineExceeded",
+			})
+			return d
+		}(),
+		wantStatus: corev1.ConditionFalse,
+		wantReason: "ProgressDeadlineExceeded",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &ContainerSourceStatus{}
+			s.InitializeConditions()
+			s.PropagateReceiveAdapterStatus(test.d)
+			got := s.GetCondition(ContainerSourceConditionReceiveAdapterReady)
+			if got.Status != test.wantStatus || got.Reason != test.wantReason {
+				t.Errorf("unexpected condition: want %v/%q, got %v/%q", test.wantStatus, test.wantReason, got.Status, got.Reason)
+			}
+		})
+	}
+}
+
+func TestContainerSourceStatusPropagateContainerStatuses(t *testing.T) {
+	crashedAt := metav1.Now()
+	pods := []corev1.Pod{{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "adapter",
+				RestartCount: 1,
+				LastTerminationState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{
+						Reason:     "OOMKilled",
+						ExitCode:   137,
+						FinishedAt: metav1.NewTime(crashedAt.Add(-time.Minute)),
+					},
+				},
+			}, {
+				Name: "sidecar",
+			}},
+		},
+	}, {
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "adapter",
+				RestartCount: 4,
+				State: corev1.ContainerState{
+					Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+				},
+				LastTerminationState: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{
+						Reason:     "Error",
+						Message:    "boom",
+						ExitCode:   1,
+						FinishedAt: crashedAt,
+					},
+				},
+			}, {
+				Name: "sidecar",
+				State: corev1.ContainerState{
+					Waiting: &corev1.ContainerStateWaiting{Reason: "ContainerCreating"},
+				},
+			}},
+		},
+	}}
+
+	s := &ContainerSourceStatus{}
+	s.InitializeConditions()
+	s.PropagateSinkBindingStatus(&readySinkBinding.Status)
+	s.PropagateReceiveAdapterStatus(availableDeployment)
+	s.PropagateContainerStatuses(pods)
+
+	want := []ContainerSourceContainerStatus{{
+		Name:                    "adapter",
+		RestartCount:            5,
+		WaitingReason:           "CrashLoopBackOff",
+		LastTerminationReason:   "Error",
+		LastTerminationMessage:  "boom",
+		LastTerminationExitCode: 1,
+	}, {
+		Name:          "sidecar",
+		WaitingReason: "ContainerCreating",
+	}}
+	if diff := cmp.Diff(want, s.ContainerStatuses); diff != "" {
+		t.Error("unexpected container statuses (-want, +got) =", diff)
+	}
+
+	wantCond := &apis.Condition{
+		Type:    ContainerSourceConditionReceiveAdapterReady,
+		Status:  corev1.ConditionFalse,
+		Reason:  "CrashLoopBackOff",
+		Message: "The container 'adapter' is in CrashLoopBackOff. It last terminated with reason Error and exit code 1.",
+	}
+	got := s.GetCondition(ContainerSourceConditionReceiveAdapterReady)
+	if diff := cmp.Diff(wantCond, got, cmpopts.IgnoreFields(apis.Condition{}, "LastTransitionTime", "Severity")); diff != "" {
+		t.Error("unexpected condition (-want, +got) =", diff)
+	}
+	if s.IsReady() {
+		t.Error("crash-looping ContainerSource is ready")
+	}
+
+	// Healthy pods do not affect the readiness.
+	s = &ContainerSourceStatus{}
+	s.InitializeConditions()
+	s.PropagateSinkBindingStatus(&readySinkBinding.Status)
+	s.PropagateReceiveAdapterStatus(availableDeployment)
+	s.PropagateContainerStatuses(pods[:1])
+	if !s.IsReady() {
+		t.Error("healthy ContainerSource is not ready")
+	}
+}
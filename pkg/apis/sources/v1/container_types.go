@@ -26,6 +26,14 @@ import (
 	"knative.dev/pkg/kmeta"
 )
 
+// ContainerSourceEventLivenessTimeoutAnnotation is the annotation holding the
+// duration within which a ContainerSource is expected to emit an event. When
+// set, receive adapters built on knative.dev/eventing/pkg/adapter/v2 emit a
+// NoEventsEmitted Kubernetes event on the ContainerSource when no event was
+// delivered within that duration. The service account of the pods must be
+// allowed to create events.
+const ContainerSourceEventLivenessTimeoutAnnotation = "sources.knative.dev/event-liveness-timeout"
+
 // +genclient
 // +genreconciler
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -76,6 +84,42 @@ type ContainerSourceStatus struct {
 	// * SinkURI - the current active sink URI that has been configured for the
 	//   Source.
 	duckv1.SourceStatus `json:",inline"`
+
+	// ContainerStatuses summarizes, for each container of the template, the
+	// restarts and failures of the containers of the ContainerSource pods.
+	// +optional
+	ContainerStatuses []ContainerSourceContainerStatus `json:"containerStatuses,omitempty"`
+}
+
+// ContainerSourceContainerStatus summarizes the state of a container of the
+// ContainerSource pods.
+type ContainerSourceContainerStatus struct {
+	// Name of the container.
+	Name string `json:"name"`
+
+	// RestartCount is the number of restarts of the container, summed over
+	// all the pods.
+	RestartCount int32 `json:"restartCount"`
+
+	// WaitingReason is the reason a container is waiting to run, such as
+	// CrashLoopBackOff or ImagePullBackOff.
+	// +optional
+	WaitingReason string `json:"waitingReason,omitempty"`
+
+	// LastTerminationReason is the reason of the last termination of the
+	// container, such as Error or OOMKilled.
+	// +optional
+	LastTerminationReason string `json:"lastTerminationReason,omitempty"`
+
+	// LastTerminationMessage is the message of the last termination of the
+	// container.
+	// +optional
+	LastTerminationMessage string `json:"lastTerminationMessage,omitempty"`
+
+	// LastTerminationExitCode is the exit code of the last termination of the
+	// container.
+	// +optional
+	LastTerminationExitCode int32 `json:"lastTerminationExitCode,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
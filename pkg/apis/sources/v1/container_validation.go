@@ -18,13 +18,20 @@ package v1
 
 import (
 	"context"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"knative.dev/pkg/apis"
 )
 
 func (c *ContainerSource) Validate(ctx context.Context) *apis.FieldError {
-	return c.Spec.Validate(ctx).ViaField("spec")
+	errs := c.Spec.Validate(ctx).ViaField("spec")
+	if v, ok := c.Annotations[ContainerSourceEventLivenessTimeoutAnnotation]; ok {
+		if d, err := time.ParseDuration(v); err != nil || d <= 0 {
+			errs = errs.Also(apis.ErrInvalidValue(v, ContainerSourceEventLivenessTimeoutAnnotation).ViaField("metadata", "annotations"))
+		}
+	}
+	return errs
 }
 
 func (cs *ContainerSourceSpec) Validate(ctx context.Context) *apis.FieldError {
@@ -22,6 +22,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 )
@@ -145,3 +146,57 @@ func TestContainerSourceValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestContainerSourceEventLivenessTimeoutValidation(t *testing.T) {
+	spec := ContainerSourceSpec{
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{
+					Name:  "name",
+					Image: "image",
+				}},
+			},
+		},
+		SourceSpec: duckv1.SourceSpec{
+			Sink: duckv1.Destination{
+				Ref: &duckv1.KReference{
+					APIVersion: "eventing.knative.dev/v1",
+					Kind:       "Broker",
+					Name:       "default",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		timeout string
+		want    *apis.FieldError
+	}{{
+		name:    "valid timeout",
+		timeout: "10m",
+	}, {
+		name:    "invalid timeout",
+		timeout: "ten minutes",
+		want:    apis.ErrInvalidValue("ten minutes", "metadata.annotations."+ContainerSourceEventLivenessTimeoutAnnotation),
+	}, {
+		name:    "negative timeout",
+		timeout: "-1m",
+		want:    apis.ErrInvalidValue("-1m", "metadata.annotations."+ContainerSourceEventLivenessTimeoutAnnotation),
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			source := &ContainerSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{ContainerSourceEventLivenessTimeoutAnnotation: test.timeout},
+				},
+				Spec: spec,
+			}
+			got := source.Validate(context.TODO())
+			if diff := cmp.Diff(test.want.Error(), got.Error()); diff != "" {
+				t.Error("ContainerSource.Validate (-want, +got) =", diff)
+			}
+		})
+	}
+}
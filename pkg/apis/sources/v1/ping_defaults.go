@@ -29,7 +29,7 @@ func (s *PingSource) SetDefaults(ctx context.Context) {
 }
 
 func (ss *PingSourceSpec) SetDefaults(ctx context.Context) {
-	if ss.Schedule == "" {
+	if ss.Schedule == "" && ss.RunAt == nil {
 		ss.Schedule = defaultSchedule
 	}
 }
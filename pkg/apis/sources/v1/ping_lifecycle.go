@@ -18,9 +18,12 @@ package v1
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/robfig/cron/v3"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -134,6 +137,21 @@ func (s *PingSourceStatus) PropagateDeploymentAvailability(d *appsv1.Deployment)
 	}
 }
 
+// PropagateScheduleTimes updates NextScheduleTime and LastScheduleTime relative to now.
+// A NextScheduleTime that has passed becomes the LastScheduleTime.
+func (s *PingSourceStatus) PropagateScheduleTimes(schedule cron.Schedule, now time.Time) {
+	if s.NextScheduleTime != nil && !now.Before(s.NextScheduleTime.Time) {
+		s.LastScheduleTime = s.NextScheduleTime
+	}
+
+	next := schedule.Next(now)
+	if next.IsZero() {
+		s.NextScheduleTime = nil
+	} else {
+		s.NextScheduleTime = &metav1.Time{Time: next}
+	}
+}
+
 func (s *PingSourceStatus) MarkOIDCIdentityCreatedSucceeded() {
 	PingSourceCondSet.Manage(s).MarkTrue(PingSourceConditionOIDCIdentityCreated)
 }
@@ -18,8 +18,10 @@ package v1
 
 import (
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/google/go-cmp/cmp"
@@ -340,3 +342,48 @@ func TestPingSourceStatusGetCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestPingSourceStatusPropagateScheduleTimes(t *testing.T) {
+	now := time.Date(2026, time.November, 1, 9, 0, 0, 0, time.UTC)
+	runAt := &metav1.Time{Time: now}
+
+	tests := []struct {
+		name     string
+		s        *PingSourceStatus
+		spec     PingSourceSpec
+		wantNext *metav1.Time
+		wantLast *metav1.Time
+	}{{
+		name:     "first schedule",
+		s:        &PingSourceStatus{},
+		spec:     PingSourceSpec{Schedule: "0 10 * * *", Timezone: "UTC"},
+		wantNext: &metav1.Time{Time: now.Add(time.Hour)},
+	}, {
+		name:     "next schedule time has passed",
+		s:        &PingSourceStatus{NextScheduleTime: &metav1.Time{Time: now}},
+		spec:     PingSourceSpec{Schedule: "0 10 * * *", Timezone: "UTC"},
+		wantNext: &metav1.Time{Time: now.Add(time.Hour)},
+		wantLast: &metav1.Time{Time: now},
+	}, {
+		name:     "one-shot completed",
+		s:        &PingSourceStatus{NextScheduleTime: runAt},
+		spec:     PingSourceSpec{RunAt: runAt},
+		wantLast: runAt,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedule, err := test.spec.CronSchedule()
+			if err != nil {
+				t.Fatal("CronSchedule() =", err)
+			}
+			test.s.PropagateScheduleTimes(schedule, now)
+			if diff := cmp.Diff(test.wantNext, test.s.NextScheduleTime); diff != "" {
+				t.Error("unexpected next schedule time (-want, +got) =", diff)
+			}
+			if diff := cmp.Diff(test.wantLast, test.s.LastScheduleTime); diff != "" {
+				t.Error("unexpected last schedule time (-want, +got) =", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// PingSourceDateLayout is the layout of the dates listed in ExcludedDates.
+	PingSourceDateLayout = "2006-01-02"
+
+	// maxExcludedActivations bounds the number of consecutive excluded
+	// activations skipped when looking for the next activation time.
+	maxExcludedActivations = 10000
+)
+
+// PingScheduleParser parses the cron schedules supported by PingSource.
+var PingScheduleParser = cron.NewParser(
+	cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
+// CronSchedule returns the schedule described by the spec, taking RunAt,
+// Timezone and ExcludedDates into account. The returned schedule yields the
+// zero time when no activation is left.
+func (ps *PingSourceSpec) CronSchedule() (cron.Schedule, error) {
+	location := time.Local
+	if ps.Timezone != "" {
+		l, err := time.LoadLocation(ps.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		location = l
+	}
+
+	var schedule cron.Schedule
+	if ps.RunAt != nil {
+		schedule = runAtSchedule(ps.RunAt.Time)
+	} else {
+		spec := ps.Schedule
+		if ps.Timezone != "" {
+			spec = "CRON_TZ=" + ps.Timezone + " " + spec
+		}
+		s, err := PingScheduleParser.Parse(spec)
+		if err != nil {
+			return nil, err
+		}
+		schedule = s
+	}
+
+	if len(ps.ExcludedDates) == 0 {
+		return schedule, nil
+	}
+	return &exclusionSchedule{
+		schedule: schedule,
+		location: location,
+		excluded: sets.New(ps.ExcludedDates...),
+	}, nil
+}
+
+// IsOneShot returns true when the spec describes a single activation.
+func (ps *PingSourceSpec) IsOneShot() bool {
+	return ps.RunAt != nil
+}
+
+// runAtSchedule activates once, at the given time.
+type runAtSchedule time.Time
+
+func (s runAtSchedule) Next(t time.Time) time.Time {
+	if runAt := time.Time(s); t.Before(runAt) {
+		return runAt
+	}
+	return time.Time{}
+}
+
+// exclusionSchedule skips the activations of the wrapped schedule falling
+// on excluded dates.
+type exclusionSchedule struct {
+	schedule cron.Schedule
+	location *time.Location
+	excluded sets.Set[string]
+}
+
+func (s *exclusionSchedule) Next(t time.Time) time.Time {
+	next := s.schedule.Next(t)
+	for i := 0; i < maxExcludedActivations && !next.IsZero(); i++ {
+		if !s.excluded.Has(next.In(s.location).Format(PingSourceDateLayout)) {
+			return next
+		}
+		next = s.schedule.Next(next)
+	}
+	return time.Time{}
+}
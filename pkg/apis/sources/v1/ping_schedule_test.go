@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPingSourceSpecCronSchedule(t *testing.T) {
+	// Friday
+	now := time.Date(2026, time.December, 24, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		spec    PingSourceSpec
+		want    []time.Time
+		wantErr bool
+	}{{
+		name: "cron schedule",
+		spec: PingSourceSpec{Schedule: "0 9 * * *", Timezone: "UTC"},
+		want: []time.Time{
+			time.Date(2026, time.December, 25, 9, 0, 0, 0, time.UTC),
+			time.Date(2026, time.December, 26, 9, 0, 0, 0, time.UTC),
+		},
+	}, {
+		name: "business days with excluded dates",
+		spec: PingSourceSpec{
+			Schedule:      "0 9 * * 1-5",
+			Timezone:      "UTC",
+			ExcludedDates: []string{"2026-12-25", "2026-12-28"},
+		},
+		want: []time.Time{
+			time.Date(2026, time.December, 29, 9, 0, 0, 0, time.UTC),
+			time.Date(2026, time.December, 30, 9, 0, 0, 0, time.UTC),
+		},
+	}, {
+		name: "excluded dates relative to timezone",
+		spec: PingSourceSpec{
+			Schedule:      "0 1 * * *",
+			Timezone:      "Europe/Paris",
+			ExcludedDates: []string{"2026-12-25"},
+		},
+		want: []time.Time{
+			time.Date(2026, time.December, 26, 0, 0, 0, 0, time.UTC),
+		},
+	}, {
+		name: "run at",
+		spec: PingSourceSpec{RunAt: &metav1.Time{Time: time.Date(2026, time.December, 31, 23, 0, 0, 0, time.UTC)}},
+		want: []time.Time{
+			time.Date(2026, time.December, 31, 23, 0, 0, 0, time.UTC),
+			{},
+		},
+	}, {
+		name: "run at in the past",
+		spec: PingSourceSpec{RunAt: &metav1.Time{Time: now.Add(-time.Minute)}},
+		want: []time.Time{{}},
+	}, {
+		name: "run at on excluded date",
+		spec: PingSourceSpec{
+			RunAt:         &metav1.Time{Time: time.Date(2026, time.December, 31, 23, 0, 0, 0, time.UTC)},
+			ExcludedDates: []string{"2026-12-31"},
+			Timezone:      "UTC",
+		},
+		want: []time.Time{{}},
+	}, {
+		name:    "invalid timezone",
+		spec:    PingSourceSpec{Schedule: "* * * * *", Timezone: "Knative/Land"},
+		wantErr: true,
+	}, {
+		name:    "invalid schedule",
+		spec:    PingSourceSpec{Schedule: "invalid"},
+		wantErr: true,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule, err := tc.spec.CronSchedule()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("CronSchedule() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			next := now
+			for i, want := range tc.want {
+				next = schedule.Next(next)
+				if !next.Equal(want) {
+					t.Errorf("activation %d: got %v, want %v", i, next, want)
+				}
+				if next.IsZero() {
+					return
+				}
+			}
+		})
+	}
+}
@@ -58,10 +58,22 @@ type PingSourceSpec struct {
 	//   and modifications of the event sent to the sink.
 	duckv1.SourceSpec `json:",inline"`
 
-	// Schedule is the cron schedule. Defaults to `* * * * *`.
+	// Schedule is the cron schedule. Defaults to `* * * * *` unless RunAt is set.
+	// Mutually exclusive with RunAt.
 	// +optional
 	Schedule string `json:"schedule,omitempty"`
 
+	// RunAt is the RFC 3339 time at which a single event is posted to the sink.
+	// Once RunAt has passed, no further event is sent.
+	// Mutually exclusive with Schedule.
+	// +optional
+	RunAt *metav1.Time `json:"runAt,omitempty"`
+
+	// ExcludedDates is a list of dates, in the `YYYY-MM-DD` format, on which
+	// no event is sent. Dates are relative to Timezone.
+	// +optional
+	ExcludedDates []string `json:"excludedDates,omitempty"`
+
 	// Timezone modifies the actual time relative to the specified timezone.
 	// Defaults to the system time zone.
 	// More general information about time zones: https://www.iana.org/time-zones
@@ -93,6 +105,17 @@ type PingSourceStatus struct {
 	// * SinkURI - the current active sink URI that has been configured for the
 	//   Source.
 	duckv1.SourceStatus `json:",inline"`
+
+	// NextScheduleTime is the next time an event is scheduled to be sent, as
+	// computed when the controller last observed the spec. It is not
+	// refreshed as events are sent.
+	// +optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// LastScheduleTime is the last time an event was scheduled to be sent, as
+	// computed when the controller last observed the spec.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -23,10 +23,10 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 
-	"github.com/robfig/cron/v3"
 	"knative.dev/pkg/apis"
 
 	"knative.dev/eventing/pkg/apis/sources/config"
@@ -38,25 +38,23 @@ func (c *PingSource) Validate(ctx context.Context) *apis.FieldError {
 
 func (cs *PingSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 	var errs *apis.FieldError
-	schedule := cs.Schedule
 
-	errs = validateDescriptor(schedule)
-
-	if cs.Timezone != "" {
-		schedule = "CRON_TZ=" + cs.Timezone + " " + schedule
+	if cs.RunAt != nil {
+		if cs.Schedule != "" {
+			errs = errs.Also(apis.ErrMultipleOneOf("schedule", "runAt"))
+		}
+		if cs.Timezone != "" {
+			if _, err := time.LoadLocation(cs.Timezone); err != nil {
+				errs = errs.Also(apis.ErrInvalidValue(err, "timezone"))
+			}
+		}
+	} else {
+		errs = errs.Also(validateSchedule(cs.Schedule, cs.Timezone))
 	}
 
-	parser := cron.NewParser(
-		cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
-	)
-
-	if _, err := parser.Parse(schedule); err != nil {
-		if strings.HasPrefix(err.Error(), "provided bad location") {
-			fe := apis.ErrInvalidValue(err, "timezone")
-			errs = errs.Also(fe)
-		} else {
-			fe := apis.ErrInvalidValue(err, "schedule")
-			errs = errs.Also(fe)
+	for i, date := range cs.ExcludedDates {
+		if _, err := time.Parse(PingSourceDateLayout, date); err != nil {
+			errs = errs.Also(apis.ErrInvalidArrayValue(date, "excludedDates", i))
 		}
 	}
 
@@ -102,6 +100,23 @@ func (cs *PingSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 	return errs
 }
 
+func validateSchedule(schedule, timezone string) *apis.FieldError {
+	errs := validateDescriptor(schedule)
+
+	if timezone != "" {
+		schedule = "CRON_TZ=" + timezone + " " + schedule
+	}
+
+	if _, err := PingScheduleParser.Parse(schedule); err != nil {
+		if strings.HasPrefix(err.Error(), "provided bad location") {
+			errs = errs.Also(apis.ErrInvalidValue(err, "timezone"))
+		} else {
+			errs = errs.Also(apis.ErrInvalidValue(err, "schedule"))
+		}
+	}
+	return errs
+}
+
 func validateJSON(str string) error {
 	var objmap map[string]interface{}
 	return json.Unmarshal([]byte(str), &objmap)
@@ -21,12 +21,14 @@ import (
 	"encoding/base64"
 	"strings"
 	"testing"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/pkg/apis"
 
 	"knative.dev/eventing/pkg/apis/sources/config"
@@ -57,6 +59,61 @@ func TestPingSourceValidation(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			name: "valid spec with runAt",
+			source: PingSource{
+				Spec: PingSourceSpec{
+					RunAt:         &metav1.Time{Time: time.Date(2026, time.November, 1, 9, 0, 0, 0, time.UTC)},
+					ExcludedDates: []string{"2026-12-25"},
+					SourceSpec: duckv1.SourceSpec{
+						Sink: duckv1.Destination{
+							Ref: &duckv1.KReference{
+								APIVersion: "v1",
+								Kind:       "broker",
+								Name:       "default",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		}, {
+			name: "invalid spec with runAt and schedule",
+			source: PingSource{
+				Spec: PingSourceSpec{
+					Schedule: "*/2 * * * *",
+					RunAt:    &metav1.Time{Time: time.Date(2026, time.November, 1, 9, 0, 0, 0, time.UTC)},
+					SourceSpec: duckv1.SourceSpec{
+						Sink: duckv1.Destination{
+							Ref: &duckv1.KReference{
+								APIVersion: "v1",
+								Kind:       "broker",
+								Name:       "default",
+							},
+						},
+					},
+				},
+			},
+			want: apis.ErrMultipleOneOf("spec.schedule", "spec.runAt"),
+		}, {
+			name: "invalid spec with excluded date",
+			source: PingSource{
+				Spec: PingSourceSpec{
+					Schedule:      "0 9 * * 1-5",
+					ExcludedDates: []string{"2026-12-25", "25/12/2026"},
+					SourceSpec: duckv1.SourceSpec{
+						Sink: duckv1.Destination{
+							Ref: &duckv1.KReference{
+								APIVersion: "v1",
+								Kind:       "broker",
+								Name:       "default",
+							},
+						},
+					},
+				},
+			},
+			want: apis.ErrInvalidArrayValue("25/12/2026", "spec.excludedDates", 1),
+		},
 		{
 			name: "valid spec with schedule every 5 seconds",
 			source: PingSource{
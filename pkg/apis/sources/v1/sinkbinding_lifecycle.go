@@ -156,6 +156,37 @@ func (sbs *SinkBindingStatus) MarkOIDCTokenSecretCreatedUnknown(reason, messageF
 	sbCondSet.Manage(sbs).MarkUnknown(SinkBindingConditionOIDCTokenSecretCreated, reason, messageFormat, messageA...)
 }
 
+// OutputData returns the data of the output ConfigMap or Secret of the
+// SinkBinding, from its resolved sink.
+func (sb *SinkBinding) OutputData() (map[string]string, error) {
+	if sb.Status.SinkURI == nil {
+		return nil, fmt.Errorf("sink of SinkBinding %s/%s is not resolved", sb.Namespace, sb.Name)
+	}
+
+	data := map[string]string{
+		"K_SINK": sb.Status.SinkURI.String(),
+	}
+	envFile := []string{"K_SINK=" + data["K_SINK"]}
+	if sb.Status.SinkCACerts != nil {
+		data["K_CA_CERTS"] = *sb.Status.SinkCACerts
+	}
+	if sb.Status.SinkAudience != nil {
+		data["K_AUDIENCE"] = *sb.Status.SinkAudience
+		envFile = append(envFile, "K_AUDIENCE="+data["K_AUDIENCE"])
+	}
+	if sb.Spec.CloudEventOverrides != nil {
+		co, err := json.Marshal(sb.Spec.CloudEventOverrides)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal CloudEventOverrides: %w", err)
+		}
+		data["K_CE_OVERRIDES"] = string(co)
+		envFile = append(envFile, "K_CE_OVERRIDES="+data["K_CE_OVERRIDES"])
+	}
+	data[SinkBindingOutputEnvFileKey] = strings.Join(envFile, "\n") + "\n"
+
+	return data, nil
+}
+
 // Do implements psbinding.Bindable
 func (sb *SinkBinding) Do(ctx context.Context, ps *duckv1.WithPod) {
 	// First undo so that we can just unconditionally append below.
@@ -1012,3 +1012,68 @@ func TestSinkBindingUnavailable(t *testing.T) {
 		t.Error("unexpected condition: ", r)
 	}
 }
+
+func TestSinkBindingOutputData(t *testing.T) {
+	sinkURI, _ := apis.ParseURL("https://sink.moore.svc.cluster.local")
+
+	tests := []struct {
+		name    string
+		sb      *SinkBinding
+		want    map[string]string
+		wantErr bool
+	}{{
+		name:    "unresolved sink",
+		sb:      &SinkBinding{},
+		wantErr: true,
+	}, {
+		name: "sink only",
+		sb: &SinkBinding{
+			Status: SinkBindingStatus{
+				SourceStatus: duckv1.SourceStatus{SinkURI: sinkURI},
+			},
+		},
+		want: map[string]string{
+			"K_SINK":   "https://sink.moore.svc.cluster.local",
+			"sink.env": "K_SINK=https://sink.moore.svc.cluster.local\n",
+		},
+	}, {
+		name: "sink, CA certs, audience and overrides",
+		sb: &SinkBinding{
+			Spec: SinkBindingSpec{
+				SourceSpec: duckv1.SourceSpec{
+					CloudEventOverrides: &duckv1.CloudEventOverrides{
+						Extensions: map[string]string{"foo": "bar"},
+					},
+				},
+			},
+			Status: SinkBindingStatus{
+				SourceStatus: duckv1.SourceStatus{
+					SinkURI:      sinkURI,
+					SinkCACerts:  pointer.String(caCert),
+					SinkAudience: pointer.String("sink-audience"),
+				},
+			},
+		},
+		want: map[string]string{
+			"K_SINK":         "https://sink.moore.svc.cluster.local",
+			"K_CA_CERTS":     caCert,
+			"K_AUDIENCE":     "sink-audience",
+			"K_CE_OVERRIDES": `{"extensions":{"foo":"bar"}}`,
+			"sink.env": "K_SINK=https://sink.moore.svc.cluster.local\n" +
+				"K_AUDIENCE=sink-audience\n" +
+				`K_CE_OVERRIDES={"extensions":{"foo":"bar"}}` + "\n",
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.sb.OutputData()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("OutputData() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Error("OutputData() (-want, +got) =", diff)
+			}
+		})
+	}
+}
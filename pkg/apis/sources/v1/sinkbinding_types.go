@@ -67,8 +67,46 @@ type SinkBindingSpec struct {
 	// * Subject - Subject references the resource(s) whose "runtime contract"
 	//   should be augmented by Binding implementations.
 	duckv1.BindingSpec `json:",inline"`
+
+	// Output configures a ConfigMap or a Secret the resolved sink is written
+	// to, for workloads which cannot be bound through their PodSpec. The
+	// subject is optional when an output is configured.
+	// +optional
+	Output *SinkBindingOutput `json:"output,omitempty"`
+}
+
+// SinkBindingOutput is the ConfigMap or Secret, in the namespace of the
+// SinkBinding, holding its resolved sink. It has one key per environment
+// variable injected in bound subjects (K_SINK, K_CA_CERTS, K_AUDIENCE and
+// K_CE_OVERRIDES), which can be consumed with envFrom or a projected volume,
+// and a sink.env key holding the single line variables in the env-file format.
+type SinkBindingOutput struct {
+	// Kind of the output, ConfigMap or Secret.
+	Kind string `json:"kind"`
+
+	// Name of the ConfigMap or Secret.
+	Name string `json:"name"`
 }
 
+const (
+	// SinkBindingOutputConfigMap is the kind of ConfigMap outputs.
+	SinkBindingOutputConfigMap = "ConfigMap"
+
+	// SinkBindingOutputSecret is the kind of Secret outputs.
+	SinkBindingOutputSecret = "Secret"
+
+	// SinkBindingOutputEnvFileKey is the output key holding the sink in the
+	// env-file format.
+	SinkBindingOutputEnvFileKey = "sink.env"
+
+	// SinkBindingOutputLabelKey is the label holding the name of the
+	// SinkBinding of an output.
+	SinkBindingOutputLabelKey = "sources.knative.dev/sinkbinding"
+
+	// SinkBindingOutputLabelSelector selects the outputs of SinkBindings.
+	SinkBindingOutputLabelSelector = SinkBindingOutputLabelKey
+)
+
 const (
 	// SinkBindingConditionAvailable is configured to indicate whether the Binding
 	// has been configured for resources subject to its runtime contract.
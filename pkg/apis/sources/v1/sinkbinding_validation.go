@@ -19,7 +19,9 @@ package v1
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/util/validation"
 	"knative.dev/pkg/apis"
+	"knative.dev/pkg/tracker"
 )
 
 // Validate implements apis.Validatable
@@ -33,8 +35,36 @@ func (fb *SinkBinding) Validate(ctx context.Context) *apis.FieldError {
 
 // Validate implements apis.Validatable
 func (fbs *SinkBindingSpec) Validate(ctx context.Context) *apis.FieldError {
-	err := fbs.Subject.Validate(ctx).ViaField("subject").Also(
-		fbs.Sink.Validate(ctx).ViaField("sink"))
+	err := fbs.Sink.Validate(ctx).ViaField("sink")
+	if fbs.Output == nil || fbs.HasSubject() {
+		err = err.Also(fbs.Subject.Validate(ctx).ViaField("subject"))
+	}
+	if fbs.Output != nil {
+		err = err.Also(fbs.Output.Validate(ctx).ViaField("output"))
+	}
 	err = err.Also(fbs.SourceSpec.Validate(ctx))
 	return err
 }
+
+// HasSubject returns true if the SinkBinding binds a subject.
+func (fbs *SinkBindingSpec) HasSubject() bool {
+	return fbs.Subject != tracker.Reference{}
+}
+
+// Validate implements apis.Validatable
+func (o *SinkBindingOutput) Validate(ctx context.Context) *apis.FieldError {
+	var err *apis.FieldError
+	switch o.Kind {
+	case SinkBindingOutputConfigMap, SinkBindingOutputSecret:
+	case "":
+		err = err.Also(apis.ErrMissingField("kind"))
+	default:
+		err = err.Also(apis.ErrInvalidValue(o.Kind, "kind"))
+	}
+	if o.Name == "" {
+		err = err.Also(apis.ErrMissingField("name"))
+	} else if msgs := validation.IsDNS1123Subdomain(o.Name); len(msgs) > 0 {
+		err = err.Also(apis.ErrInvalidValue(o.Name, "name", msgs...))
+	}
+	return err
+}
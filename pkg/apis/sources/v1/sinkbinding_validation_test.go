@@ -146,6 +146,112 @@ func TestSinkBindingValidation(t *testing.T) {
 			"spec.ceOverrides.extensions",
 			"keys are expected to be alphanumeric",
 		),
+	}, {
+		name: "output without subject",
+		in: &SinkBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "matt",
+				Namespace: "moore",
+			},
+			Spec: SinkBindingSpec{
+				SourceSpec: duckv1.SourceSpec{
+					Sink: duckv1.Destination{
+						Ref: &duckv1.KReference{
+							APIVersion: "serving.knative.dev/v1",
+							Kind:       "Service",
+							Name:       "gemma",
+							Namespace:  "moore",
+						},
+					},
+				},
+				Output: &SinkBindingOutput{
+					Kind: SinkBindingOutputConfigMap,
+					Name: "sink",
+				},
+			},
+		},
+		want: nil,
+	}, {
+		name: "output with invalid subject",
+		in: &SinkBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "matt",
+				Namespace: "moore",
+			},
+			Spec: SinkBindingSpec{
+				BindingSpec: duckv1.BindingSpec{
+					Subject: tracker.Reference{
+						APIVersion: "apps/v1",
+						Kind:       "Deployment",
+						Namespace:  "moore",
+					},
+				},
+				SourceSpec: duckv1.SourceSpec{
+					Sink: duckv1.Destination{
+						Ref: &duckv1.KReference{
+							APIVersion: "serving.knative.dev/v1",
+							Kind:       "Service",
+							Name:       "gemma",
+							Namespace:  "moore",
+						},
+					},
+				},
+				Output: &SinkBindingOutput{
+					Kind: SinkBindingOutputSecret,
+					Name: "sink",
+				},
+			},
+		},
+		want: apis.ErrMissingOneOf("spec.subject.name", "spec.subject.selector"),
+	}, {
+		name: "output with invalid kind",
+		in: &SinkBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "matt",
+				Namespace: "moore",
+			},
+			Spec: SinkBindingSpec{
+				SourceSpec: duckv1.SourceSpec{
+					Sink: duckv1.Destination{
+						Ref: &duckv1.KReference{
+							APIVersion: "serving.knative.dev/v1",
+							Kind:       "Service",
+							Name:       "gemma",
+							Namespace:  "moore",
+						},
+					},
+				},
+				Output: &SinkBindingOutput{
+					Kind: "Pod",
+					Name: "sink",
+				},
+			},
+		},
+		want: apis.ErrInvalidValue("Pod", "spec.output.kind"),
+	}, {
+		name: "output without name",
+		in: &SinkBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "matt",
+				Namespace: "moore",
+			},
+			Spec: SinkBindingSpec{
+				SourceSpec: duckv1.SourceSpec{
+					Sink: duckv1.Destination{
+						Ref: &duckv1.KReference{
+							APIVersion: "serving.knative.dev/v1",
+							Kind:       "Service",
+							Name:       "gemma",
+							Namespace:  "moore",
+						},
+					},
+				},
+				Output: &SinkBindingOutput{
+					Kind: SinkBindingOutputConfigMap,
+				},
+			},
+		},
+		want: apis.ErrMissingField("spec.output.name"),
 	}}
 
 	for _, test := range tests {
@@ -216,6 +216,22 @@ func (in *ContainerSource) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerSourceContainerStatus) DeepCopyInto(out *ContainerSourceContainerStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSourceContainerStatus.
+func (in *ContainerSourceContainerStatus) DeepCopy() *ContainerSourceContainerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerSourceContainerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerSourceList) DeepCopyInto(out *ContainerSourceList) {
 	*out = *in
@@ -271,6 +287,11 @@ func (in *ContainerSourceSpec) DeepCopy() *ContainerSourceSpec {
 func (in *ContainerSourceStatus) DeepCopyInto(out *ContainerSourceStatus) {
 	*out = *in
 	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	if in.ContainerStatuses != nil {
+		in, out := &in.ContainerStatuses, &out.ContainerStatuses
+		*out = make([]ContainerSourceContainerStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -349,6 +370,15 @@ func (in *PingSourceList) DeepCopyObject() runtime.Object {
 func (in *PingSourceSpec) DeepCopyInto(out *PingSourceSpec) {
 	*out = *in
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.RunAt != nil {
+		in, out := &in.RunAt, &out.RunAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExcludedDates != nil {
+		in, out := &in.ExcludedDates, &out.ExcludedDates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -366,6 +396,14 @@ func (in *PingSourceSpec) DeepCopy() *PingSourceSpec {
 func (in *PingSourceStatus) DeepCopyInto(out *PingSourceStatus) {
 	*out = *in
 	in.SourceStatus.DeepCopyInto(&out.SourceStatus)
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -440,11 +478,32 @@ func (in *SinkBindingList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SinkBindingOutput) DeepCopyInto(out *SinkBindingOutput) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SinkBindingOutput.
+func (in *SinkBindingOutput) DeepCopy() *SinkBindingOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(SinkBindingOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SinkBindingSpec) DeepCopyInto(out *SinkBindingSpec) {
 	*out = *in
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	in.BindingSpec.DeepCopyInto(&out.BindingSpec)
+	if in.Output != nil {
+		in, out := &in.Output, &out.Output
+		*out = new(SinkBindingOutput)
+		**out = **in
+	}
 	return
 }
 
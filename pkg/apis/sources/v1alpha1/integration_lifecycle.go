@@ -53,6 +53,12 @@ func (iss *IntegrationSourceStatus) IsReady() bool {
 	return IntegrationCondSet.Manage(iss).IsHappy()
 }
 
+// MarkImageNotConfigured sets the condition that the ContainerSource can't be created because
+// the image of the source kind isn't configured.
+func (s *IntegrationSourceStatus) MarkImageNotConfigured(messageFormat string, messageA ...interface{}) {
+	IntegrationCondSet.Manage(s).MarkFalse(IntegrationSourceConditionContainerSourceReady, "ImageNotConfigured", messageFormat, messageA...)
+}
+
 func (s *IntegrationSourceStatus) PropagateContainerSourceStatus(status *v1.ContainerSourceStatus) {
 	// ContainerSource status has all we need, hence deep copy it.
 	s.SourceStatus = *status.SourceStatus.DeepCopy()
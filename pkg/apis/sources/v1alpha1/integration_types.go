@@ -59,8 +59,10 @@ type IntegrationSourceSpec struct {
 	//   and modifications of the event sent to the sink.
 	duckv1.SourceSpec `json:",inline"`
 
-	Aws   *Aws   `json:"aws,omitempty"`   // AWS source configuration
-	Timer *Timer `json:"timer,omitempty"` // Timer configuration
+	Aws     *Aws     `json:"aws,omitempty"`     // AWS source configuration
+	Timer   *Timer   `json:"timer,omitempty"`   // Timer configuration
+	HTTP    *HTTP    `json:"http,omitempty"`    // HTTP polling configuration
+	Webhook *Webhook `json:"webhook,omitempty"` // Webhook configuration
 
 	Template *corev1.PodTemplateSpec `json:"template,omitempty"` // Pod configuration
 }
@@ -72,6 +74,27 @@ type Timer struct {
 	RepeatCount int    `json:"repeatCount,omitempty"`            // Max number of fires (optional)
 }
 
+// HTTP polls an HTTP endpoint and emits its responses.
+type HTTP struct {
+	URL           string         `json:"url"`                            // URL of the polled endpoint
+	Method        string         `json:"method,omitempty" default:"GET"` // HTTP method of the requests
+	Period        int            `json:"period" default:"10000"`         // Interval (in milliseconds) between requests
+	ContentType   string         `json:"contentType,omitempty"`          // Content type of the emitted responses, defaults to the response content type
+	EmitUnchanged bool           `json:"emitUnchanged" default:"false"`  // Emit responses identical to the previous one
+	Auth          *v1alpha1.Auth `json:"auth,omitempty"`                 // Basic (http.username/http.password) or bearer (http.token) authentication secret
+}
+
+// Webhook receives HTTP requests and emits their bodies. Requests are accepted on the
+// Service reported in the IntegrationSource status.
+type Webhook struct {
+	Path               string         `json:"path,omitempty" default:"/"`                              // Path receiving the webhooks
+	SignatureHeader    string         `json:"signatureHeader,omitempty" default:"X-Hub-Signature-256"` // Header holding the HMAC signature of the body
+	SignatureAlgorithm string         `json:"signatureAlgorithm,omitempty" default:"HmacSHA256"`       // HMAC algorithm, one of HmacSHA1, HmacSHA256 or HmacSHA512
+	SignaturePrefix    string         `json:"signaturePrefix,omitempty"`                               // Prefix of the hex encoded signature, for example "sha256="
+	EventType          string         `json:"eventType,omitempty"`                                     // Type of the emitted events
+	Auth               *v1alpha1.Auth `json:"auth,omitempty"`                                          // Secret holding the HMAC key (webhook.hmacKey), signatures are not verified when unset
+}
+
 type Aws struct {
 	S3         *v1alpha1.AWSS3         `json:"s3,omitempty"`         // S3 source configuration
 	SQS        *v1alpha1.AWSSQS        `json:"sqs,omitempty"`        // SQS source configuration
@@ -94,6 +117,12 @@ type IntegrationSourceStatus struct {
 	// * SinkURI - the current active sink URI that has been configured for the
 	//   Source.
 	duckv1.SourceStatus `json:",inline"`
+
+	// WebhookURL is the cluster-local URL receiving the webhooks, when the source is a webhook.
+	// To receive webhooks from outside of the cluster, expose the Service of this URL, for
+	// example with an Ingress.
+	// +optional
+	WebhookURL *apis.URL `json:"webhookURL,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
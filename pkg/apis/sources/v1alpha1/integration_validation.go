@@ -18,10 +18,17 @@ package v1alpha1
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"knative.dev/pkg/apis"
 )
 
+var (
+	httpPollMethods            = []string{"GET", "POST"}
+	webhookSignatureAlgorithms = []string{"HmacSHA1", "HmacSHA256", "HmacSHA512"}
+)
+
 func (source *IntegrationSource) Validate(ctx context.Context) *apis.FieldError {
 	ctx = apis.WithinParent(ctx, source.ObjectMeta)
 	return source.Spec.Validate(ctx).ViaField("spec")
@@ -35,6 +42,12 @@ func (spec *IntegrationSourceSpec) Validate(ctx context.Context) *apis.FieldErro
 	if spec.Timer != nil {
 		sourceSetCount++
 	}
+	if spec.HTTP != nil {
+		sourceSetCount++
+	}
+	if spec.Webhook != nil {
+		sourceSetCount++
+	}
 	if spec.Aws != nil {
 		if spec.Aws.S3 != nil {
 			sourceSetCount++
@@ -94,5 +107,47 @@ func (spec *IntegrationSourceSpec) Validate(ctx context.Context) *apis.FieldErro
 		}
 	}
 
+	if sourceSetCount == 1 && spec.HTTP != nil {
+		errs = errs.Also(spec.HTTP.Validate(ctx).ViaField("http"))
+	}
+	if sourceSetCount == 1 && spec.Webhook != nil {
+		errs = errs.Also(spec.Webhook.Validate(ctx).ViaField("webhook"))
+	}
+
+	return errs
+}
+
+func (h *HTTP) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if h.URL == "" {
+		errs = errs.Also(apis.ErrMissingField("url"))
+	} else if u, err := apis.ParseURL(h.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errs = errs.Also(apis.ErrInvalidValue(h.URL, "url", "must be an absolute http or https URL"))
+	}
+	if h.Method != "" && !slices.Contains(httpPollMethods, h.Method) {
+		errs = errs.Also(apis.ErrInvalidValue(h.Method, "method", "must be one of "+strings.Join(httpPollMethods, ", ")))
+	}
+	if h.Period < 0 {
+		errs = errs.Also(apis.ErrInvalidValue(h.Period, "period", "must not be negative"))
+	}
+	if h.Auth != nil && (h.Auth.Secret == nil || h.Auth.Secret.Ref == nil || h.Auth.Secret.Ref.Name == "") {
+		errs = errs.Also(apis.ErrMissingField("auth.secret.ref.name"))
+	}
+	return errs
+}
+
+func (w *Webhook) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if w.Path != "" && !strings.HasPrefix(w.Path, "/") {
+		errs = errs.Also(apis.ErrInvalidValue(w.Path, "path", "must start with /"))
+	}
+	if w.SignatureAlgorithm != "" && !slices.Contains(webhookSignatureAlgorithms, w.SignatureAlgorithm) {
+		errs = errs.Also(apis.ErrInvalidValue(w.SignatureAlgorithm, "signatureAlgorithm", "must be one of "+strings.Join(webhookSignatureAlgorithms, ", ")))
+	}
+	if w.Auth != nil && (w.Auth.Secret == nil || w.Auth.Secret.Ref == nil || w.Auth.Secret.Ref.Name == "") {
+		errs = errs.Also(apis.ErrMissingField("auth.secret.ref.name"))
+	}
 	return errs
 }
@@ -309,6 +309,91 @@ func TestIntegrationSourceSpecValidation(t *testing.T) {
 			},
 			want: apis.ErrMissingField("aws.s3.region"),
 		},
+		{
+			name: "valid HTTP source",
+			spec: IntegrationSourceSpec{
+				HTTP: &HTTP{
+					URL:    "https://example.com/status",
+					Method: "GET",
+					Period: 5000,
+					Auth: &v1alpha1.Auth{
+						Secret: &v1alpha1.Secret{
+							Ref: &v1alpha1.SecretReference{
+								Name: "http-secret",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "HTTP source without URL",
+			spec: IntegrationSourceSpec{
+				HTTP: &HTTP{Period: 5000},
+			},
+			want: apis.ErrMissingField("http.url"),
+		},
+		{
+			name: "HTTP source with invalid URL and method",
+			spec: IntegrationSourceSpec{
+				HTTP: &HTTP{URL: "example.com/status", Method: "DELETE"},
+			},
+			want: apis.ErrInvalidValue("example.com/status", "http.url", "must be an absolute http or https URL").
+				Also(apis.ErrInvalidValue("DELETE", "http.method", "must be one of GET, POST")),
+		},
+		{
+			name: "HTTP source with auth without secret",
+			spec: IntegrationSourceSpec{
+				HTTP: &HTTP{
+					URL:  "https://example.com/status",
+					Auth: &v1alpha1.Auth{ServiceAccountName: "sa"},
+				},
+			},
+			want: apis.ErrMissingField("http.auth.secret.ref.name"),
+		},
+		{
+			name: "valid webhook source",
+			spec: IntegrationSourceSpec{
+				Webhook: &Webhook{
+					Path:               "/github",
+					SignatureHeader:    "X-Hub-Signature-256",
+					SignatureAlgorithm: "HmacSHA256",
+					SignaturePrefix:    "sha256=",
+					Auth: &v1alpha1.Auth{
+						Secret: &v1alpha1.Secret{
+							Ref: &v1alpha1.SecretReference{
+								Name: "webhook-secret",
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "valid webhook source without signature verification",
+			spec: IntegrationSourceSpec{
+				Webhook: &Webhook{},
+			},
+			want: nil,
+		},
+		{
+			name: "webhook source with invalid path and algorithm",
+			spec: IntegrationSourceSpec{
+				Webhook: &Webhook{Path: "github", SignatureAlgorithm: "MD5"},
+			},
+			want: apis.ErrInvalidValue("github", "webhook.path", "must start with /").
+				Also(apis.ErrInvalidValue("MD5", "webhook.signatureAlgorithm", "must be one of HmacSHA1, HmacSHA256, HmacSHA512")),
+		},
+		{
+			name: "HTTP and webhook sources set (invalid)",
+			spec: IntegrationSourceSpec{
+				HTTP:    &HTTP{URL: "https://example.com/status"},
+				Webhook: &Webhook{},
+			},
+			want: apis.ErrGeneric("only one source type can be set", "spec"),
+		},
 	}
 
 	for _, test := range tests {
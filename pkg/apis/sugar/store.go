@@ -37,6 +37,18 @@ type Config struct {
 	// TriggerSelector specifies a LabelSelector which
 	// determines which triggers the Sugar Controller should operate upon
 	TriggerSelector *metav1.LabelSelector
+
+	// BrokerTemplate specifies the Broker created in the namespaces
+	// selected by NamespaceSelector
+	BrokerTemplate *BrokerTemplate
+
+	// EventPolicies specifies the EventPolicies created in the namespaces
+	// selected by NamespaceSelector
+	EventPolicies []EventPolicyTemplate
+
+	// Triggers specifies the Triggers created in the namespaces
+	// selected by NamespaceSelector
+	Triggers []TriggerTemplate
 }
 
 func (c *Config) DeepCopy() *Config {
@@ -45,6 +57,19 @@ func (c *Config) DeepCopy() *Config {
 	}
 	out := new(Config)
 	*out = *c
+	out.BrokerTemplate = c.BrokerTemplate.DeepCopy()
+	if c.EventPolicies != nil {
+		out.EventPolicies = make([]EventPolicyTemplate, len(c.EventPolicies))
+		for i := range c.EventPolicies {
+			out.EventPolicies[i] = *c.EventPolicies[i].DeepCopy()
+		}
+	}
+	if c.Triggers != nil {
+		out.Triggers = make([]TriggerTemplate, len(c.Triggers))
+		for i := range c.Triggers {
+			out.Triggers[i] = *c.Triggers[i].DeepCopy()
+		}
+	}
 	return out
 }
 
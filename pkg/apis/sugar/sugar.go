@@ -17,8 +17,11 @@ limitations under the License.
 package sugar
 
 import (
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	cm "knative.dev/pkg/configmap"
 	"sigs.k8s.io/yaml"
 )
@@ -33,6 +36,21 @@ const (
 	// entry that specifies a LabelSelector to control which triggers
 	// the Sugar Controller operates on.
 	TriggerSelectorKey = "trigger-selector"
+
+	// BrokerTemplateKey is the name of the configuration entry
+	// that specifies the Broker the Sugar Controller creates in
+	// the namespaces it operates on.
+	BrokerTemplateKey = "broker-template"
+
+	// EventPoliciesKey is the name of the configuration entry
+	// that specifies the EventPolicies the Sugar Controller creates in
+	// the namespaces it operates on.
+	EventPoliciesKey = "event-policies"
+
+	// TriggersKey is the name of the configuration entry
+	// that specifies the Triggers the Sugar Controller creates in
+	// the namespaces it operates on.
+	TriggersKey = "triggers"
 )
 
 // NewConfigFromConfigMap creates a Config from the supplied ConfigMap
@@ -46,10 +64,34 @@ func NewConfigFromMap(data map[string]string) (*Config, error) {
 	if err := cm.Parse(data,
 		asLabelSelector(NamespaceSelectorKey, &nc.NamespaceSelector),
 		asLabelSelector(TriggerSelectorKey, &nc.TriggerSelector),
+		asYAML(BrokerTemplateKey, &nc.BrokerTemplate),
+		asYAML(EventPoliciesKey, &nc.EventPolicies),
+		asYAML(TriggersKey, &nc.Triggers),
 	); err != nil {
 		return nil, err
 	}
 
+	eventPolicyNames := sets.New[string]()
+	for _, ep := range nc.EventPolicies {
+		if ep.Name == "" {
+			return nil, fmt.Errorf("%s: name is required", EventPoliciesKey)
+		}
+		if eventPolicyNames.Has(ep.Name) {
+			return nil, fmt.Errorf("%s: duplicate name %q", EventPoliciesKey, ep.Name)
+		}
+		eventPolicyNames.Insert(ep.Name)
+	}
+	triggerNames := sets.New[string]()
+	for _, t := range nc.Triggers {
+		if t.Name == "" {
+			return nil, fmt.Errorf("%s: name is required", TriggersKey)
+		}
+		if triggerNames.Has(t.Name) {
+			return nil, fmt.Errorf("%s: duplicate name %q", TriggersKey, t.Name)
+		}
+		triggerNames.Insert(t.Name)
+	}
+
 	return nc, nil
 }
 
@@ -68,3 +110,15 @@ func asLabelSelector(key string, target **metav1.LabelSelector) cm.ParseFunc {
 		return nil
 	}
 }
+
+// asYAML unmarshals the YAML value of the given configmap key into target.
+func asYAML[T any](key string, target *T) cm.ParseFunc {
+	return func(data map[string]string) error {
+		if raw, ok := data[key]; ok && len(raw) > 0 {
+			if err := yaml.UnmarshalStrict([]byte(raw), target); err != nil {
+				return fmt.Errorf("failed to parse %s: %w", key, err)
+			}
+		}
+		return nil
+	}
+}
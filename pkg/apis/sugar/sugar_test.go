@@ -21,6 +21,12 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingv1alpha1 "knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
 )
 
 func TestNewConfigFromMap(t *testing.T) {
@@ -89,6 +95,82 @@ func TestNewConfigFromMap(t *testing.T) {
 				  }`,
 			},
 		},
+		{
+			name:    "templates",
+			wantErr: false,
+			wantConfig: &Config{
+				BrokerTemplate: &BrokerTemplate{
+					Name:        "tenant",
+					Class:       "MTChannelBasedBroker",
+					Annotations: map[string]string{"team": "platform"},
+					Delivery: &eventingduckv1.DeliverySpec{
+						Retry: ptr.Int32(5),
+					},
+				},
+				EventPolicies: []EventPolicyTemplate{{
+					Name: "allow-gateway",
+					Spec: eventingv1alpha1.EventPolicySpec{
+						From: []eventingv1alpha1.EventPolicySpecFrom{{
+							Sub: ptr.String("system:serviceaccount:gateway:*"),
+						}},
+					},
+				}},
+				Triggers: []TriggerTemplate{{
+					Name: "audit",
+					Spec: eventingv1.TriggerSpec{
+						Subscriber: duckv1.Destination{
+							URI: apis.HTTP("audit.audit.svc.cluster.local"),
+						},
+					},
+				}},
+			},
+			data: map[string]string{
+				"broker-template": `
+name: tenant
+class: MTChannelBasedBroker
+annotations:
+  team: platform
+delivery:
+  retry: 5
+`,
+				"event-policies": `
+- name: allow-gateway
+  spec:
+    from:
+    - sub: "system:serviceaccount:gateway:*"
+`,
+				"triggers": `
+- name: audit
+  spec:
+    subscriber:
+      uri: http://audit.audit.svc.cluster.local
+`,
+			},
+		},
+		{
+			name:       "unknown broker-template field",
+			wantErr:    true,
+			wantConfig: &Config{},
+			data: map[string]string{
+				"broker-template": `brokerClass: MTChannelBasedBroker`,
+			},
+		},
+		{
+			name:       "trigger without name",
+			wantErr:    true,
+			wantConfig: &Config{},
+			data: map[string]string{
+				"triggers": `[{"spec": {}}]`,
+			},
+		},
+		{
+			name:       "duplicate event policy name",
+			wantErr:    true,
+			wantConfig: &Config{},
+			data: map[string]string{
+				"event-policies": `[{"name": "a"}, {"name": "a"}]`,
+			},
+		},
 		{
 			name:       "dangling namespace-selector key/val",
 			wantErr:    true,
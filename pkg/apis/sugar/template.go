@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sugar
+
+import (
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	eventingv1alpha1 "knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+const (
+	// DefaultBrokerName is the name of the Broker created by the Sugar Controller
+	// when the Broker template does not specify one.
+	DefaultBrokerName = "default"
+)
+
+// BrokerTemplate specifies the Broker the Sugar Controller creates in
+// the namespaces it operates upon.
+type BrokerTemplate struct {
+	// Name of the Broker, defaults to "default".
+	Name string `json:"name,omitempty"`
+
+	// Class of the Broker, defaults to the cluster or namespace default
+	// Broker class.
+	Class string `json:"class,omitempty"`
+
+	// Annotations added to the Broker.
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Config is a KReference to the configuration of the Broker.
+	Config *duckv1.KReference `json:"config,omitempty"`
+
+	// Delivery contains the default delivery spec of the Broker.
+	Delivery *eventingduckv1.DeliverySpec `json:"delivery,omitempty"`
+}
+
+// GetName returns the name of the Broker created from the template.
+func (t *BrokerTemplate) GetName() string {
+	if t == nil || t.Name == "" {
+		return DefaultBrokerName
+	}
+	return t.Name
+}
+
+// EventPolicyTemplate specifies an EventPolicy the Sugar Controller creates
+// in the namespaces it operates upon.
+type EventPolicyTemplate struct {
+	// Name of the EventPolicy.
+	Name string `json:"name"`
+
+	// Annotations added to the EventPolicy.
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Spec of the EventPolicy.
+	Spec eventingv1alpha1.EventPolicySpec `json:"spec"`
+}
+
+// TriggerTemplate specifies a Trigger the Sugar Controller creates in the
+// namespaces it operates upon.
+type TriggerTemplate struct {
+	// Name of the Trigger.
+	Name string `json:"name"`
+
+	// Annotations added to the Trigger.
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Spec of the Trigger. When the Broker is not set, the Trigger refers to
+	// the Broker created from the Broker template.
+	Spec eventingv1.TriggerSpec `json:"spec"`
+}
+
+func (t *BrokerTemplate) DeepCopy() *BrokerTemplate {
+	if t == nil {
+		return nil
+	}
+	out := new(BrokerTemplate)
+	*out = *t
+	if t.Annotations != nil {
+		out.Annotations = make(map[string]string, len(t.Annotations))
+		for k, v := range t.Annotations {
+			out.Annotations[k] = v
+		}
+	}
+	out.Config = t.Config.DeepCopy()
+	out.Delivery = t.Delivery.DeepCopy()
+	return out
+}
+
+func (t *EventPolicyTemplate) DeepCopy() *EventPolicyTemplate {
+	out := new(EventPolicyTemplate)
+	*out = *t
+	if t.Annotations != nil {
+		out.Annotations = make(map[string]string, len(t.Annotations))
+		for k, v := range t.Annotations {
+			out.Annotations[k] = v
+		}
+	}
+	t.Spec.DeepCopyInto(&out.Spec)
+	return out
+}
+
+func (t *TriggerTemplate) DeepCopy() *TriggerTemplate {
+	out := new(TriggerTemplate)
+	*out = *t
+	if t.Annotations != nil {
+		out.Annotations = make(map[string]string, len(t.Annotations))
+		for k, v := range t.Annotations {
+			out.Annotations[k] = v
+		}
+	}
+	t.Spec.DeepCopyInto(&out.Spec)
+	return out
+}
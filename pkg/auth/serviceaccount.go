@@ -154,7 +154,8 @@ type OIDCIdentityStatusMarker interface {
 }
 
 func SetupOIDCServiceAccount(ctx context.Context, flags feature.Flags, serviceAccountLister corev1listers.ServiceAccountLister, kubeclient kubernetes.Interface, gvk schema.GroupVersionKind, objectMeta metav1.ObjectMeta, marker OIDCIdentityStatusMarker, setAuthStatus func(a *duckv1.AuthStatus)) pkgreconciler.Event {
-	if flags.IsOIDCAuthentication() {
+	// The identity is also used to issue client certificates.
+	if flags.IsOIDCAuthentication() || flags.IsClientCertAuthentication() {
 		saName := GetOIDCServiceAccountNameForResource(gvk, objectMeta)
 		setAuthStatus(&duckv1.AuthStatus{
 			ServiceAccountName: &saName,
@@ -96,21 +96,29 @@ func NewVerifier(ctx context.Context, eventPolicyLister listerseventingv1alpha1.
 // VerifyRequest verifies AuthN and AuthZ in the request. On verification errors, it sets the
 // responses HTTP status and returns an error
 func (v *Verifier) VerifyRequest(ctx context.Context, features feature.Flags, requiredOIDCAudience *string, resourceNamespace string, policyRefs []duckv1.AppliedEventPolicyRef, req *http.Request, resp http.ResponseWriter) error {
-	if !features.IsOIDCAuthentication() {
-		return nil
+	_, err := v.VerifyRequestSubject(ctx, features, requiredOIDCAudience, resourceNamespace, policyRefs, req, resp)
+	return err
+}
+
+// VerifyRequestSubject verifies AuthN and AuthZ in the request like VerifyRequest() and
+// returns the authenticated subject of the request. The subject is empty when neither
+// OIDC nor client certificate authentication is enabled.
+func (v *Verifier) VerifyRequestSubject(ctx context.Context, features feature.Flags, requiredOIDCAudience *string, resourceNamespace string, policyRefs []duckv1.AppliedEventPolicyRef, req *http.Request, resp http.ResponseWriter) (string, error) {
+	if !features.IsOIDCAuthentication() && !features.IsClientCertAuthentication() {
+		return "", nil
 	}
 
-	idToken, err := v.verifyAuthN(ctx, requiredOIDCAudience, req, resp)
+	idToken, err := v.verifyAuthN(ctx, features, requiredOIDCAudience, req, resp)
 	if err != nil {
-		return fmt.Errorf("authentication of request could not be verified: %w", err)
+		return "", fmt.Errorf("authentication of request could not be verified: %w", err)
 	}
 
 	err = v.verifyAuthZ(ctx, features, idToken, resourceNamespace, policyRefs, req, resp)
 	if err != nil {
-		return fmt.Errorf("authorization of request could not be verified: %w", err)
+		return "", fmt.Errorf("authorization of request could not be verified: %w", err)
 	}
 
-	return nil
+	return idToken.Subject, nil
 }
 
 // VerifyRequestFromSubject verifies AuthN and AuthZ in the request.
@@ -119,11 +127,11 @@ func (v *Verifier) VerifyRequest(ctx context.Context, features feature.Flags, re
 // This method is similar to VerifyRequest() except that VerifyRequestFromSubject()
 // verifies in the AuthZ part that the request comes from a given subject.
 func (v *Verifier) VerifyRequestFromSubject(ctx context.Context, features feature.Flags, requiredOIDCAudience *string, allowedSubject string, req *http.Request, resp http.ResponseWriter) error {
-	if !features.IsOIDCAuthentication() {
+	if !features.IsOIDCAuthentication() && !features.IsClientCertAuthentication() {
 		return nil
 	}
 
-	idToken, err := v.verifyAuthN(ctx, requiredOIDCAudience, req, resp)
+	idToken, err := v.verifyAuthN(ctx, features, requiredOIDCAudience, req, resp)
 	if err != nil {
 		return fmt.Errorf("authentication of request could not be verified: %w", err)
 	}
@@ -143,11 +151,11 @@ func (v *Verifier) VerifyRequestFromSubject(ctx context.Context, features featur
 // VerifyRequestFromSubjectsWithFilters() allows to check based on a list of
 // subjects with filters.
 func (v *Verifier) VerifyRequestFromSubjectsWithFilters(ctx context.Context, features feature.Flags, requiredOIDCAudience *string, allowedSubjectsWithFilters []SubjectsWithFilters, resourceNamespace string, req *http.Request, resp http.ResponseWriter) error {
-	if !features.IsOIDCAuthentication() {
+	if !features.IsOIDCAuthentication() && !features.IsClientCertAuthentication() {
 		return nil
 	}
 
-	idToken, err := v.verifyAuthN(ctx, requiredOIDCAudience, req, resp)
+	idToken, err := v.verifyAuthN(ctx, features, requiredOIDCAudience, req, resp)
 	if err != nil {
 		return fmt.Errorf("authentication of request could not be verified: %w", err)
 	}
@@ -160,10 +168,19 @@ func (v *Verifier) VerifyRequestFromSubjectsWithFilters(ctx context.Context, fea
 	return nil
 }
 
-// verifyAuthN verifies if the incoming request contains a correct JWT token
-func (v *Verifier) verifyAuthN(ctx context.Context, audience *string, req *http.Request, resp http.ResponseWriter) (*IDToken, error) {
+// verifyAuthN verifies if the incoming request contains a correct JWT token or,
+// when client certificate authentication is enabled, a verified client certificate.
+// The subject of the client certificate is used when the request has no JWT token.
+func (v *Verifier) verifyAuthN(ctx context.Context, features feature.Flags, audience *string, req *http.Request, resp http.ResponseWriter) (*IDToken, error) {
 	token := GetJWTFromHeader(req.Header)
-	if token == "" {
+	if token == "" || !features.IsOIDCAuthentication() {
+		if features.IsClientCertAuthentication() {
+			if sub := eventingtls.ClientCertSubject(req.TLS); sub != "" {
+				return &IDToken{Subject: sub}, nil
+			}
+			resp.WriteHeader(http.StatusUnauthorized)
+			return nil, fmt.Errorf("no JWT token or client certificate found in request")
+		}
 		resp.WriteHeader(http.StatusUnauthorized)
 		return nil, fmt.Errorf("no JWT token found in request")
 	}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetypes "github.com/cloudevents/sdk-go/v2/types"
+	"github.com/rickb777/date/period"
+)
+
+const (
+	// DeliverAtAttribute is the name of the CloudEvents extension attribute used by producers to
+	// request the delivery of an event at a given point in time. The value is an RFC3339 timestamp.
+	DeliverAtAttribute = "deliverat"
+
+	// DeliverAfterAttribute is the name of the CloudEvents extension attribute used by producers to
+	// request the delivery of an event after a given delay, relative to the time the Broker received
+	// it. The value is an ISO 8601 duration, for example PT15M.
+	DeliverAfterAttribute = "deliverafter"
+)
+
+// GetDeliveryTime returns the time at which the event has been requested to be delivered, based
+// on the DeliverAtAttribute and DeliverAfterAttribute extensions. now is the time the event has
+// been received. The second return param is false when the event doesn't request a delayed
+// delivery. If both extensions are set, the latest of the two times is returned.
+func GetDeliveryTime(ctx cloudevents.EventContext, now time.Time) (time.Time, bool, error) {
+	var deliverAt time.Time
+	found := false
+
+	if raw, err := ctx.GetExtension(DeliverAtAttribute); err == nil {
+		t, err := cetypes.ToTime(raw)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid %s extension: %w", DeliverAtAttribute, err)
+		}
+		deliverAt = t
+		found = true
+	}
+
+	if raw, err := ctx.GetExtension(DeliverAfterAttribute); err == nil {
+		s, err := cetypes.ToString(raw)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid %s extension: %w", DeliverAfterAttribute, err)
+		}
+		p, err := period.Parse(s)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid %s extension: %w", DeliverAfterAttribute, err)
+		}
+		if p.IsNegative() {
+			return time.Time{}, false, fmt.Errorf("invalid %s extension: negative duration %q", DeliverAfterAttribute, s)
+		}
+		if t := now.Add(p.DurationApprox()); !found || t.After(deliverAt) {
+			deliverAt = t
+		}
+		found = true
+	}
+
+	return deliverAt, found, nil
+}
+
+// DeleteDeliveryTime removes the DeliverAtAttribute and DeliverAfterAttribute CE extension attributes.
+func DeleteDeliveryTime(ctx cloudevents.EventContext) {
+	_ = ctx.SetExtension(DeliverAtAttribute, nil)
+	_ = ctx.SetExtension(DeliverAfterAttribute, nil)
+}
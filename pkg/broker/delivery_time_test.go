@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package broker
+
+import (
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+func TestGetDeliveryTime(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		extensions map[string]interface{}
+		want       time.Time
+		wantFound  bool
+		wantErr    bool
+	}{
+		"no extensions": {},
+		"deliverat": {
+			extensions: map[string]interface{}{
+				DeliverAtAttribute: "2026-01-01T12:00:00Z",
+			},
+			want:      time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantFound: true,
+		},
+		"deliverafter": {
+			extensions: map[string]interface{}{
+				DeliverAfterAttribute: "PT15M",
+			},
+			want:      now.Add(15 * time.Minute),
+			wantFound: true,
+		},
+		"both, latest wins": {
+			extensions: map[string]interface{}{
+				DeliverAtAttribute:    "2026-01-01T10:05:00Z",
+				DeliverAfterAttribute: "PT1H",
+			},
+			want:      now.Add(time.Hour),
+			wantFound: true,
+		},
+		"invalid deliverat": {
+			extensions: map[string]interface{}{
+				DeliverAtAttribute: "tomorrow",
+			},
+			wantErr: true,
+		},
+		"invalid deliverafter": {
+			extensions: map[string]interface{}{
+				DeliverAfterAttribute: "15m",
+			},
+			wantErr: true,
+		},
+		"negative deliverafter": {
+			extensions: map[string]interface{}{
+				DeliverAfterAttribute: "-PT15M",
+			},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			event := cloudevents.NewEvent()
+			for k, v := range tc.extensions {
+				event.SetExtension(k, v)
+			}
+			got, found, err := GetDeliveryTime(event.Context, now)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("GetDeliveryTime() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if found != tc.wantFound {
+				t.Errorf("GetDeliveryTime() found = %v, want %v", found, tc.wantFound)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("GetDeliveryTime() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDeleteDeliveryTime(t *testing.T) {
+	event := cloudevents.NewEvent()
+	event.SetExtension(DeliverAtAttribute, "2026-01-01T12:00:00Z")
+	event.SetExtension(DeliverAfterAttribute, "PT15M")
+
+	DeleteDeliveryTime(event.Context)
+
+	if _, found, _ := GetDeliveryTime(event.Context, time.Now()); found {
+		t.Errorf("expected delivery time extensions to be deleted, got %v", event.Extensions())
+	}
+}
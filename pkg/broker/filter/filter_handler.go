@@ -53,9 +53,11 @@ import (
 	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/eventing/pkg/utils"
 
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/apis/feature"
 	eventingbroker "knative.dev/eventing/pkg/broker"
+	"knative.dev/eventing/pkg/claimcheck"
 	v1 "knative.dev/eventing/pkg/client/informers/externalversions/eventing/v1"
 	eventinglisters "knative.dev/eventing/pkg/client/listers/eventing/v1"
 	messaginglisters "knative.dev/eventing/pkg/client/listers/messaging/v1"
@@ -98,8 +100,10 @@ type Handler struct {
 	logger             *zap.Logger
 	withContext        func(ctx context.Context) context.Context
 	filtersMap         *subscriptionsapi.FiltersMap
+	transformsMap      *transformsMap
 	tokenVerifier      *auth.Verifier
 	EventTypeCreator   *eventtype.EventTypeAutoHandler
+	ClaimCheckStore    claimcheck.Store
 	tracer             trace.Tracer
 	dispatchDuration   metric.Float64Histogram
 	processDuration    metric.Float64Histogram
@@ -114,6 +118,7 @@ func NewHandler(
 	brokerInformer v1.BrokerInformer,
 	subscriptionInformer messaginginformers.SubscriptionInformer,
 	trustBundleConfigMapLister corev1listers.ConfigMapNamespaceLister,
+	clientCertificates *eventingtls.ClientCertificates,
 	wc func(ctx context.Context) context.Context,
 	meterProvider metric.MeterProvider,
 	traceProvider trace.TracerProvider,
@@ -124,9 +129,11 @@ func NewHandler(
 	})
 
 	fm := subscriptionsapi.NewFiltersMap()
+	tm := newTransformsMap()
 
 	clientConfig := eventingtls.ClientConfig{
 		TrustBundleConfigMapLister: trustBundleConfigMapLister,
+		ClientCertificates:         clientCertificates,
 	}
 
 	triggerInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -167,6 +174,7 @@ func NewHandler(
 			}
 			logger.Debug("Deleting filter in filtersMap")
 			fm.Delete(trigger)
+			tm.Delete(trigger)
 			kncloudevents.DeleteAddressableHandler(duckv1.Addressable{
 				URL:     trigger.Status.SubscriberURI,
 				CACerts: trigger.Status.SubscriberCACerts,
@@ -188,6 +196,7 @@ func NewHandler(
 		tokenVerifier:      tokenVerifier,
 		withContext:        wc,
 		filtersMap:         fm,
+		transformsMap:      tm,
 		tracer:             traceProvider.Tracer(ScopeName),
 	}
 
@@ -335,7 +344,7 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	h.handleDispatchToSubscriberRequest(ctx, trigger, writer, request, event, start)
+	h.handleDispatchToSubscriberRequest(ctx, trigger, broker, writer, request, event, start)
 }
 
 func (h *Handler) handleDispatchToReplyRequest(
@@ -418,7 +427,7 @@ func (h *Handler) handleDispatchToDLSRequest(
 	h.send(ctx, writer, request.Header, *target, event, trigger, skipTTL)
 }
 
-func (h *Handler) handleDispatchToSubscriberRequest(ctx context.Context, trigger *eventingv1.Trigger, writer http.ResponseWriter, request *http.Request, event *event.Event, start time.Time) {
+func (h *Handler) handleDispatchToSubscriberRequest(ctx context.Context, trigger *eventingv1.Trigger, brokerRef types.NamespacedName, writer http.ResponseWriter, request *http.Request, event *event.Event, start time.Time) {
 	triggerRef := types.NamespacedName{
 		Name:      trigger.Name,
 		Namespace: trigger.Namespace,
@@ -465,11 +474,37 @@ func (h *Handler) handleDispatchToSubscriberRequest(ctx context.Context, trigger
 		Audience: trigger.Status.SubscriberAudience,
 	}
 
+	if h.ClaimCheckStore != nil && (trigger.Spec.Delivery == nil || trigger.Spec.Delivery.DataRef == nil || *trigger.Spec.Delivery.DataRef != eventingduckv1.DataRefPolicyReference) {
+		err := claimcheck.Resolve(ctx, h.ClaimCheckStore, event, claimcheck.Prefix("brokers", brokerRef.Namespace, brokerRef.Name))
+		if errors.Is(err, claimcheck.ErrForbidden) {
+			h.logger.Warn("Event references offloaded data of another broker", zap.Any("triggerRef", triggerRef), zap.Error(err))
+			writer.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			h.logger.Error("Failed to resolve offloaded event data", zap.Any("triggerRef", triggerRef), zap.Error(err))
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
 	sendOptions := []kncloudevents.SendOption{}
 	if trigger.Spec.Delivery != nil && trigger.Spec.Delivery.Format != nil {
 		sendOptions = append(sendOptions, kncloudevents.WithEventFormat(trigger.Spec.Delivery.Format))
 	}
 
+	if trigger.Spec.Transform != nil {
+		t, err := h.transformsMap.Get(trigger)
+		if err != nil {
+			h.logger.Error("Failed to create the trigger transformation", zap.Any("triggerRef", triggerRef), zap.Error(err))
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		prepared := t.Prepare(*event)
+		event = &prepared
+		sendOptions = append(sendOptions, kncloudevents.WithTransformers(t.Transformers()...))
+	}
+
 	h.send(ctx, writer, utils.PassThroughHeaders(request.Header), target, event, trigger, ttl, sendOptions...)
 }
 
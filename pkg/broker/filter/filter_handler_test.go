@@ -52,17 +52,20 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
 	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/fake"
 	filteredconfigmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered/fake"
 	"knative.dev/pkg/logging"
 	reconcilertesting "knative.dev/pkg/reconciler/testing"
 
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	v1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/eventing/pkg/broker"
+	"knative.dev/eventing/pkg/claimcheck"
 	"knative.dev/eventing/pkg/eventfilter/subscriptionsapi"
 
 	brokerinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker/fake"
@@ -510,6 +513,7 @@ func TestReceiver(t *testing.T) {
 				brokerinformerfake.Get(ctx),
 				subscriptioninformerfake.Get(ctx),
 				configmapinformer.Get(ctx).Lister().ConfigMaps("ns"),
+				nil,
 				func(ctx context.Context) context.Context {
 					return ctx
 				},
@@ -737,6 +741,7 @@ func TestReceiver_WithSubscriptionsAPI(t *testing.T) {
 				brokerinformerfake.Get(ctx),
 				subscriptioninformerfake.Get(ctx),
 				configmapinformer.Get(ctx).Lister().ConfigMaps("ns"),
+				nil,
 				func(ctx context.Context) context.Context {
 					return ctx
 				},
@@ -1019,6 +1024,185 @@ func makeEmptyResponse(status int) *http.Response {
 }
 
 func SetUpInformerSelector(ctx context.Context) context.Context {
-	ctx = filteredFactory.WithSelectors(ctx, eventingtls.TrustBundleLabelSelector)
+	ctx = filteredFactory.WithSelectors(ctx, eventingtls.TrustBundleLabelSelector, eventingtls.ClientCABundleLabelSelector)
 	return ctx
 }
+
+func TestReceiver_ClaimCheck(t *testing.T) {
+	testCases := map[string]struct {
+		dataRef      *eventingduckv1.DataRefPolicyType
+		key          string
+		wantResolved bool
+		wantStatus   int
+	}{
+		"default resolves the data": {
+			wantResolved: true,
+		},
+		"resolve": {
+			dataRef:      ptr.To(eventingduckv1.DataRefPolicyResolve),
+			wantResolved: true,
+		},
+		"reference": {
+			dataRef:      ptr.To(eventingduckv1.DataRefPolicyReference),
+			wantResolved: false,
+		},
+		"data of another broker": {
+			key:        "brokers/test-namespace/other-broker/1",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for n, tc := range testCases {
+		t.Run(n, func(t *testing.T) {
+			ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+
+			store, err := claimcheck.NewFileStore(t.TempDir())
+			if err != nil {
+				t.Fatal(err)
+			}
+			key := tc.key
+			if key == "" {
+				key = "brokers/test-namespace/broker/1"
+			}
+			ref, err := store.Put(ctx, key, []byte("offloaded"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var received *cloudevents.Event
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received, _ = cloudevents.NewEventFromHTTPRequest(r)
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer s.Close()
+
+			trig := makeTrigger()
+			trig.Spec.Broker = "broker"
+			trig.Status.SubscriberURI, _ = apis.ParseURL(s.URL)
+			if tc.dataRef != nil {
+				trig.Spec.Delivery = &eventingduckv1.DeliverySpec{DataRef: tc.dataRef}
+			}
+			triggerinformerfake.Get(ctx).Informer().GetStore().Add(trig)
+			brokerinformerfake.Get(ctx).Informer().GetStore().Add(&v1.Broker{
+				ObjectMeta: metav1.ObjectMeta{Name: trig.Spec.Broker, Namespace: trig.Namespace},
+			})
+
+			r, err := NewHandler(
+				zaptest.NewLogger(t),
+				nil,
+				nil,
+				triggerinformerfake.Get(ctx),
+				brokerinformerfake.Get(ctx),
+				subscriptioninformerfake.Get(ctx),
+				configmapinformer.Get(ctx).Lister().ConfigMaps("ns"),
+				nil,
+				func(ctx context.Context) context.Context {
+					return ctx
+				},
+				metric.NewMeterProvider(),
+				trace.NewTracerProvider(),
+			)
+			if err != nil {
+				t.Fatal("Unable to create receiver:", err)
+			}
+			r.ClaimCheckStore = store
+
+			e := makeEventWithExtension(claimcheck.DataRefExtension, ref)
+			b, err := e.MarshalJSON()
+			if err != nil {
+				t.Fatal(err)
+			}
+			request := httptest.NewRequest(http.MethodPost, validPath, bytes.NewBuffer(b))
+			request.Header.Set(cehttp.ContentType, event.ApplicationCloudEventsJSON)
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, request)
+
+			if tc.wantStatus != 0 {
+				if got := recorder.Code; got != tc.wantStatus {
+					t.Errorf("expected status code %d, got %d", tc.wantStatus, got)
+				}
+				if received != nil {
+					t.Error("expected event not to be dispatched")
+				}
+				return
+			}
+			if received == nil {
+				t.Fatal("expected event to be dispatched")
+			}
+			_, hasRef := received.Extensions()[claimcheck.DataRefExtension]
+			if tc.wantResolved {
+				if hasRef || string(received.Data()) != "offloaded" {
+					t.Errorf("expected resolved data, got data %q and extensions %v", string(received.Data()), received.Extensions())
+				}
+			} else if !hasRef || len(received.Data()) != 0 {
+				t.Errorf("expected data reference, got data %q and extensions %v", string(received.Data()), received.Extensions())
+			}
+		})
+	}
+}
+
+func TestReceiver_Transform(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+
+	var received *cloudevents.Event
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = cloudevents.NewEventFromHTTPRequest(r)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer s.Close()
+
+	trig := makeTrigger()
+	trig.Status.SubscriberURI, _ = apis.ParseURL(s.URL)
+	trig.Spec.Transform = &eventingduckv1.TransformSpec{
+		Rename: map[string]string{"tenant": "customer"},
+		Set:    map[string]string{"type": "order.received"},
+		Data:   "$.order",
+	}
+	triggerinformerfake.Get(ctx).Informer().GetStore().Add(trig)
+	brokerinformerfake.Get(ctx).Informer().GetStore().Add(&v1.Broker{
+		ObjectMeta: metav1.ObjectMeta{Name: trig.Spec.Broker, Namespace: trig.Namespace},
+	})
+
+	r, err := NewHandler(
+		zaptest.NewLogger(t),
+		nil,
+		nil,
+		triggerinformerfake.Get(ctx),
+		brokerinformerfake.Get(ctx),
+		subscriptioninformerfake.Get(ctx),
+		configmapinformer.Get(ctx).Lister().ConfigMaps("ns"),
+		nil,
+		func(ctx context.Context) context.Context {
+			return ctx
+		},
+		metric.NewMeterProvider(),
+		trace.NewTracerProvider(),
+	)
+	if err != nil {
+		t.Fatal("Unable to create receiver:", err)
+	}
+
+	e := makeEventWithExtension("tenant", "acme")
+	if err := e.SetData(cloudevents.ApplicationJSON, map[string]interface{}{"order": map[string]interface{}{"id": "o-1"}, "card": "4111"}); err != nil {
+		t.Fatal(err)
+	}
+	b, err := e.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := httptest.NewRequest(http.MethodPost, validPath, bytes.NewBuffer(b))
+	request.Header.Set(cehttp.ContentType, event.ApplicationCloudEventsJSON)
+	r.ServeHTTP(httptest.NewRecorder(), request)
+
+	if received == nil {
+		t.Fatal("expected event to be dispatched")
+	}
+	if received.Type() != "order.received" {
+		t.Errorf("expected type order.received, got %q", received.Type())
+	}
+	if _, ok := received.Extensions()["tenant"]; ok || received.Extensions()["customer"] != "acme" {
+		t.Errorf("expected tenant to be renamed to customer, got extensions %v", received.Extensions())
+	}
+	if string(received.Data()) != `{"id":"o-1"}` {
+		t.Errorf("expected projected data, got %q", string(received.Data()))
+	}
+}
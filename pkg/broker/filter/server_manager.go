@@ -31,6 +31,7 @@ import (
 	"knative.dev/pkg/configmap"
 
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
 	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
 	"knative.dev/pkg/system"
 )
@@ -65,5 +66,7 @@ func getServerTLSConfig(ctx context.Context) (*tls.Config, error) {
 
 	serverTLSConfig := eventingtls.NewDefaultServerConfig()
 	serverTLSConfig.GetCertificate = eventingtls.GetCertificateFromSecret(ctx, secretinformer.Get(ctx), kubeclient.Get(ctx), secret)
+	serverTLSConfig.ClientCAs = eventingtls.GetClientCAsFromBundles(
+		configmapinformer.Get(ctx, eventingtls.ClientCABundleLabelSelector).Lister().ConfigMaps(system.Namespace()))
 	return eventingtls.GetTLSServerConfig(serverTLSConfig)
 }
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/kncloudevents/transform"
+)
+
+// transformsMap caches the compiled transform of each Trigger, so that it is
+// compiled once per Trigger generation rather than once per event.
+type transformsMap struct {
+	transforms map[types.UID]cachedTransform
+	rwMutex    sync.RWMutex
+}
+
+type cachedTransform struct {
+	generation int64
+	transform  *transform.Transform
+}
+
+func newTransformsMap() *transformsMap {
+	return &transformsMap{
+		transforms: make(map[types.UID]cachedTransform),
+	}
+}
+
+// Get returns the compiled transform of the trigger, compiling it when the
+// cached one is missing or belongs to another generation of the trigger.
+func (tm *transformsMap) Get(trigger *eventingv1.Trigger) (*transform.Transform, error) {
+	tm.rwMutex.RLock()
+	cached, found := tm.transforms[trigger.UID]
+	tm.rwMutex.RUnlock()
+	if found && cached.generation == trigger.Generation {
+		return cached.transform, nil
+	}
+
+	t, err := transform.New(trigger.Spec.Transform)
+	if err != nil {
+		return nil, err
+	}
+
+	tm.rwMutex.Lock()
+	defer tm.rwMutex.Unlock()
+	// Don't replace the transform of a newer generation, cached concurrently.
+	if cached, found := tm.transforms[trigger.UID]; !found || cached.generation < trigger.Generation {
+		tm.transforms[trigger.UID] = cachedTransform{generation: trigger.Generation, transform: t}
+	}
+	return t, nil
+}
+
+func (tm *transformsMap) Delete(trigger *eventingv1.Trigger) {
+	tm.rwMutex.Lock()
+	defer tm.rwMutex.Unlock()
+	delete(tm.transforms, trigger.UID)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"testing"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+)
+
+func TestTransformsMap(t *testing.T) {
+	tm := newTransformsMap()
+
+	trig := makeTrigger()
+	trig.UID = "trigger-uid"
+	trig.Generation = 1
+	trig.Spec.Transform = &eventingduckv1.TransformSpec{Set: map[string]string{"type": "order.received"}}
+
+	first, err := tm.Get(trig)
+	if err != nil {
+		t.Fatal("Get() =", err)
+	}
+	if again, _ := tm.Get(trig); again != first {
+		t.Error("expected the transform to be compiled once per generation")
+	}
+
+	updated := trig.DeepCopy()
+	updated.Generation = 2
+	updated.Spec.Transform = &eventingduckv1.TransformSpec{Data: "$.order"}
+	second, err := tm.Get(updated)
+	if err != nil {
+		t.Fatal("Get() =", err)
+	}
+	if second == first {
+		t.Error("expected the transform to be compiled again for a new generation")
+	}
+	// A stale copy of the trigger doesn't replace the transform of the newer generation.
+	if _, err := tm.Get(trig); err != nil {
+		t.Fatal("Get() =", err)
+	}
+	if again, _ := tm.Get(updated); again != second {
+		t.Error("expected the transform of the newer generation to be kept")
+	}
+
+	tm.Delete(updated)
+	if again, _ := tm.Get(updated); again == second {
+		t.Error("expected the transform to be compiled again after the trigger was deleted")
+	}
+
+	invalid := trig.DeepCopy()
+	invalid.UID = "invalid-uid"
+	invalid.Spec.Transform = &eventingduckv1.TransformSpec{Data: "$["}
+	if _, err := tm.Get(invalid); err == nil {
+		t.Error("expected an invalid transform to fail")
+	}
+}
@@ -0,0 +1,466 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.opentelemetry.io/otel/metric"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"knative.dev/pkg/kmeta"
+
+	"knative.dev/eventing/pkg/apis/eventing"
+)
+
+const (
+	// DelayedEventLabelKey is the label set on the Secrets storing the events parked by the
+	// Broker ingress until their requested delivery time.
+	DelayedEventLabelKey = eventing.GroupName + "/broker-delayed-event"
+	// DelayedEventNamespaceLabelKey is the namespace of the Broker a parked event has been sent to.
+	DelayedEventNamespaceLabelKey = eventing.GroupName + "/broker-namespace"
+
+	// DelayedEventBrokerAnnotation is the <namespace>/<name> of the Broker a parked event has been sent to.
+	DelayedEventBrokerAnnotation = eventing.GroupName + "/broker"
+	// DelayedEventDeliverAtAnnotation is the RFC3339 time at which a parked event is released.
+	DelayedEventDeliverAtAnnotation = eventing.GroupName + "/deliver-at"
+	// DelayedEventRetryAtAnnotation is the RFC3339 time at which a parked event which failed to
+	// be released is released again.
+	DelayedEventRetryAtAnnotation = eventing.GroupName + "/retry-at"
+	// DelayedEventClaimAnnotation is set by the ingress replica releasing a parked event, in the
+	// format <pod name>,<RFC3339 time>.
+	DelayedEventClaimAnnotation = eventing.GroupName + "/claimed-by"
+
+	delayedEventKey = "event"
+
+	defaultDelayStorePollInterval = time.Second
+	// delayStoreClaimTimeout is the time after which a claim of a replica which didn't release
+	// the event, for example because it crashed, is ignored by the other replicas.
+	delayStoreClaimTimeout = time.Minute
+	// delayStoreRetryDelay is the delay before a parked event which failed to be released is
+	// released again.
+	delayStoreRetryDelay = 10 * time.Second
+	// delayStoreReservationTimeout is the time after which an event parked by this replica is
+	// counted from the lister only, which is expected to be synced by then.
+	delayStoreReservationTimeout = time.Minute
+)
+
+var (
+	delayedEventSelector = labels.SelectorFromSet(labels.Set{DelayedEventLabelKey: "true"})
+
+	// ErrDelayStoreFull is returned when an event can't be parked as the store reached its capacity.
+	ErrDelayStoreFull = errors.New("delay store is full")
+	// ErrBrokerNotFound is returned by a DelayedEventSender when the Broker of a parked event
+	// doesn't exist anymore. The parked event is then dropped.
+	ErrBrokerNotFound = errors.New("broker not found")
+	// ErrNoDeadLetterSink is returned by the DelayedEventSender dead lettering the expired
+	// events when the Broker has no dead letter sink. The expired event is then dropped.
+	ErrNoDeadLetterSink = errors.New("broker has no dead letter sink")
+)
+
+// DelayedEventSender sends a parked event to the Broker once it is due.
+type DelayedEventSender func(ctx context.Context, event *cloudevents.Event, broker types.NamespacedName) error
+
+// DelayStore parks events requesting a delayed delivery until their delivery time. Events are
+// stored as Secrets in the given namespace so that they survive restarts of the ingress, and
+// they are released by the ingress replica that first claims them.
+//
+// The capacity bounds the number of events parked for the Brokers of a namespace. Each replica
+// accounts for the events it parks before they are seen by its lister, the events parked
+// concurrently by other replicas are accounted for once their informers are synced.
+//
+// Events which can't be released within maxAge of their delivery time are sent to the dead
+// letter sink of their Broker by the deadLetterSender.
+type DelayStore struct {
+	logger           *zap.Logger
+	kubeClient       kubernetes.Interface
+	lister           corev1listers.SecretNamespaceLister
+	namespace        string
+	podName          string
+	capacity         int
+	maxAge           time.Duration
+	pollInterval     time.Duration
+	sender           DelayedEventSender
+	deadLetterSender DelayedEventSender
+	now              func() time.Time
+
+	// reservationsMu serializes the capacity checks of the parked events.
+	reservationsMu sync.Mutex
+	// reservations are the time at which the events parked by this replica have been parked,
+	// by Secret name and namespace of their Broker.
+	reservations map[string]map[string]time.Time
+
+	releasedEvents     metric.Int64Counter
+	rejectedEvents     metric.Int64Counter
+	deadLetteredEvents metric.Int64Counter
+}
+
+func NewDelayStore(
+	logger *zap.Logger,
+	kubeClient kubernetes.Interface,
+	lister corev1listers.SecretNamespaceLister,
+	namespace string,
+	podName string,
+	capacity int,
+	maxAge time.Duration,
+	sender DelayedEventSender,
+	deadLetterSender DelayedEventSender,
+	meterProvider metric.MeterProvider,
+) (*DelayStore, error) {
+	s := &DelayStore{
+		logger:           logger,
+		kubeClient:       kubeClient,
+		lister:           lister,
+		namespace:        namespace,
+		podName:          podName,
+		capacity:         capacity,
+		maxAge:           maxAge,
+		pollInterval:     defaultDelayStorePollInterval,
+		sender:           sender,
+		deadLetterSender: deadLetterSender,
+		now:              time.Now,
+		reservations:     make(map[string]map[string]time.Time),
+	}
+
+	meter := meterProvider.Meter(ScopeName)
+
+	var err error
+	_, err = meter.Int64ObservableGauge(
+		"kn.eventing.broker.delayed.events",
+		metric.WithDescription("The number of events parked until their delivery time"),
+		metric.WithUnit("{event}"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			secrets, err := s.lister.List(delayedEventSelector)
+			if err != nil {
+				return err
+			}
+			o.Observe(int64(len(secrets)))
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.releasedEvents, err = meter.Int64Counter(
+		"kn.eventing.broker.delayed.released",
+		metric.WithDescription("The number of parked events released to the broker"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rejectedEvents, err = meter.Int64Counter(
+		"kn.eventing.broker.delayed.rejected",
+		metric.WithDescription("The number of events rejected because the delay store is full"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.deadLetteredEvents, err = meter.Int64Counter(
+		"kn.eventing.broker.delayed.deadlettered",
+		metric.WithDescription("The number of parked events sent to the dead letter sink because they couldn't be released in time"),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Park stores the event until deliverAt.
+func (s *DelayStore) Park(ctx context.Context, event *cloudevents.Event, broker types.NamespacedName, deliverAt time.Time) error {
+	data, err := event.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	name := delayedEventSecretName(event, broker)
+	if err := s.reserve(ctx, broker.Namespace, name); err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: s.namespace,
+			Labels: map[string]string{
+				DelayedEventLabelKey:          "true",
+				DelayedEventNamespaceLabelKey: broker.Namespace,
+			},
+			Annotations: map[string]string{
+				DelayedEventBrokerAnnotation:    broker.String(),
+				DelayedEventDeliverAtAnnotation: deliverAt.UTC().Format(time.RFC3339Nano),
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			delayedEventKey: data,
+		},
+	}
+
+	_, err = s.kubeClient.CoreV1().Secrets(s.namespace).Create(ctx, secret, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		s.unreserve(broker.Namespace, name)
+		return fmt.Errorf("failed to park event: %w", err)
+	}
+	return nil
+}
+
+// reserve accounts for the event parked for a Broker of the namespace, or returns
+// ErrDelayStoreFull when the namespace reached the capacity.
+func (s *DelayStore) reserve(ctx context.Context, namespace, name string) error {
+	s.reservationsMu.Lock()
+	defer s.reservationsMu.Unlock()
+
+	secrets, err := s.lister.List(labels.SelectorFromSet(labels.Set{
+		DelayedEventLabelKey:          "true",
+		DelayedEventNamespaceLabelKey: namespace,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to list parked events: %w", err)
+	}
+
+	parked := make(map[string]struct{}, len(secrets))
+	for _, secret := range secrets {
+		parked[secret.Name] = struct{}{}
+	}
+	if _, ok := parked[name]; ok {
+		// The event is already parked, parking it again is a no-op.
+		return nil
+	}
+
+	// Events parked by this replica are counted until the lister sees them.
+	now := s.now()
+	reservations := s.reservations[namespace]
+	count := len(secrets)
+	for reserved, at := range reservations {
+		if _, ok := parked[reserved]; ok || now.After(at.Add(delayStoreReservationTimeout)) {
+			delete(reservations, reserved)
+			continue
+		}
+		if reserved != name {
+			count++
+		}
+	}
+
+	if count >= s.capacity {
+		s.rejectedEvents.Add(ctx, 1)
+		return ErrDelayStoreFull
+	}
+
+	if reservations == nil {
+		reservations = make(map[string]time.Time, 1)
+		s.reservations[namespace] = reservations
+	}
+	reservations[name] = now
+	return nil
+}
+
+func (s *DelayStore) unreserve(namespace, name string) {
+	s.reservationsMu.Lock()
+	defer s.reservationsMu.Unlock()
+
+	delete(s.reservations[namespace], name)
+	if len(s.reservations[namespace]) == 0 {
+		delete(s.reservations, namespace)
+	}
+}
+
+// Run releases the parked events which are due until the context is done.
+func (s *DelayStore) Run(ctx context.Context) {
+	wait.UntilWithContext(ctx, s.releaseDue, s.pollInterval)
+}
+
+func (s *DelayStore) releaseDue(ctx context.Context) {
+	secrets, err := s.lister.List(delayedEventSelector)
+	if err != nil {
+		s.logger.Warn("Failed to list parked events", zap.Error(err))
+		return
+	}
+
+	now := s.now()
+	due := make([]*corev1.Secret, 0, len(secrets))
+	for _, secret := range secrets {
+		if releaseTime(secret).After(now) || isClaimed(secret, now) {
+			continue
+		}
+		due = append(due, secret)
+	}
+	sort.Slice(due, func(i, j int) bool {
+		return releaseTime(due[i]).Before(releaseTime(due[j]))
+	})
+
+	for _, secret := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		s.release(ctx, secret, now)
+	}
+}
+
+func (s *DelayStore) release(ctx context.Context, secret *corev1.Secret, now time.Time) {
+	logger := s.logger.With(zap.String("secret", secret.Name))
+
+	// Claim the event, the update fails with a conflict when another replica claimed it first.
+	claimed := secret.DeepCopy()
+	if claimed.Annotations == nil {
+		claimed.Annotations = make(map[string]string, 1)
+	}
+	claimed.Annotations[DelayedEventClaimAnnotation] = s.podName + "," + now.UTC().Format(time.RFC3339)
+	claimed, err := s.kubeClient.CoreV1().Secrets(s.namespace).Update(ctx, claimed, metav1.UpdateOptions{})
+	if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		logger.Warn("Failed to claim parked event", zap.Error(err))
+		return
+	}
+
+	event := cloudevents.NewEvent()
+	if err := event.UnmarshalJSON(claimed.Data[delayedEventKey]); err != nil {
+		logger.Error("Dropping parked event which can't be unmarshalled", zap.Error(err))
+		s.delete(ctx, claimed)
+		return
+	}
+	brokerRef, err := parseBrokerAnnotation(claimed.Annotations[DelayedEventBrokerAnnotation])
+	if err != nil {
+		logger.Error("Dropping parked event with an invalid broker", zap.Error(err))
+		s.delete(ctx, claimed)
+		return
+	}
+
+	err = s.sender(ctx, &event, brokerRef)
+	if errors.Is(err, ErrBrokerNotFound) {
+		logger.Info("Dropping parked event as its broker doesn't exist anymore", zap.Stringer("broker", brokerRef))
+		s.delete(ctx, claimed)
+		return
+	}
+	if err == nil {
+		s.releasedEvents.Add(ctx, 1)
+		s.delete(ctx, claimed)
+		return
+	}
+
+	if now.Sub(deliveryTime(claimed)) < s.maxAge {
+		logger.Warn("Failed to release parked event, retrying later", zap.Error(err))
+		s.reschedule(ctx, claimed, now)
+		return
+	}
+
+	logger.Warn("Failed to release parked event in time, sending it to the dead letter sink", zap.Error(err))
+	err = s.deadLetterSender(ctx, &event, brokerRef)
+	if errors.Is(err, ErrBrokerNotFound) || errors.Is(err, ErrNoDeadLetterSink) {
+		logger.Warn("Dropping parked event which couldn't be released in time", zap.Stringer("broker", brokerRef), zap.Error(err))
+		s.delete(ctx, claimed)
+		return
+	}
+	if err != nil {
+		logger.Warn("Failed to send parked event to the dead letter sink, retrying later", zap.Error(err))
+		s.reschedule(ctx, claimed, now)
+		return
+	}
+
+	s.deadLetteredEvents.Add(ctx, 1)
+	s.delete(ctx, claimed)
+}
+
+// reschedule releases the event again after delayStoreRetryDelay. The delivery time is kept,
+// so that the age of the event is known.
+func (s *DelayStore) reschedule(ctx context.Context, secret *corev1.Secret, now time.Time) {
+	delete(secret.Annotations, DelayedEventClaimAnnotation)
+	secret.Annotations[DelayedEventRetryAtAnnotation] = now.Add(delayStoreRetryDelay).UTC().Format(time.RFC3339Nano)
+	if _, err := s.kubeClient.CoreV1().Secrets(s.namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		s.logger.Warn("Failed to reschedule parked event", zap.String("secret", secret.Name), zap.Error(err))
+	}
+}
+
+func (s *DelayStore) delete(ctx context.Context, secret *corev1.Secret) {
+	err := s.kubeClient.CoreV1().Secrets(s.namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &secret.UID},
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		s.logger.Warn("Failed to delete parked event", zap.String("secret", secret.Name), zap.Error(err))
+	}
+}
+
+// deliveryTime returns the delivery time requested for the event. An event with an invalid
+// delivery time is due right away.
+func deliveryTime(secret *corev1.Secret) time.Time {
+	deliverAt, err := time.Parse(time.RFC3339Nano, secret.Annotations[DelayedEventDeliverAtAnnotation])
+	if err != nil {
+		return time.Time{}
+	}
+	return deliverAt
+}
+
+// releaseTime returns the time at which the event is released, which is later than its
+// delivery time when releasing it failed.
+func releaseTime(secret *corev1.Secret) time.Time {
+	if retryAt, err := time.Parse(time.RFC3339Nano, secret.Annotations[DelayedEventRetryAtAnnotation]); err == nil {
+		return retryAt
+	}
+	return deliveryTime(secret)
+}
+
+// isClaimed returns true when another replica is releasing the event.
+func isClaimed(secret *corev1.Secret, now time.Time) bool {
+	claim, ok := secret.Annotations[DelayedEventClaimAnnotation]
+	if !ok {
+		return false
+	}
+	_, claimedAt, _ := strings.Cut(claim, ",")
+	t, err := time.Parse(time.RFC3339, claimedAt)
+	if err != nil {
+		return false
+	}
+	return now.Before(t.Add(delayStoreClaimTimeout))
+}
+
+func parseBrokerAnnotation(value string) (types.NamespacedName, error) {
+	namespace, name, ok := strings.Cut(value, "/")
+	if !ok || namespace == "" || name == "" {
+		return types.NamespacedName{}, fmt.Errorf("invalid broker %q", value)
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, nil
+}
+
+// delayedEventSecretName returns a name unique to the event and broker, so that an event sent
+// again by a producer is parked only once.
+func delayedEventSecretName(event *cloudevents.Event, broker types.NamespacedName) string {
+	h := md5.Sum([]byte(broker.String() + "/" + event.Source() + "/" + event.ID())) //nolint:gosec
+	return kmeta.ChildName("delayed-event-", fmt.Sprintf("%x", h))
+}
@@ -0,0 +1,473 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"context"
+	"errors"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/event"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/observability/metrics/metricstest"
+	reconcilertesting "knative.dev/pkg/reconciler/testing"
+	"knative.dev/pkg/system"
+
+	"knative.dev/eventing/pkg/apis/eventing"
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/broker"
+	"knative.dev/eventing/pkg/claimcheck"
+	brokerinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker/fake"
+)
+
+var testBroker = types.NamespacedName{Namespace: "ns", Name: "name"}
+
+const testDelayStoreMaxAge = time.Hour
+
+func newTestDelayStore(t *testing.T, capacity int, sender, deadLetterSender DelayedEventSender) (*DelayStore, kubernetes.Interface, func(), *metric.ManualReader) {
+	t.Helper()
+
+	client := fake.NewSimpleClientset()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	lister := corev1listers.NewSecretLister(indexer).Secrets(system.Namespace())
+
+	// sync mimics the informer, updating the lister with the secrets of the client.
+	sync := func() {
+		secrets, err := client.CoreV1().Secrets(system.Namespace()).List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		objs := make([]interface{}, 0, len(secrets.Items))
+		for i := range secrets.Items {
+			objs = append(objs, &secrets.Items[i])
+		}
+		if err := indexer.Replace(objs, ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reader := metric.NewManualReader()
+	s, err := NewDelayStore(zap.NewNop(), client, lister, system.Namespace(), "ingress-0", capacity, testDelayStoreMaxAge, sender, deadLetterSender, metric.NewMeterProvider(metric.WithReader(reader)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, client, sync, reader
+}
+
+func newTestEvent(id string) *cloudevents.Event {
+	e := cloudevents.NewEvent()
+	e.SetID(id)
+	e.SetType("type")
+	e.SetSource("source")
+	return &e
+}
+
+func listParked(t *testing.T, client kubernetes.Interface) []corev1.Secret {
+	t.Helper()
+	secrets, err := client.CoreV1().Secrets(system.Namespace()).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return secrets.Items
+}
+
+func TestDelayStorePark(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	s, client, sync, reader := newTestDelayStore(t, 2, nil, nil)
+
+	if err := s.Park(ctx, newTestEvent("1"), testBroker, now.Add(time.Hour)); err != nil {
+		t.Fatal("Park() =", err)
+	}
+	sync()
+	// Parking the same event again is a no-op.
+	if err := s.Park(ctx, newTestEvent("1"), testBroker, now.Add(time.Hour)); err != nil {
+		t.Fatal("Park() =", err)
+	}
+	if err := s.Park(ctx, newTestEvent("2"), testBroker, now.Add(time.Hour)); err != nil {
+		t.Fatal("Park() =", err)
+	}
+	// The event parked before the lister is synced is accounted for.
+	if err := s.Park(ctx, newTestEvent("3"), testBroker, now.Add(time.Hour)); !errors.Is(err, ErrDelayStoreFull) {
+		t.Fatalf("Park() = %v, want %v", err, ErrDelayStoreFull)
+	}
+	sync()
+	if err := s.Park(ctx, newTestEvent("3"), testBroker, now.Add(time.Hour)); !errors.Is(err, ErrDelayStoreFull) {
+		t.Fatalf("Park() = %v, want %v", err, ErrDelayStoreFull)
+	}
+	// The capacity is per namespace.
+	otherBroker := types.NamespacedName{Namespace: "other-ns", Name: "name"}
+	if err := s.Park(ctx, newTestEvent("3"), otherBroker, now.Add(time.Hour)); err != nil {
+		t.Fatal("Park() =", err)
+	}
+
+	secrets := listParked(t, client)
+	if len(secrets) != 3 {
+		t.Fatalf("expected 3 parked events, got %d", len(secrets))
+	}
+	secret := secrets[0]
+	if secret.Annotations[DelayedEventBrokerAnnotation] != testBroker.String() {
+		secret = secrets[1]
+	}
+	if got := secret.Labels[DelayedEventLabelKey]; got != "true" {
+		t.Errorf("expected label %s=true, got %q", DelayedEventLabelKey, got)
+	}
+	if got := secret.Labels[DelayedEventNamespaceLabelKey]; got != "ns" {
+		t.Errorf("expected label %s=ns, got %q", DelayedEventNamespaceLabelKey, got)
+	}
+	if got := secret.Annotations[DelayedEventBrokerAnnotation]; got != "ns/name" {
+		t.Errorf("expected broker annotation ns/name, got %q", got)
+	}
+	if got, want := secret.Annotations[DelayedEventDeliverAtAnnotation], "2026-01-01T11:00:00Z"; got != want {
+		t.Errorf("expected deliver at annotation %q, got %q", want, got)
+	}
+
+	metricstest.AssertMetrics(t, reader,
+		metricstest.MetricsPresent(ScopeName, "kn.eventing.broker.delayed.events", "kn.eventing.broker.delayed.rejected"),
+	)
+}
+
+func TestDelayStoreRelease(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                 string
+		deliverAt            time.Time
+		claimedBy            string
+		senderErr            error
+		deadLetterErr        error
+		wantSent             bool
+		wantDeadLettered     bool
+		wantParked           bool
+		wantRetry            string
+		wantReleased         bool
+		wantDeadLetterMetric bool
+	}{{
+		name:       "not due",
+		deliverAt:  now.Add(time.Minute),
+		wantParked: true,
+	}, {
+		name:         "due",
+		deliverAt:    now.Add(-time.Second),
+		wantSent:     true,
+		wantReleased: true,
+	}, {
+		name:       "claimed by another replica",
+		deliverAt:  now.Add(-time.Second),
+		claimedBy:  "ingress-1," + now.Add(-10*time.Second).Format(time.RFC3339),
+		wantParked: true,
+	}, {
+		name:         "expired claim",
+		deliverAt:    now.Add(-time.Minute),
+		claimedBy:    "ingress-1," + now.Add(-2*time.Minute).Format(time.RFC3339),
+		wantSent:     true,
+		wantReleased: true,
+	}, {
+		name:       "send failure",
+		deliverAt:  now.Add(-time.Second),
+		senderErr:  errors.New("unexpected status code 500"),
+		wantSent:   true,
+		wantParked: true,
+		wantRetry:  "2026-01-01T10:00:10Z",
+	}, {
+		name:                 "send failure after max age",
+		deliverAt:            now.Add(-testDelayStoreMaxAge),
+		senderErr:            errors.New("unexpected status code 500"),
+		wantSent:             true,
+		wantDeadLettered:     true,
+		wantDeadLetterMetric: true,
+	}, {
+		name:             "send failure after max age without dead letter sink",
+		deliverAt:        now.Add(-testDelayStoreMaxAge),
+		senderErr:        errors.New("unexpected status code 500"),
+		deadLetterErr:    ErrNoDeadLetterSink,
+		wantSent:         true,
+		wantDeadLettered: true,
+	}, {
+		name:             "dead letter failure",
+		deliverAt:        now.Add(-testDelayStoreMaxAge),
+		senderErr:        errors.New("unexpected status code 500"),
+		deadLetterErr:    errors.New("unexpected status code 500"),
+		wantSent:         true,
+		wantDeadLettered: true,
+		wantParked:       true,
+		wantRetry:        "2026-01-01T10:00:10Z",
+	}, {
+		name:      "broker not found",
+		deliverAt: now.Add(-time.Second),
+		senderErr: ErrBrokerNotFound,
+		wantSent:  true,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			sent := false
+			sender := func(_ context.Context, event *cloudevents.Event, b types.NamespacedName) error {
+				sent = true
+				if event.ID() != "1" {
+					t.Errorf("unexpected event %s", event.ID())
+				}
+				if b != testBroker {
+					t.Errorf("unexpected broker %s", b)
+				}
+				return tc.senderErr
+			}
+			deadLettered := false
+			deadLetterSender := func(_ context.Context, event *cloudevents.Event, b types.NamespacedName) error {
+				deadLettered = true
+				if event.ID() != "1" {
+					t.Errorf("unexpected event %s", event.ID())
+				}
+				return tc.deadLetterErr
+			}
+
+			s, client, sync, reader := newTestDelayStore(t, 10, sender, deadLetterSender)
+			s.now = func() time.Time { return now }
+
+			if err := s.Park(ctx, newTestEvent("1"), testBroker, tc.deliverAt); err != nil {
+				t.Fatal("Park() =", err)
+			}
+			if tc.claimedBy != "" {
+				secret := listParked(t, client)[0]
+				secret.Annotations[DelayedEventClaimAnnotation] = tc.claimedBy
+				if _, err := client.CoreV1().Secrets(system.Namespace()).Update(ctx, &secret, metav1.UpdateOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			sync()
+
+			s.releaseDue(ctx)
+
+			if sent != tc.wantSent {
+				t.Errorf("sent = %v, want %v", sent, tc.wantSent)
+			}
+			if deadLettered != tc.wantDeadLettered {
+				t.Errorf("dead lettered = %v, want %v", deadLettered, tc.wantDeadLettered)
+			}
+			secrets := listParked(t, client)
+			if parked := len(secrets) == 1; parked != tc.wantParked {
+				t.Errorf("parked = %v, want %v", parked, tc.wantParked)
+			}
+			if tc.wantRetry != "" {
+				if got := secrets[0].Annotations[DelayedEventRetryAtAnnotation]; got != tc.wantRetry {
+					t.Errorf("expected event to be rescheduled at %s, got %s", tc.wantRetry, got)
+				}
+				if got, want := secrets[0].Annotations[DelayedEventDeliverAtAnnotation], tc.deliverAt.Format(time.RFC3339Nano); got != want {
+					t.Errorf("expected delivery time %s to be kept, got %s", want, got)
+				}
+				if _, ok := secrets[0].Annotations[DelayedEventClaimAnnotation]; ok {
+					t.Error("expected claim to be removed from rescheduled event")
+				}
+			}
+
+			expectedMetrics := []string{"kn.eventing.broker.delayed.events"}
+			if tc.wantReleased {
+				expectedMetrics = append(expectedMetrics, "kn.eventing.broker.delayed.released")
+			}
+			if tc.wantDeadLetterMetric {
+				expectedMetrics = append(expectedMetrics, "kn.eventing.broker.delayed.deadlettered")
+			}
+			metricstest.AssertMetrics(t, reader, metricstest.MetricsPresent(ScopeName, expectedMetrics...))
+		})
+	}
+}
+
+func TestHandlerDelayedDelivery(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+
+	received := make(chan *event.Event, 1)
+	channel := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		e, err := cloudevents.NewEventFromHTTPRequest(r)
+		if err != nil {
+			t.Error(err)
+		}
+		received <- e
+		w.WriteHeader(nethttp.StatusAccepted)
+	}))
+	defer channel.Close()
+
+	b := makeBroker("name", "ns")
+	b.Status.Annotations[eventing.BrokerChannelAddressStatusAnnotationKey] = channel.URL
+	brokerinformerfake.Get(ctx).Informer().GetStore().Add(b)
+
+	mp := metric.NewMeterProvider()
+	h, err := NewHandler(zap.NewNop(),
+		broker.TTLDefaulter(zap.NewNop(), 100),
+		brokerinformerfake.Get(ctx),
+		nil,
+		nil,
+		nil,
+		nil,
+		func(ctx context.Context) context.Context {
+			return feature.ToContext(ctx, feature.Flags{feature.DelayedDelivery: feature.Enabled})
+		},
+		mp,
+		trace.NewTracerProvider(),
+	)
+	if err != nil {
+		t.Fatal("Unable to create handler:", err)
+	}
+
+	s, client, sync, _ := newTestDelayStore(t, 10, h.ReleaseDelayed, h.DeadLetterDelayed)
+	h.DelayStore = s
+
+	e := newTestEvent("1234")
+	e.SetExtension(broker.DeliverAfterAttribute, "PT1H")
+	body, _ := e.MarshalJSON()
+
+	request := httptest.NewRequest(nethttp.MethodPost, "/ns/name", strings.NewReader(string(body)))
+	request.Header.Add(cehttp.ContentType, event.ApplicationCloudEventsJSON)
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, request)
+
+	if got := recorder.Result().StatusCode; got != nethttp.StatusAccepted {
+		t.Fatalf("expected status code %d, got %d", nethttp.StatusAccepted, got)
+	}
+	select {
+	case <-received:
+		t.Fatal("expected event to be parked")
+	default:
+	}
+	if secrets := listParked(t, client); len(secrets) != 1 {
+		t.Fatalf("expected 1 parked event, got %d", len(secrets))
+	}
+
+	sync()
+	s.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	s.releaseDue(ctx)
+
+	select {
+	case released := <-received:
+		if _, ok := released.Extensions()[broker.DeliverAfterAttribute]; ok {
+			t.Errorf("expected %s extension to be removed, got %v", broker.DeliverAfterAttribute, released.Extensions())
+		}
+	default:
+		t.Fatal("expected parked event to be released")
+	}
+	if secrets := listParked(t, client); len(secrets) != 0 {
+		t.Errorf("expected released event to be deleted, got %d parked events", len(secrets))
+	}
+}
+
+func TestHandlerDelayedDeliveryClaimCheck(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+
+	received := make(chan *event.Event, 1)
+	channel := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		e, err := cloudevents.NewEventFromHTTPRequest(r)
+		if err != nil {
+			t.Error(err)
+		}
+		received <- e
+		w.WriteHeader(nethttp.StatusAccepted)
+	}))
+	defer channel.Close()
+
+	b := makeBroker("name", "ns")
+	b.Spec.ClaimCheck = &eventingv1.ClaimCheckSpec{Threshold: 4}
+	b.Status.Annotations[eventing.BrokerChannelAddressStatusAnnotationKey] = channel.URL
+	brokerinformerfake.Get(ctx).Informer().GetStore().Add(b)
+
+	h, err := NewHandler(zap.NewNop(),
+		broker.TTLDefaulter(zap.NewNop(), 100),
+		brokerinformerfake.Get(ctx),
+		nil,
+		nil,
+		nil,
+		nil,
+		func(ctx context.Context) context.Context {
+			return feature.ToContext(ctx, feature.Flags{feature.DelayedDelivery: feature.Enabled})
+		},
+		metric.NewMeterProvider(),
+		trace.NewTracerProvider(),
+	)
+	if err != nil {
+		t.Fatal("Unable to create handler:", err)
+	}
+	store, err := claimcheck.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.ClaimCheckStore = store
+
+	s, _, sync, _ := newTestDelayStore(t, 10, h.ReleaseDelayed, h.DeadLetterDelayed)
+	h.DelayStore = s
+
+	// The event is delivered after the claim check TTL of a day.
+	const ttl = 24 * time.Hour
+	e := newTestEvent("1234")
+	e.SetExtension(broker.DeliverAfterAttribute, "PT48H")
+	_ = e.SetData(event.TextPlain, "large data")
+	body, _ := e.MarshalJSON()
+
+	request := httptest.NewRequest(nethttp.MethodPost, "/ns/name", strings.NewReader(string(body)))
+	request.Header.Add(cehttp.ContentType, event.ApplicationCloudEventsJSON)
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, request)
+
+	if got := recorder.Result().StatusCode; got != nethttp.StatusAccepted {
+		t.Fatalf("expected status code %d, got %d", nethttp.StatusAccepted, got)
+	}
+	if objects, err := store.List(ctx, claimcheck.Prefix("brokers", "ns", "name")); err != nil || len(objects) != 0 {
+		t.Fatalf("expected the data of the parked event not to be offloaded, got %v, %v", objects, err)
+	}
+
+	// Everything stored until the delivery time minus the TTL expires.
+	deliverAt := time.Now().Add(48 * time.Hour)
+	if err := claimcheck.DeleteExpired(ctx, store, claimcheck.Prefix("brokers", "", ""), deliverAt.Add(-ttl)); err != nil {
+		t.Fatal(err)
+	}
+
+	sync()
+	s.now = func() time.Time { return deliverAt.Add(time.Minute) }
+	s.releaseDue(ctx)
+
+	var released *event.Event
+	select {
+	case released = <-received:
+	default:
+		t.Fatal("expected parked event to be released")
+	}
+	if len(released.Data()) != 0 {
+		t.Errorf("expected data to be offloaded on release, got %q", string(released.Data()))
+	}
+	if err := claimcheck.Resolve(ctx, store, released, claimcheck.Prefix("brokers", "ns", "name")); err != nil {
+		t.Fatal("Resolve() =", err)
+	}
+	if got := string(released.Data()); got != "large data" {
+		t.Errorf("expected released data %q, got %q", "large data", got)
+	}
+}
@@ -18,6 +18,7 @@ package ingress
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -48,6 +49,7 @@ import (
 	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/eventing/pkg/broker"
+	"knative.dev/eventing/pkg/claimcheck"
 	v1 "knative.dev/eventing/pkg/client/informers/externalversions/eventing/v1"
 	eventinglisters "knative.dev/eventing/pkg/client/listers/eventing/v1"
 	"knative.dev/eventing/pkg/eventingtls"
@@ -74,9 +76,14 @@ type Handler struct {
 	// BrokerLister gets broker objects
 	BrokerLister     eventinglisters.BrokerLister
 	EvenTypeHandler  *eventtype.EventTypeAutoHandler
+	DelayStore       *DelayStore
+	ClaimCheckStore  claimcheck.Store
+	ClusterName      string
+	RedactionHashKey []byte
 	Logger           *zap.Logger
 	eventDispatcher  *kncloudevents.Dispatcher
 	tokenVerifier    *auth.Verifier
+	ingressRules     *ingressRules
 	withContext      func(ctx context.Context) context.Context
 	tracer           trace.Tracer
 	dispatchDuration metric.Float64Histogram
@@ -89,6 +96,7 @@ func NewHandler(
 	tokenVerifier *auth.Verifier,
 	oidcTokenProvider *auth.OIDCTokenProvider,
 	trustBundleConfigMapLister corev1listers.ConfigMapNamespaceLister,
+	clientCertificates *eventingtls.ClientCertificates,
 	withContext func(ctx context.Context) context.Context,
 	meterProvider metric.MeterProvider,
 	traceProvider trace.TracerProvider,
@@ -101,6 +109,7 @@ func NewHandler(
 
 	clientConfig := eventingtls.ClientConfig{
 		TrustBundleConfigMapLister: trustBundleConfigMapLister,
+		ClientCertificates:         clientCertificates,
 	}
 
 	brokerInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -147,6 +156,7 @@ func NewHandler(
 			kncloudevents.WithTraceProvider(traceProvider),
 		),
 		tokenVerifier: tokenVerifier,
+		ingressRules:  &ingressRules{logger: logger},
 		withContext:   withContext,
 		tracer:        traceProvider.Tracer(ScopeName),
 	}
@@ -176,6 +186,15 @@ func (h *Handler) getBroker(name, namespace string) (*eventingv1.Broker, error)
 	return broker, nil
 }
 
+// maxEventSize returns the maximum event size of the broker, or 0 when there is no limit.
+func (h *Handler) maxEventSize(namespace, name string) int64 {
+	broker, err := h.BrokerLister.Brokers(namespace).Get(name)
+	if err != nil || broker.Spec.MaxEventSize == nil {
+		return 0
+	}
+	return *broker.Spec.MaxEventSize
+}
+
 func (h *Handler) getChannelAddress(broker *eventingv1.Broker) (*duckv1.Addressable, error) {
 	if broker.Status.Annotations == nil {
 		return nil, fmt.Errorf("broker status annotations uninitialized")
@@ -232,8 +251,21 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 		return
 	}
 
+	if maxEventSize := h.maxEventSize(nsBrokerName[1], nsBrokerName[2]); maxEventSize > 0 {
+		if !utils.LimitRequestBody(writer, request, maxEventSize) {
+			h.Logger.Info("Event exceeds the maximum event size", zap.String("URI", request.RequestURI), zap.Int64("maxEventSize", maxEventSize))
+			writer.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+	}
+
 	// copy the request, as we need access to the body (in case of a structured event) for the auth checks too
 	reqCp, err := utils.CopyRequest(request)
+	if utils.IsRequestBodyTooLarge(err) {
+		h.Logger.Info("Event exceeds the maximum event size", zap.String("URI", request.RequestURI))
+		writer.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
 	if err != nil {
 		h.Logger.Error("Failed to copy request", zap.Error(err))
 		writer.WriteHeader(http.StatusInternalServerError)
@@ -285,7 +317,7 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 	if broker.Status.Address != nil {
 		audience = broker.Status.Address.Audience
 	}
-	err = h.tokenVerifier.VerifyRequest(ctx, features, audience, brokerNamespace, broker.Status.Policies, reqCp, writer)
+	subject, err := h.tokenVerifier.VerifyRequestSubject(ctx, features, audience, brokerNamespace, broker.Status.Policies, reqCp, writer)
 	if err != nil {
 		h.Logger.Warn("Failed to verify AuthN and AuthZ.", zap.Error(err))
 		return
@@ -306,7 +338,7 @@ func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 		span.End()
 	}()
 
-	statusCode, dispatchTime := h.receive(ctx, utils.PassThroughHeaders(request.Header), event, broker)
+	statusCode, dispatchTime := h.receive(ctx, utils.PassThroughHeaders(request.Header), event, broker, subject)
 	if dispatchTime > kncloudevents.NoDuration {
 		ctx = observability.WithHTTPStatusCodeLabel(ctx, statusCode)
 		labeler, _ := otelhttp.LabelerFromContext(ctx)
@@ -338,9 +370,10 @@ func toKReference(broker *eventingv1.Broker) *duckv1.KReference {
 	return kref
 }
 
-func (h *Handler) receive(ctx context.Context, headers http.Header, event *cloudevents.Event, brokerObj *eventingv1.Broker) (int, time.Duration) {
+func (h *Handler) receive(ctx context.Context, headers http.Header, event *cloudevents.Event, brokerObj *eventingv1.Broker, subject string) (int, time.Duration) {
+	receiveTime := time.Now()
 	// Setting the extension as a string as the CloudEvents sdk does not support non-string extensions.
-	event.SetExtension(broker.EventArrivalTime, cloudevents.Timestamp{Time: time.Now()})
+	event.SetExtension(broker.EventArrivalTime, cloudevents.Timestamp{Time: receiveTime})
 	if h.Defaulter != nil {
 		newEvent := h.Defaulter(ctx, *event)
 		event = &newEvent
@@ -351,6 +384,145 @@ func (h *Handler) receive(ctx context.Context, headers http.Header, event *cloud
 		return http.StatusBadRequest, kncloudevents.NoDuration
 	}
 
+	if brokerObj.Spec.Ingress != nil {
+		values := ingressRuleValues{
+			subject:     subject,
+			receiveTime: receiveTime,
+			clusterName: h.ClusterName,
+		}
+		if err := h.ingressRules.apply(event, brokerObj.Spec.Ingress.Rules, values, h.RedactionHashKey); err != nil {
+			h.Logger.Warn("failed to apply broker ingress rules", zap.String("event.id", event.ID()), zap.Error(err))
+			return http.StatusBadRequest, kncloudevents.NoDuration
+		}
+	}
+
+	if h.ClaimCheckStore != nil {
+		// Only the broker sets references to the claim check store, producers could otherwise
+		// make triggers resolve data they don't own.
+		if claimcheck.Strip(h.ClaimCheckStore, event) {
+			h.Logger.Debug("removed claim check data reference set by the producer", zap.String("event.id", event.ID()))
+		}
+	} else if brokerObj.Spec.ClaimCheck != nil {
+		h.Logger.Debug("claim check is configured on the broker, but no claim check store is configured")
+	}
+
+	return h.dispatch(ctx, headers, event, brokerObj)
+}
+
+// dispatch parks the event until its delivery time, or sends it to the channel of the broker.
+func (h *Handler) dispatch(ctx context.Context, headers http.Header, event *cloudevents.Event, brokerObj *eventingv1.Broker) (int, time.Duration) {
+	if h.DelayStore != nil && feature.FromContext(ctx).IsEnabled(feature.DelayedDelivery) {
+		now := time.Now()
+		deliverAt, delayed, err := broker.GetDeliveryTime(event.Context, now)
+		if err != nil {
+			h.Logger.Debug("invalid delivery time", zap.String("event.id", event.ID()), zap.Error(err))
+			return http.StatusBadRequest, kncloudevents.NoDuration
+		}
+		if delayed {
+			// The delivery time extensions are removed so that the event isn't parked again
+			// when it is released or replied unchanged to the broker.
+			broker.DeleteDeliveryTime(event.Context)
+			if deliverAt.After(now) {
+				return h.park(ctx, event, brokerObj, deliverAt), kncloudevents.NoDuration
+			}
+		}
+	}
+
+	return h.offloadAndSend(ctx, headers, event, brokerObj)
+}
+
+// offloadAndSend offloads the data of the event to the claim check store when the broker
+// configures it, and sends the event to the channel of the broker. Parked events are offloaded
+// only once released, so that their data doesn't expire before their delivery time.
+func (h *Handler) offloadAndSend(ctx context.Context, headers http.Header, event *cloudevents.Event, brokerObj *eventingv1.Broker) (int, time.Duration) {
+	var dataRef string
+	if h.ClaimCheckStore != nil && brokerObj.Spec.ClaimCheck != nil {
+		ref, err := claimcheck.Offload(ctx, h.ClaimCheckStore, event, claimcheck.Prefix("brokers", brokerObj.Namespace, brokerObj.Name), int(brokerObj.Spec.ClaimCheck.Threshold))
+		if err != nil {
+			h.Logger.Error("failed to offload event data", zap.Error(err))
+			return http.StatusInternalServerError, kncloudevents.NoDuration
+		}
+		dataRef = ref
+	}
+
+	statusCode, retryAfter := h.send(ctx, headers, event, brokerObj)
+	if dataRef != "" && (statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices) {
+		// The event hasn't been accepted, nothing references the offloaded data anymore.
+		if err := h.ClaimCheckStore.Delete(ctx, dataRef); err != nil {
+			h.Logger.Warn("failed to delete offloaded event data", zap.String("dataref", dataRef), zap.Error(err))
+		}
+	}
+	return statusCode, retryAfter
+}
+
+func (h *Handler) park(ctx context.Context, event *cloudevents.Event, brokerObj *eventingv1.Broker, deliverAt time.Time) int {
+	brokerRef := types.NamespacedName{Namespace: brokerObj.Namespace, Name: brokerObj.Name}
+	err := h.DelayStore.Park(ctx, event, brokerRef, deliverAt)
+	if errors.Is(err, ErrDelayStoreFull) {
+		h.Logger.Warn("delay store is full, rejecting event", zap.String("event.id", event.ID()))
+		return http.StatusTooManyRequests
+	}
+	if err != nil {
+		h.Logger.Error("failed to park event", zap.Error(err))
+		return http.StatusInternalServerError
+	}
+	return http.StatusAccepted
+}
+
+// ReleaseDelayed sends a parked event to the channel of its broker. It is the DelayedEventSender
+// of the DelayStore. Headers of the original request aren't preserved for parked events.
+func (h *Handler) ReleaseDelayed(ctx context.Context, event *cloudevents.Event, brokerRef types.NamespacedName) error {
+	brokerObj, err := h.getBroker(brokerRef.Name, brokerRef.Namespace)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("%w: %v", ErrBrokerNotFound, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx = h.withContext(ctx)
+	ctx = observability.WithBrokerLabels(ctx, brokerRef)
+	ctx = observability.WithMinimalEventLabels(ctx, event)
+
+	event.SetExtension(broker.EventArrivalTime, cloudevents.Timestamp{Time: time.Now()})
+	statusCode, _ := h.offloadAndSend(ctx, nil, event, brokerObj)
+	if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %d", statusCode)
+	}
+	return nil
+}
+
+// DeadLetterDelayed sends a parked event which couldn't be released in time to the dead letter
+// sink of its broker. It is the dead letter DelayedEventSender of the DelayStore.
+func (h *Handler) DeadLetterDelayed(ctx context.Context, event *cloudevents.Event, brokerRef types.NamespacedName) error {
+	brokerObj, err := h.getBroker(brokerRef.Name, brokerRef.Namespace)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("%w: %v", ErrBrokerNotFound, err)
+	}
+	if err != nil {
+		return err
+	}
+	if brokerObj.Status.DeadLetterSinkURI == nil {
+		return ErrNoDeadLetterSink
+	}
+
+	ctx = h.withContext(ctx)
+	ctx = observability.WithBrokerLabels(ctx, brokerRef)
+	ctx = observability.WithMinimalEventLabels(ctx, event)
+
+	dls := duckv1.Addressable{
+		URL:      brokerObj.Status.DeadLetterSinkURI,
+		CACerts:  brokerObj.Status.DeadLetterSinkCACerts,
+		Audience: brokerObj.Status.DeadLetterSinkAudience,
+	}
+	_, err = h.eventDispatcher.SendEvent(ctx, *event, dls, kncloudevents.WithOIDCAuthentication(&types.NamespacedName{
+		Name:      "mt-broker-ingress-oidc",
+		Namespace: system.Namespace(),
+	}))
+	return err
+}
+
+func (h *Handler) send(ctx context.Context, headers http.Header, event *cloudevents.Event, brokerObj *eventingv1.Broker) (int, time.Duration) {
 	channelAddress, err := h.getChannelAddress(brokerObj)
 	if err != nil {
 		h.Logger.Warn("could not get channel address from broker", zap.Error(err))
@@ -22,6 +22,8 @@ import (
 	"io"
 	nethttp "net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -38,6 +40,7 @@ import (
 
 	"knative.dev/eventing/pkg/eventingtls"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"github.com/cloudevents/sdk-go/v2/client"
 	"github.com/cloudevents/sdk-go/v2/event"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
@@ -57,6 +60,7 @@ import (
 	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/eventing/pkg/auth"
 	"knative.dev/eventing/pkg/broker"
+	"knative.dev/eventing/pkg/claimcheck"
 
 	brokerinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker/fake"
 	eventpolicyinformerfake "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy/fake"
@@ -240,6 +244,31 @@ func TestHandler_ServeHTTP(t *testing.T) {
 				makeBroker("name", "ns"),
 			},
 		},
+		{
+			name:       "event exceeds max event size",
+			method:     nethttp.MethodPost,
+			uri:        "/ns/name",
+			body:       getValidEvent(),
+			statusCode: nethttp.StatusRequestEntityTooLarge,
+			handler:    handler(),
+			defaulter:  broker.TTLDefaulter(logger, 100),
+			brokers: []*eventingv1.Broker{
+				withMaxEventSize(makeBroker("name", "ns"), 10),
+			},
+		},
+		{
+			name:       "event within max event size",
+			method:     nethttp.MethodPost,
+			uri:        "/ns/name",
+			body:       getValidEvent(),
+			statusCode: senderResponseStatusCode,
+			handler:    handler(),
+			defaulter:  broker.TTLDefaulter(logger, 100),
+			brokers: []*eventingv1.Broker{
+				withMaxEventSize(makeBroker("name", "ns"), 1024),
+			},
+			expectDispatchDuration: true,
+		},
 		{
 			name:       "pass headers to handler",
 			method:     nethttp.MethodPost,
@@ -323,6 +352,7 @@ func TestHandler_ServeHTTP(t *testing.T) {
 				authVerifier,
 				tokenProvider,
 				configmapinformer.Get(ctx).Lister().ConfigMaps("ns"),
+				nil,
 				func(ctx context.Context) context.Context {
 					return ctx
 				},
@@ -417,12 +447,173 @@ func makeBroker(name, namespace string) *eventingv1.Broker {
 	}
 }
 
+func withMaxEventSize(b *eventingv1.Broker, maxEventSize int64) *eventingv1.Broker {
+	b.Spec.MaxEventSize = &maxEventSize
+	return b
+}
+
 func withUninitializedAnnotations(b *eventingv1.Broker) *eventingv1.Broker {
 	b.Status.Annotations = nil
 	return b
 }
 
 func SetUpInformerSelector(ctx context.Context) context.Context {
-	ctx = filteredFactory.WithSelectors(ctx, eventingtls.TrustBundleLabelSelector)
+	ctx = filteredFactory.WithSelectors(ctx, eventingtls.TrustBundleLabelSelector, eventingtls.ClientCABundleLabelSelector)
 	return ctx
 }
+
+func TestHandlerClaimCheck(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+
+	var received *event.Event
+	s := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		received, _ = cloudevents.NewEventFromHTTPRequest(r)
+		w.WriteHeader(nethttp.StatusAccepted)
+	}))
+	defer s.Close()
+
+	b := makeBroker("name", "ns")
+	b.Spec.ClaimCheck = &eventingv1.ClaimCheckSpec{Threshold: 4}
+	b.Status.Annotations[eventing.BrokerChannelAddressStatusAnnotationKey] = s.URL
+	brokerinformerfake.Get(ctx).Informer().GetStore().Add(b)
+
+	h, err := NewHandler(zap.NewNop(),
+		broker.TTLDefaulter(zap.NewNop(), 100),
+		brokerinformerfake.Get(ctx),
+		nil,
+		nil,
+		nil,
+		nil,
+		func(ctx context.Context) context.Context {
+			return ctx
+		},
+		metric.NewMeterProvider(),
+		trace.NewTracerProvider(),
+	)
+	if err != nil {
+		t.Fatal("Unable to create handler:", err)
+	}
+	store, err := claimcheck.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.ClaimCheckStore = store
+
+	e := event.New()
+	e.SetType("type")
+	e.SetSource("source")
+	e.SetID("1234")
+	_ = e.SetData(event.TextPlain, "large data")
+	body, _ := e.MarshalJSON()
+
+	request := httptest.NewRequest(nethttp.MethodPost, "/ns/name", bytes.NewBuffer(body))
+	request.Header.Add(cehttp.ContentType, event.ApplicationCloudEventsJSON)
+	recorder := httptest.NewRecorder()
+	h.ServeHTTP(recorder, request)
+
+	if got := recorder.Result().StatusCode; got != nethttp.StatusAccepted {
+		t.Fatalf("expected status code %d, got %d", nethttp.StatusAccepted, got)
+	}
+	if received == nil {
+		t.Fatal("expected event to be dispatched")
+	}
+	if len(received.Data()) != 0 {
+		t.Errorf("expected data to be offloaded, got %q", string(received.Data()))
+	}
+	if err := claimcheck.Resolve(ctx, store, received, claimcheck.Prefix("brokers", "ns", "name")); err != nil {
+		t.Fatal("Resolve() =", err)
+	}
+	if got := string(received.Data()); got != "large data" {
+		t.Errorf("expected offloaded data %q, got %q", "large data", got)
+	}
+}
+
+func TestHandlerClaimCheckReferences(t *testing.T) {
+	ctx, _ := reconcilertesting.SetupFakeContext(t, SetUpInformerSelector)
+
+	var received *event.Event
+	status := nethttp.StatusAccepted
+	s := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		received, _ = cloudevents.NewEventFromHTTPRequest(r)
+		w.WriteHeader(status)
+	}))
+	defer s.Close()
+
+	b := makeBroker("name", "ns")
+	b.Spec.ClaimCheck = &eventingv1.ClaimCheckSpec{Threshold: 4}
+	b.Status.Annotations[eventing.BrokerChannelAddressStatusAnnotationKey] = s.URL
+	brokerinformerfake.Get(ctx).Informer().GetStore().Add(b)
+
+	h, err := NewHandler(zap.NewNop(),
+		broker.TTLDefaulter(zap.NewNop(), 100),
+		brokerinformerfake.Get(ctx),
+		nil,
+		nil,
+		nil,
+		nil,
+		func(ctx context.Context) context.Context {
+			return ctx
+		},
+		metric.NewMeterProvider(),
+		trace.NewTracerProvider(),
+	)
+	if err != nil {
+		t.Fatal("Unable to create handler:", err)
+	}
+	dir := t.TempDir()
+	store, err := claimcheck.NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.ClaimCheckStore = store
+
+	send := func(data string, extensions map[string]string) int {
+		e := event.New()
+		e.SetType("type")
+		e.SetSource("source")
+		e.SetID("1234")
+		for k, v := range extensions {
+			e.SetExtension(k, v)
+		}
+		_ = e.SetData(event.TextPlain, data)
+		body, _ := e.MarshalJSON()
+		request := httptest.NewRequest(nethttp.MethodPost, "/ns/name", bytes.NewBuffer(body))
+		request.Header.Add(cehttp.ContentType, event.ApplicationCloudEventsJSON)
+		recorder := httptest.NewRecorder()
+		h.ServeHTTP(recorder, request)
+		return recorder.Result().StatusCode
+	}
+
+	// A reference to the store set by the producer is removed.
+	stolen, err := store.Put(ctx, "brokers/other/broker/1", []byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := send("", map[string]string{claimcheck.DataRefExtension: stolen}); got != nethttp.StatusAccepted {
+		t.Fatalf("expected status code %d, got %d", nethttp.StatusAccepted, got)
+	}
+	if _, ok := received.Extensions()[claimcheck.DataRefExtension]; ok {
+		t.Errorf("expected the dataref set by the producer to be removed, got %v", received.Extensions())
+	}
+
+	// A reference to another location is kept.
+	if got := send("", map[string]string{claimcheck.DataRefExtension: "https://example.com/data"}); got != nethttp.StatusAccepted {
+		t.Fatalf("expected status code %d, got %d", nethttp.StatusAccepted, got)
+	}
+	if got := received.Extensions()[claimcheck.DataRefExtension]; got != "https://example.com/data" {
+		t.Errorf("expected the foreign dataref to be kept, got %v", got)
+	}
+
+	// The offloaded data is deleted when the event isn't accepted.
+	status = nethttp.StatusInternalServerError
+	if got := send("large data", nil); got == nethttp.StatusAccepted {
+		t.Fatalf("expected the event to be rejected, got status code %d", got)
+	}
+	entries, err := os.ReadDir(filepath.Join(dir, "brokers", "ns", "name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("expected the offloaded data to be deleted, got %d files", len(entries))
+	}
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	cesql "github.com/cloudevents/sdk-go/sql/v2"
+	cesqlparser "github.com/cloudevents/sdk-go/sql/v2/parser"
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"go.uber.org/zap"
+
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/utils/jsonpath"
+)
+
+// ingressRuleValues are the values known by the ingress for an event, which
+// are added to it by the enrichments of the Broker ingress rules.
+type ingressRuleValues struct {
+	subject     string
+	receiveTime time.Time
+	clusterName string
+}
+
+// ingressRules applies the ingress rules of the Brokers to the received events.
+type ingressRules struct {
+	logger *zap.Logger
+	// conditions caches the parsed CESQL conditions by expression.
+	conditions sync.Map
+	// paths caches the parsed JSONPath expressions of the data redactions.
+	paths sync.Map
+}
+
+// apply applies the rules to the event. The values are hashed with HMAC-SHA256
+// and the given key, values to hash are removed when there is no key.
+func (r *ingressRules) apply(event *cloudevents.Event, rules []eventingv1.BrokerIngressRule, values ingressRuleValues, hashKey []byte) error {
+	// The enriched extensions are cleared first, so that senders can't set
+	// them themselves for the events not matching the rules enriching them.
+	for _, rule := range rules {
+		for _, e := range rule.Enrich {
+			if err := event.Context.SetExtension(e.Extension, nil); err != nil {
+				return fmt.Errorf("failed to clear extension %s: %w", e.Extension, err)
+			}
+		}
+	}
+
+	for _, rule := range rules {
+		if !r.matches(event, rule.Condition) {
+			continue
+		}
+
+		for _, e := range rule.Enrich {
+			var value interface{}
+			switch e.Source {
+			case eventingv1.EnrichmentSourceSubject:
+				value = values.subject
+			case eventingv1.EnrichmentSourceReceiveTime:
+				value = cloudevents.Timestamp{Time: values.receiveTime}
+			case eventingv1.EnrichmentSourceClusterName:
+				value = values.clusterName
+			}
+			// An extension without value stays removed.
+			if value == "" {
+				continue
+			}
+			if err := event.Context.SetExtension(e.Extension, value); err != nil {
+				return fmt.Errorf("failed to set extension %s: %w", e.Extension, err)
+			}
+		}
+
+		var dataRedactions []eventingv1.BrokerIngressRedaction
+		for _, rd := range rule.Redact {
+			if rd.DataPath != "" {
+				dataRedactions = append(dataRedactions, rd)
+				continue
+			}
+			if err := redactExtension(event, rd.Extension, hashKeyFor(rd, hashKey)); err != nil {
+				return err
+			}
+		}
+		if len(dataRedactions) > 0 {
+			if err := r.redactData(event, dataRedactions, hashKey); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// matches returns whether the event matches the CESQL condition. Conditions
+// which can't be evaluated on the event don't match, like the CESQL filters
+// of Triggers.
+func (r *ingressRules) matches(event *cloudevents.Event, condition string) bool {
+	if condition == "" {
+		return true
+	}
+
+	var expression cesql.Expression
+	if cached, ok := r.conditions.Load(condition); ok {
+		expression = cached.(cesql.Expression)
+	} else {
+		parsed, err := cesqlparser.Parse(condition)
+		if err != nil {
+			r.logger.Warn("failed to parse ingress rule condition", zap.String("condition", condition), zap.Error(err))
+			return false
+		}
+		r.conditions.Store(condition, parsed)
+		expression = parsed
+	}
+
+	res, err := expression.Evaluate(*event)
+	if err != nil {
+		r.logger.Debug("failed to evaluate ingress rule condition", zap.String("condition", condition), zap.Error(err))
+		return false
+	}
+	matched, _ := res.(bool)
+	return matched
+}
+
+func (r *ingressRules) path(expr string) (*jsonpath.Path, error) {
+	if cached, ok := r.paths.Load(expr); ok {
+		return cached.(*jsonpath.Path), nil
+	}
+	p, err := jsonpath.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+	r.paths.Store(expr, p)
+	return p, nil
+}
+
+// hashKeyFor returns the key to hash the value redacted by the redaction with,
+// or nil when the value is removed.
+func hashKeyFor(rd eventingv1.BrokerIngressRedaction, hashKey []byte) []byte {
+	if rd.Action == nil || *rd.Action != eventingv1.RedactionActionHash {
+		return nil
+	}
+	return hashKey
+}
+
+func redactExtension(event *cloudevents.Event, name string, hashKey []byte) error {
+	value, ok := event.Extensions()[name]
+	if !ok {
+		return nil
+	}
+	if len(hashKey) == 0 {
+		return event.Context.SetExtension(name, nil)
+	}
+	s, err := types.Format(value)
+	if err != nil {
+		return fmt.Errorf("failed to format extension %s: %w", name, err)
+	}
+	return event.Context.SetExtension(name, hashValue(hashKey, []byte(s)))
+}
+
+// redactData redacts the fields of the JSON data of the event. Events without
+// JSON data are left untouched.
+func (r *ingressRules) redactData(event *cloudevents.Event, redactions []eventingv1.BrokerIngressRedaction, hashKey []byte) error {
+	if len(event.Data()) == 0 || !jsonpath.IsJSONMediaType(event.DataMediaType()) {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(event.Data()))
+	decoder.UseNumber()
+	var data interface{}
+	if err := decoder.Decode(&data); err != nil {
+		return nil
+	}
+
+	modified := false
+	for _, rd := range redactions {
+		p, err := r.path(rd.DataPath)
+		if err != nil {
+			r.logger.Warn("failed to parse ingress rule data path", zap.String("dataPath", rd.DataPath), zap.Error(err))
+			continue
+		}
+		var found bool
+		if key := hashKeyFor(rd, hashKey); len(key) > 0 {
+			found = p.Replace(data, func(v interface{}) interface{} { return hashJSONValue(key, v) })
+		} else {
+			found = p.Remove(data)
+		}
+		if found {
+			modified = true
+		}
+	}
+	if !modified {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(data); err != nil {
+		return fmt.Errorf("failed to encode redacted data: %w", err)
+	}
+	// The encoded data is set as is, to keep the encoding of the event.
+	event.DataEncoded = bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+	return nil
+}
+
+// hashJSONValue hashes strings as is and the other values in their JSON encoding.
+func hashJSONValue(key []byte, v interface{}) string {
+	if s, ok := v.(string); ok {
+		return hashValue(key, []byte(s))
+	}
+	b, _ := json.Marshal(v)
+	return hashValue(key, b)
+}
+
+// hashValue returns the hex encoded HMAC-SHA256 of the value. Unlike plain
+// hashes, it can't be reversed by hashing candidate values without the key.
+func hashValue(key, b []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(b)
+	return hex.EncodeToString(mac.Sum(nil))
+}
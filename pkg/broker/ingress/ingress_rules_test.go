@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingress
+
+import (
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"k8s.io/utils/ptr"
+
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+)
+
+var testHashKey = []byte("test-hash-key")
+
+func TestIngressRules(t *testing.T) {
+	receiveTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	values := ingressRuleValues{
+		subject:     "system:serviceaccount:ns:sender",
+		receiveTime: receiveTime,
+		clusterName: "prod-1",
+	}
+
+	tests := []struct {
+		name           string
+		rules          []eventingv1.BrokerIngressRule
+		values         ingressRuleValues
+		contentType    string
+		data           string
+		extensions     map[string]interface{}
+		wantExtensions map[string]interface{}
+		noHashKey      bool
+		wantData       string
+	}{{
+		name: "enrich",
+		rules: []eventingv1.BrokerIngressRule{{
+			Enrich: []eventingv1.BrokerIngressEnrichment{
+				{Extension: "authsubject", Source: eventingv1.EnrichmentSourceSubject},
+				{Extension: "receivetime", Source: eventingv1.EnrichmentSourceReceiveTime},
+				{Extension: "cluster", Source: eventingv1.EnrichmentSourceClusterName},
+			},
+		}},
+		values:     values,
+		extensions: map[string]interface{}{"authsubject": "spoofed"},
+		wantExtensions: map[string]interface{}{
+			"authsubject": "system:serviceaccount:ns:sender",
+			"receivetime": "2026-01-02T03:04:05Z",
+			"cluster":     "prod-1",
+		},
+	}, {
+		name: "enrich without values removes extensions",
+		rules: []eventingv1.BrokerIngressRule{{
+			Enrich: []eventingv1.BrokerIngressEnrichment{
+				{Extension: "authsubject", Source: eventingv1.EnrichmentSourceSubject},
+				{Extension: "cluster", Source: eventingv1.EnrichmentSourceClusterName},
+			},
+		}},
+		extensions:     map[string]interface{}{"authsubject": "spoofed", "cluster": "spoofed"},
+		wantExtensions: map[string]interface{}{},
+	}, {
+		name: "condition not matching",
+		rules: []eventingv1.BrokerIngressRule{{
+			Condition: "type = 'order.created'",
+			Redact:    []eventingv1.BrokerIngressRedaction{{Extension: "email"}},
+		}},
+		extensions:     map[string]interface{}{"email": "jane@example.com"},
+		wantExtensions: map[string]interface{}{"email": "jane@example.com"},
+	}, {
+		name: "condition not matching clears enriched extensions",
+		rules: []eventingv1.BrokerIngressRule{{
+			Condition: "type = 'order.created'",
+			Enrich: []eventingv1.BrokerIngressEnrichment{
+				{Extension: "authsubject", Source: eventingv1.EnrichmentSourceSubject},
+			},
+		}},
+		values:         values,
+		extensions:     map[string]interface{}{"authsubject": "spoofed"},
+		wantExtensions: map[string]interface{}{},
+	}, {
+		name: "condition failing to evaluate",
+		rules: []eventingv1.BrokerIngressRule{{
+			Condition: "missing = 'value'",
+			Redact:    []eventingv1.BrokerIngressRedaction{{Extension: "email"}},
+		}},
+		extensions:     map[string]interface{}{"email": "jane@example.com"},
+		wantExtensions: map[string]interface{}{"email": "jane@example.com"},
+	}, {
+		name: "redact extensions",
+		rules: []eventingv1.BrokerIngressRule{{
+			Condition: "type = 'test-type'",
+			Redact: []eventingv1.BrokerIngressRedaction{
+				{Extension: "email"},
+				{Extension: "phone", Action: ptr.To(eventingv1.RedactionActionHash)},
+				{Extension: "missing"},
+			},
+		}},
+		extensions: map[string]interface{}{"email": "jane@example.com", "phone": "555-0100"},
+		wantExtensions: map[string]interface{}{
+			"phone": hashValue(testHashKey, []byte("555-0100")),
+		},
+	}, {
+		name: "hash without key removes values",
+		rules: []eventingv1.BrokerIngressRule{{
+			Redact: []eventingv1.BrokerIngressRedaction{
+				{Extension: "phone", Action: ptr.To(eventingv1.RedactionActionHash)},
+				{DataPath: "$.user.ssn", Action: ptr.To(eventingv1.RedactionActionHash)},
+			},
+		}},
+		noHashKey:      true,
+		contentType:    cloudevents.ApplicationJSON,
+		data:           `{"user":{"ssn":"123-45-6789","name":"Jane"}}`,
+		extensions:     map[string]interface{}{"phone": "555-0100"},
+		wantExtensions: map[string]interface{}{},
+		wantData:       `{"user":{"name":"Jane"}}`,
+	}, {
+		name: "redact data",
+		rules: []eventingv1.BrokerIngressRule{{
+			Redact: []eventingv1.BrokerIngressRedaction{
+				{DataPath: "$.user.email"},
+				{DataPath: "$.user.ssn", Action: ptr.To(eventingv1.RedactionActionHash)},
+				{DataPath: "$.items[*].card"},
+				{DataPath: "$.items[0]", Action: ptr.To(eventingv1.RedactionActionHash)},
+				{DataPath: "$.user.missing"},
+			},
+		}},
+		contentType:    cloudevents.ApplicationJSON,
+		data:           `{"user":{"email":"jane@example.com","ssn":"123-45-6789","name":"Jane <J>"},"items":[42,{"card":"4111","qty":1.50}]}`,
+		wantExtensions: map[string]interface{}{},
+		wantData:       `{"items":["` + hashValue(testHashKey, []byte("42")) + `",{"qty":1.50}],"user":{"name":"Jane <J>","ssn":"` + hashValue(testHashKey, []byte("123-45-6789")) + `"}}`,
+	}, {
+		name: "redact data not JSON",
+		rules: []eventingv1.BrokerIngressRule{{
+			Redact: []eventingv1.BrokerIngressRedaction{{DataPath: "$.user.email"}},
+		}},
+		contentType:    "text/plain",
+		data:           `{"user":{"email":"jane@example.com"}}`,
+		wantExtensions: map[string]interface{}{},
+		wantData:       `{"user":{"email":"jane@example.com"}}`,
+	}, {
+		name: "rules applied in order",
+		rules: []eventingv1.BrokerIngressRule{{
+			Enrich: []eventingv1.BrokerIngressEnrichment{
+				{Extension: "authsubject", Source: eventingv1.EnrichmentSourceSubject},
+			},
+		}, {
+			Condition: "authsubject LIKE 'system:serviceaccount:%'",
+			Redact: []eventingv1.BrokerIngressRedaction{
+				{Extension: "authsubject", Action: ptr.To(eventingv1.RedactionActionHash)},
+			},
+		}},
+		values: values,
+		wantExtensions: map[string]interface{}{
+			"authsubject": hashValue(testHashKey, []byte("system:serviceaccount:ns:sender")),
+		},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			event := cloudevents.NewEvent()
+			event.SetID("test-id")
+			event.SetType("test-type")
+			event.SetSource("test-source")
+			for name, value := range tc.extensions {
+				event.SetExtension(name, value)
+			}
+			if tc.data != "" {
+				if err := event.SetData(tc.contentType, []byte(tc.data)); err != nil {
+					t.Fatal(err)
+				}
+				event.DataBase64 = false
+			}
+
+			hashKey := testHashKey
+			if tc.noHashKey {
+				hashKey = nil
+			}
+			r := &ingressRules{logger: zap.NewNop()}
+			if err := r.apply(&event, tc.rules, tc.values, hashKey); err != nil {
+				t.Fatal("apply() =", err)
+			}
+
+			gotExtensions := make(map[string]interface{}, len(event.Extensions()))
+			for name, value := range event.Extensions() {
+				if ts, ok := value.(cloudevents.Timestamp); ok {
+					value = ts.Time.Format(time.RFC3339)
+				}
+				gotExtensions[name] = value
+			}
+			if diff := cmp.Diff(tc.wantExtensions, gotExtensions); diff != "" {
+				t.Error("unexpected extensions (-want, +got) =", diff)
+			}
+			if diff := cmp.Diff(tc.wantData, string(event.Data())); diff != "" {
+				t.Error("unexpected data (-want, +got) =", diff)
+			}
+			if event.DataBase64 {
+				t.Error("expected the data encoding to be kept")
+			}
+		})
+	}
+}
@@ -192,3 +192,22 @@ func WithDNSNames(dnsNames ...string) CertificateOption {
 		cert.Spec.DNSNames = dnsNames
 	}
 }
+
+func WithURIs(uris ...string) CertificateOption {
+	return func(cert *cmv1.Certificate) {
+		cert.Spec.URIs = uris
+	}
+}
+
+// WithIssuer makes the certificate issued by the cert-manager ClusterIssuer with the given name.
+func WithIssuer(name string) CertificateOption {
+	return func(cert *cmv1.Certificate) {
+		cert.Spec.IssuerRef.Name = name
+	}
+}
+
+func WithUsages(usages ...cmv1.KeyUsage) CertificateOption {
+	return func(cert *cmv1.Certificate) {
+		cert.Spec.Usages = usages
+	}
+}
@@ -82,6 +82,7 @@ type EventReceiver struct {
 	tokenVerifier        *auth.Verifier
 	audience             string
 	getPoliciesForFunc   GetPoliciesForFunc
+	maxEventSizeFunc     MaxEventSizeFunc
 	withContext          func(context.Context) context.Context
 	meterProvider        metric.MeterProvider
 	traceProvider        trace.TracerProvider
@@ -130,6 +131,17 @@ func ReceiverWithGetPoliciesForFunc(fn GetPoliciesForFunc) EventReceiverOptions
 	}
 }
 
+// MaxEventSizeFunc function enables the EventReceiver to get the maximum size in bytes of the
+// events accepted by a Channel, 0 meaning no limit.
+type MaxEventSizeFunc func(channel ChannelReference) int64
+
+func ReceiverWithMaxEventSizeFunc(fn MaxEventSizeFunc) EventReceiverOptions {
+	return func(r *EventReceiver) error {
+		r.maxEventSizeFunc = fn
+		return nil
+	}
+}
+
 func OIDCTokenVerification(tokenVerifier *auth.Verifier, audience string) EventReceiverOptions {
 	return func(r *EventReceiver) error {
 		r.tokenVerifier = tokenVerifier
@@ -249,6 +261,7 @@ func (r *EventReceiver) ServeHTTP(response nethttp.ResponseWriter, request *neth
 	//   202 - the event was sent to subscribers
 	//   400 - the request was malformed
 	//   404 - the request was for an unknown channel
+	//   413 - the event exceeds the maximum event size of the channel
 	//   500 - an error occurred processing the request
 	var channel ChannelReference
 	var err error
@@ -282,8 +295,21 @@ func (r *EventReceiver) ServeHTTP(response nethttp.ResponseWriter, request *neth
 	ctx = observability.WithChannelLabels(ctx, types.NamespacedName{Name: channel.Name, Namespace: channel.Namespace})
 	ctx = observability.WithRequestLabels(ctx, request)
 
+	if r.maxEventSizeFunc != nil {
+		if maxEventSize := r.maxEventSizeFunc(channel); maxEventSize > 0 && !utils.LimitRequestBody(response, request, maxEventSize) {
+			r.logger.Info("Event exceeds the maximum event size", zap.String("channel", channel.String()), zap.Int64("maxEventSize", maxEventSize))
+			response.WriteHeader(nethttp.StatusRequestEntityTooLarge)
+			return
+		}
+	}
+
 	// copy the request, as we need access to the body (in case of a structured event) for the auth checks too
 	reqCopy, err := utils.CopyRequest(request)
+	if utils.IsRequestBodyTooLarge(err) {
+		r.logger.Info("Event exceeds the maximum event size", zap.String("channel", channel.String()))
+		response.WriteHeader(nethttp.StatusRequestEntityTooLarge)
+		return
+	}
 	if err != nil {
 		r.logger.Error("Failed to copy request", zap.Error(err))
 		response.WriteHeader(nethttp.StatusInternalServerError)
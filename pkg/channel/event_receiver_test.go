@@ -98,6 +98,24 @@ func TestEventReceiver_ServeHTTP(t *testing.T) {
 			expected: nethttp.StatusAccepted,
 			opts:     []EventReceiverOptions{ResolveChannelFromPath(ParseChannelFromPath)},
 		},
+		"event exceeds max event size": {
+			receiverFunc: func(_ context.Context, _ ChannelReference, _ event.Event, _ nethttp.Header) error {
+				return errors.New("event exceeding the max event size should not be received")
+			},
+			expected: nethttp.StatusRequestEntityTooLarge,
+			opts: []EventReceiverOptions{ReceiverWithMaxEventSizeFunc(func(ChannelReference) int64 {
+				return 4
+			})},
+		},
+		"event within max event size": {
+			receiverFunc: func(_ context.Context, _ ChannelReference, _ event.Event, _ nethttp.Header) error {
+				return nil
+			},
+			expected: nethttp.StatusAccepted,
+			opts: []EventReceiverOptions{ReceiverWithMaxEventSizeFunc(func(ChannelReference) int64 {
+				return 1024
+			})},
+		},
 		"headers and body pass through": {
 			// The header, body, and host values set here are verified in the receiverFunc. Altering
 			// them here will require the same alteration in the receiverFunc.
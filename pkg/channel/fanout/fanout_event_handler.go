@@ -43,6 +43,7 @@ import (
 	"knative.dev/eventing/pkg/channel"
 	"knative.dev/eventing/pkg/eventtype"
 	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/eventing/pkg/kncloudevents/transform"
 	"knative.dev/eventing/pkg/observability"
 	"knative.dev/eventing/pkg/tracing"
 )
@@ -57,14 +58,16 @@ var (
 )
 
 type Subscription struct {
-	Subscriber     duckv1.Addressable
-	Reply          *duckv1.Addressable
-	DeadLetter     *duckv1.Addressable
-	RetryConfig    *kncloudevents.RetryConfig
-	ServiceAccount *types.NamespacedName
-	Name           string
-	Namespace      string
-	UID            types.UID
+	Subscriber       duckv1.Addressable
+	Reply            *duckv1.Addressable
+	DeadLetter       *duckv1.Addressable
+	DeadLetterFormat *eventingduckv1.DeadLetterFormatType
+	RetryConfig      *kncloudevents.RetryConfig
+	Transform        *transform.Transform
+	ServiceAccount   *types.NamespacedName
+	Name             string
+	Namespace        string
+	UID              types.UID
 }
 
 // Config for a fanout.EventHandler.
@@ -202,6 +205,11 @@ func SubscriberSpecToFanoutConfig(sub eventingduckv1.SubscriberSpec) (*Subscript
 		}
 	}
 
+	var deadLetterFormat *eventingduckv1.DeadLetterFormatType
+	if sub.Delivery != nil {
+		deadLetterFormat = sub.Delivery.DeadLetterFormat
+	}
+
 	var retryConfig *kncloudevents.RetryConfig
 	if sub.Delivery != nil {
 		if rc, err := kncloudevents.RetryConfigFromDeliverySpec(*sub.Delivery); err != nil {
@@ -211,7 +219,12 @@ func SubscriberSpecToFanoutConfig(sub eventingduckv1.SubscriberSpec) (*Subscript
 		}
 	}
 
-	s := &Subscription{Subscriber: destination, Reply: reply, DeadLetter: deadLetter, RetryConfig: retryConfig, UID: sub.UID}
+	t, err := transform.New(sub.Transform)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Subscription{Subscriber: destination, Reply: reply, DeadLetter: deadLetter, DeadLetterFormat: deadLetterFormat, RetryConfig: retryConfig, Transform: t, UID: sub.UID}
 
 	if sub.Name != nil {
 		s.Name = *sub.Name
@@ -372,6 +385,7 @@ func (f *FanoutEventHandler) makeFanoutRequest(ctx context.Context, event event.
 		kncloudevents.WithHeader(additionalHeaders),
 		kncloudevents.WithReply(sub.Reply),
 		kncloudevents.WithDeadLetterSink(sub.DeadLetter),
+		kncloudevents.WithDeadLetterFormat(sub.DeadLetterFormat),
 		kncloudevents.WithRetryConfig(sub.RetryConfig),
 	}
 
@@ -392,6 +406,11 @@ func (f *FanoutEventHandler) makeFanoutRequest(ctx context.Context, event event.
 		dispatchOptions = append(dispatchOptions, kncloudevents.WithOIDCAuthentication(sub.ServiceAccount))
 	}
 
+	if sub.Transform != nil {
+		event = sub.Transform.Prepare(event)
+		dispatchOptions = append(dispatchOptions, kncloudevents.WithTransformers(sub.Transform.Transformers()...))
+	}
+
 	return f.eventDispatcher.SendEvent(ctx, event, sub.Subscriber, dispatchOptions...)
 }
 
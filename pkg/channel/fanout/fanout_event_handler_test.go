@@ -503,3 +503,83 @@ func callableSucceed(writer http.ResponseWriter, _ *http.Request) {
 	writer.WriteHeader(http.StatusOK)
 	_, _ = writer.Write([]byte("{}"))
 }
+
+func TestFanoutEventHandler_Transform(t *testing.T) {
+	ctx := context.Background()
+	ctx, _ = fakekubeclient.With(ctx)
+	ctx = injection.WithConfig(ctx, &rest.Config{})
+
+	var mu sync.Mutex
+	received := map[string]*event.Event{}
+	newServer := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			e, err := cloudevents.NewEventFromHTTPRequest(r)
+			if err != nil {
+				t.Error("failed to read event:", err)
+			}
+			mu.Lock()
+			received[name] = e
+			mu.Unlock()
+			w.WriteHeader(http.StatusAccepted)
+		}))
+	}
+	plainServer := newServer("plain")
+	defer plainServer.Close()
+	transformedServer := newServer("transformed")
+	defer transformedServer.Close()
+
+	plain, err := SubscriberSpecToFanoutConfig(eventingduckv1.SubscriberSpec{
+		SubscriberURI: apis.HTTP(plainServer.URL[7:]),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	transformed, err := SubscriberSpecToFanoutConfig(eventingduckv1.SubscriberSpec{
+		SubscriberURI: apis.HTTP(transformedServer.URL[7:]),
+		Transform: &eventingduckv1.TransformSpec{
+			Set:    map[string]string{"type": "transformed.type"},
+			Remove: []string{"subject"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logger := zap.NewNop()
+	dispatcher := kncloudevents.NewDispatcher(eventingtls.NewDefaultClientConfig(), auth.NewOIDCTokenProvider(ctx))
+	h, err := NewFanoutEventHandler(
+		logger,
+		Config{Subscriptions: []Subscription{*plain, *transformed}},
+		nil,
+		nil,
+		nil,
+		dispatcher,
+		metric.NewMeterProvider(),
+		sdktrace.NewTracerProvider(),
+	)
+	if err != nil {
+		t.Fatal("NewHandler failed =", err)
+	}
+	h.timeout = 10 * time.Second
+
+	e := makeCloudEvent()
+	e.SetSubject("subject")
+	req := httptest.NewRequest(http.MethodPost, "http://channelname.channelnamespace/", nil)
+	if err := bindingshttp.WriteRequest(context.Background(), binding.ToMessage(&e), req); err != nil {
+		t.Fatal("WriteRequest =", err)
+	}
+	resp := httptest.NewRecorder()
+	h.ServeHTTP(resp, req)
+	if resp.Code != http.StatusAccepted {
+		t.Fatalf("Unexpected status code. Expected %v, Actual %v", http.StatusAccepted, resp.Code)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if got := received["plain"]; got == nil || got.Type() != e.Type() || got.Subject() != "subject" {
+		t.Errorf("expected the untransformed event, got %v", got)
+	}
+	if got := received["transformed"]; got == nil || got.Type() != "transformed.type" || got.Subject() != "" {
+		t.Errorf("expected the transformed event, got %v", got)
+	}
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package claimcheck implements the claim check pattern for CloudEvents: the data of large events
+// is offloaded to a blob store and replaced by a reference, which is resolved again when the event
+// is delivered.
+package claimcheck
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetypes "github.com/cloudevents/sdk-go/v2/types"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"knative.dev/pkg/logging"
+)
+
+const (
+	// DataRefExtension is the CloudEvents dataref extension attribute, a reference to the
+	// location where the data of the event is stored.
+	// See https://github.com/cloudevents/spec/blob/main/cloudevents/extensions/dataref.md
+	DataRefExtension = "dataref"
+
+	// holdsInfix separates the key of held data from the holder in the key of a hold.
+	holdsInfix = ".holds/"
+)
+
+var (
+	// ErrNotFound is returned by a Store when the referenced data doesn't exist.
+	ErrNotFound = errors.New("data not found")
+	// ErrForbidden is returned by Resolve when the referenced data belongs to another owner.
+	ErrForbidden = errors.New("data reference outside of the owner prefix")
+)
+
+// Store stores the data offloaded from events.
+type Store interface {
+	// Put stores data under key and returns the reference to it.
+	Put(ctx context.Context, key string, data []byte) (string, error)
+	// Get returns the data for a reference returned by Put.
+	Get(ctx context.Context, ref string) ([]byte, error)
+	// Delete deletes the data for a reference returned by Put, it doesn't fail when the data
+	// doesn't exist.
+	Delete(ctx context.Context, ref string) error
+	// List returns the data stored under keys starting with prefix.
+	List(ctx context.Context, prefix string) ([]Object, error)
+	// Key returns the key of the referenced data, and false if the reference doesn't point to
+	// this store. References set by producers to other locations are left untouched.
+	Key(ref string) (string, bool)
+	// Ref returns the reference to the data stored under key, it is the reference returned by Put.
+	Ref(key string) string
+}
+
+// Object is data listed from a Store.
+type Object struct {
+	// Key is the key the data is stored under.
+	Key string
+	// LastModified is the time at which the data was stored.
+	LastModified time.Time
+}
+
+// Prefix returns the key prefix of the data offloaded for the given owner, for example the
+// "brokers" resource. The namespace and name are omitted when empty, to get the prefix of the
+// data of every owner of a resource.
+func Prefix(resource, namespace, name string) string {
+	return path.Join(resource, namespace, name) + "/"
+}
+
+// Offload moves the data of the event to the store when it is larger than threshold bytes, and
+// sets the DataRefExtension to its reference. prefix is prepended to the key of the data, so that
+// data can be grouped by owner. It returns the reference, which is empty when the data hasn't
+// been offloaded.
+func Offload(ctx context.Context, store Store, event *cloudevents.Event, prefix string, threshold int) (string, error) {
+	data := event.Data()
+	if len(data) <= threshold {
+		return "", nil
+	}
+
+	ref, err := store.Put(ctx, path.Join(prefix, uuid.NewString()), data)
+	if err != nil {
+		return "", fmt.Errorf("failed to offload event data: %w", err)
+	}
+
+	event.SetExtension(DataRefExtension, ref)
+	// The data content type is kept, it is the content type of the referenced data.
+	event.DataEncoded = nil
+	event.DataBase64 = false
+	return ref, nil
+}
+
+// Strip removes the DataRefExtension of an event when it references the store, so that
+// producers can't make the data plane resolve data they don't own. It returns true when the
+// extension has been removed.
+func Strip(store Store, event *cloudevents.Event) bool {
+	ref, ok := DataRef(event)
+	if !ok {
+		return false
+	}
+	if _, owned := store.Key(ref); !owned {
+		return false
+	}
+	event.SetExtension(DataRefExtension, nil)
+	return true
+}
+
+// Resolve replaces the DataRefExtension of an event with the referenced data when it has been
+// offloaded to the store. Events without the extension, or referencing data outside of the
+// store are left untouched. Only data stored under prefix is resolved, ErrForbidden is returned
+// for data of other owners.
+func Resolve(ctx context.Context, store Store, event *cloudevents.Event, prefix string) error {
+	ref, ok := DataRef(event)
+	if !ok {
+		return nil
+	}
+	key, owned := store.Key(ref)
+	if !owned {
+		return nil
+	}
+	if !strings.HasPrefix(key, prefix) {
+		return fmt.Errorf("failed to resolve event data %s: %w", ref, ErrForbidden)
+	}
+
+	data, err := store.Get(ctx, ref)
+	if err != nil {
+		return fmt.Errorf("failed to resolve event data %s: %w", ref, err)
+	}
+
+	event.SetExtension(DataRefExtension, nil)
+	event.DataEncoded = data
+	return nil
+}
+
+// Hold marks the referenced data as in use by holder, so that it doesn't expire until holder
+// releases it. holder identifies the holder within the store, for example the key of the data
+// holding the reference. References outside of the store are ignored.
+func Hold(ctx context.Context, store Store, ref, holder string) error {
+	key, owned := store.Key(ref)
+	if !owned {
+		return nil
+	}
+	if _, err := store.Put(ctx, holdKey(key, holder), nil); err != nil {
+		return fmt.Errorf("failed to hold event data %s: %w", ref, err)
+	}
+	return nil
+}
+
+// Release releases the hold of holder on the referenced data, it doesn't fail when there is no
+// such hold.
+func Release(ctx context.Context, store Store, ref, holder string) error {
+	key, owned := store.Key(ref)
+	if !owned {
+		return nil
+	}
+	if err := store.Delete(ctx, store.Ref(holdKey(key, holder))); err != nil {
+		return fmt.Errorf("failed to release event data %s: %w", ref, err)
+	}
+	return nil
+}
+
+// DeleteExpired deletes the data stored under prefix before the given time, unless it is held.
+// Holds of data which doesn't exist anymore are deleted as well once expired.
+func DeleteExpired(ctx context.Context, store Store, prefix string, before time.Time) error {
+	objects, err := store.List(ctx, prefix)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]bool, len(objects))
+	held := make(map[string]bool)
+	for _, o := range objects {
+		if key, _, ok := strings.Cut(o.Key, holdsInfix); ok {
+			held[key] = true
+		} else {
+			keys[o.Key] = true
+		}
+	}
+
+	for _, o := range objects {
+		if !o.LastModified.Before(before) {
+			continue
+		}
+		// Keep the held data, and the holds of existing data.
+		key, _, isHold := strings.Cut(o.Key, holdsInfix)
+		if (isHold && keys[key]) || (!isHold && held[key]) {
+			continue
+		}
+		if err := store.Delete(ctx, store.Ref(o.Key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunExpiration deletes the data stored under prefix and older than ttl from the store
+// periodically, until ctx is done. Each component sharing a store runs it for the prefix of the
+// data it offloads.
+func RunExpiration(ctx context.Context, store Store, prefix string, ttl time.Duration) {
+	logger := logging.FromContext(ctx)
+	interval := min(ttl/2, time.Hour)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := DeleteExpired(ctx, store, prefix, time.Now().Add(-ttl)); err != nil {
+				logger.Warnw("Failed to delete expired claim check data", zap.Error(err))
+			}
+		}
+	}
+}
+
+// holdKey returns the key of the hold of holder on the data stored under key.
+func holdKey(key, holder string) string {
+	h := sha256.Sum256([]byte(holder))
+	return key + holdsInfix + hex.EncodeToString(h[:])
+}
+
+// DataRef returns the DataRefExtension of the event, and false when it isn't set.
+func DataRef(event *cloudevents.Event) (string, bool) {
+	raw, ok := event.Extensions()[DataRefExtension]
+	if !ok {
+		return "", false
+	}
+	ref, err := cetypes.ToString(raw)
+	if err != nil {
+		return "", false
+	}
+	return ref, true
+}
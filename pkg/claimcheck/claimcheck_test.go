@@ -0,0 +1,321 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package claimcheck
+
+import (
+	"context"
+	"errors"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+func newEvent(t *testing.T, data string) *cloudevents.Event {
+	t.Helper()
+	e := cloudevents.NewEvent()
+	e.SetID("1")
+	e.SetType("type")
+	e.SetSource("source")
+	if err := e.SetData(cloudevents.TextPlain, data); err != nil {
+		t.Fatal(err)
+	}
+	return &e
+}
+
+func TestOffloadResolve(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	small := newEvent(t, "small")
+	ref, err := Offload(ctx, store, small, Prefix("brokers", "ns", "broker"), 10)
+	if err != nil || ref != "" {
+		t.Fatalf("Offload() = %q, %v, want \"\", nil", ref, err)
+	}
+	if _, ok := small.Extensions()[DataRefExtension]; ok {
+		t.Error("expected small event not to have a dataref")
+	}
+
+	data := strings.Repeat("x", 100)
+	large := newEvent(t, data)
+	ref, err = Offload(ctx, store, large, Prefix("brokers", "ns", "broker"), 10)
+	if err != nil || ref == "" {
+		t.Fatalf("Offload() = %q, %v, want a reference", ref, err)
+	}
+	if got, ok := large.Extensions()[DataRefExtension].(string); !ok || got != ref || !strings.HasPrefix(ref, "file://") || !strings.Contains(ref, "/brokers/ns/broker/") {
+		t.Errorf("unexpected dataref %v", large.Extensions()[DataRefExtension])
+	}
+	if len(large.Data()) != 0 {
+		t.Errorf("expected data to be offloaded, got %q", string(large.Data()))
+	}
+	if large.DataContentType() != cloudevents.TextPlain {
+		t.Errorf("expected datacontenttype to be kept, got %q", large.DataContentType())
+	}
+
+	if err := Resolve(ctx, store, large, Prefix("brokers", "ns", "broker")); err != nil {
+		t.Fatal("Resolve() =", err)
+	}
+	if got := string(large.Data()); got != data {
+		t.Errorf("expected resolved data %q, got %q", data, got)
+	}
+	if _, ok := large.Extensions()[DataRefExtension]; ok {
+		t.Error("expected dataref to be removed")
+	}
+}
+
+func TestResolveForeignReference(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := newEvent(t, "")
+	e.SetExtension(DataRefExtension, "https://example.com/data")
+	if err := Resolve(context.Background(), store, e, Prefix("brokers", "ns", "broker")); err != nil {
+		t.Fatal("Resolve() =", err)
+	}
+	if got := e.Extensions()[DataRefExtension]; got != "https://example.com/data" {
+		t.Errorf("expected foreign dataref to be kept, got %v", got)
+	}
+}
+
+func TestResolveNotFound(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := newEvent(t, "")
+	e.SetExtension(DataRefExtension, "file://"+dir+"/brokers/ns/broker/missing")
+	if err := Resolve(context.Background(), store, e, Prefix("brokers", "ns", "broker")); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Resolve() = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestResolveOtherOwner(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, prefix := range []string{Prefix("brokers", "other", "broker"), Prefix("brokers", "ns", "broker-2")} {
+		e := newEvent(t, strings.Repeat("x", 100))
+		if _, err := Offload(ctx, store, e, prefix, 10); err != nil {
+			t.Fatal("Offload() =", err)
+		}
+		if err := Resolve(ctx, store, e, Prefix("brokers", "ns", "broker")); !errors.Is(err, ErrForbidden) {
+			t.Errorf("Resolve() = %v, want %v", err, ErrForbidden)
+		}
+	}
+
+	e := newEvent(t, "")
+	e.SetExtension(DataRefExtension, "file://"+store.dir+"/brokers/ns/broker/../../other/broker/1")
+	if err := Resolve(ctx, store, e, Prefix("brokers", "ns", "broker")); !errors.Is(err, ErrForbidden) {
+		t.Errorf("Resolve() = %v, want %v", err, ErrForbidden)
+	}
+}
+
+func TestStrip(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	owned := newEvent(t, "")
+	owned.SetExtension(DataRefExtension, "file://"+store.dir+"/brokers/ns/broker/1")
+	if !Strip(store, owned) {
+		t.Error("expected a reference to the store to be stripped")
+	}
+	if _, ok := owned.Extensions()[DataRefExtension]; ok {
+		t.Error("expected dataref to be removed")
+	}
+
+	foreign := newEvent(t, "")
+	foreign.SetExtension(DataRefExtension, "https://example.com/data")
+	if Strip(store, foreign) {
+		t.Error("expected a foreign reference not to be stripped")
+	}
+}
+
+func TestFileStoreDelete(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oldRef, err := store.Put(ctx, "brokers/ns/broker/old", []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	newRef, err := store.Put(ctx, "brokers/ns/broker/new", []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherRef, err := store.Put(ctx, "deadlettersinks/ns/broker/old", []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-time.Hour)
+	for _, ref := range []string{oldRef, otherRef} {
+		p, _ := store.refPath(ref)
+		if err := os.Chtimes(p, old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := DeleteExpired(ctx, store, Prefix("brokers", "", ""), time.Now().Add(-time.Minute)); err != nil {
+		t.Fatal("DeleteExpired() =", err)
+	}
+	if _, err := store.Get(ctx, oldRef); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() of expired data = %v, want %v", err, ErrNotFound)
+	}
+	if _, err := store.Get(ctx, newRef); err != nil {
+		t.Errorf("Get() of recent data = %v", err)
+	}
+	if _, err := store.Get(ctx, otherRef); err != nil {
+		t.Errorf("Get() of expired data of another owner = %v", err)
+	}
+
+	if err := store.Delete(ctx, newRef); err != nil {
+		t.Fatal("Delete() =", err)
+	}
+	if _, err := store.Get(ctx, newRef); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() after Delete() = %v, want %v", err, ErrNotFound)
+	}
+	if err := store.Delete(ctx, newRef); err != nil {
+		t.Errorf("Delete() of missing data = %v", err)
+	}
+}
+
+func TestDeleteExpiredHeld(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	heldRef, err := store.Put(ctx, "brokers/ns/broker/held", []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	releasedRef, err := store.Put(ctx, "brokers/ns/broker/released", []byte("data"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ref := range []string{heldRef, releasedRef} {
+		if err := Hold(ctx, store, ref, "deadlettersinks/ns/dls/1"); err != nil {
+			t.Fatal("Hold() =", err)
+		}
+	}
+	if err := Release(ctx, store, releasedRef, "deadlettersinks/ns/dls/1"); err != nil {
+		t.Fatal("Release() =", err)
+	}
+	if err := Hold(ctx, store, "https://example.com/data", "deadlettersinks/ns/dls/1"); err != nil {
+		t.Error("Hold() of data outside of the store =", err)
+	}
+
+	// Everything stored so far is expired.
+	if err := DeleteExpired(ctx, store, Prefix("brokers", "", ""), time.Now().Add(time.Minute)); err != nil {
+		t.Fatal("DeleteExpired() =", err)
+	}
+	if _, err := store.Get(ctx, heldRef); err != nil {
+		t.Errorf("Get() of held data = %v", err)
+	}
+	if _, err := store.Get(ctx, releasedRef); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() of released data = %v, want %v", err, ErrNotFound)
+	}
+
+	// Holds of deleted data expire as well.
+	if err := store.Delete(ctx, heldRef); err != nil {
+		t.Fatal("Delete() =", err)
+	}
+	if err := DeleteExpired(ctx, store, Prefix("brokers", "", ""), time.Now().Add(time.Minute)); err != nil {
+		t.Fatal("DeleteExpired() =", err)
+	}
+	if objects, err := store.List(ctx, ""); err != nil || len(objects) != 0 {
+		t.Errorf("expected every object to be deleted, got %v, %v", objects, err)
+	}
+}
+
+func TestFileStoreList(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"ns/dls/1", "ns/dls/2", "ns/dls-2/1", "ns/other/1"} {
+		if _, err := store.Put(ctx, key, []byte("data")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	objects, err := store.List(ctx, "ns/dls/")
+	if err != nil {
+		t.Fatal("List() =", err)
+	}
+	var keys []string
+	for _, o := range objects {
+		keys = append(keys, o.Key)
+		if o.LastModified.IsZero() {
+			t.Errorf("missing LastModified for %s", o.Key)
+		}
+	}
+	sort.Strings(keys)
+	if want := []string{"ns/dls/1", "ns/dls/2"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("List() keys = %v, want %v", keys, want)
+	}
+
+	if objects, err := store.List(ctx, "ns/missing/"); err != nil || len(objects) != 0 {
+		t.Errorf("List() of missing prefix = %v, %v", objects, err)
+	}
+}
+
+func TestFileStoreInvalidKey(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Put(context.Background(), "../outside", []byte("data")); err == nil {
+		t.Error("expected error for a key outside of the store directory")
+	}
+}
+
+func TestNewStore(t *testing.T) {
+	if s, err := NewStore(EnvConfig{}); s != nil || err != nil {
+		t.Errorf("NewStore() = %v, %v, want nil, nil", s, err)
+	}
+	if _, err := NewStore(EnvConfig{StoreType: "unknown"}); err == nil {
+		t.Error("expected error for unknown store type")
+	}
+	if _, err := NewStore(EnvConfig{StoreType: StoreTypeS3}); err == nil {
+		t.Error("expected error for s3 store without endpoint")
+	}
+	if s, err := NewStore(EnvConfig{StoreType: StoreTypeFilesystem, FilesystemPath: t.TempDir()}); s == nil || err != nil {
+		t.Errorf("NewStore() = %v, %v, want a filesystem store", s, err)
+	}
+}
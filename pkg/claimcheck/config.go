@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package claimcheck
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	StoreTypeFilesystem = "filesystem"
+	StoreTypeS3         = "s3"
+)
+
+// EnvConfig is the configuration of the claim check store of a data plane component, read from
+// environment variables. No store is configured when StoreType is empty.
+type EnvConfig struct {
+	StoreType string `envconfig:"CLAIM_CHECK_STORE"`
+	// TTL is the time after which the data offloaded by the component is deleted, unless it
+	// is held, events still referencing it can't be resolved anymore. Data is never deleted
+	// when it is 0.
+	TTL time.Duration `envconfig:"CLAIM_CHECK_TTL" default:"24h"`
+
+	FilesystemPath string `envconfig:"CLAIM_CHECK_FILESYSTEM_PATH" default:"/var/run/knative/claim-check"`
+
+	S3Endpoint        string `envconfig:"CLAIM_CHECK_S3_ENDPOINT"`
+	S3Bucket          string `envconfig:"CLAIM_CHECK_S3_BUCKET"`
+	S3Region          string `envconfig:"CLAIM_CHECK_S3_REGION"`
+	S3AccessKeyID     string `envconfig:"CLAIM_CHECK_S3_ACCESS_KEY_ID"`
+	S3SecretAccessKey string `envconfig:"CLAIM_CHECK_S3_SECRET_ACCESS_KEY"`
+}
+
+// NewStore creates the Store configured by env, it returns nil when no store is configured.
+func NewStore(env EnvConfig) (Store, error) {
+	switch env.StoreType {
+	case "":
+		return nil, nil
+	case StoreTypeFilesystem:
+		s, err := NewFileStore(env.FilesystemPath)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	case StoreTypeS3:
+		s, err := NewS3Store(env.S3Endpoint, env.S3Bucket, env.S3Region, env.S3AccessKeyID, env.S3SecretAccessKey)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	default:
+		return nil, fmt.Errorf("unknown claim check store type %q", env.StoreType)
+	}
+}
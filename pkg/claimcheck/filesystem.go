@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package claimcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const fileScheme = "file"
+
+// FileStore stores data in files of a directory, for example a mounted PersistentVolume shared by
+// the components offloading and resolving the data.
+type FileStore struct {
+	dir string
+}
+
+var _ Store = &FileStore{}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid directory %q: %w", dir, err)
+	}
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create directory %q: %w", dir, err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) Put(_ context.Context, key string, data []byte) (string, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o750); err != nil {
+		return "", err
+	}
+	// Write to a temporary file first, so that a partially written file is never read.
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, p); err != nil {
+		return "", err
+	}
+	return s.Ref(key), nil
+}
+
+func (s *FileStore) Get(_ context.Context, ref string) ([]byte, error) {
+	p, err := s.refPath(ref)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+func (s *FileStore) Delete(_ context.Context, ref string) error {
+	p, err := s.refPath(ref)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (s *FileStore) List(ctx context.Context, prefix string) ([]Object, error) {
+	// Only walk the directory holding the keys with the prefix.
+	dir := s.dir
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		p, err := s.path(prefix[:i+1])
+		if err != nil {
+			return nil, err
+		}
+		dir = p
+	}
+
+	var objects []Object
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if d.IsDir() || strings.HasSuffix(p, ".tmp") {
+			return nil
+		}
+		key := filepath.ToSlash(strings.TrimPrefix(p, s.dir+string(filepath.Separator)))
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := d.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		objects = append(objects, Object{Key: key, LastModified: info.ModTime()})
+		return nil
+	})
+	return objects, err
+}
+
+func (s *FileStore) Key(ref string) (string, bool) {
+	u, err := url.Parse(ref)
+	if err != nil || u.Scheme != fileScheme {
+		return "", false
+	}
+	p := filepath.Clean(filepath.FromSlash(u.Path))
+	if !strings.HasPrefix(p, s.dir+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(strings.TrimPrefix(p, s.dir+string(filepath.Separator))), true
+}
+
+func (s *FileStore) Ref(key string) string {
+	return (&url.URL{Scheme: fileScheme, Path: filepath.Join(s.dir, filepath.FromSlash(key))}).String()
+}
+
+// refPath returns the path of the file for a reference returned by Put.
+func (s *FileStore) refPath(ref string) (string, error) {
+	key, ok := s.Key(ref)
+	if !ok {
+		return "", fmt.Errorf("invalid reference %q", ref)
+	}
+	return s.path(key)
+}
+
+// path returns the path of the file for key, making sure it is within the directory of the store.
+func (s *FileStore) path(key string) (string, error) {
+	p := filepath.Join(s.dir, filepath.FromSlash(key))
+	if !strings.HasPrefix(p, s.dir+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid key %q", key)
+	}
+	return p, nil
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	scheme "knative.dev/eventing/pkg/client/clientset/versioned/scheme"
+)
+
+// DeadLetterSinksGetter has a method to return a DeadLetterSinkInterface.
+// A group's client should implement this interface.
+type DeadLetterSinksGetter interface {
+	DeadLetterSinks(namespace string) DeadLetterSinkInterface
+}
+
+// DeadLetterSinkInterface has methods to work with DeadLetterSink resources.
+type DeadLetterSinkInterface interface {
+	Create(ctx context.Context, deadLetterSink *sinksv1alpha1.DeadLetterSink, opts v1.CreateOptions) (*sinksv1alpha1.DeadLetterSink, error)
+	Update(ctx context.Context, deadLetterSink *sinksv1alpha1.DeadLetterSink, opts v1.UpdateOptions) (*sinksv1alpha1.DeadLetterSink, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, deadLetterSink *sinksv1alpha1.DeadLetterSink, opts v1.UpdateOptions) (*sinksv1alpha1.DeadLetterSink, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*sinksv1alpha1.DeadLetterSink, error)
+	List(ctx context.Context, opts v1.ListOptions) (*sinksv1alpha1.DeadLetterSinkList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sinksv1alpha1.DeadLetterSink, err error)
+	DeadLetterSinkExpansion
+}
+
+// deadLetterSinks implements DeadLetterSinkInterface
+type deadLetterSinks struct {
+	*gentype.ClientWithList[*sinksv1alpha1.DeadLetterSink, *sinksv1alpha1.DeadLetterSinkList]
+}
+
+// newDeadLetterSinks returns a DeadLetterSinks
+func newDeadLetterSinks(c *SinksV1alpha1Client, namespace string) *deadLetterSinks {
+	return &deadLetterSinks{
+		gentype.NewClientWithList[*sinksv1alpha1.DeadLetterSink, *sinksv1alpha1.DeadLetterSinkList](
+			"deadlettersinks",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *sinksv1alpha1.DeadLetterSink { return &sinksv1alpha1.DeadLetterSink{} },
+			func() *sinksv1alpha1.DeadLetterSinkList { return &sinksv1alpha1.DeadLetterSinkList{} },
+		),
+	}
+}
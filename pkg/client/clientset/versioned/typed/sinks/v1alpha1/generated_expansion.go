@@ -18,6 +18,8 @@ limitations under the License.
 
 package v1alpha1
 
+type DeadLetterSinkExpansion interface{}
+
 type IntegrationSinkExpansion interface{}
 
 type JobSinkExpansion interface{}
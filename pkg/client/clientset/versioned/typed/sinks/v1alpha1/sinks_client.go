@@ -28,6 +28,7 @@ import (
 
 type SinksV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	DeadLetterSinksGetter
 	IntegrationSinksGetter
 	JobSinksGetter
 }
@@ -37,6 +38,10 @@ type SinksV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *SinksV1alpha1Client) DeadLetterSinks(namespace string) DeadLetterSinkInterface {
+	return newDeadLetterSinks(c, namespace)
+}
+
 func (c *SinksV1alpha1Client) IntegrationSinks(namespace string) IntegrationSinkInterface {
 	return newIntegrationSinks(c, namespace)
 }
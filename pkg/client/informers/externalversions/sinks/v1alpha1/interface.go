@@ -24,6 +24,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// DeadLetterSinks returns a DeadLetterSinkInformer.
+	DeadLetterSinks() DeadLetterSinkInformer
 	// IntegrationSinks returns a IntegrationSinkInformer.
 	IntegrationSinks() IntegrationSinkInformer
 	// JobSinks returns a JobSinkInformer.
@@ -41,6 +43,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// DeadLetterSinks returns a DeadLetterSinkInformer.
+func (v *version) DeadLetterSinks() DeadLetterSinkInformer {
+	return &deadLetterSinkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // IntegrationSinks returns a IntegrationSinkInformer.
 func (v *version) IntegrationSinks() IntegrationSinkInformer {
 	return &integrationSinkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+)
+
+// DeadLetterSinkLister helps list DeadLetterSinks.
+// All objects returned here must be treated as read-only.
+type DeadLetterSinkLister interface {
+	// List lists all DeadLetterSinks in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*sinksv1alpha1.DeadLetterSink, err error)
+	// DeadLetterSinks returns an object that can list and get DeadLetterSinks.
+	DeadLetterSinks(namespace string) DeadLetterSinkNamespaceLister
+	DeadLetterSinkListerExpansion
+}
+
+// deadLetterSinkLister implements the DeadLetterSinkLister interface.
+type deadLetterSinkLister struct {
+	listers.ResourceIndexer[*sinksv1alpha1.DeadLetterSink]
+}
+
+// NewDeadLetterSinkLister returns a new DeadLetterSinkLister.
+func NewDeadLetterSinkLister(indexer cache.Indexer) DeadLetterSinkLister {
+	return &deadLetterSinkLister{listers.New[*sinksv1alpha1.DeadLetterSink](indexer, sinksv1alpha1.Resource("deadlettersink"))}
+}
+
+// DeadLetterSinks returns an object that can list and get DeadLetterSinks.
+func (s *deadLetterSinkLister) DeadLetterSinks(namespace string) DeadLetterSinkNamespaceLister {
+	return deadLetterSinkNamespaceLister{listers.NewNamespaced[*sinksv1alpha1.DeadLetterSink](s.ResourceIndexer, namespace)}
+}
+
+// DeadLetterSinkNamespaceLister helps list and get DeadLetterSinks.
+// All objects returned here must be treated as read-only.
+type DeadLetterSinkNamespaceLister interface {
+	// List lists all DeadLetterSinks in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*sinksv1alpha1.DeadLetterSink, err error)
+	// Get retrieves the DeadLetterSink from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*sinksv1alpha1.DeadLetterSink, error)
+	DeadLetterSinkNamespaceListerExpansion
+}
+
+// deadLetterSinkNamespaceLister implements the DeadLetterSinkNamespaceLister
+// interface.
+type deadLetterSinkNamespaceLister struct {
+	listers.ResourceIndexer[*sinksv1alpha1.DeadLetterSink]
+}
@@ -18,6 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
+// DeadLetterSinkListerExpansion allows custom methods to be added to
+// DeadLetterSinkLister.
+type DeadLetterSinkListerExpansion interface{}
+
+// DeadLetterSinkNamespaceListerExpansion allows custom methods to be added to
+// DeadLetterSinkNamespaceLister.
+type DeadLetterSinkNamespaceListerExpansion interface{}
+
 // IntegrationSinkListerExpansion allows custom methods to be added to
 // IntegrationSinkLister.
 type IntegrationSinkListerExpansion interface{}
@@ -0,0 +1,288 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventingtls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	coreinformersv1 "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"knative.dev/pkg/kmeta"
+)
+
+const (
+	// ClientCAIssuerName is the name of the cert-manager ClusterIssuer issuing the client
+	// certificates of eventing identities. It must be dedicated to client certificates, as
+	// servers authenticate any certificate it issued.
+	ClientCAIssuerName = "knative-eventing-client-ca-issuer"
+
+	// ClientCABundleLabelKey is the label key of the ConfigMaps, in the system namespace, holding
+	// the CA certificates of ClientCAIssuerName. Unlike trust bundles, used by clients to verify
+	// servers, client CA bundles are only used by servers to verify client certificates.
+	ClientCABundleLabelKey = "eventing.knative.dev/client-ca-bundle"
+	// ClientCABundleLabelValue is the label value of the client CA bundle ConfigMaps.
+	ClientCABundleLabelValue = "true"
+	// ClientCABundleLabelSelector is the ConfigMap label selector for client CA bundles.
+	ClientCABundleLabelSelector = ClientCABundleLabelKey + "=" + ClientCABundleLabelValue
+
+	// ClientCertificateLabelKey is the label key of the Secrets holding the client certificates
+	// of eventing identities.
+	//nolint:gosec // Not a credential, just a label
+	ClientCertificateLabelKey = "eventing.knative.dev/client-certificate"
+	// ClientCertificateLabelSelector is the Secret label selector for client certificates.
+	ClientCertificateLabelSelector = ClientCertificateLabelKey
+
+	// ClientCertificateMountPath is the path where the client certificate Secret is mounted in
+	// receive adapters, see GetClientCertificateFromDir.
+	ClientCertificateMountPath = "/etc/client-tls"
+)
+
+// ClientCABundleSelector is a selector for client CA bundle ConfigMaps.
+var ClientCABundleSelector = labels.SelectorFromSet(map[string]string{
+	ClientCABundleLabelKey: ClientCABundleLabelValue,
+})
+
+// ClientCertificateSecretName returns the name of the Secret holding the client certificate of
+// the given identity service account, the Secret is in the service account namespace.
+func ClientCertificateSecretName(serviceAccountName string) string {
+	return kmeta.ChildName(serviceAccountName, "-client-tls")
+}
+
+// ClientCertificateURI returns the URI SAN of the client certificate of the given identity
+// service account, it matches the subject of the OIDC tokens issued for the service account.
+func ClientCertificateURI(serviceAccount types.NamespacedName) string {
+	return fmt.Sprintf("system:serviceaccount:%s:%s", serviceAccount.Namespace, serviceAccount.Name)
+}
+
+// ClientCertificate is a certificate presented by clients to servers requesting
+// TLS client authentication (mutual TLS).
+type ClientCertificate struct {
+	// Name identifies the certificate, for example the namespaced name of the
+	// Secret holding it. Clients are cached per destination and certificate name.
+	Name string
+
+	// Get returns the certificate, it returns nil when no certificate is available.
+	Get func() (*tls.Certificate, error)
+}
+
+func (c *ClientCertificate) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	cert, err := c.Get()
+	if err != nil {
+		return nil, err
+	}
+	if cert == nil {
+		// An empty certificate tells the server that there is no client certificate.
+		return &tls.Certificate{}, nil
+	}
+	return cert, nil
+}
+
+// GetClientCertificateFromSecret returns a ClientCertificate that will automatically return
+// the latest certificate that is present in the provided secret.
+//
+// The secret is expected to have at least 2 keys in data: see TLSKey and TLSCrt constants for
+// knowing the key names.
+func GetClientCertificateFromSecret(ctx context.Context, informer coreinformersv1.SecretInformer, kube kubernetes.Interface, secret types.NamespacedName) *ClientCertificate {
+	load := watchCertificateFromSecret(ctx, informer, kube, secret)
+
+	return &ClientCertificate{
+		Name: secret.String(),
+		Get: func() (*tls.Certificate, error) {
+			return load(), nil
+		},
+	}
+}
+
+// GetClientCertificateFromDir returns a ClientCertificate loading the TLSCrt and TLSKey files
+// in the given directory, usually a mounted TLS Secret. The files are read on every handshake
+// so that rotated certificates are picked up, no certificate is presented while the files
+// don't exist.
+func GetClientCertificateFromDir(dir string) *ClientCertificate {
+	return &ClientCertificate{
+		Name: dir,
+		Get: func() (*tls.Certificate, error) {
+			cert, err := tls.LoadX509KeyPair(filepath.Join(dir, TLSCrt), filepath.Join(dir, TLSKey))
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			return &cert, nil
+		},
+	}
+}
+
+// ClientCertificates provides the client certificates of eventing identities, stored in the
+// Secrets named ClientCertificateSecretName in the namespace of the identity service account.
+type ClientCertificates struct {
+	lister corev1listers.SecretLister
+
+	lock  sync.Mutex
+	certs map[types.NamespacedName]loadedCertificate
+}
+
+type loadedCertificate struct {
+	resourceVersion string
+	cert            *tls.Certificate
+}
+
+// NewClientCertificates returns ClientCertificates reading the client certificate Secrets from
+// the given lister, usually filtered with ClientCertificateLabelSelector.
+func NewClientCertificates(lister corev1listers.SecretLister) *ClientCertificates {
+	return &ClientCertificates{
+		lister: lister,
+		certs:  make(map[types.NamespacedName]loadedCertificate),
+	}
+}
+
+// Get returns the client certificate of the given identity service account. The certificate
+// is nil when c is nil, no certificate is presented while the Secret doesn't exist.
+func (c *ClientCertificates) Get(serviceAccount types.NamespacedName) *ClientCertificate {
+	if c == nil {
+		return nil
+	}
+	secret := types.NamespacedName{
+		Namespace: serviceAccount.Namespace,
+		Name:      ClientCertificateSecretName(serviceAccount.Name),
+	}
+	return &ClientCertificate{
+		Name: secret.String(),
+		Get: func() (*tls.Certificate, error) {
+			return c.load(secret)
+		},
+	}
+}
+
+func (c *ClientCertificates) load(name types.NamespacedName) (*tls.Certificate, error) {
+	secret, err := c.lister.Secrets(name.Namespace).Get(name.Name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get client certificate secret %s: %w", name, err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if loaded, ok := c.certs[name]; ok && loaded.resourceVersion == secret.ResourceVersion {
+		return loaded.cert, nil
+	}
+
+	crt, crtOk := secret.Data[TLSCrt]
+	key, keyOk := secret.Data[TLSKey]
+	if !crtOk || !keyOk {
+		// The certificate hasn't been issued yet.
+		return nil, nil
+	}
+	cert, err := tls.X509KeyPair(crt, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse client certificate secret %s: %w", name, err)
+	}
+	c.certs[name] = loadedCertificate{resourceVersion: secret.ResourceVersion, cert: &cert}
+	return &cert, nil
+}
+
+// GetClientCAsFromBundles returns a function returning the CA certificates of the client CA
+// bundles, see ClientCABundleLabelKey, used by servers to verify client certificates. The pool
+// is only rebuilt when the client CA bundle ConfigMaps change.
+func GetClientCAsFromBundles(lister corev1listers.ConfigMapNamespaceLister) func() (*x509.CertPool, error) {
+	var (
+		lock    sync.Mutex
+		pool    *x509.CertPool
+		version string
+	)
+
+	return func() (*x509.CertPool, error) {
+		cms, err := lister.List(ClientCABundleSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list client CA bundle ConfigMaps: %w", err)
+		}
+		versions := make([]string, 0, len(cms))
+		for _, cm := range cms {
+			versions = append(versions, cm.Name+"@"+cm.ResourceVersion)
+		}
+		sort.Strings(versions)
+		v := strings.Join(versions, ",")
+
+		lock.Lock()
+		defer lock.Unlock()
+
+		if pool != nil && v == version {
+			return pool, nil
+		}
+
+		p := x509.NewCertPool()
+		for _, cm := range cms {
+			for _, d := range cm.Data {
+				p.AppendCertsFromPEM([]byte(d))
+			}
+			for _, d := range cm.BinaryData {
+				p.AppendCertsFromPEM(d)
+			}
+		}
+		pool, version = p, v
+		return pool, nil
+	}
+}
+
+// ClientCertSubject returns the subject of the verified client certificate of the given
+// connection, that is its URI SAN naming an identity service account, see
+// ClientCertificateURI. It returns an empty string when the client didn't present a verified
+// certificate, when the certificate isn't meant for client authentication, or when it doesn't
+// have exactly one URI SAN of the form system:serviceaccount:<namespace>:<name>.
+func ClientCertSubject(state *tls.ConnectionState) string {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return ""
+	}
+
+	cert := state.VerifiedChains[0][0]
+	if !slices.Contains(cert.ExtKeyUsage, x509.ExtKeyUsageClientAuth) || len(cert.URIs) != 1 {
+		return ""
+	}
+	subject := cert.URIs[0].String()
+	if !isServiceAccountSubject(subject) {
+		return ""
+	}
+	return subject
+}
+
+// isServiceAccountSubject returns whether subject is of the form
+// system:serviceaccount:<namespace>:<name>.
+func isServiceAccountSubject(subject string) bool {
+	parts := strings.Split(subject, ":")
+	if len(parts) != 4 || parts[0] != "system" || parts[1] != "serviceaccount" {
+		return false
+	}
+	return len(validation.IsDNS1123Label(parts[2])) == 0 && len(validation.IsDNS1123Subdomain(parts[3])) == 0
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventingtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestClientCertificateAuthentication(t *testing.T) {
+	ca, caKey := mustTestCA(t, "client-ca")
+	otherCA, otherCAKey := mustTestCA(t, "other-ca")
+	serverCA, serverCAKey := mustTestCA(t, "server-ca")
+
+	serverCert := mustTestCert(t, serverCA, serverCAKey, &x509.Certificate{
+		DNSNames:    []string{"localhost"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	identity, _ := url.Parse("system:serviceaccount:my-ns:my-source")
+	otherIdentity, _ := url.Parse("system:serviceaccount:my-ns:other")
+	spiffeID, _ := url.Parse("spiffe://cluster.local/ns/my-ns/sa/my-source")
+	invalidIdentity, _ := url.Parse("system:serviceaccount:my-ns")
+
+	serverConfig := NewDefaultServerConfig()
+	serverConfig.GetCertificate = func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		return serverCert, nil
+	}
+	serverConfig.ClientCAs = func() (*x509.CertPool, error) {
+		p := x509.NewCertPool()
+		p.AddCert(ca)
+		return p, nil
+	}
+	tlsConfig, err := GetTLSServerConfig(serverConfig)
+	if err != nil {
+		t.Fatal("GetTLSServerConfig() =", err)
+	}
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(ClientCertSubject(r.TLS)))
+	}))
+	server.TLS = tlsConfig
+	server.StartTLS()
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		clientCert  *ClientCertificate
+		wantSubject string
+		wantErr     bool
+	}{{
+		name: "no client certificate",
+	}, {
+		name: "client certificate without certificate",
+		clientCert: &ClientCertificate{
+			Name: "empty",
+			Get:  func() (*tls.Certificate, error) { return nil, nil },
+		},
+	}, {
+		name:        "service account URI SAN",
+		clientCert:  testClientCertificate(mustTestCert(t, ca, caKey, &x509.Certificate{URIs: []*url.URL{identity}})),
+		wantSubject: "system:serviceaccount:my-ns:my-source",
+	}, {
+		name:       "DNS SAN",
+		clientCert: testClientCertificate(mustTestCert(t, ca, caKey, &x509.Certificate{DNSNames: []string{"system:serviceaccount:my-ns:my-source"}})),
+	}, {
+		name:       "URI SAN not naming a service account",
+		clientCert: testClientCertificate(mustTestCert(t, ca, caKey, &x509.Certificate{URIs: []*url.URL{spiffeID}})),
+	}, {
+		name:       "invalid service account URI SAN",
+		clientCert: testClientCertificate(mustTestCert(t, ca, caKey, &x509.Certificate{URIs: []*url.URL{invalidIdentity}})),
+	}, {
+		name:       "several URI SANs",
+		clientCert: testClientCertificate(mustTestCert(t, ca, caKey, &x509.Certificate{URIs: []*url.URL{identity, otherIdentity}})),
+	}, {
+		name: "no client authentication usage",
+		clientCert: testClientCertificate(mustTestCert(t, ca, caKey, &x509.Certificate{
+			URIs:        []*url.URL{identity},
+			ExtKeyUsage: []x509.ExtKeyUsage{},
+		})),
+	}, {
+		name:       "untrusted client certificate",
+		clientCert: testClientCertificate(mustTestCert(t, otherCA, otherCAKey, &x509.Certificate{URIs: []*url.URL{identity}})),
+		wantErr:    true,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientConfig := NewDefaultClientConfig()
+			clientConfig.ClientCertificate = tc.clientCert
+			cfg, err := GetTLSClientConfig(clientConfig)
+			if err != nil {
+				t.Fatal("GetTLSClientConfig() =", err)
+			}
+			cfg.RootCAs = x509.NewCertPool()
+			cfg.RootCAs.AddCert(serverCA)
+
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: cfg}}
+			resp, err := client.Get(server.URL)
+			if tc.wantErr {
+				if err == nil {
+					resp.Body.Close()
+					t.Fatal("expected request to fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal("request failed:", err)
+			}
+			defer resp.Body.Close()
+
+			body, _ := io.ReadAll(resp.Body)
+			if got := string(body); got != tc.wantSubject {
+				t.Errorf("ClientCertSubject() = %q, want %q", got, tc.wantSubject)
+			}
+		})
+	}
+}
+
+func TestClientCertificates(t *testing.T) {
+	ca, caKey := mustTestCA(t, "client-ca")
+	sa := types.NamespacedName{Namespace: "my-ns", Name: "my-trigger-oidc-identity"}
+	uri, _ := url.Parse(ClientCertificateURI(sa))
+	cert := mustTestCert(t, ca, caKey, &x509.Certificate{URIs: []*url.URL{uri}})
+
+	if got := (*ClientCertificates)(nil).Get(sa); got != nil {
+		t.Errorf("nil ClientCertificates Get() = %v, want nil", got)
+	}
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	certs := NewClientCertificates(corev1listers.NewSecretLister(indexer))
+
+	clientCert := certs.Get(sa)
+	if got, err := clientCert.Get(); err != nil || got != nil {
+		t.Fatalf("Get() without Secret = %v, %v, want nil, nil", got, err)
+	}
+
+	key, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       sa.Namespace,
+			Name:            ClientCertificateSecretName(sa.Name),
+			ResourceVersion: "1",
+		},
+		Data: map[string][]byte{
+			TLSCrt: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}),
+			TLSKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: key}),
+		},
+	}
+	if err := indexer.Add(secret); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := clientCert.Get()
+	if err != nil {
+		t.Fatal("Get() =", err)
+	}
+	parsed, err := x509.ParseCertificate(got.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed.URIs) != 1 || parsed.URIs[0].String() != "system:serviceaccount:my-ns:my-trigger-oidc-identity" {
+		t.Errorf("Get() URIs = %v, want the identity URI", parsed.URIs)
+	}
+	if again, _ := clientCert.Get(); again != got {
+		t.Error("Get() parsed the unchanged Secret again")
+	}
+}
+
+func TestGetClientCAsFromBundles(t *testing.T) {
+	clientCA, _ := mustTestCA(t, "client-ca")
+	serverCA, _ := mustTestCA(t, "server-ca")
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, cm := range []*corev1.ConfigMap{{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "knative-eventing",
+			Name:            "client-ca-bundle",
+			ResourceVersion: "1",
+			Labels:          map[string]string{ClientCABundleLabelKey: ClientCABundleLabelValue},
+		},
+		Data: map[string]string{"ca.crt": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clientCA.Raw}))},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "knative-eventing",
+			Name:            "trust-bundle",
+			ResourceVersion: "1",
+			Labels:          map[string]string{TrustBundleLabelKey: TrustBundleLabelValue},
+		},
+		Data: map[string]string{"ca.crt": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: serverCA.Raw}))},
+	}} {
+		if err := indexer.Add(cm); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	clientCAs := GetClientCAsFromBundles(corev1listers.NewConfigMapLister(indexer).ConfigMaps("knative-eventing"))
+	pool, err := clientCAs()
+	if err != nil {
+		t.Fatal("GetClientCAsFromBundles() =", err)
+	}
+	if !pool.Equal(func() *x509.CertPool { p := x509.NewCertPool(); p.AddCert(clientCA); return p }()) {
+		t.Error("expected only the client CA bundle to be trusted for client certificates")
+	}
+	if again, _ := clientCAs(); again != pool {
+		t.Error("expected the pool to be reused while the bundles don't change")
+	}
+}
+
+func testClientCertificate(cert *tls.Certificate) *ClientCertificate {
+	return &ClientCertificate{
+		Name: "test",
+		Get:  func() (*tls.Certificate, error) { return cert, nil },
+	}
+}
+
+func mustTestCA(t *testing.T, cn string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func mustTestCert(t *testing.T, ca *x509.Certificate, caKey *ecdsa.PrivateKey, tmpl *x509.Certificate) *tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl.SerialNumber = big.NewInt(time.Now().UnixNano())
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	tmpl.KeyUsage = x509.KeyUsageDigitalSignature
+	if tmpl.ExtKeyUsage == nil {
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
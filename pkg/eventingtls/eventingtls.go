@@ -25,6 +25,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"sync/atomic"
 
 	"go.uber.org/zap"
@@ -53,6 +54,8 @@ const (
 	IMCDispatcherServerTLSSecretName = "imc-dispatcher-server-tls" //nolint:gosec // This is not a hardcoded credential
 	// JobSinkDispatcherServerTLSSecretName is the name of the tls secret for the job sink dispatcher server
 	JobSinkDispatcherServerTLSSecretName = "job-sink-server-tls" //nolint:gosec // This is not a hardcoded credential
+	// DeadLetterSinkServerTLSSecretName is the name of the tls secret for the dead letter sink server
+	DeadLetterSinkServerTLSSecretName = "dead-letter-sink-server-tls" //nolint:gosec // This is not a hardcoded credential
 	// BrokerFilterServerTLSSecretName is the name of the tls secret for the broker filter server
 	BrokerFilterServerTLSSecretName = "mt-broker-filter-server-tls" //nolint:gosec // This is not a hardcoded credential
 	// BrokerIngressServerTLSSecretName is the name of the tls secret for the broker ingress server
@@ -68,6 +71,17 @@ type ClientConfig struct {
 
 	// TrustBundleConfigMapLister is a ConfigMap lister to list trust bundles ConfigMaps.
 	TrustBundleConfigMapLister corev1listers.ConfigMapNamespaceLister
+
+	// ClientCertificate is the certificate presented to servers requesting
+	// TLS client authentication.
+	ClientCertificate *ClientCertificate
+
+	// ClientCertificates provides the client certificates of eventing identities,
+	// presented instead of ClientCertificate when sending on behalf of an identity.
+	ClientCertificates *ClientCertificates
+
+	// TLSPolicy overrides the TLS configuration for the destination.
+	TLSPolicy *TLSPolicy
 }
 
 type ServerConfig struct {
@@ -79,6 +93,11 @@ type ServerConfig struct {
 	// retrieved from NameToCertificate. If NameToCertificate is nil, the
 	// best element of Certificates will be used.
 	GetCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error)
+
+	// ClientCAs returns the CA certificates used to verify client certificates.
+	// When set, clients may present a certificate, which is verified against the
+	// returned pool on every handshake, see ClientCertSubject.
+	ClientCAs func() (*x509.CertPool, error)
 }
 
 // GetCertificate returns a Certificate based on the given
@@ -96,6 +115,16 @@ type GetCertificate func(*tls.ClientHelloInfo) (*tls.Certificate, error)
 // The secret is expected to have at least 2 keys in data: see TLSKey and TLSCrt constants for
 // knowing the key names.
 func GetCertificateFromSecret(ctx context.Context, informer coreinformersv1.SecretInformer, kube kubernetes.Interface, secret types.NamespacedName) GetCertificate {
+	load := watchCertificateFromSecret(ctx, informer, kube, secret)
+
+	return func(info *tls.ClientHelloInfo) (*tls.Certificate, error) {
+		return load(), nil
+	}
+}
+
+// watchCertificateFromSecret returns a function that will automatically return the latest
+// certificate that is present in the provided secret, or nil if there is none.
+func watchCertificateFromSecret(ctx context.Context, informer coreinformersv1.SecretInformer, kube kubernetes.Interface, secret types.NamespacedName) func() *tls.Certificate {
 
 	certHolder := atomic.Value{}
 
@@ -150,12 +179,12 @@ func GetCertificateFromSecret(ctx context.Context, informer coreinformersv1.Secr
 		store(firstValue)
 	}
 
-	return func(info *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return func() *tls.Certificate {
 		cert := certHolder.Load()
 		if cert == nil {
-			return nil, nil
+			return nil
 		}
-		return cert.(*tls.Certificate), nil
+		return cert.(*tls.Certificate)
 	}
 }
 
@@ -177,6 +206,14 @@ func GetTLSClientConfig(config ClientConfig) (*tls.Config, error) {
 	}
 
 	cfg.RootCAs = pool
+	if config.ClientCertificate != nil {
+		cfg.GetClientCertificate = config.ClientCertificate.getClientCertificate
+	}
+	if config.TLSPolicy != nil {
+		if err := config.TLSPolicy.apply(cfg); err != nil {
+			return nil, fmt.Errorf("failed to apply TLS policy %q: %w", config.TLSPolicy.Name, err)
+		}
+	}
 	return cfg, nil
 }
 
@@ -191,6 +228,34 @@ func GetTLSServerConfig(config ServerConfig) (*tls.Config, error) {
 	}
 
 	cfg.GetCertificate = config.GetCertificate
+	if config.ClientCAs != nil {
+		// Client certificates are optional at the TLS layer, so that the requirement
+		// can follow the feature flags without restarting the server.
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+		var (
+			lock       sync.Mutex
+			clientCfg  *tls.Config
+			clientCAs  *x509.CertPool
+			serverBase = cfg.Clone()
+		)
+		cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			pool, err := config.ClientCAs()
+			if err != nil {
+				return nil, fmt.Errorf("failed to load client CAs: %w", err)
+			}
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			// Only clone the configuration when the client CAs changed.
+			if clientCfg == nil || clientCAs != pool {
+				clientCfg = serverBase.Clone()
+				clientCfg.ClientCAs = pool
+				clientCAs = pool
+			}
+			return clientCfg, nil
+		}
+	}
 	return cfg, nil
 }
 
@@ -236,6 +301,24 @@ func loadCertPool(config ClientConfig) (*x509.CertPool, error) {
 		return nil, err
 	}
 
+	if err := appendTrustBundles(p, config.TrustBundleConfigMapLister); err != nil {
+		return p, err
+	}
+
+	if config.CACerts == nil || *config.CACerts == "" {
+		return p, nil
+	}
+
+	if ok := p.AppendCertsFromPEM([]byte(*config.CACerts)); !ok {
+		return p, fmt.Errorf("failed to append CA certs from PEM")
+	}
+
+	return p, nil
+}
+
+// appendTrustBundles appends the certs of the knative trust bundles in TrustBundleMountPath
+// and the trust bundles ConfigMaps to the given pool.
+func appendTrustBundles(p *x509.CertPool, lister corev1listers.ConfigMapNamespaceLister) error {
 	_ = filepath.WalkDir(TrustBundleMountPath, func(path string, d fs.DirEntry, err error) error {
 		if err != nil || d.IsDir() {
 			return nil
@@ -250,10 +333,10 @@ func loadCertPool(config ClientConfig) (*x509.CertPool, error) {
 		return nil
 	})
 
-	if config.TrustBundleConfigMapLister != nil {
-		cms, err := config.TrustBundleConfigMapLister.List(TrustBundleSelector)
+	if lister != nil {
+		cms, err := lister.List(TrustBundleSelector)
 		if err != nil {
-			return p, fmt.Errorf("failed to list trust bundle ConfigMaps: %w", err)
+			return fmt.Errorf("failed to list trust bundle ConfigMaps: %w", err)
 		}
 		for _, cm := range cms {
 			for _, v := range cm.Data {
@@ -265,13 +348,5 @@ func loadCertPool(config ClientConfig) (*x509.CertPool, error) {
 		}
 	}
 
-	if config.CACerts == nil || *config.CACerts == "" {
-		return p, nil
-	}
-
-	if ok := p.AppendCertsFromPEM([]byte(*config.CACerts)); !ok {
-		return p, fmt.Errorf("failed to append CA certs from PEM")
-	}
-
-	return p, nil
+	return nil
 }
@@ -0,0 +1,266 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventingtls
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+)
+
+const (
+	// CertificateExpiryAnnotationKey is the status annotation containing the expiry time, in
+	// RFC 3339 format, of the certificate expiring first among the certificates of a resource.
+	CertificateExpiryAnnotationKey = "eventing.knative.dev/certificate-expiry"
+
+	// CertificatesValidConditionType is a non-terminal condition which is False, with a
+	// warning severity, when a certificate expires within the threshold or has expired.
+	CertificatesValidConditionType apis.ConditionType = "CertificatesValid"
+
+	// CertificateExpiringReason is the condition and event reason for certificates expiring
+	// within the threshold.
+	CertificateExpiringReason = "CertificateExpiring"
+
+	// CertificateExpiredReason is the condition and event reason for expired certificates.
+	CertificateExpiredReason = "CertificateExpired"
+
+	// CertificateExpiryThresholdEnv is the environment variable configuring the duration before
+	// the expiry of a certificate from which the CertificatesValid condition is False.
+	CertificateExpiryThresholdEnv = "CERTIFICATE_EXPIRY_THRESHOLD"
+
+	// DefaultCertificateExpiryThreshold is the default certificate expiry threshold.
+	DefaultCertificateExpiryThreshold = 30 * 24 * time.Hour
+
+	certificateExpiryScopeName = "knative.dev/eventing/pkg/eventingtls"
+)
+
+// CertificateExpiry is the expiry of a certificate.
+type CertificateExpiry struct {
+	// Source identifies where the certificate comes from, for example the namespaced
+	// name of a Secret.
+	Source string
+	// Subject is the subject of the certificate.
+	Subject string
+	// NotAfter is the expiry time of the certificate.
+	NotAfter time.Time
+}
+
+// SecretCertificateExpiry returns the expiry of the certificate expiring first among the
+// TLSCrt and SecretCACert certificates of the given Secret, or nil when there is no certificate.
+func SecretCertificateExpiry(secret *corev1.Secret) (*CertificateExpiry, error) {
+	source := secret.Namespace + "/" + secret.Name
+	var expiries []*CertificateExpiry
+	for _, key := range []string{TLSCrt, SecretCACert} {
+		exp, err := certificateExpiryFromPEM(source, secret.Data[key])
+		if err != nil {
+			return nil, err
+		}
+		expiries = append(expiries, exp)
+	}
+	return EarliestCertificateExpiry(expiries...), nil
+}
+
+// TrustBundlesCertificateExpiry returns the expiry of the certificate expiring first among the
+// certificates of the trust bundle ConfigMaps, or nil when there is no certificate.
+func TrustBundlesCertificateExpiry(lister corev1listers.ConfigMapNamespaceLister) (*CertificateExpiry, error) {
+	cms, err := lister.List(TrustBundleSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trust bundle ConfigMaps: %w", err)
+	}
+
+	var expiries []*CertificateExpiry
+	for _, cm := range cms {
+		source := cm.Namespace + "/" + cm.Name
+		for _, v := range cm.Data {
+			exp, err := certificateExpiryFromPEM(source, []byte(v))
+			if err != nil {
+				return nil, err
+			}
+			expiries = append(expiries, exp)
+		}
+		for _, v := range cm.BinaryData {
+			exp, err := certificateExpiryFromPEM(source, v)
+			if err != nil {
+				return nil, err
+			}
+			expiries = append(expiries, exp)
+		}
+	}
+	return EarliestCertificateExpiry(expiries...), nil
+}
+
+// ServerCertificateExpiry returns the expiry of the certificate expiring first among the
+// certificates of the given server TLS Secret and, when the lister is not nil, of the trust bundles.
+func ServerCertificateExpiry(secrets corev1listers.SecretNamespaceLister, secretName string, trustBundles corev1listers.ConfigMapNamespaceLister) (*CertificateExpiry, error) {
+	secret, err := secrets.Get(secretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server TLS secret %s: %w", secretName, err)
+	}
+	exp, err := SecretCertificateExpiry(secret)
+	if err != nil {
+		return nil, err
+	}
+	if trustBundles == nil {
+		return exp, nil
+	}
+	bundlesExp, err := TrustBundlesCertificateExpiry(trustBundles)
+	if err != nil {
+		return nil, err
+	}
+	return EarliestCertificateExpiry(exp, bundlesExp), nil
+}
+
+// EarliestCertificateExpiry returns the expiry of the certificate expiring first, nil expiries are ignored.
+func EarliestCertificateExpiry(expiries ...*CertificateExpiry) *CertificateExpiry {
+	var earliest *CertificateExpiry
+	for _, exp := range expiries {
+		if exp != nil && (earliest == nil || exp.NotAfter.Before(earliest.NotAfter)) {
+			earliest = exp
+		}
+	}
+	return earliest
+}
+
+func certificateExpiryFromPEM(source string, data []byte) (*CertificateExpiry, error) {
+	var expiries []*CertificateExpiry
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate from %s: %w", source, err)
+		}
+		expiries = append(expiries, &CertificateExpiry{
+			Source:   source,
+			Subject:  cert.Subject.String(),
+			NotAfter: cert.NotAfter,
+		})
+	}
+	return EarliestCertificateExpiry(expiries...), nil
+}
+
+// CertificateExpiryMonitor reports the expiry of the certificates of resources on their status,
+// with Kubernetes Events and with a gauge of the seconds until expiry.
+type CertificateExpiryMonitor struct {
+	threshold time.Duration
+	gauge     metric.Float64Gauge
+	now       func() time.Time
+}
+
+// NewCertificateExpiryMonitor creates a CertificateExpiryMonitor using the threshold configured
+// with the CertificateExpiryThresholdEnv environment variable.
+func NewCertificateExpiryMonitor(mp metric.MeterProvider) (*CertificateExpiryMonitor, error) {
+	threshold := DefaultCertificateExpiryThreshold
+	if v, ok := os.LookupEnv(CertificateExpiryThresholdEnv); ok && v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return nil, fmt.Errorf("invalid %s %q, expected a positive duration", CertificateExpiryThresholdEnv, v)
+		}
+		threshold = d
+	}
+
+	gauge, err := mp.Meter(certificateExpiryScopeName).Float64Gauge(
+		"kn.eventing.certificate.expiry",
+		metric.WithDescription("The time until the expiry of the certificate expiring first among the certificates of a resource"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CertificateExpiryMonitor{
+		threshold: threshold,
+		gauge:     gauge,
+		now:       time.Now,
+	}, nil
+}
+
+// Reconcile reports the given certificate expiry of the resource obj, of kind gvk, on its status
+// and records the time until expiry. A warning Event is emitted when the CertificatesValid
+// condition becomes False. When exp is nil, the annotation and condition are removed from the status.
+//
+// A nil CertificateExpiryMonitor doesn't report anything.
+func (m *CertificateExpiryMonitor) Reconcile(ctx context.Context, gvk schema.GroupVersionKind, obj kmeta.Accessor, status *duckv1.Status, conditionSet apis.ConditionSet, exp *CertificateExpiry) {
+	if m == nil {
+		return
+	}
+
+	manager := conditionSet.Manage(status)
+	if exp == nil {
+		delete(status.Annotations, CertificateExpiryAnnotationKey)
+		_ = manager.ClearCondition(CertificatesValidConditionType)
+		return
+	}
+
+	if status.Annotations == nil {
+		status.Annotations = make(map[string]string, 1)
+	}
+	status.Annotations[CertificateExpiryAnnotationKey] = exp.NotAfter.UTC().Format(time.RFC3339)
+
+	remaining := exp.NotAfter.Sub(m.now())
+	m.gauge.Record(ctx, remaining.Seconds(), metric.WithAttributes(
+		attribute.String("kn.resource.kind", gvk.Kind),
+		attribute.String("kn.resource.name", obj.GetName()),
+		attribute.String("kn.resource.namespace", obj.GetNamespace()),
+		attribute.String("kn.certificate.source", exp.Source),
+	))
+
+	cond := apis.Condition{
+		Type:     CertificatesValidConditionType,
+		Status:   corev1.ConditionFalse,
+		Severity: apis.ConditionSeverityWarning,
+	}
+	switch {
+	case remaining <= 0:
+		cond.Reason = CertificateExpiredReason
+		cond.Message = fmt.Sprintf("Certificate %q from %s expired at %s", exp.Subject, exp.Source, exp.NotAfter.UTC().Format(time.RFC3339))
+	case remaining <= m.threshold:
+		cond.Reason = CertificateExpiringReason
+		cond.Message = fmt.Sprintf("Certificate %q from %s expires at %s", exp.Subject, exp.Source, exp.NotAfter.UTC().Format(time.RFC3339))
+	default:
+		manager.MarkTrue(CertificatesValidConditionType)
+		return
+	}
+
+	prev := manager.GetCondition(CertificatesValidConditionType)
+	manager.SetCondition(cond)
+	if prev != nil && prev.Status == cond.Status && prev.Reason == cond.Reason {
+		return
+	}
+	if recorder := controller.GetEventRecorder(ctx); recorder != nil {
+		recorder.Event(obj, corev1.EventTypeWarning, cond.Reason, cond.Message)
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventingtls
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/metric/noop"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/controller"
+)
+
+func TestSecretCertificateExpiry(t *testing.T) {
+	ca, caKey := mustTestCA(t, "server-ca")
+	cert := mustTestCert(t, ca, caKey, &x509.Certificate{DNSNames: []string{"server"}})
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "knative-eventing", Name: "server-tls"},
+		Data: map[string][]byte{
+			TLSCrt:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}),
+			SecretCACert: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw}),
+		},
+	}
+
+	exp, err := SecretCertificateExpiry(secret)
+	if err != nil {
+		t.Fatal("SecretCertificateExpiry() =", err)
+	}
+	if exp == nil {
+		t.Fatal("SecretCertificateExpiry() = nil, want the certificate expiry")
+	}
+	if exp.Source != "knative-eventing/server-tls" {
+		t.Errorf("Source = %q, want knative-eventing/server-tls", exp.Source)
+	}
+	if exp.NotAfter.After(ca.NotAfter) {
+		t.Errorf("NotAfter = %v, want the earliest expiry, before %v", exp.NotAfter, ca.NotAfter)
+	}
+
+	exp, err = SecretCertificateExpiry(&corev1.Secret{})
+	if err != nil || exp != nil {
+		t.Errorf("SecretCertificateExpiry() without certificates = %v, %v, want nil, nil", exp, err)
+	}
+
+	secret.Data[TLSCrt] = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("invalid")})
+	if _, err := SecretCertificateExpiry(secret); err == nil {
+		t.Error("SecretCertificateExpiry() with an invalid certificate, want an error")
+	}
+}
+
+func TestCertificateExpiryMonitorReconcile(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		exp        *CertificateExpiry
+		prev       *apis.Condition
+		wantStatus corev1.ConditionStatus
+		wantReason string
+		wantEvent  bool
+	}{{
+		name:       "valid certificate",
+		exp:        &CertificateExpiry{Source: "ns/secret", Subject: "CN=a", NotAfter: now.Add(365 * 24 * time.Hour)},
+		wantStatus: corev1.ConditionTrue,
+	}, {
+		name:       "expiring certificate",
+		exp:        &CertificateExpiry{Source: "ns/secret", Subject: "CN=a", NotAfter: now.Add(24 * time.Hour)},
+		wantStatus: corev1.ConditionFalse,
+		wantReason: CertificateExpiringReason,
+		wantEvent:  true,
+	}, {
+		name:       "expiring certificate already reported",
+		exp:        &CertificateExpiry{Source: "ns/secret", Subject: "CN=a", NotAfter: now.Add(24 * time.Hour)},
+		prev:       &apis.Condition{Type: CertificatesValidConditionType, Status: corev1.ConditionFalse, Reason: CertificateExpiringReason},
+		wantStatus: corev1.ConditionFalse,
+		wantReason: CertificateExpiringReason,
+	}, {
+		name:       "expired certificate",
+		exp:        &CertificateExpiry{Source: "ns/secret", Subject: "CN=a", NotAfter: now.Add(-time.Hour)},
+		prev:       &apis.Condition{Type: CertificatesValidConditionType, Status: corev1.ConditionFalse, Reason: CertificateExpiringReason},
+		wantStatus: corev1.ConditionFalse,
+		wantReason: CertificateExpiredReason,
+		wantEvent:  true,
+	}, {
+		name: "no certificate",
+		prev: &apis.Condition{Type: CertificatesValidConditionType, Status: corev1.ConditionFalse, Reason: CertificateExpiringReason},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := NewCertificateExpiryMonitor(noop.NewMeterProvider())
+			if err != nil {
+				t.Fatal("NewCertificateExpiryMonitor() =", err)
+			}
+			m.now = func() time.Time { return now }
+
+			recorder := record.NewFakeRecorder(10)
+			ctx := controller.WithEventRecorder(context.Background(), recorder)
+
+			obj := &duckv1.KResource{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "name"}}
+			if tc.prev != nil {
+				obj.Status.Conditions = duckv1.Conditions{*tc.prev}
+				obj.Status.Annotations = map[string]string{CertificateExpiryAnnotationKey: "2025-01-01T00:00:00Z"}
+			}
+			conditionSet := apis.NewLivingConditionSet()
+
+			m.Reconcile(ctx, corev1.SchemeGroupVersion.WithKind("Test"), obj, &obj.Status, conditionSet, tc.exp)
+
+			cond := conditionSet.Manage(&obj.Status).GetCondition(CertificatesValidConditionType)
+			if tc.exp == nil {
+				if cond != nil {
+					t.Errorf("condition = %v, want nil", cond)
+				}
+				if _, ok := obj.Status.Annotations[CertificateExpiryAnnotationKey]; ok {
+					t.Errorf("annotations = %v, want no %s", obj.Status.Annotations, CertificateExpiryAnnotationKey)
+				}
+				return
+			}
+
+			if got, want := obj.Status.Annotations[CertificateExpiryAnnotationKey], tc.exp.NotAfter.Format(time.RFC3339); got != want {
+				t.Errorf("annotation = %q, want %q", got, want)
+			}
+			if cond == nil || cond.Status != tc.wantStatus || cond.Reason != tc.wantReason {
+				t.Fatalf("condition = %v, want status %s and reason %q", cond, tc.wantStatus, tc.wantReason)
+			}
+			if cond.Status == corev1.ConditionFalse && cond.Severity != apis.ConditionSeverityWarning {
+				t.Errorf("condition severity = %q, want %q", cond.Severity, apis.ConditionSeverityWarning)
+			}
+			if got := len(recorder.Events); got != 0 != tc.wantEvent {
+				t.Errorf("got %d events, want event %v", got, tc.wantEvent)
+			}
+			if ready := conditionSet.Manage(&obj.Status).GetCondition(apis.ConditionReady); ready != nil && ready.IsFalse() {
+				t.Errorf("Ready condition = %v, want not False", ready)
+			}
+		})
+	}
+
+	// A nil monitor doesn't report anything.
+	var m *CertificateExpiryMonitor
+	status := &duckv1.Status{}
+	m.Reconcile(context.Background(), corev1.SchemeGroupVersion.WithKind("Test"), &duckv1.KResource{}, status, apis.NewLivingConditionSet(), &CertificateExpiry{NotAfter: now})
+	if len(status.Annotations) != 0 || len(status.Conditions) != 0 {
+		t.Errorf("nil monitor updated the status: %v", status)
+	}
+}
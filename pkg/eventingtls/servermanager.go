@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
@@ -35,6 +36,11 @@ import (
 // disabled: only http server
 // permissive: both http and https servers
 // strict: only https server
+//
+// When the `authentication-client-certificate` feature is enabled, which requires strict transport
+// encryption, the https server only accepts requests from clients presenting a verified client
+// certificate, see ServerConfig.ClientCAs, or a bearer token when the `authentication-oidc`
+// feature is enabled. The token is verified by the handler.
 type ServerManager struct {
 	httpReceiver  Receiver
 	httpsReceiver Receiver
@@ -115,6 +121,17 @@ func (s *ServerManager) httpsHandler() http.Handler {
 			response.WriteHeader(http.StatusNotFound)
 			return
 		}
+		if flags.IsClientCertAuthentication() && ClientCertSubject(request.TLS) == "" &&
+			!(flags.IsOIDCAuthentication() && hasBearerToken(request)) {
+			response.WriteHeader(http.StatusUnauthorized)
+			return
+		}
 		s.handler.ServeHTTP(response, request)
 	})
 }
+
+// hasBearerToken returns whether the request carries a bearer token in its
+// Authorization header.
+func hasBearerToken(request *http.Request) bool {
+	return strings.HasPrefix(request.Header.Get("Authorization"), "Bearer ")
+}
@@ -104,6 +104,55 @@ func TestStartServers(t *testing.T) {
 	}
 }
 
+func TestStartServersClientCertAuthentication(t *testing.T) {
+	ctx, cancelFunc := context.WithCancel(context.TODO())
+	httpReceiver := kncloudevents.NewHTTPEventReceiver(0, kncloudevents.WithDrainQuietPeriod(time.Millisecond))
+	httpsReceiver := kncloudevents.NewHTTPEventReceiver(0, kncloudevents.WithDrainQuietPeriod(time.Millisecond))
+	errChan := make(chan error)
+
+	cmw := configmap.NewStaticWatcher(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: feature.FlagsConfigName,
+		},
+		Data: map[string]string{
+			feature.TransportEncryption:      string(feature.Strict),
+			feature.ClientCertAuthentication: string(feature.Enabled),
+			feature.OIDCAuthentication:       string(feature.Enabled),
+		},
+	})
+	sm, err := eventingtls.NewServerManager(ctx, httpReceiver, httpsReceiver, &basicHandler{}, cmw)
+	assert.NoError(t, err)
+	go func() {
+		errChan <- sm.StartServers(ctx)
+	}()
+
+	<-httpReceiver.Ready
+	<-httpsReceiver.Ready
+
+	client := &http.Client{}
+
+	// Plain HTTP is disabled in strict mode.
+	httpResp, err := client.Get("http://" + httpReceiver.GetAddr())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, httpResp.StatusCode)
+
+	// Requests without a verified client certificate are rejected.
+	httpsResp, err := client.Get("http://" + httpsReceiver.GetAddr())
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, httpsResp.StatusCode)
+
+	// Requests with a bearer token are authenticated with OIDC instead.
+	req, err := http.NewRequest(http.MethodGet, "http://"+httpsReceiver.GetAddr(), nil)
+	assert.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer token")
+	httpsResp, err = client.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, httpsResp.StatusCode)
+
+	cancelFunc()
+	assert.NoError(t, <-errChan)
+}
+
 func TestStartServersHttpError(t *testing.T) {
 	ctx := context.TODO()
 	receiver := kncloudevents.NewHTTPEventReceiver(0, kncloudevents.WithDrainQuietPeriod(time.Millisecond))
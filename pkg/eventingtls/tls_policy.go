@@ -0,0 +1,254 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventingtls
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// TLSPoliciesConfigName is the name of the ConfigMap containing the TLS policies
+	// applied to event destinations.
+	TLSPoliciesConfigName = "config-tls-policies"
+
+	// TLSPoliciesKey is the key in the TLS policies ConfigMap containing the list of policies.
+	TLSPoliciesKey = "policies"
+
+	// pinnedCertificatePrefix is the prefix of the pinned certificates fingerprints.
+	pinnedCertificatePrefix = "sha256/"
+)
+
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// TLSPolicy overrides the TLS configuration used to send events to some destinations.
+type TLSPolicy struct {
+	// Name identifies the policy, clients are cached per destination and policy name.
+	Name string `json:"name"`
+
+	// Hosts are the destination hosts the policy applies to. A host starting with "*."
+	// matches any subdomain.
+	Hosts []string `json:"hosts"`
+
+	// MinVersion is the minimum TLS version, either "1.2" or "1.3".
+	// +optional
+	MinVersion string `json:"minVersion,omitempty"`
+
+	// CipherSuites are the names of the allowed TLS 1.2 cipher suites, for example
+	// TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256.
+	// +optional
+	CipherSuites []string `json:"cipherSuites,omitempty"`
+
+	// ServerName overrides the server name sent with SNI and used to verify the
+	// server certificate, for example for destinations behind an ingress.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+
+	// PinnedCertificates are fingerprints in the form "sha256/<base64>" of the SHA-256
+	// hash of the subject public key info of certificates. The verified chain of the
+	// server must contain one of them.
+	// +optional
+	PinnedCertificates []string `json:"pinnedCertificates,omitempty"`
+}
+
+// TLSPolicies are the TLS policies applied to event destinations.
+type TLSPolicies struct {
+	Policies []TLSPolicy
+}
+
+// ErrTLSPolicyPlainHTTP is returned when a TLS policy applies to a destination using
+// plain http, the policy can't be enforced.
+var ErrTLSPolicyPlainHTTP = errors.New("TLS policy applies to a plain http destination")
+
+// NewTLSPoliciesFromConfigMap creates TLSPolicies from the supplied ConfigMap.
+func NewTLSPoliciesFromConfigMap(cm *corev1.ConfigMap) (*TLSPolicies, error) {
+	if cm == nil {
+		return &TLSPolicies{}, nil
+	}
+	return ParseTLSPolicies(cm.Data[TLSPoliciesKey])
+}
+
+// ParseTLSPolicies creates TLSPolicies from the YAML or JSON list of policies, as found
+// in the TLS policies ConfigMap.
+func ParseTLSPolicies(raw string) (*TLSPolicies, error) {
+	p := &TLSPolicies{}
+	if strings.TrimSpace(raw) == "" {
+		return p, nil
+	}
+	if err := yaml.UnmarshalStrict([]byte(raw), &p.Policies); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", TLSPoliciesKey, err)
+	}
+
+	names := sets.New[string]()
+	for i := range p.Policies {
+		policy := &p.Policies[i]
+		if policy.Name == "" {
+			return nil, fmt.Errorf("%s[%d]: name is required", TLSPoliciesKey, i)
+		}
+		if names.Has(policy.Name) {
+			return nil, fmt.Errorf("%s[%d]: duplicate name %q", TLSPoliciesKey, i, policy.Name)
+		}
+		names.Insert(policy.Name)
+		if len(policy.Hosts) == 0 {
+			return nil, fmt.Errorf("%s[%d]: hosts are required", TLSPoliciesKey, i)
+		}
+		// Validate the policy by applying it to an empty configuration.
+		if err := policy.apply(&tls.Config{}); err != nil {
+			return nil, fmt.Errorf("%s[%d]: %w", TLSPoliciesKey, i, err)
+		}
+	}
+	return p, nil
+}
+
+// ForHost returns the policy applying to the given host, exact matches take precedence
+// over wildcard matches. It returns nil if no policy applies.
+func (p *TLSPolicies) ForHost(host string) *TLSPolicy {
+	if p == nil {
+		return nil
+	}
+
+	var wildcard *TLSPolicy
+	for i := range p.Policies {
+		for _, h := range p.Policies[i].Hosts {
+			if strings.EqualFold(h, host) {
+				return &p.Policies[i]
+			}
+			if wildcard == nil && strings.HasPrefix(h, "*.") && hasSuffixFold(host, h[1:]) {
+				wildcard = &p.Policies[i]
+			}
+		}
+	}
+	return wildcard
+}
+
+// ForURL returns the policy applying to the host of the given URL, see ForHost. It returns
+// an error wrapping ErrTLSPolicyPlainHTTP when the policy applies to a plain http URL.
+func (p *TLSPolicies) ForURL(rawURL string) (*TLSPolicy, error) {
+	if p == nil || len(p.Policies) == 0 {
+		return nil, nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse URL %q: %w", rawURL, err)
+	}
+	policy := p.ForHost(u.Hostname())
+	if policy == nil {
+		return nil, nil
+	}
+	if err := policy.VerifyURL(rawURL); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// VerifyURL returns an error wrapping ErrTLSPolicyPlainHTTP when the given URL, to which
+// the policy applies, uses plain http.
+func (p *TLSPolicy) VerifyURL(rawURL string) error {
+	if !IsHttpsSink(rawURL) {
+		return fmt.Errorf("%w: policy %q applies to %s", ErrTLSPolicyPlainHTTP, p.Name, rawURL)
+	}
+	return nil
+}
+
+func hasSuffixFold(s, suffix string) bool {
+	return len(s) > len(suffix) && strings.EqualFold(s[len(s)-len(suffix):], suffix)
+}
+
+// apply overrides the given TLS configuration with the policy.
+func (p *TLSPolicy) apply(cfg *tls.Config) error {
+	if p.MinVersion != "" {
+		v, ok := tlsVersions[p.MinVersion]
+		if !ok {
+			return fmt.Errorf("invalid minVersion %q, expected 1.2 or 1.3", p.MinVersion)
+		}
+		cfg.MinVersion = v
+		if cfg.MaxVersion != 0 && cfg.MaxVersion < v {
+			cfg.MaxVersion = v
+		}
+	}
+
+	if len(p.CipherSuites) > 0 {
+		suites := make([]uint16, 0, len(p.CipherSuites))
+		for _, name := range p.CipherSuites {
+			id, ok := cipherSuiteID(name)
+			if !ok {
+				return fmt.Errorf("invalid cipher suite %q", name)
+			}
+			suites = append(suites, id)
+		}
+		cfg.CipherSuites = suites
+	}
+
+	if p.ServerName != "" {
+		cfg.ServerName = p.ServerName
+	}
+
+	if len(p.PinnedCertificates) > 0 {
+		pins := sets.New[string]()
+		for _, pin := range p.PinnedCertificates {
+			fingerprint, ok := strings.CutPrefix(pin, pinnedCertificatePrefix)
+			if !ok {
+				return fmt.Errorf("invalid pinned certificate %q, expected %s<base64>", pin, pinnedCertificatePrefix)
+			}
+			if b, err := base64.StdEncoding.DecodeString(fingerprint); err != nil || len(b) != sha256.Size {
+				return fmt.Errorf("invalid pinned certificate %q, expected the base64 encoded SHA-256 hash", pin)
+			}
+			pins.Insert(fingerprint)
+		}
+		cfg.VerifyConnection = func(state tls.ConnectionState) error {
+			for _, chain := range state.VerifiedChains {
+				for _, cert := range chain {
+					if pins.Has(SPKIFingerprint(cert)) {
+						return nil
+					}
+				}
+			}
+			return fmt.Errorf("none of the certificates presented by %q matches the pinned certificates of TLS policy %q", state.ServerName, p.Name)
+		}
+	}
+
+	return nil
+}
+
+// SPKIFingerprint returns the base64 encoded SHA-256 hash of the subject public key
+// info of the given certificate, as used by TLSPolicy.PinnedCertificates.
+func SPKIFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func cipherSuiteID(name string) (uint16, bool) {
+	for _, s := range tls.CipherSuites() {
+		if s.Name == name {
+			return s.ID, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventingtls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestNewTLSPoliciesFromConfigMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    int
+		wantErr bool
+	}{{
+		name: "no policies",
+	}, {
+		name: "valid policies",
+		data: map[string]string{TLSPoliciesKey: `
+- name: regulated
+  hosts: ["payments.example.com", "*.regulated.svc"]
+  minVersion: "1.3"
+- name: ingress
+  hosts: ["sink.example.com"]
+  serverName: sink.internal
+  cipherSuites: ["TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"]
+  pinnedCertificates: ["sha256/47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="]
+`},
+		want: 2,
+	}, {
+		name:    "unknown field",
+		data:    map[string]string{TLSPoliciesKey: `[{name: a, hosts: [a], foo: bar}]`},
+		wantErr: true,
+	}, {
+		name:    "missing name",
+		data:    map[string]string{TLSPoliciesKey: `[{hosts: [a]}]`},
+		wantErr: true,
+	}, {
+		name:    "duplicate name",
+		data:    map[string]string{TLSPoliciesKey: `[{name: a, hosts: [a]}, {name: a, hosts: [b]}]`},
+		wantErr: true,
+	}, {
+		name:    "missing hosts",
+		data:    map[string]string{TLSPoliciesKey: `[{name: a}]`},
+		wantErr: true,
+	}, {
+		name:    "invalid min version",
+		data:    map[string]string{TLSPoliciesKey: `[{name: a, hosts: [a], minVersion: "1.1"}]`},
+		wantErr: true,
+	}, {
+		name:    "invalid cipher suite",
+		data:    map[string]string{TLSPoliciesKey: `[{name: a, hosts: [a], cipherSuites: [foo]}]`},
+		wantErr: true,
+	}, {
+		name:    "invalid pinned certificate",
+		data:    map[string]string{TLSPoliciesKey: `[{name: a, hosts: [a], pinnedCertificates: ["sha256/Zm9v"]}]`},
+		wantErr: true,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NewTLSPoliciesFromConfigMap(&corev1.ConfigMap{Data: tc.data})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("NewTLSPoliciesFromConfigMap() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err == nil && len(got.Policies) != tc.want {
+				t.Errorf("NewTLSPoliciesFromConfigMap() got %d policies, want %d", len(got.Policies), tc.want)
+			}
+		})
+	}
+}
+
+func TestTLSPoliciesForHost(t *testing.T) {
+	policies := &TLSPolicies{Policies: []TLSPolicy{
+		{Name: "wildcard", Hosts: []string{"*.example.com"}},
+		{Name: "exact", Hosts: []string{"sink.example.com"}},
+	}}
+
+	tests := map[string]string{
+		"sink.example.com":  "exact",
+		"SINK.example.com":  "exact",
+		"other.example.com": "wildcard",
+		"a.b.example.com":   "wildcard",
+		"example.com":       "",
+		"example.org":       "",
+	}
+	for host, want := range tests {
+		got := ""
+		if p := policies.ForHost(host); p != nil {
+			got = p.Name
+		}
+		if got != want {
+			t.Errorf("ForHost(%q) = %q, want %q", host, got, want)
+		}
+	}
+
+	if p := (*TLSPolicies)(nil).ForHost("sink.example.com"); p != nil {
+		t.Errorf("ForHost() on nil policies = %v, want nil", p)
+	}
+}
+
+func TestTLSPoliciesForURL(t *testing.T) {
+	policies := &TLSPolicies{Policies: []TLSPolicy{
+		{Name: "exact", Hosts: []string{"sink.example.com"}},
+	}}
+
+	tests := map[string]struct {
+		want    string
+		wantErr error
+	}{
+		"https://sink.example.com/path": {want: "exact"},
+		"https://sink.example.org":      {},
+		"http://sink.example.org":       {},
+		"http://sink.example.com:8080":  {wantErr: ErrTLSPolicyPlainHTTP},
+	}
+	for u, tc := range tests {
+		p, err := policies.ForURL(u)
+		if !errors.Is(err, tc.wantErr) {
+			t.Errorf("ForURL(%q) error = %v, want %v", u, err, tc.wantErr)
+		}
+		got := ""
+		if p != nil {
+			got = p.Name
+		}
+		if got != tc.want {
+			t.Errorf("ForURL(%q) = %q, want %q", u, got, tc.want)
+		}
+	}
+}
+
+func TestTLSPolicy(t *testing.T) {
+	ca, caKey := mustTestCA(t, "server-ca")
+	serverCert := mustTestCert(t, ca, caKey, &x509.Certificate{
+		DNSNames:    []string{"sink.internal"},
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	leaf, err := x509.ParseCertificate(serverCert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherCA, _ := mustTestCA(t, "other-ca")
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{*serverCert}}
+	server.StartTLS()
+	defer server.Close()
+
+	tls12Server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	tls12Server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{*serverCert},
+		MaxVersion:   tls.VersionTLS12,
+	}
+	tls12Server.StartTLS()
+	defer tls12Server.Close()
+
+	tests := []struct {
+		name    string
+		policy  *TLSPolicy
+		tls12   bool
+		wantErr bool
+	}{{
+		name:   "no policy",
+		policy: nil,
+	}, {
+		name:   "server name",
+		policy: &TLSPolicy{Name: "sni", ServerName: "sink.internal"},
+	}, {
+		name:    "unknown server name",
+		policy:  &TLSPolicy{Name: "sni", ServerName: "unknown.internal"},
+		wantErr: true,
+	}, {
+		name:    "TLS 1.2 server without policy",
+		tls12:   true,
+		wantErr: true,
+	}, {
+		name:   "TLS 1.2 server with min version",
+		policy: &TLSPolicy{Name: "tls12", MinVersion: "1.2", CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"}},
+		tls12:  true,
+	}, {
+		name:    "TLS 1.2 server without matching cipher suite",
+		policy:  &TLSPolicy{Name: "tls12", MinVersion: "1.2", CipherSuites: []string{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"}},
+		tls12:   true,
+		wantErr: true,
+	}, {
+		name:   "pinned leaf certificate",
+		policy: &TLSPolicy{Name: "pinned", PinnedCertificates: []string{pinnedCertificatePrefix + SPKIFingerprint(leaf)}},
+	}, {
+		name:   "pinned CA certificate",
+		policy: &TLSPolicy{Name: "pinned", PinnedCertificates: []string{pinnedCertificatePrefix + SPKIFingerprint(ca)}},
+	}, {
+		name:    "pinned certificate not in chain",
+		policy:  &TLSPolicy{Name: "pinned", PinnedCertificates: []string{pinnedCertificatePrefix + SPKIFingerprint(otherCA)}},
+		wantErr: true,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clientConfig := NewDefaultClientConfig()
+			clientConfig.TLSPolicy = tc.policy
+			cfg, err := GetTLSClientConfig(clientConfig)
+			if err != nil {
+				t.Fatal("GetTLSClientConfig() =", err)
+			}
+			cfg.RootCAs = x509.NewCertPool()
+			cfg.RootCAs.AddCert(ca)
+
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: cfg}}
+			url := server.URL
+			if tc.tls12 {
+				url = tls12Server.URL
+			}
+			resp, err := client.Get(url)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("request error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -450,7 +450,7 @@ func (g *Graph) AddSubscription(subscription messagingv1.Subscription) error {
 	subscriptionDest := &duckv1.Destination{Ref: subscriptionRef}
 
 	to := g.getOrCreateVertex(subscription.Spec.Subscriber, nil)
-	channel.AddEdge(to, subscriptionDest, NoTransform{}, false)
+	channel.AddEdge(to, subscriptionDest, getTransformForSubscription(subscription), false)
 
 	// If the subscription has a reply field set, there should be another Edge struct.
 	if subscription.Spec.Reply != nil {
@@ -619,8 +619,27 @@ func resourceFromUnstructured(u *unstructured.Unstructured) (string, error) {
 }
 
 func getTransformForTrigger(trigger eventingv1.Trigger) Transform {
+	var transforms Transforms
 	if len(trigger.Spec.Filters) == 0 && trigger.Spec.Filter != nil {
-		return &AttributesFilterTransform{Filter: trigger.Spec.Filter}
+		transforms = append(transforms, &AttributesFilterTransform{Filter: trigger.Spec.Filter})
+	}
+	if trigger.Spec.Transform != nil {
+		transforms = append(transforms, SubscriberTransform{Transform: trigger.Spec.Transform})
+	}
+
+	switch len(transforms) {
+	case 0:
+		return NoTransform{}
+	case 1:
+		return transforms[0]
+	default:
+		return transforms
+	}
+}
+
+func getTransformForSubscription(subscription messagingv1.Subscription) Transform {
+	if subscription.Spec.Transform != nil {
+		return SubscriberTransform{Transform: subscription.Spec.Transform}
 	}
 
 	return NoTransform{}
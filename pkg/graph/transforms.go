@@ -21,6 +21,7 @@ import (
 	"regexp"
 	"strings"
 
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	eventingv1beta3 "knative.dev/eventing/pkg/apis/eventing/v1beta3"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
@@ -186,3 +187,82 @@ func (cet CloudEventOverridesTransform) Apply(et *eventingv1beta3.EventType, tfc
 func (cet CloudEventOverridesTransform) Name() string {
 	return "source-ce-overrides-transform"
 }
+
+// Transforms applies several transforms in order, stopping at the first one
+// which returns no eventtype.
+type Transforms []Transform
+
+var _ Transform = Transforms{}
+
+func (ts Transforms) Apply(et *eventingv1beta3.EventType, tfc TransformFunctionContext) (*eventingv1beta3.EventType, TransformFunctionContext) {
+	for _, t := range ts {
+		et, tfc = t.Apply(et, tfc)
+		if et == nil {
+			return nil, tfc
+		}
+	}
+	return et, tfc
+}
+
+func (ts Transforms) Name() string {
+	names := make([]string, 0, len(ts))
+	for _, t := range ts {
+		names = append(names, t.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+type SubscriberTransform struct {
+	Transform *eventingduckv1.TransformSpec
+}
+
+var _ Transform = SubscriberTransform{}
+
+// Apply applies the attribute operations of the inline transformation of a Trigger or
+// a Subscription: the extensions are renamed, the removed attributes are dropped and the
+// set attributes are required with the given value. The data projection doesn't change
+// the attributes, so it isn't represented.
+func (st SubscriberTransform) Apply(et *eventingv1beta3.EventType, tfc TransformFunctionContext) (*eventingv1beta3.EventType, TransformFunctionContext) {
+	removed := make(map[string]bool, len(st.Transform.Remove))
+	for _, name := range st.Transform.Remove {
+		removed[name] = true
+	}
+
+	etAttributes := make(map[string]*eventingv1beta3.EventAttributeDefinition)
+	for i := range et.Spec.Attributes {
+		attribute := &et.Spec.Attributes[i]
+		if to, ok := st.Transform.Rename[attribute.Name]; ok {
+			attribute.Name = to
+		}
+		if removed[attribute.Name] {
+			continue
+		}
+		etAttributes[attribute.Name] = attribute
+	}
+
+	for k, v := range st.Transform.Set {
+		if attribute, ok := etAttributes[k]; ok {
+			attribute.Value = v
+			attribute.Required = true
+		} else {
+			etAttributes[k] = &eventingv1beta3.EventAttributeDefinition{
+				Name:     k,
+				Value:    v,
+				Required: true,
+			}
+		}
+	}
+
+	updatedAttributes := make([]eventingv1beta3.EventAttributeDefinition, 0, len(etAttributes))
+	for _, v := range etAttributes {
+		updatedAttributes = append(updatedAttributes, *v)
+	}
+
+	et.Spec.Attributes = updatedAttributes
+
+	return et, tfc
+}
+
+func (st SubscriberTransform) Name() string {
+	return "subscriber-transform"
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	eventingv1beta3 "knative.dev/eventing/pkg/apis/eventing/v1beta3"
 )
@@ -194,3 +195,54 @@ func TestAttributeFilterTransform(t *testing.T) {
 		})
 	}
 }
+
+func TestSubscriberTransform(t *testing.T) {
+	input := &eventingv1beta3.EventType{
+		Spec: eventingv1beta3.EventTypeSpec{
+			Attributes: []eventingv1beta3.EventAttributeDefinition{
+				{Name: "type", Value: "order.created", Required: true},
+				{Name: "subject", Value: "{order}", Required: false},
+				{Name: "tenant", Value: "{tenant}", Required: true},
+				{Name: "traceparent", Required: false},
+			},
+		},
+	}
+	transform := SubscriberTransform{Transform: &eventingduckv1.TransformSpec{
+		Rename: map[string]string{"tenant": "customer"},
+		Remove: []string{"subject", "traceparent"},
+		Set:    map[string]string{"type": "order.received", "priority": "high"},
+		Data:   "$.order",
+	}}
+
+	out, _ := transform.Apply(input, TransformFunctionContext{})
+
+	assert.ElementsMatch(t, []eventingv1beta3.EventAttributeDefinition{
+		{Name: "type", Value: "order.received", Required: true},
+		{Name: "customer", Value: "{tenant}", Required: true},
+		{Name: "priority", Value: "high", Required: true},
+	}, out.Spec.Attributes)
+}
+
+func TestTransforms(t *testing.T) {
+	transforms := Transforms{
+		&AttributesFilterTransform{Filter: &eventingv1.TriggerFilter{Attributes: eventingv1.TriggerFilterAttributes{"type": "order.created"}}},
+		SubscriberTransform{Transform: &eventingduckv1.TransformSpec{Set: map[string]string{"type": "order.received"}}},
+	}
+	assert.Equal(t, "attributes-filter,subscriber-transform", transforms.Name())
+
+	out, _ := transforms.Apply(&eventingv1beta3.EventType{
+		Spec: eventingv1beta3.EventTypeSpec{
+			Attributes: []eventingv1beta3.EventAttributeDefinition{{Name: "type", Value: "order.{action}", Required: true}},
+		},
+	}, TransformFunctionContext{})
+	assert.ElementsMatch(t, []eventingv1beta3.EventAttributeDefinition{
+		{Name: "type", Value: "order.received", Required: true},
+	}, out.Spec.Attributes)
+
+	out, _ = transforms.Apply(&eventingv1beta3.EventType{
+		Spec: eventingv1beta3.EventTypeSpec{
+			Attributes: []eventingv1beta3.EventAttributeDefinition{{Name: "type", Value: "invoice.created", Required: true}},
+		},
+	}, TransformFunctionContext{})
+	assert.Nil(t, out)
+}
@@ -28,6 +28,9 @@ const (
 	KnativeErrorCodeExtensionKey       = "knativeerrorcode"
 	KnativeErrorDataExtensionKey       = "knativeerrordata"
 	KnativeErrorDataExtensionMaxLength = 1024
+
+	KnativeErrorAttemptsExtensionKey       = "knativeerrorattempts"
+	KnativeErrorAttemptsExtensionMaxLength = 2048
 )
 
 // KnativeErrorTransformers returns Transformers which add the specified destination and error code/data extensions.
@@ -40,3 +43,9 @@ func KnativeErrorTransformers(destination url.URL, code int, data string) bindin
 	dataTransformer := transformer.AddExtension(KnativeErrorDataExtensionKey, data)
 	return binding.Transformers{destTransformer, codeTransformer, dataTransformer}
 }
+
+// KnativeErrorAttemptsTransformer returns a Transformer which adds the specified delivery attempts extension.
+// The attempts are expected to be encoded within KnativeErrorAttemptsExtensionMaxLength.
+func KnativeErrorAttemptsTransformer(attempts string) binding.Transformer {
+	return transformer.AddExtension(KnativeErrorAttemptsExtensionKey, attempts)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kncloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"knative.dev/pkg/apis"
+
+	"knative.dev/eventing/pkg/kncloudevents/attributes"
+)
+
+const (
+	// DeadLetterEnvelopeEventType is the type of the envelope events sent to dead letter
+	// sinks with the envelope dead letter format.
+	DeadLetterEnvelopeEventType = "dev.knative.eventing.deadletter"
+)
+
+// DeliveryAttempt is an attempt to deliver an event to a destination.
+type DeliveryAttempt struct {
+	// Time is the time at which the attempt started.
+	Time time.Time
+	// ResponseCode is the response status code, or NoResponse when no response was received.
+	ResponseCode int
+	// Error is the error preventing to receive a response.
+	Error string
+	// Latency is the duration of the attempt.
+	Latency time.Duration
+	// RetryAfter is the duration of the "Retry-After" header of the response honoured
+	// before the next attempt.
+	RetryAfter time.Duration
+}
+
+type deliveryAttemptJSON struct {
+	Time         string `json:"time"`
+	ResponseCode int    `json:"code"`
+	Error        string `json:"error,omitempty"`
+	Latency      string `json:"latency"`
+	RetryAfter   string `json:"retryAfter,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler, durations are formatted with time.Duration.String.
+func (a DeliveryAttempt) MarshalJSON() ([]byte, error) {
+	v := deliveryAttemptJSON{
+		Time:         a.Time.UTC().Format(time.RFC3339Nano),
+		ResponseCode: a.ResponseCode,
+		Error:        a.Error,
+		Latency:      a.Latency.String(),
+	}
+	if a.RetryAfter > 0 {
+		v.RetryAfter = a.RetryAfter.String()
+	}
+	return json.Marshal(v)
+}
+
+// deliveryAttemptsRecorder records the attempts of a request, including its retries.
+type deliveryAttemptsRecorder struct {
+	attempts []DeliveryAttempt
+}
+
+// roundTripper returns an http.RoundTripper recording the attempts sent with next.
+func (r *deliveryAttemptsRecorder) roundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		start := time.Now()
+		resp, err := next.RoundTrip(req)
+
+		attempt := DeliveryAttempt{
+			Time:         start,
+			ResponseCode: NoResponse,
+			Latency:      time.Since(start),
+		}
+		if err != nil {
+			attempt.Error = err.Error()
+		} else {
+			attempt.ResponseCode = resp.StatusCode
+		}
+		r.attempts = append(r.attempts, attempt)
+
+		return resp, err
+	})
+}
+
+// retryAfter records the "Retry-After" duration honoured after the last attempt.
+func (r *deliveryAttemptsRecorder) retryAfter(d time.Duration) {
+	if len(r.attempts) > 0 {
+		r.attempts[len(r.attempts)-1].RetryAfter = d
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// encodeDeliveryAttempts encodes the attempts as a JSON array within the given maximum
+// length, the oldest attempts are dropped when the encoded attempts are longer.
+func encodeDeliveryAttempts(attempts []DeliveryAttempt, maxLength int) string {
+	for len(attempts) > 0 {
+		b, err := json.Marshal(attempts)
+		if err == nil && len(b) <= maxLength {
+			return string(b)
+		}
+		attempts = attempts[1:]
+	}
+	return "[]"
+}
+
+// deadLetterEnvelope is the data of the envelope events sent to dead letter sinks with
+// the envelope dead letter format.
+type deadLetterEnvelope struct {
+	// Destination is the destination the event could not be delivered to.
+	Destination string `json:"destination"`
+	// ResponseCode is the response status code of the last attempt.
+	ResponseCode int `json:"code"`
+	// ResponseBody is the response body of the last attempt, truncated to
+	// attributes.KnativeErrorDataExtensionMaxLength bytes.
+	ResponseBody []byte `json:"data,omitempty"`
+	// Attempts are the delivery attempts.
+	Attempts []DeliveryAttempt `json:"attempts"`
+	// Event is the event in the structured JSON format.
+	Event *cloudevents.Event `json:"event"`
+}
+
+// deadLetterEnvelopeMessage returns a message containing the event of the given message in
+// an envelope, along with the attempts to deliver it to the given destination.
+func deadLetterEnvelopeMessage(ctx context.Context, message binding.Message, destination *apis.URL, dispatchExecutionInfo *DispatchInfo, transformers ...binding.Transformer) (binding.Message, error) {
+	event, err := binding.ToEvent(ctx, message, transformers...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read event for the dead letter envelope: %w", err)
+	}
+
+	destination, responseBody, _ := dispatchErrorInfo(destination, dispatchExecutionInfo)
+	if len(responseBody) > attributes.KnativeErrorDataExtensionMaxLength {
+		responseBody = responseBody[:attributes.KnativeErrorDataExtensionMaxLength]
+	}
+
+	envelope := cloudevents.NewEvent()
+	envelope.SetID(event.ID())
+	envelope.SetSource(event.Source())
+	envelope.SetType(DeadLetterEnvelopeEventType)
+	envelope.SetTime(time.Now())
+	for k, v := range event.Extensions() {
+		envelope.SetExtension(k, v)
+	}
+	if err := envelope.SetData(cloudevents.ApplicationJSON, deadLetterEnvelope{
+		Destination:  destination.String(),
+		ResponseCode: dispatchExecutionInfo.ResponseCode,
+		ResponseBody: responseBody,
+		Attempts:     dispatchExecutionInfo.Attempts,
+		Event:        event,
+	}); err != nil {
+		return nil, fmt.Errorf("failed to write the dead letter envelope: %w", err)
+	}
+
+	return binding.ToMessage(&envelope), nil
+}
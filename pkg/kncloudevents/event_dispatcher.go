@@ -67,6 +67,8 @@ type DispatchInfo struct {
 	ResponseHeader http.Header
 	ResponseBody   []byte
 	Scheme         string
+	// Attempts are the attempts to deliver the event, including the retries.
+	Attempts []DeliveryAttempt
 }
 
 type SendOption func(*senderConfig) error
@@ -78,6 +80,15 @@ func WithEventFormat(format *v1.FormatType) SendOption {
 	}
 }
 
+// WithDeadLetterFormat sets how the delivery attempts are attached to the events sent
+// to the dead letter sink, see v1.DeliverySpec.DeadLetterFormat.
+func WithDeadLetterFormat(format *v1.DeadLetterFormatType) SendOption {
+	return func(sc *senderConfig) error {
+		sc.deadLetterFormat = format
+		return nil
+	}
+}
+
 func WithReply(reply *duckv1.Addressable) SendOption {
 	return func(sc *senderConfig) error {
 		sc.reply = reply
@@ -129,6 +140,19 @@ func WithOIDCAuthentication(serviceAccount *types.NamespacedName) SendOption {
 	}
 }
 
+// WithClientCertificate presents the given client certificate instead of the one of the
+// Dispatcher to destinations requesting TLS client authentication.
+func WithClientCertificate(cert *eventingtls.ClientCertificate) SendOption {
+	return func(sc *senderConfig) error {
+		if cert == nil || cert.Name == "" || cert.Get == nil {
+			return fmt.Errorf("client certificate name and getter must not be empty")
+		}
+		sc.clientCertificate = cert
+
+		return nil
+	}
+}
+
 func WithEventTypeAutoHandler(handler *eventtype.EventTypeAutoHandler, ref *duckv1.KReference, ownerUID types.UID) SendOption {
 	return func(sc *senderConfig) error {
 		if handler != nil && (ref == nil || ownerUID == types.UID("")) {
@@ -149,10 +173,12 @@ type senderConfig struct {
 	retryConfig          *RetryConfig
 	transformers         binding.Transformers
 	oidcServiceAccount   *types.NamespacedName
+	clientCertificate    *eventingtls.ClientCertificate
 	eventTypeAutoHandler *eventtype.EventTypeAutoHandler
 	eventTypeRef         *duckv1.KReference
 	eventTypeOnwerUID    types.UID
 	eventFormat          *v1.FormatType
+	deadLetterFormat     *v1.DeadLetterFormatType
 }
 
 type Dispatcher struct {
@@ -277,20 +303,25 @@ func (d *Dispatcher) send(ctx context.Context, message binding.Message, destinat
 		additionalHeadersForDestination,
 		config.retryConfig,
 		config.oidcServiceAccount,
+		config.clientCertificate,
 		config.transformers,
 	)
 	if err != nil {
 		// If DeadLetter is configured, then send original message with knative error extensions
 		if config.deadLetterSink != nil {
-			dispatchTransformers := dispatchExecutionInfoTransformers(destination.URL, dispatchExecutionInfo)
+			deadLetterMessage, deadLetterTransformers, deadLetterErr := deadLetterMessage(ctx, message, destination.URL, dispatchExecutionInfo, config)
+			if deadLetterErr != nil {
+				return dispatchExecutionInfo, fmt.Errorf("unable to complete request to either %s (%v) or %s (%v)", destination.URL, err, config.deadLetterSink.URL, deadLetterErr)
+			}
 			_, deadLetterResponse, dispatchExecutionInfo, deadLetterErr := d.executeRequest(
 				ctx,
 				*config.deadLetterSink,
-				message,
+				deadLetterMessage,
 				config.additionalHeaders,
 				config.retryConfig,
 				config.oidcServiceAccount,
-				append(config.transformers, dispatchTransformers),
+				config.clientCertificate,
+				deadLetterTransformers...,
 			)
 			if deadLetterErr != nil {
 				return dispatchExecutionInfo, fmt.Errorf("unable to complete request to either %s (%v) or %s (%v)", destination.URL, err, config.deadLetterSink.URL, deadLetterErr)
@@ -341,20 +372,25 @@ func (d *Dispatcher) send(ctx context.Context, message binding.Message, destinat
 		responseAdditionalHeaders,
 		config.retryConfig,
 		config.oidcServiceAccount,
+		config.clientCertificate,
 		config.transformers,
 	)
 	if err != nil {
 		// If DeadLetter is configured, then send original message with knative error extensions
 		if config.deadLetterSink != nil {
-			dispatchTransformers := dispatchExecutionInfoTransformers(config.reply.URL, dispatchExecutionInfo)
+			deadLetterMessage, deadLetterTransformers, deadLetterErr := deadLetterMessage(ctx, message, config.reply.URL, dispatchExecutionInfo, config)
+			if deadLetterErr != nil {
+				return dispatchExecutionInfo, fmt.Errorf("failed to forward reply to %s (%v) and failed to send it to the dead letter sink %s (%v)", config.reply.URL, err, config.deadLetterSink.URL, deadLetterErr)
+			}
 			_, deadLetterResponse, dispatchExecutionInfo, deadLetterErr := d.executeRequest(
 				ctx,
 				*config.deadLetterSink,
-				message,
+				deadLetterMessage,
 				responseAdditionalHeaders,
 				config.retryConfig,
 				config.oidcServiceAccount,
-				append(config.transformers, dispatchTransformers),
+				config.clientCertificate,
+				deadLetterTransformers...,
 			)
 			if deadLetterErr != nil {
 				return dispatchExecutionInfo, fmt.Errorf("failed to forward reply to %s (%v) and failed to send it to the dead letter sink %s (%v)", config.reply.URL, err, config.deadLetterSink.URL, deadLetterErr)
@@ -382,6 +418,7 @@ func (d *Dispatcher) executeRequest(
 	additionalHeaders http.Header,
 	retryConfig *RetryConfig,
 	oidcServiceAccount *types.NamespacedName,
+	clientCertificate *eventingtls.ClientCertificate,
 	transformers ...binding.Transformer,
 ) (context.Context, cloudevents.Message, *DispatchInfo, error) {
 	var scheme string
@@ -416,14 +453,25 @@ func (d *Dispatcher) executeRequest(
 		return ctx, nil, &dispatchInfo, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	client, err := newClient(d.clientConfig, target, d.meterProvider, d.traceProvider)
+	clientConfig := d.clientConfig
+	if clientCertificate == nil && oidcServiceAccount != nil {
+		// Present the certificate of the identity we're sending on behalf of.
+		clientCertificate = clientConfig.ClientCertificates.Get(*oidcServiceAccount)
+	}
+	if clientCertificate != nil {
+		clientConfig.ClientCertificate = clientCertificate
+	}
+
+	client, err := newClient(clientConfig, target, d.meterProvider, d.traceProvider)
 	if err != nil {
 		return ctx, nil, &dispatchInfo, fmt.Errorf("failed to create http client: %w", err)
 	}
 
+	recorder := &deliveryAttemptsRecorder{}
 	start := time.Now()
-	response, err := client.DoWithRetries(req, retryConfig)
+	response, err := client.DoWithRetries(req, retryConfig, recorder)
 	dispatchInfo.Duration = time.Since(start)
+	dispatchInfo.Attempts = recorder.attempts
 
 	if err != nil {
 		dispatchInfo.ResponseCode = http.StatusInternalServerError
@@ -527,12 +575,18 @@ func (c *client) Do(req *http.Request) (*http.Response, error) {
 	return c.Client.Do(req)
 }
 
-func (c *client) DoWithRetries(req *http.Request, retryConfig *RetryConfig) (*http.Response, error) {
+// DoWithRetries sends the request, retrying it according to the optional retryConfig.
+// The attempts are recorded with the optional recorder.
+func (c *client) DoWithRetries(req *http.Request, retryConfig *RetryConfig, recorder *deliveryAttemptsRecorder) (*http.Response, error) {
+	client := c.Client
+	if recorder != nil {
+		client.Transport = recorder.roundTripper(client.Transport)
+	}
+
 	if retryConfig == nil {
-		return c.Do(req)
+		return client.Do(req)
 	}
 
-	client := c.Client
 	if retryConfig.RequestTimeout != 0 {
 		client = http.Client{
 			Transport:     client.Transport,
@@ -548,7 +602,7 @@ func (c *client) DoWithRetries(req *http.Request, retryConfig *RetryConfig) (*ht
 		RetryWaitMax: defaultRetryWaitMax,
 		RetryMax:     retryConfig.RetryMax,
 		CheckRetry:   retryablehttp.CheckRetry(retryConfig.CheckRetry),
-		Backoff:      generateBackoffFn(retryConfig),
+		Backoff:      generateBackoffFn(retryConfig, recorder),
 		ErrorHandler: func(resp *http.Response, err error, numTries int) (*http.Response, error) {
 			return resp, err
 		},
@@ -562,8 +616,26 @@ func (c *client) DoWithRetries(req *http.Request, retryConfig *RetryConfig) (*ht
 	return retryableClient.Do(retryableReq)
 }
 
-// dispatchExecutionTransformer returns Transformers based on the specified destination and DispatchExecutionInfo
-func dispatchExecutionInfoTransformers(destination *apis.URL, dispatchExecutionInfo *DispatchInfo) binding.Transformers {
+// deadLetterMessage returns the message to send to the dead letter sink, after failing to send
+// the given message to the destination, and the transformers to apply to it, according to the
+// dead letter format.
+func deadLetterMessage(ctx context.Context, message binding.Message, destination *apis.URL, dispatchExecutionInfo *DispatchInfo, config *senderConfig) (binding.Message, binding.Transformers, error) {
+	dispatchTransformers := dispatchExecutionInfoTransformers(destination, dispatchExecutionInfo, config.deadLetterFormat)
+
+	if config.deadLetterFormat != nil && *config.deadLetterFormat == v1.DeadLetterFormatEnvelope {
+		envelope, err := deadLetterEnvelopeMessage(ctx, message, destination, dispatchExecutionInfo, config.transformers...)
+		if err != nil {
+			return nil, nil, err
+		}
+		return envelope, dispatchTransformers, nil
+	}
+
+	return message, append(config.transformers, dispatchTransformers...), nil
+}
+
+// dispatchErrorInfo returns the destination and response body of the failed dispatch. When the
+// destination is the broker filter, they are the ones of the failed Trigger subscriber.
+func dispatchErrorInfo(destination *apis.URL, dispatchExecutionInfo *DispatchInfo) (*apis.URL, []byte, bool) {
 	if destination == nil {
 		destination = &apis.URL{}
 	}
@@ -575,13 +647,21 @@ func dispatchExecutionInfoTransformers(destination *apis.URL, dispatchExecutionI
 
 		err := json.Unmarshal(dispatchExecutionInfo.ResponseBody, &errExtensionInfo)
 		if err != nil {
-			return nil
+			return destination, httpResponseBody, false
 		}
 		destination = errExtensionInfo.ErrDestination
 		httpResponseBody = errExtensionInfo.ErrResponseBody
 	}
 
-	destination = sanitizeURL(destination)
+	return sanitizeURL(destination), httpResponseBody, true
+}
+
+// dispatchExecutionTransformer returns Transformers based on the specified destination and DispatchExecutionInfo
+func dispatchExecutionInfoTransformers(destination *apis.URL, dispatchExecutionInfo *DispatchInfo, format *v1.DeadLetterFormatType) binding.Transformers {
+	destination, httpResponseBody, ok := dispatchErrorInfo(destination, dispatchExecutionInfo)
+	if !ok {
+		return nil
+	}
 
 	// Encodes response body as base64 for the resulting length.
 	bodyLen := len(httpResponseBody)
@@ -592,7 +672,12 @@ func dispatchExecutionInfoTransformers(destination *apis.URL, dispatchExecutionI
 	encodedBuf := make([]byte, encodedLen)
 	base64.StdEncoding.Encode(encodedBuf, httpResponseBody)
 
-	return attributes.KnativeErrorTransformers(*destination.URL(), dispatchExecutionInfo.ResponseCode, string(encodedBuf[:encodedLen]))
+	transformers := attributes.KnativeErrorTransformers(*destination.URL(), dispatchExecutionInfo.ResponseCode, string(encodedBuf[:encodedLen]))
+	if format != nil && *format == v1.DeadLetterFormatAttempts {
+		attempts := encodeDeliveryAttempts(dispatchExecutionInfo.Attempts, attributes.KnativeErrorAttemptsExtensionMaxLength)
+		transformers = append(transformers, attributes.KnativeErrorAttemptsTransformer(attempts))
+	}
+	return transformers
 }
 
 // isFailure returns true if the status code is not a successful HTTP status.
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -45,6 +46,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	"knative.dev/pkg/injection"
 	rectesting "knative.dev/pkg/reconciler/testing"
 
@@ -1294,3 +1296,101 @@ func canonicalizeHeaders(rvs ...requestValidation) {
 		}
 	}
 }
+
+func TestSendEventWithDeadLetterFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format *v1.DeadLetterFormatType
+		assert func(t *testing.T, sent, received cloudevents.Event)
+	}{
+		{
+			name: "default format",
+			assert: func(t *testing.T, sent, received cloudevents.Event) {
+				require.Equal(t, sent.Type(), received.Type())
+				require.Equal(t, strconv.Itoa(http.StatusServiceUnavailable), received.Extensions()["knativeerrorcode"])
+				require.NotContains(t, received.Extensions(), "knativeerrorattempts")
+			},
+		},
+		{
+			name:   "attempts format",
+			format: ptr.To(v1.DeadLetterFormatAttempts),
+			assert: func(t *testing.T, sent, received cloudevents.Event) {
+				require.Equal(t, sent.Type(), received.Type())
+				require.Equal(t, sent.Data(), received.Data())
+
+				var attempts []map[string]interface{}
+				require.Nil(t, json.Unmarshal([]byte(received.Extensions()["knativeerrorattempts"].(string)), &attempts))
+				require.Len(t, attempts, 2)
+				require.Equal(t, float64(http.StatusServiceUnavailable), attempts[0]["code"])
+				require.Equal(t, "10ms", attempts[0]["retryAfter"])
+				require.Equal(t, float64(http.StatusServiceUnavailable), attempts[1]["code"])
+				require.NotContains(t, attempts[1], "retryAfter")
+			},
+		},
+		{
+			name:   "envelope format",
+			format: ptr.To(v1.DeadLetterFormatEnvelope),
+			assert: func(t *testing.T, sent, received cloudevents.Event) {
+				require.Equal(t, kncloudevents.DeadLetterEnvelopeEventType, received.Type())
+				require.Equal(t, sent.ID(), received.ID())
+				require.Equal(t, strconv.Itoa(http.StatusServiceUnavailable), received.Extensions()["knativeerrorcode"])
+
+				var envelope struct {
+					Code     int                      `json:"code"`
+					Attempts []map[string]interface{} `json:"attempts"`
+					Event    cloudevents.Event        `json:"event"`
+				}
+				require.Nil(t, json.Unmarshal(received.Data(), &envelope))
+				require.Equal(t, http.StatusServiceUnavailable, envelope.Code)
+				require.Len(t, envelope.Attempts, 2)
+				require.Equal(t, sent.ID(), envelope.Event.ID())
+				require.Equal(t, sent.Type(), envelope.Event.Type())
+				require.Equal(t, sent.Data(), envelope.Event.Data())
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := rectesting.SetupFakeContext(t)
+
+			destinationServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer destinationServer.Close()
+
+			received := make(chan cloudevents.Event, 1)
+			deadLetterServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				event, err := binding.ToEvent(r.Context(), cehttp.NewMessageFromHttpRequest(r))
+				require.Nil(t, err)
+				received <- *event
+			}))
+			defer deadLetterServer.Close()
+
+			retryAfterMax := 10 * time.Millisecond
+			retryConfig := kncloudevents.RetryConfig{
+				RetryMax:              1,
+				CheckRetry:            kncloudevents.SelectiveRetry,
+				Backoff:               func(int, *http.Response) time.Duration { return time.Millisecond },
+				RetryAfterMaxDuration: &retryAfterMax,
+			}
+
+			event := test.FullEvent()
+			dispatcher := kncloudevents.NewDispatcher(eventingtls.NewDefaultClientConfig(), auth.NewOIDCTokenProvider(ctx))
+			_, err := dispatcher.SendEvent(ctx, event, duckv1.Addressable{URL: apis.HTTP(strings.TrimPrefix(destinationServer.URL, "http://"))},
+				kncloudevents.WithDeadLetterSink(&duckv1.Addressable{URL: apis.HTTP(strings.TrimPrefix(deadLetterServer.URL, "http://"))}),
+				kncloudevents.WithRetryConfig(&retryConfig),
+				kncloudevents.WithDeadLetterFormat(tc.format),
+			)
+			require.Nil(t, err)
+
+			select {
+			case e := <-received:
+				tc.assert(t, event, e)
+			case <-time.After(5 * time.Second):
+				t.Fatal("Timed out waiting for the dead letter event")
+			}
+		})
+	}
+}
@@ -21,12 +21,14 @@ import (
 	"fmt"
 	"net"
 	nethttp "net/http"
+	"strings"
 	"sync"
 	"time"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/api/equality"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/network"
 
@@ -37,6 +39,8 @@ const (
 	defaultRetryWaitMin    = 1 * time.Second
 	defaultRetryWaitMax    = 30 * time.Second
 	defaultCleanupInterval = 5 * time.Minute
+
+	clientKeySeparator = "#"
 )
 
 var (
@@ -48,6 +52,7 @@ type clientsHolder struct {
 	clients         map[string]*nethttp.Client
 	timerMu         sync.Mutex
 	connectionArgs  *ConnectionArgs
+	tlsPolicies     *eventingtls.TLSPolicies
 	cleanupInterval time.Duration
 	cancelCleanup   context.CancelFunc
 }
@@ -66,16 +71,24 @@ func getClientForAddressable(cfg eventingtls.ClientConfig, addressable duckv1.Ad
 	clients.clientsMu.Lock()
 	defer clients.clientsMu.Unlock()
 
-	clientKey := addressable.URL.String()
+	// A TLS policy can't be enforced over plain http, surface it rather than silently
+	// sending the event without it.
+	if policy := tlsPolicyFor(cfg, addressable); policy != nil {
+		if err := policy.VerifyURL(addressable.URL.String()); err != nil {
+			return nil, err
+		}
+	}
+
+	key := clientKey(cfg, addressable)
 
-	client, ok := clients.clients[clientKey]
+	client, ok := clients.clients[key]
 	if !ok {
 		newClient, err := createNewClient(cfg, addressable, mp, tp)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create new client for addressable: %w", err)
 		}
 
-		clients.clients[clientKey] = newClient
+		clients.clients[key] = newClient
 
 		client = newClient
 	}
@@ -91,6 +104,8 @@ func createNewClient(cfg eventingtls.ClientConfig, addressable duckv1.Addressabl
 		clientConfig := eventingtls.ClientConfig{
 			CACerts:                    addressable.CACerts,
 			TrustBundleConfigMapLister: cfg.TrustBundleConfigMapLister,
+			ClientCertificate:          cfg.ClientCertificate,
+			TLSPolicy:                  tlsPolicyFor(cfg, addressable),
 		}
 
 		base.DialTLSContext = func(ctx context.Context, net, addr string) (net.Conn, error) {
@@ -126,23 +141,55 @@ func AddOrUpdateAddressableHandler(cfg eventingtls.ClientConfig, addressable duc
 	clients.clientsMu.Lock()
 	defer clients.clientsMu.Unlock()
 
-	clientKey := addressable.URL.String()
-
 	client, err := createNewClient(cfg, addressable, mp, tp)
 	if err != nil {
 		fmt.Printf("failed to create new client: %v", err)
 		return
 	}
-	clients.clients[clientKey] = client
+	clients.clients[clientKey(cfg, addressable)] = client
 }
 
 func DeleteAddressableHandler(addressable duckv1.Addressable) {
 	clients.clientsMu.Lock()
 	defer clients.clientsMu.Unlock()
 
-	clientKey := addressable.URL.String()
+	url := addressable.URL.String()
+
+	// Delete the clients of all the client certificates used for the addressable.
+	for key := range clients.clients {
+		if key == url || strings.HasPrefix(key, url+clientKeySeparator) {
+			delete(clients.clients, key)
+		}
+	}
+}
+
+// clientKey returns the key of the client for the given addressable, clients
+// presenting different client certificates or using different TLS policies don't
+// share connections.
+func clientKey(cfg eventingtls.ClientConfig, addressable duckv1.Addressable) string {
+	key := addressable.URL.String()
+	if !eventingtls.IsHttpsSink(key) {
+		return key
+	}
+	if cfg.ClientCertificate != nil {
+		key += clientKeySeparator + cfg.ClientCertificate.Name
+	}
+	if policy := tlsPolicyFor(cfg, addressable); policy != nil {
+		key += clientKeySeparator + "policy=" + policy.Name
+	}
+	return key
+}
 
-	delete(clients.clients, clientKey)
+// tlsPolicyFor returns the TLS policy applying to the given addressable, the policy
+// of the client config takes precedence over the configured TLS policies.
+func tlsPolicyFor(cfg eventingtls.ClientConfig, addressable duckv1.Addressable) *eventingtls.TLSPolicy {
+	if cfg.TLSPolicy != nil {
+		return cfg.TLSPolicy
+	}
+	if addressable.URL == nil {
+		return nil
+	}
+	return clients.tlsPolicies.ForHost(addressable.URL.URL().Hostname())
 }
 
 // ConfigureConnectionArgs configures the new connection args.
@@ -174,6 +221,24 @@ func ConfigureConnectionArgs(ca *ConnectionArgs) {
 	clients.connectionArgs = ca
 }
 
+// ConfigureTLSPolicies configures the TLS policies applied to destinations.
+// Existing clients are reset when the policies change.
+func ConfigureTLSPolicies(p *eventingtls.TLSPolicies) {
+	clients.clientsMu.Lock()
+	defer clients.clientsMu.Unlock()
+
+	if equality.Semantic.DeepEqual(clients.tlsPolicies, p) {
+		return
+	}
+
+	for _, clientEntry := range clients.clients {
+		clientEntry.CloseIdleConnections()
+	}
+	clients.clients = make(map[string]*nethttp.Client)
+
+	clients.tlsPolicies = p
+}
+
 // SetClientCleanupInterval sets the interval before the clients map is re-checked for expired entries.
 // forceRestart will force the loop to restart with the new interval, cancelling the current iteration.
 func SetClientCleanupInterval(cleanupInterval time.Duration, forceRestart bool) {
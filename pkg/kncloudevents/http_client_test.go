@@ -17,6 +17,7 @@ limitations under the License.
 package kncloudevents
 
 import (
+	"crypto/tls"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -129,3 +130,68 @@ func Test_ConfigureConnectionArgs(t *testing.T) {
 	require.NotSame(t, client1, client3)
 	require.NotSame(t, client2, client3)
 }
+
+func TestClientForAddressableWithClientCertificate(t *testing.T) {
+	target := duckv1.Addressable{
+		URL: apis.HTTPS("client-cert.bar"),
+	}
+	cert := &eventingtls.ClientCertificate{
+		Name: "ns/client-tls",
+		Get:  func() (*tls.Certificate, error) { return nil, nil },
+	}
+	cfgWithCert := eventingtls.NewDefaultClientConfig()
+	cfgWithCert.ClientCertificate = cert
+
+	client1, err := getClientForAddressable(eventingtls.NewDefaultClientConfig(), target, otel.GetMeterProvider(), otel.GetTracerProvider())
+	require.Nil(t, err)
+	client2, err := getClientForAddressable(cfgWithCert, target, otel.GetMeterProvider(), otel.GetTracerProvider())
+	require.Nil(t, err)
+	client2_2, err := getClientForAddressable(cfgWithCert, target, otel.GetMeterProvider(), otel.GetTracerProvider())
+	require.Nil(t, err)
+
+	// Clients presenting different certificates don't share connections.
+	require.NotSame(t, client1, client2)
+	require.Same(t, client2, client2_2)
+
+	DeleteAddressableHandler(target)
+
+	clients.clientsMu.Lock()
+	defer clients.clientsMu.Unlock()
+	require.NotContains(t, clients.clients, clientKey(eventingtls.NewDefaultClientConfig(), target))
+	require.NotContains(t, clients.clients, clientKey(cfgWithCert, target))
+}
+
+func TestClientForAddressableWithTLSPolicies(t *testing.T) {
+	target := duckv1.Addressable{
+		URL: apis.HTTPS("tls-policy.bar"),
+	}
+	cfg := eventingtls.NewDefaultClientConfig()
+
+	client1, err := getClientForAddressable(cfg, target, otel.GetMeterProvider(), otel.GetTracerProvider())
+	require.Nil(t, err)
+
+	ConfigureTLSPolicies(&eventingtls.TLSPolicies{Policies: []eventingtls.TLSPolicy{
+		{Name: "tls13", Hosts: []string{"tls-policy.bar"}, MinVersion: "1.3"},
+	}})
+	defer ConfigureTLSPolicies(nil)
+
+	require.Equal(t, "https://tls-policy.bar#policy=tls13", clientKey(cfg, target))
+	require.Equal(t, "http://tls-policy.bar", clientKey(cfg, duckv1.Addressable{URL: apis.HTTP("tls-policy.bar")}))
+
+	client2, err := getClientForAddressable(cfg, target, otel.GetMeterProvider(), otel.GetTracerProvider())
+	require.Nil(t, err)
+	client2_2, err := getClientForAddressable(cfg, target, otel.GetMeterProvider(), otel.GetTracerProvider())
+	require.Nil(t, err)
+
+	// Clients are reset when the policies change.
+	require.NotSame(t, client1, client2)
+	require.Same(t, client2, client2_2)
+
+	// A policy matching a plain http destination can't be enforced.
+	_, err = getClientForAddressable(cfg, duckv1.Addressable{URL: apis.HTTP("tls-policy.bar")}, otel.GetMeterProvider(), otel.GetTracerProvider())
+	require.ErrorIs(t, err, eventingtls.ErrTLSPolicyPlainHTTP)
+
+	// The policy of the client config takes precedence.
+	cfg.TLSPolicy = &eventingtls.TLSPolicy{Name: "explicit"}
+	require.Equal(t, "https://tls-policy.bar#policy=explicit", clientKey(cfg, target))
+}
@@ -185,8 +185,8 @@ func SelectiveRetry(_ context.Context, response *http.Response, err error) (bool
 
 // generateBackoffFunction returns a valid retryablehttp.Backoff implementation which
 // wraps the provided RetryConfig.Backoff implementation with optional "Retry-After"
-// header support.
-func generateBackoffFn(config *RetryConfig) retryablehttp.Backoff {
+// header support. The "Retry-After" durations honoured are recorded with the optional recorder.
+func generateBackoffFn(config *RetryConfig, recorder *deliveryAttemptsRecorder) retryablehttp.Backoff {
 	return func(_, _ time.Duration, attemptNum int, resp *http.Response) time.Duration {
 
 		//
@@ -232,6 +232,9 @@ func generateBackoffFn(config *RetryConfig) retryablehttp.Backoff {
 
 		// Return The Larger Of The Two Backoff Durations
 		if retryAfterDuration > backoffDuration {
+			if recorder != nil {
+				recorder.retryAfter(retryAfterDuration)
+			}
 			return retryAfterDuration
 		}
 		return backoffDuration
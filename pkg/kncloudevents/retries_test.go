@@ -563,7 +563,7 @@ func TestGenerateBackoffFnWithRetryAfter(t *testing.T) {
 			response := generateRetryAfterHttpResponse(t, tc.statusCode, tc.format, retryAfterDuration)
 
 			// Generate The Backoff Function To Test
-			backoffFn := generateBackoffFn(retryConfig)
+			backoffFn := generateBackoffFn(retryConfig, nil)
 
 			// Perform The Test
 			startTime := time.Now()
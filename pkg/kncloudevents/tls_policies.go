@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kncloudevents
+
+import (
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/configmap"
+
+	"knative.dev/eventing/pkg/eventingtls"
+)
+
+// WatchTLSPolicies watches the TLS policies ConfigMap and configures the TLS
+// policies applied to destinations, see ConfigureTLSPolicies.
+// The ConfigMap is optional when the watcher supports defaults.
+func WatchTLSPolicies(logger *zap.SugaredLogger, cmw configmap.Watcher) {
+	obs := UpdateTLSPoliciesFromConfigMap(logger)
+	if dcmw, ok := cmw.(configmap.DefaultingWatcher); ok {
+		dcmw.WatchWithDefault(corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: eventingtls.TLSPoliciesConfigName},
+			Data:       map[string]string{},
+		}, obs)
+	} else {
+		cmw.Watch(eventingtls.TLSPoliciesConfigName, obs)
+	}
+}
+
+// UpdateTLSPoliciesFromConfigMap returns a configmap.Observer configuring the TLS
+// policies applied to destinations. Invalid policies are logged and ignored, keeping
+// the previous ones.
+func UpdateTLSPoliciesFromConfigMap(logger *zap.SugaredLogger) configmap.Observer {
+	return func(cm *corev1.ConfigMap) {
+		policies, err := eventingtls.NewTLSPoliciesFromConfigMap(cm)
+		if err != nil {
+			logger.Errorw("Failed to parse TLS policies, keeping the previous ones", zap.Error(err))
+			return
+		}
+		ConfigureTLSPolicies(policies)
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transform
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/binding/spec"
+	"github.com/cloudevents/sdk-go/v2/binding/transformer"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/cloudevents/sdk-go/v2/types"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/utils/jsonpath"
+)
+
+// Transform applies the inline transformation of a subscriber to the events
+// delivered to it. The attributes are transformed by binding.Transformers, so
+// that they are applied by the dispatcher while writing the request, and the
+// data is projected by Prepare.
+type Transform struct {
+	transformers binding.Transformers
+	data         *jsonpath.Path
+}
+
+// New returns the Transform for the given spec, or nil if the spec is nil.
+func New(ts *eventingduckv1.TransformSpec) (*Transform, error) {
+	if ts == nil {
+		return nil, nil
+	}
+
+	t := &Transform{}
+	for _, from := range sortedKeys(ts.Rename) {
+		t.transformers = append(t.transformers, renameExtension(from, ts.Rename[from]))
+	}
+	for _, name := range ts.Remove {
+		t.transformers = append(t.transformers, deleteAttribute(name))
+	}
+	for _, name := range sortedKeys(ts.Set) {
+		t.transformers = append(t.transformers, setAttribute(name, ts.Set[name]))
+	}
+
+	if ts.Data != "" {
+		p, err := jsonpath.Parse(ts.Data)
+		if err != nil {
+			return nil, err
+		}
+		t.data = p
+	}
+	return t, nil
+}
+
+// Transformers returns the transformers of the attributes of the events.
+func (t *Transform) Transformers() binding.Transformers {
+	return t.transformers
+}
+
+// Prepare returns a copy of the event to dispatch with the Transformers, with
+// the data selected by the JSONPath expression of the transformation. The
+// Transformers modify the attributes of the dispatched event in place, so the
+// given event must not be dispatched to them directly. Events without JSON
+// data keep their data.
+func (t *Transform) Prepare(e event.Event) event.Event {
+	e = e.Clone()
+	if t.data == nil || len(e.Data()) == 0 || !jsonpath.IsJSONMediaType(e.DataMediaType()) {
+		return e
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(e.Data()))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return e
+	}
+
+	selected, found := t.data.Select(document)
+	if !found {
+		e.DataEncoded = nil
+		return e
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(selected); err != nil {
+		return e
+	}
+	e.DataEncoded = bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+	return e
+}
+
+func renameExtension(from, to string) binding.TransformerFunc {
+	return func(reader binding.MessageMetadataReader, writer binding.MessageMetadataWriter) error {
+		value := reader.GetExtension(from)
+		if types.IsZero(value) {
+			return nil
+		}
+		if err := writer.SetExtension(from, nil); err != nil {
+			return err
+		}
+		return writer.SetExtension(to, value)
+	}
+}
+
+func deleteAttribute(name string) binding.TransformerFunc {
+	if attr := spec.V1.Attribute(name); attr != nil {
+		return transformer.DeleteAttribute(attr.Kind())
+	}
+	return transformer.DeleteExtension(name)
+}
+
+func setAttribute(name, value string) binding.TransformerFunc {
+	updater := func(interface{}) (interface{}, error) { return value, nil }
+	if attr := spec.V1.Attribute(name); attr != nil {
+		return transformer.SetAttribute(attr.Kind(), updater)
+	}
+	return transformer.SetExtension(name, updater)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudevents/sdk-go/v2/binding"
+	"github.com/cloudevents/sdk-go/v2/event"
+	"github.com/google/go-cmp/cmp"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+)
+
+func testEvent(t *testing.T, contentType, data string) event.Event {
+	t.Helper()
+	e := event.New()
+	e.SetID("id-1")
+	e.SetType("order.created")
+	e.SetSource("/orders")
+	e.SetSubject("order-1")
+	e.SetExtension("tenant", "acme")
+	e.SetExtension("traceparent", "00-abc")
+	if data != "" {
+		if err := e.SetData(contentType, []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+		e.DataBase64 = false
+	}
+	return e
+}
+
+func TestTransformAttributes(t *testing.T) {
+	tr, err := New(&eventingduckv1.TransformSpec{
+		Rename: map[string]string{"tenant": "customer", "missing": "other"},
+		Remove: []string{"subject", "traceparent"},
+		Set:    map[string]string{"type": "order.received", "priority": "high"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := testEvent(t, "", "")
+	prepared := tr.Prepare(e)
+	got, err := binding.ToEvent(context.Background(), binding.ToMessage(&prepared), tr.Transformers()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Type() != "order.received" {
+		t.Errorf("unexpected type %q", got.Type())
+	}
+	if got.Subject() != "" {
+		t.Errorf("expected subject to be removed, got %q", got.Subject())
+	}
+	wantExtensions := map[string]interface{}{"customer": "acme", "priority": "high"}
+	if diff := cmp.Diff(wantExtensions, got.Extensions()); diff != "" {
+		t.Error("unexpected extensions (-want, +got) =", diff)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"tenant": "acme", "traceparent": "00-abc"}, e.Extensions()); diff != "" {
+		t.Error("the original event was modified (-want, +got) =", diff)
+	}
+}
+
+func TestTransformPrepare(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		contentType string
+		path        string
+		want        string
+	}{{
+		name:        "object",
+		contentType: event.ApplicationJSON,
+		data:        `{"order":{"id":"o-1","total":12.50,"note":"<fragile>"},"customer":"jane"}`,
+		path:        "$.order",
+		want:        `{"id":"o-1","note":"<fragile>","total":12.50}`,
+	}, {
+		name:        "wildcard",
+		contentType: "application/cloudevents+json",
+		data:        `{"items":[{"sku":"a"},{"sku":"b"}]}`,
+		path:        "$.items[*].sku",
+		want:        `["a","b"]`,
+	}, {
+		name:        "no match",
+		contentType: event.ApplicationJSON,
+		data:        `{"customer":"jane"}`,
+		path:        "$.order",
+		want:        "",
+	}, {
+		name:        "not JSON",
+		contentType: "text/plain",
+		data:        `{"order":{"id":"o-1"}}`,
+		path:        "$.order",
+		want:        `{"order":{"id":"o-1"}}`,
+	}, {
+		name:        "invalid JSON",
+		contentType: event.ApplicationJSON,
+		data:        `{"order":`,
+		path:        "$.order",
+		want:        `{"order":`,
+	}, {
+		name:        "no data path",
+		contentType: event.ApplicationJSON,
+		data:        `{"order":{"id":"o-1"}}`,
+		want:        `{"order":{"id":"o-1"}}`,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tr, err := New(&eventingduckv1.TransformSpec{Data: tc.path})
+			if err != nil {
+				t.Fatal(err)
+			}
+			e := testEvent(t, tc.contentType, tc.data)
+
+			got := tr.Prepare(e)
+
+			if diff := cmp.Diff(tc.want, string(got.Data())); diff != "" {
+				t.Error("unexpected data (-want, +got) =", diff)
+			}
+			if string(e.Data()) != tc.data {
+				t.Errorf("the original event was modified: %s", e.Data())
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	if tr, err := New(nil); tr != nil || err != nil {
+		t.Errorf("New(nil) = %v, %v, want nil, nil", tr, err)
+	}
+	if _, err := New(&eventingduckv1.TransformSpec{Data: "order"}); err == nil {
+		t.Error("expected an error for an invalid data path")
+	}
+}
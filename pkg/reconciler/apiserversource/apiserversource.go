@@ -43,7 +43,6 @@ import (
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
-	"knative.dev/pkg/resolver"
 
 	"knative.dev/eventing/pkg/apis/feature"
 	apisources "knative.dev/eventing/pkg/apis/sources"
@@ -53,6 +52,7 @@ import (
 	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/eventing/pkg/reconciler/apiserversource/resources"
 	reconcilersource "knative.dev/eventing/pkg/reconciler/source"
+	"knative.dev/eventing/pkg/resolver"
 )
 
 const (
@@ -253,6 +253,9 @@ func (r *Reconciler) createReceiveAdapter(ctx context.Context, src *v1.ApiServer
 		NodeSelector:  featureFlags.NodeSelector(),
 		FailFast:      skipPermissions == "true",
 	}
+	if featureFlags.IsClientCertAuthentication() && src.Status.Auth != nil && src.Status.Auth.ServiceAccountName != nil {
+		adapterArgs.ClientCertificateSecretName = eventingtls.ClientCertificateSecretName(*src.Status.Auth.ServiceAccountName)
+	}
 
 	expected, err := resources.MakeReceiveAdapter(&adapterArgs)
 	if err != nil {
@@ -63,6 +63,7 @@ import (
 
 	rttesting "knative.dev/eventing/pkg/reconciler/testing"
 	rttestingv1 "knative.dev/eventing/pkg/reconciler/testing/v1"
+	eventingresolver "knative.dev/eventing/pkg/resolver"
 
 	_ "knative.dev/pkg/client/injection/kube/informers/rbac/v1/role/fake"
 	_ "knative.dev/pkg/client/injection/kube/informers/rbac/v1/rolebinding/fake"
@@ -1732,7 +1733,7 @@ func TestReconcile(t *testing.T) {
 			kubeClientSet:              fakekubeclient.Get(ctx),
 			ceSource:                   source,
 			receiveAdapterImage:        image,
-			sinkResolver:               resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
+			sinkResolver:               &eventingresolver.URIResolver{URIResolver: resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0))},
 			configs:                    &reconcilersource.EmptyVarsGenerator{},
 			namespaceLister:            listers.GetNamespaceLister(),
 			serviceAccountLister:       listers.GetServiceAccountLister(),
@@ -34,7 +34,6 @@ import (
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
-	"knative.dev/pkg/resolver"
 
 	v1 "knative.dev/eventing/pkg/apis/sources/v1"
 	reconcilersource "knative.dev/eventing/pkg/reconciler/source"
@@ -50,6 +49,7 @@ import (
 
 	apiserversourceinformer "knative.dev/eventing/pkg/client/injection/informers/sources/v1/apiserversource"
 	apiserversourcereconciler "knative.dev/eventing/pkg/client/injection/reconciler/sources/v1/apiserversource"
+	"knative.dev/eventing/pkg/resolver"
 )
 
 // envConfig will be used to extract the required environment variables using
@@ -89,7 +89,7 @@ func NewController(
 	r := &Reconciler{
 		kubeClientSet:              kubeclient.Get(ctx),
 		ceSource:                   GetCfgHost(ctx),
-		configs:                    reconcilersource.WatchConfigurations(ctx, component, cmw),
+		configs:                    reconcilersource.WatchConfigurations(ctx, component, cmw, reconcilersource.WithLogging, reconcilersource.WithObservability, reconcilersource.WithTLSPolicies),
 		namespaceLister:            namespaceInformer.Lister(),
 		serviceAccountLister:       oidcServiceaccountInformer.Lister(),
 		roleLister:                 roleInformer.Lister(),
@@ -113,7 +113,7 @@ func NewController(
 		impl.GlobalResync(apiServerSourceInformer.Informer())
 	}
 
-	r.sinkResolver = resolver.NewURIResolverFromTracker(ctx, impl.Tracker)
+	r.sinkResolver = resolver.NewURIResolver(ctx, cmw, impl.Tracker)
 
 	apiServerSourceInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
 
@@ -21,6 +21,7 @@ import (
 	"os"
 	"testing"
 
+	"knative.dev/pkg/client/injection/ducks/duck/v1/kresource"
 	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 
 	"knative.dev/eventing/pkg/auth"
@@ -52,6 +53,7 @@ import (
 
 func TestNew(t *testing.T) {
 	ctx, _ := SetupFakeContext(t, SetUpInformerSelector)
+	ctx = kresource.WithDuck(ctx)
 
 	ctx = withCfgHost(ctx, &rest.Config{Host: "unit_test"})
 	ctx = addressable.WithDuck(ctx)
@@ -66,6 +68,11 @@ func TestNew(t *testing.T) {
 		Data: map[string]string{
 			"_example": "test-config",
 		},
+	}, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      eventingtls.TLSPoliciesConfigName,
+			Namespace: "knative-eventing",
+		},
 	}, &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      logging.ConfigMapName(),
@@ -81,6 +88,11 @@ func TestNew(t *testing.T) {
 			Name:      feature.FlagsConfigName,
 			Namespace: "knative-eventing",
 		},
+	}, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "config-kreference-mapping",
+			Namespace: "knative-eventing",
+		},
 	}))
 
 	if c == nil {
@@ -34,6 +34,7 @@ import (
 
 	"knative.dev/eventing/pkg/adapter/apiserver"
 	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/eventingtls"
 	reconcilersource "knative.dev/eventing/pkg/reconciler/source"
 )
 
@@ -51,6 +52,9 @@ type ReceiveAdapterArgs struct {
 	AllNamespaces bool
 	NodeSelector  map[string]string
 	FailFast      bool
+	// ClientCertificateSecretName is the Secret holding the client certificate of the
+	// source identity, it is optional.
+	ClientCertificateSecretName string
 }
 
 // MakeReceiveAdapter generates (but does not insert into K8s) the Receive Adapter Deployment for
@@ -63,6 +67,30 @@ func MakeReceiveAdapter(args *ReceiveAdapterArgs) (*appsv1.Deployment, error) {
 		return nil, fmt.Errorf("error generating env vars: %w", err)
 	}
 
+	var volumes []corev1.Volume
+	var volumeMounts []corev1.VolumeMount
+	if args.ClientCertificateSecretName != "" {
+		volumes = append(volumes, corev1.Volume{
+			Name: "client-tls",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: args.ClientCertificateSecretName,
+					// The certificate might not be issued yet.
+					Optional: ptr.Bool(true),
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "client-tls",
+			MountPath: eventingtls.ClientCertificateMountPath,
+			ReadOnly:  true,
+		})
+		env = append(env, corev1.EnvVar{
+			Name:  adapter.EnvConfigClientCertPath,
+			Value: eventingtls.ClientCertificateMountPath,
+		})
+	}
+
 	return &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: args.Source.Namespace,
@@ -88,11 +116,13 @@ func MakeReceiveAdapter(args *ReceiveAdapterArgs) (*appsv1.Deployment, error) {
 					NodeSelector:       args.NodeSelector,
 					ServiceAccountName: args.Source.Spec.ServiceAccountName,
 					EnableServiceLinks: ptr.Bool(false),
+					Volumes:            volumes,
 					Containers: []corev1.Container{
 						{
-							Name:  "receive-adapter",
-							Image: args.Image,
-							Env:   env,
+							Name:         "receive-adapter",
+							Image:        args.Image,
+							Env:          env,
+							VolumeMounts: volumeMounts,
 							Ports: []corev1.ContainerPort{{
 								Name:          "metrics",
 								ContainerPort: 9092,
@@ -0,0 +1,280 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/reconciler"
+
+	o11yconfigmap "knative.dev/eventing/pkg/observability/configmap"
+)
+
+// Reconciler scales the data-plane Deployments based on the rate of events they dispatch.
+// The Deployments are reconciled periodically, while they are configured.
+type Reconciler struct {
+	reconciler.LeaderAwareFuncs
+
+	kubeClientSet kubernetes.Interface
+	podLister     corev1listers.PodLister
+
+	// period is how often the Deployments are scaled.
+	period time.Duration
+	scrape scrapeFunc
+	now    func() time.Time
+
+	config atomic.Pointer[Config]
+	// metricsErr is why the pod metrics can't be scraped, it points to nil when they can.
+	metricsErr atomic.Pointer[error]
+
+	statesMu sync.Mutex
+	states   map[types.NamespacedName]*deploymentState
+}
+
+// deploymentState is the autoscaling state of a Deployment.
+type deploymentState struct {
+	// samples are the number of events dispatched by each pod at sampleTime.
+	samples    map[types.UID]float64
+	sampleTime time.Time
+
+	// belowSince is when the desired replicas became lower than the current replicas.
+	belowSince time.Time
+	// belowMax is the maximum desired replicas since belowSince.
+	belowMax int32
+}
+
+var _ controller.Reconciler = (*Reconciler)(nil)
+
+func (r *Reconciler) getConfig() Config {
+	if cfg := r.config.Load(); cfg != nil {
+		return *cfg
+	}
+	return nil
+}
+
+func (r *Reconciler) setConfig(cfg Config) {
+	r.config.Store(&cfg)
+}
+
+func (r *Reconciler) getMetricsErr() error {
+	if err := r.metricsErr.Load(); err != nil {
+		return *err
+	}
+	return fmt.Errorf("the %s ConfigMap hasn't been loaded yet", o11yconfigmap.Name())
+}
+
+func (r *Reconciler) setMetricsErr(err error) {
+	r.metricsErr.Store(&err)
+}
+
+// Reconcile implements controller.Reconciler.
+func (r *Reconciler) Reconcile(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil
+	}
+	nn := types.NamespacedName{Namespace: namespace, Name: name}
+
+	cfg, ok := r.getConfig()[name]
+	if !ok {
+		r.deleteState(nn)
+		return nil
+	}
+	if !r.IsLeaderFor(nn) {
+		return nil
+	}
+
+	logger := logging.FromContext(ctx).With(zap.String("deployment", key))
+	if err := r.autoscale(logging.WithLogger(ctx, logger), nn, cfg); err != nil {
+		logger.Warnw("Failed to autoscale data-plane Deployment", zap.Error(err))
+	}
+	return controller.NewRequeueAfter(r.period)
+}
+
+func (r *Reconciler) autoscale(ctx context.Context, nn types.NamespacedName, cfg *DeploymentConfig) error {
+	logger := logging.FromContext(ctx)
+
+	deployments := r.kubeClientSet.AppsV1().Deployments(nn.Namespace)
+	d, err := deployments.Get(ctx, nn.Name, metav1.GetOptions{})
+	if apierrs.IsNotFound(err) {
+		r.deleteState(nn)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get Deployment: %w", err)
+	}
+
+	current := int32(1)
+	if d.Spec.Replicas != nil {
+		current = *d.Spec.Replicas
+	}
+
+	var desired int32
+	if err := r.getMetricsErr(); err != nil {
+		// The event rate is unknown, only the bounds are enforced.
+		logger.Warnw("Not autoscaling on the event rate, the pod metrics can't be scraped", zap.Error(err))
+		desired = max(cfg.MinReplicas, min(current, cfg.MaxReplicas))
+	} else {
+		selector, err := metav1.LabelSelectorAsSelector(d.Spec.Selector)
+		if err != nil {
+			return fmt.Errorf("invalid Deployment selector: %w", err)
+		}
+		pods, err := r.podLister.Pods(nn.Namespace).List(selector)
+		if err != nil {
+			return fmt.Errorf("failed to list pods: %w", err)
+		}
+
+		ready := 0
+		samples := make(map[types.UID]float64, len(pods))
+		for _, pod := range pods {
+			if !isPodReady(pod) {
+				continue
+			}
+			ready++
+			events, err := r.scrape(ctx, pod, cfg.MetricsPort)
+			if err != nil {
+				logger.Infow("Failed to scrape pod metrics", zap.String("pod", pod.Name), zap.Error(err))
+				continue
+			}
+			samples[pod.UID] = events
+		}
+
+		if len(samples)*2 <= ready {
+			// The pods that couldn't be scraped may dispatch most of the events, only a quorum of
+			// the ready pods gives a reliable rate. The bounds are still enforced.
+			logger.Infow("Not enough pod metrics to autoscale", zap.Int("ready", ready), zap.Int("scraped", len(samples)))
+			desired = max(cfg.MinReplicas, min(current, cfg.MaxReplicas))
+		} else {
+			var ok bool
+			if desired, ok = r.desiredReplicas(nn, cfg, current, samples, ready); !ok {
+				return nil
+			}
+		}
+	}
+	if desired == current {
+		return nil
+	}
+
+	scale, err := deployments.GetScale(ctx, nn.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get Deployment scale: %w", err)
+	}
+	scale.Spec.Replicas = desired
+	if _, err := deployments.UpdateScale(ctx, nn.Name, scale, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update Deployment scale: %w", err)
+	}
+	logger.Infow("Scaled data-plane Deployment", zap.Int32("from", current), zap.Int32("to", desired))
+	return nil
+}
+
+// desiredReplicas records the samples and returns the desired replicas, or false when
+// there isn't enough information yet. The rate of the pods that weren't sampled twice is
+// extrapolated from the others to the ready pods. Scaling up is immediate while scaling
+// down only happens when the desired replicas stayed lower for ScaleDownDelay, to the
+// maximum desired replicas over that period.
+func (r *Reconciler) desiredReplicas(nn types.NamespacedName, cfg *DeploymentConfig, current int32, samples map[types.UID]float64, ready int) (int32, bool) {
+	r.statesMu.Lock()
+	defer r.statesMu.Unlock()
+
+	now := r.now()
+	s, ok := r.states[nn]
+	if !ok {
+		r.states[nn] = &deploymentState{samples: samples, sampleTime: now}
+		return 0, false
+	}
+
+	elapsed := now.Sub(s.sampleTime).Seconds()
+	if elapsed <= 0 {
+		return 0, false
+	}
+	events := float64(0)
+	counted := 0
+	for uid, count := range samples {
+		prev, ok := s.samples[uid]
+		if !ok {
+			// New pods are counted from the next sample.
+			continue
+		}
+		if count < prev {
+			// The counter was reset, the pod restarted.
+			prev = 0
+		}
+		events += count - prev
+		counted++
+	}
+	s.samples, s.sampleTime = samples, now
+	if counted == 0 {
+		return 0, false
+	}
+
+	rate := events / elapsed * float64(ready) / float64(counted)
+	desired := int32(math.Ceil(rate / cfg.TargetEventsPerSecond))
+	desired = max(cfg.MinReplicas, min(desired, cfg.MaxReplicas))
+
+	if current < cfg.MinReplicas || current > cfg.MaxReplicas || desired >= current {
+		s.belowSince = time.Time{}
+		if current < cfg.MinReplicas || current > cfg.MaxReplicas {
+			// Bring the Deployment within bounds right away.
+			return max(cfg.MinReplicas, min(max(desired, current), cfg.MaxReplicas)), true
+		}
+		return desired, true
+	}
+
+	if s.belowSince.IsZero() {
+		s.belowSince, s.belowMax = now, desired
+	}
+	s.belowMax = max(s.belowMax, desired)
+	if now.Sub(s.belowSince) < cfg.scaleDownDelay {
+		return current, true
+	}
+	desired = s.belowMax
+	s.belowSince = time.Time{}
+	return desired, true
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" || pod.DeletionTimestamp != nil {
+		return false
+	}
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func (r *Reconciler) deleteState(nn types.NamespacedName) {
+	r.statesMu.Lock()
+	defer r.statesMu.Unlock()
+
+	delete(r.states, nn)
+}
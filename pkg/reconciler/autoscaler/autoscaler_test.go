@@ -0,0 +1,396 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	gtesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/controller"
+	logtesting "knative.dev/pkg/logging/testing"
+	"knative.dev/pkg/reconciler"
+)
+
+const (
+	testNS         = "knative-eventing"
+	testDeployment = "mt-broker-filter"
+)
+
+// step is a reconciliation of the test Deployment.
+type step struct {
+	// elapsed is the time elapsed since the previous step.
+	elapsed time.Duration
+	// events are the events dispatched so far by each pod, by pod name.
+	events map[string]float64
+	// failing are the pods whose metrics can't be scraped.
+	failing []string
+	// want are the expected replicas after the step.
+	want int32
+}
+
+func TestAutoscale(t *testing.T) {
+	cfg := &DeploymentConfig{
+		MinReplicas:           1,
+		MaxReplicas:           5,
+		TargetEventsPerSecond: 10,
+		scaleDownDelay:        time.Minute,
+	}
+
+	tests := []struct {
+		name     string
+		replicas int32
+		steps    []step
+	}{{
+		name:     "first sample doesn't scale",
+		replicas: 1,
+		steps: []step{
+			{events: map[string]float64{"a": 1000}, want: 1},
+		},
+	}, {
+		name:     "scale up",
+		replicas: 1,
+		steps: []step{
+			{events: map[string]float64{"a": 0}, want: 1},
+			{elapsed: 10 * time.Second, events: map[string]float64{"a": 250}, want: 3},
+		},
+	}, {
+		name:     "scale up capped to max replicas",
+		replicas: 1,
+		steps: []step{
+			{events: map[string]float64{"a": 0}, want: 1},
+			{elapsed: 10 * time.Second, events: map[string]float64{"a": 10000}, want: 5},
+		},
+	}, {
+		name:     "new pods are counted from their second sample",
+		replicas: 2,
+		steps: []step{
+			{events: map[string]float64{"a": 0}, want: 2},
+			{elapsed: 10 * time.Second, events: map[string]float64{"a": 100, "b": 500}, want: 2},
+			{elapsed: 10 * time.Second, events: map[string]float64{"a": 200, "b": 600}, want: 2},
+		},
+	}, {
+		name:     "counter reset",
+		replicas: 2,
+		steps: []step{
+			{events: map[string]float64{"a": 1000, "b": 0}, want: 2},
+			{elapsed: 10 * time.Second, events: map[string]float64{"a": 100, "b": 100}, want: 2},
+		},
+	}, {
+		name:     "scale down after delay",
+		replicas: 4,
+		steps: []step{
+			{events: map[string]float64{"a": 0}, want: 4},
+			{elapsed: 30 * time.Second, events: map[string]float64{"a": 600}, want: 4},
+			{elapsed: 30 * time.Second, events: map[string]float64{"a": 900}, want: 4},
+			{elapsed: 30 * time.Second, events: map[string]float64{"a": 900}, want: 2},
+		},
+	}, {
+		name:     "scale down interrupted by load",
+		replicas: 4,
+		steps: []step{
+			{events: map[string]float64{"a": 0}, want: 4},
+			{elapsed: 30 * time.Second, events: map[string]float64{"a": 0}, want: 4},
+			{elapsed: 30 * time.Second, events: map[string]float64{"a": 1500}, want: 5},
+			{elapsed: 30 * time.Second, events: map[string]float64{"a": 1500}, want: 5},
+			{elapsed: 30 * time.Second, events: map[string]float64{"a": 1500}, want: 5},
+			{elapsed: 30 * time.Second, events: map[string]float64{"a": 1500}, want: 1},
+		},
+	}, {
+		name:     "below min replicas",
+		replicas: 0,
+		steps: []step{
+			{events: map[string]float64{}, want: 1},
+		},
+	}, {
+		name:     "scrape failures of most pods skip the decision",
+		replicas: 4,
+		steps: []step{
+			{events: map[string]float64{"a": 0, "b": 0, "c": 0, "d": 0}, want: 4},
+			{elapsed: time.Minute, events: map[string]float64{"a": 0}, failing: []string{"b", "c", "d"}, want: 4},
+			{elapsed: time.Minute, events: map[string]float64{"a": 0}, failing: []string{"b", "c", "d"}, want: 4},
+			{elapsed: time.Minute, events: map[string]float64{"a": 0}, failing: []string{"b", "c", "d"}, want: 4},
+		},
+	}, {
+		name:     "rate extrapolated to the pods that couldn't be scraped",
+		replicas: 3,
+		steps: []step{
+			{events: map[string]float64{"a": 0, "b": 0, "c": 0}, want: 3},
+			{elapsed: 10 * time.Second, events: map[string]float64{"a": 150, "b": 150}, failing: []string{"c"}, want: 5},
+		},
+	}, {
+		name:     "not ready pods are ignored",
+		replicas: 1,
+		steps: []step{
+			{events: map[string]float64{"a": 0, "not-ready": 0}, want: 1},
+			{elapsed: 10 * time.Second, events: map[string]float64{"a": 100, "not-ready": 10000}, want: 1},
+		},
+	}, {
+		name:     "above max replicas",
+		replicas: 8,
+		steps: []step{
+			{events: map[string]float64{"a": 0}, want: 8},
+			{elapsed: 10 * time.Second, events: map[string]float64{"a": 0}, want: 5},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := logtesting.TestContextWithLogger(t)
+			kc := newFakeClient(tt.replicas)
+			pods := newPodIndexer()
+
+			now := time.Now()
+			var events map[string]float64
+			r := newTestReconciler(kc, pods, func() time.Time { return now }, func(_ context.Context, pod *corev1.Pod, _ int32) (float64, error) {
+				e, ok := events[pod.Name]
+				if !ok {
+					return 0, fmt.Errorf("pod %s not found", pod.Name)
+				}
+				return e, nil
+			})
+
+			for i, s := range tt.steps {
+				now = now.Add(s.elapsed)
+				events = s.events
+				setPods(t, pods, s.events, s.failing)
+
+				if err := r.autoscale(ctx, types.NamespacedName{Namespace: testNS, Name: testDeployment}, cfg); err != nil {
+					t.Fatalf("step %d: autoscale() = %v", i, err)
+				}
+				d, err := kc.AppsV1().Deployments(testNS).Get(ctx, testDeployment, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got := *d.Spec.Replicas; got != s.want {
+					t.Errorf("step %d: replicas = %d, want %d", i, got, s.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAutoscaleWithoutMetrics(t *testing.T) {
+	cfg := &DeploymentConfig{MinReplicas: 2, MaxReplicas: 5, TargetEventsPerSecond: 10}
+
+	tests := []struct {
+		name     string
+		replicas int32
+		want     int32
+	}{{
+		name:     "within bounds",
+		replicas: 3,
+		want:     3,
+	}, {
+		name:     "below min replicas",
+		replicas: 1,
+		want:     2,
+	}, {
+		name:     "above max replicas",
+		replicas: 8,
+		want:     5,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := logtesting.TestContextWithLogger(t)
+			kc := newFakeClient(tt.replicas)
+			pods := newPodIndexer()
+			setPods(t, pods, map[string]float64{"a": 0}, nil)
+			r := newTestReconciler(kc, pods, time.Now, func(context.Context, *corev1.Pod, int32) (float64, error) {
+				t.Error("scrape() called without Prometheus metrics")
+				return 0, nil
+			})
+			r.setMetricsErr(errors.New("no Prometheus metrics"))
+
+			if err := r.autoscale(ctx, types.NamespacedName{Namespace: testNS, Name: testDeployment}, cfg); err != nil {
+				t.Fatalf("autoscale() = %v", err)
+			}
+			d, err := kc.AppsV1().Deployments(testNS).Get(ctx, testDeployment, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := *d.Spec.Replicas; got != tt.want {
+				t.Errorf("replicas = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := logtesting.TestContextWithLogger(t)
+	kc := newFakeClient(1)
+	pods := newPodIndexer()
+	setPods(t, pods, map[string]float64{"a": 0}, nil)
+	r := newTestReconciler(kc, pods, time.Now, func(context.Context, *corev1.Pod, int32) (float64, error) {
+		return 0, nil
+	})
+	key := testNS + "/" + testDeployment
+
+	// Not configured.
+	if err := r.Reconcile(ctx, key); err != nil {
+		t.Errorf("Reconcile() = %v, want nil", err)
+	}
+
+	r.setConfig(Config{testDeployment: {MinReplicas: 2, MaxReplicas: 3, TargetEventsPerSecond: 1}})
+
+	// Not the leader.
+	if err := r.Reconcile(ctx, key); err != nil {
+		t.Errorf("Reconcile() = %v, want nil", err)
+	}
+
+	if err := r.Promote(reconciler.UniversalBucket(), func(reconciler.Bucket, types.NamespacedName) {}); err != nil {
+		t.Fatal(err)
+	}
+	err := r.Reconcile(ctx, key)
+	if ok, d := controller.IsRequeueKey(err); !ok || d != r.period {
+		t.Errorf("Reconcile() = %v, want requeue after %v", err, r.period)
+	}
+	r.statesMu.Lock()
+	if _, ok := r.states[types.NamespacedName{Namespace: testNS, Name: testDeployment}]; !ok {
+		t.Error("Reconcile() didn't record the Deployment samples")
+	}
+	r.statesMu.Unlock()
+
+	// Removed from the configuration.
+	r.setConfig(Config{})
+	if err := r.Reconcile(ctx, key); err != nil {
+		t.Errorf("Reconcile() = %v, want nil", err)
+	}
+	r.statesMu.Lock()
+	if len(r.states) != 0 {
+		t.Error("Reconcile() didn't delete the Deployment state")
+	}
+	r.statesMu.Unlock()
+}
+
+func newTestReconciler(kc *fake.Clientset, pods cache.Indexer, now func() time.Time, scrape scrapeFunc) *Reconciler {
+	r := &Reconciler{
+		kubeClientSet: kc,
+		podLister:     corev1listers.NewPodLister(pods),
+		period:        defaultPeriod,
+		scrape:        scrape,
+		now:           now,
+		states:        make(map[types.NamespacedName]*deploymentState),
+	}
+	r.setMetricsErr(nil)
+	return r
+}
+
+func newPodIndexer() cache.Indexer {
+	return cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+}
+
+func newFakeClient(replicas int32) *fake.Clientset {
+	kc := fake.NewSimpleClientset(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNS, Name: testDeployment},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(replicas),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": testDeployment}},
+		},
+	})
+
+	deploymentsResource := appsv1.SchemeGroupVersion.WithResource("deployments")
+	kc.PrependReactor("get", "deployments", func(action gtesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "scale" {
+			return false, nil, nil
+		}
+		get := action.(gtesting.GetAction)
+		obj, err := kc.Tracker().Get(deploymentsResource, get.GetNamespace(), get.GetName())
+		if err != nil {
+			return true, nil, err
+		}
+		d := obj.(*appsv1.Deployment)
+		return true, &autoscalingv1.Scale{
+			ObjectMeta: metav1.ObjectMeta{Namespace: d.Namespace, Name: d.Name},
+			Spec:       autoscalingv1.ScaleSpec{Replicas: *d.Spec.Replicas},
+		}, nil
+	})
+	kc.PrependReactor("update", "deployments", func(action gtesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "scale" {
+			return false, nil, nil
+		}
+		scale := action.(gtesting.UpdateAction).GetObject().(*autoscalingv1.Scale)
+		obj, err := kc.Tracker().Get(deploymentsResource, scale.Namespace, scale.Name)
+		if err != nil {
+			return true, nil, err
+		}
+		d := obj.(*appsv1.Deployment).DeepCopy()
+		d.Spec.Replicas = ptr.To(scale.Spec.Replicas)
+		return true, scale, kc.Tracker().Update(deploymentsResource, d, d.Namespace)
+	})
+	return kc
+}
+
+// setPods makes the running pods of the test Deployment match the given pod names. The
+// pods are ready, unless their name starts with "not-ready".
+func setPods(t *testing.T, pods cache.Indexer, events map[string]float64, failing []string) {
+	t.Helper()
+
+	names := make(map[string]bool, len(events)+len(failing))
+	for name := range events {
+		names[name] = true
+	}
+	for _, name := range failing {
+		names[name] = true
+	}
+
+	for _, obj := range pods.List() {
+		if p := obj.(*corev1.Pod); !names[p.Name] {
+			if err := pods.Delete(p); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for name := range names {
+		if _, ok, _ := pods.GetByKey(testNS + "/" + name); ok {
+			continue
+		}
+		ready := corev1.ConditionTrue
+		if strings.HasPrefix(name, "not-ready") {
+			ready = corev1.ConditionFalse
+		}
+		err := pods.Add(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNS,
+				Name:      name,
+				UID:       types.UID(name),
+				Labels:    map[string]string{"app": testDeployment},
+			},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				PodIP:      "10.0.0.1",
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
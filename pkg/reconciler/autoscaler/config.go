@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"knative.dev/pkg/observability/metrics"
+
+	"knative.dev/eventing/pkg/observability"
+	o11yconfigmap "knative.dev/eventing/pkg/observability/configmap"
+)
+
+const (
+	// ConfigKey is the key of the data-plane autoscaling configuration in the
+	// config-br-defaults and config-imc-event-dispatcher ConfigMaps. The event rate is
+	// scraped from the Prometheus endpoint of the pods, so the data plane must use the
+	// prometheus metrics-protocol of config-observability, the Deployments are only kept
+	// within their bounds otherwise.
+	ConfigKey = "data-plane-autoscaling"
+
+	defaultScaleDownDelay = 5 * time.Minute
+)
+
+// Config is the data-plane autoscaling configuration of each Deployment, by Deployment name.
+type Config map[string]*DeploymentConfig
+
+// DeploymentConfig is the autoscaling configuration of a data-plane Deployment.
+type DeploymentConfig struct {
+	// MinReplicas is the minimum number of replicas, at least 1.
+	MinReplicas int32 `json:"minReplicas"`
+	// MaxReplicas is the maximum number of replicas.
+	MaxReplicas int32 `json:"maxReplicas"`
+	// TargetEventsPerSecond is the number of events per second dispatched by each replica.
+	TargetEventsPerSecond float64 `json:"targetEventsPerSecond"`
+	// ScaleDownDelay is how long the load must stay lower before scaling down, as a
+	// Go duration. Defaults to 5m.
+	ScaleDownDelay string `json:"scaleDownDelay,omitempty"`
+	// MetricsPort is the port of the Prometheus metrics of the pods. Defaults to 9092.
+	MetricsPort int32 `json:"metricsPort,omitempty"`
+
+	scaleDownDelay time.Duration
+}
+
+// NewConfigFromConfigMap returns the data-plane autoscaling configuration of the ConfigMap.
+// The configuration is empty when the ConfigMap doesn't have the ConfigKey key.
+func NewConfigFromConfigMap(cm *corev1.ConfigMap) (Config, error) {
+	value, ok := cm.Data[ConfigKey]
+	if !ok || value == "" {
+		return Config{}, nil
+	}
+
+	cfg := Config{}
+	if err := yaml.Unmarshal([]byte(value), &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %w", ConfigKey, err)
+	}
+	for name, d := range cfg {
+		if err := d.setDefaults(); err != nil {
+			return nil, fmt.Errorf("invalid %q configuration for Deployment %q: %w", ConfigKey, name, err)
+		}
+	}
+	return cfg, nil
+}
+
+// checkObservability returns why the pod metrics can't be scraped with the given
+// config-observability ConfigMap of the data plane, or nil when they can.
+func checkObservability(cm *corev1.ConfigMap) error {
+	cfg, err := o11yconfigmap.Parse(cm)
+	if err != nil {
+		return fmt.Errorf("invalid %s ConfigMap: %w", cm.Name, err)
+	}
+	if protocol := cfg.Metrics.Protocol; protocol != metrics.ProtocolPrometheus {
+		return fmt.Errorf("the data plane doesn't export Prometheus metrics, the metrics-protocol of %s is %q rather than %q",
+			cm.Name, protocol, metrics.ProtocolPrometheus)
+	}
+	return nil
+}
+
+func (d *DeploymentConfig) setDefaults() error {
+	if d == nil {
+		return fmt.Errorf("missing configuration")
+	}
+	if d.MinReplicas < 1 {
+		return fmt.Errorf("minReplicas must be at least 1")
+	}
+	if d.MaxReplicas < d.MinReplicas {
+		return fmt.Errorf("maxReplicas must be greater than or equal to minReplicas")
+	}
+	if d.TargetEventsPerSecond <= 0 {
+		return fmt.Errorf("targetEventsPerSecond must be greater than 0")
+	}
+
+	d.scaleDownDelay = defaultScaleDownDelay
+	if d.ScaleDownDelay != "" {
+		delay, err := time.ParseDuration(d.ScaleDownDelay)
+		if err != nil || delay < 0 {
+			return fmt.Errorf("scaleDownDelay must be a positive duration: %q", d.ScaleDownDelay)
+		}
+		d.scaleDownDelay = delay
+	}
+
+	if d.MetricsPort == 0 {
+		d.MetricsPort = observability.DefaultMetricsPort
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewConfigFromConfigMap(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    Config
+		wantErr bool
+	}{{
+		name: "no configuration",
+		want: Config{},
+	}, {
+		name: "defaults",
+		data: map[string]string{ConfigKey: `
+mt-broker-filter:
+  minReplicas: 1
+  maxReplicas: 10
+  targetEventsPerSecond: 100
+`},
+		want: Config{"mt-broker-filter": {
+			MinReplicas:           1,
+			MaxReplicas:           10,
+			TargetEventsPerSecond: 100,
+			MetricsPort:           9092,
+			scaleDownDelay:        defaultScaleDownDelay,
+		}},
+	}, {
+		name: "all fields",
+		data: map[string]string{ConfigKey: `
+imc-dispatcher:
+  minReplicas: 2
+  maxReplicas: 4
+  targetEventsPerSecond: 50.5
+  scaleDownDelay: 1m
+  metricsPort: 9090
+`},
+		want: Config{"imc-dispatcher": {
+			MinReplicas:           2,
+			MaxReplicas:           4,
+			TargetEventsPerSecond: 50.5,
+			ScaleDownDelay:        "1m",
+			MetricsPort:           9090,
+			scaleDownDelay:        time.Minute,
+		}},
+	}, {
+		name:    "invalid yaml",
+		data:    map[string]string{ConfigKey: `mt-broker-filter: [`},
+		wantErr: true,
+	}, {
+		name:    "missing min replicas",
+		data:    map[string]string{ConfigKey: `mt-broker-filter: {maxReplicas: 10, targetEventsPerSecond: 100}`},
+		wantErr: true,
+	}, {
+		name:    "max lower than min",
+		data:    map[string]string{ConfigKey: `mt-broker-filter: {minReplicas: 3, maxReplicas: 2, targetEventsPerSecond: 100}`},
+		wantErr: true,
+	}, {
+		name:    "missing target",
+		data:    map[string]string{ConfigKey: `mt-broker-filter: {minReplicas: 1, maxReplicas: 2}`},
+		wantErr: true,
+	}, {
+		name:    "invalid scale down delay",
+		data:    map[string]string{ConfigKey: `mt-broker-filter: {minReplicas: 1, maxReplicas: 2, targetEventsPerSecond: 1, scaleDownDelay: soon}`},
+		wantErr: true,
+	}, {
+		name:    "missing deployment configuration",
+		data:    map[string]string{ConfigKey: `mt-broker-filter:`},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewConfigFromConfigMap(&corev1.ConfigMap{Data: tt.data})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewConfigFromConfigMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(DeploymentConfig{})); diff != "" {
+				t.Error("unexpected config (-want, +got):", diff)
+			}
+		})
+	}
+}
+
+func TestCheckObservability(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]string
+		wantErr bool
+	}{{
+		name:    "default protocol",
+		wantErr: true,
+	}, {
+		name:    "otlp",
+		data:    map[string]string{"metrics-protocol": "http/protobuf"},
+		wantErr: true,
+	}, {
+		name: "prometheus",
+		data: map[string]string{"metrics-protocol": "prometheus"},
+	}, {
+		name:    "invalid protocol",
+		data:    map[string]string{"metrics-protocol": "carrier-pigeon"},
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config-observability"}, Data: tt.data}
+			if err := checkObservability(cm); (err != nil) != tt.wantErr {
+				t.Errorf("checkObservability() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
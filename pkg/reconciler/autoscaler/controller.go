@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	podinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/pod/filtered"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+
+	"knative.dev/eventing/pkg/apis/config"
+	o11yconfigmap "knative.dev/eventing/pkg/observability/configmap"
+	imcconfig "knative.dev/eventing/pkg/reconciler/inmemorychannel/controller/config"
+)
+
+const (
+	// ReconcilerName is the name of the reconciler.
+	ReconcilerName = "DataPlaneAutoscaler"
+
+	defaultPeriod = 30 * time.Second
+
+	// PodLabelSelector selects the pods cached for the autoscaler, only the pods of the
+	// data-plane Deployments are autoscaled.
+	PodLabelSelector = "app.kubernetes.io/name=knative-eventing"
+)
+
+// NewBrokerController returns the autoscaler of the MT broker data plane, configured
+// by the ConfigKey key of config-br-defaults.
+func NewBrokerController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	return NewController(config.DefaultsConfigName)(ctx, cmw)
+}
+
+// NewInMemoryChannelController returns the autoscaler of the in-memory channel data plane,
+// configured by the ConfigKey key of config-imc-event-dispatcher.
+func NewInMemoryChannelController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	return NewController(imcconfig.EventDispatcherConfigMap)(ctx, cmw)
+}
+
+// NewController returns a function that initializes the autoscaler of the Deployments
+// configured by the ConfigKey key of the given ConfigMap, in the system namespace.
+func NewController(configMapName string) injection.ControllerConstructor {
+	return func(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+		logger := logging.FromContext(ctx)
+		namespace := system.Namespace()
+
+		scraper := &httpScraper{client: &http.Client{Timeout: 5 * time.Second}}
+		r := &Reconciler{
+			kubeClientSet: kubeclient.Get(ctx),
+			podLister:     podinformer.Get(ctx, PodLabelSelector).Lister(),
+			period:        defaultPeriod,
+			scrape:        scraper.scrape,
+			now:           time.Now,
+			states:        make(map[types.NamespacedName]*deploymentState),
+		}
+
+		impl := controller.NewContext(ctx, r, controller.ControllerOptions{
+			Logger: logger, WorkQueueName: ReconcilerName,
+		})
+
+		r.LeaderAwareFuncs = reconciler.LeaderAwareFuncs{
+			PromoteFunc: func(bkt reconciler.Bucket, enq func(reconciler.Bucket, types.NamespacedName)) error {
+				for name := range r.getConfig() {
+					enq(bkt, types.NamespacedName{Namespace: namespace, Name: name})
+				}
+				return nil
+			},
+		}
+
+		// reportMetrics reports that the configured Deployments are only kept within their
+		// bounds, when the pod metrics can't be scraped.
+		reportMetrics := func() {
+			if err := r.getMetricsErr(); err != nil && len(r.getConfig()) > 0 {
+				logger.Errorw("The data-plane Deployments are not autoscaled on the event rate, the pod metrics can't be scraped", "error", err)
+			}
+		}
+
+		cmw.Watch(o11yconfigmap.Name(), func(cm *corev1.ConfigMap) {
+			r.setMetricsErr(checkObservability(cm))
+			reportMetrics()
+		})
+
+		cmw.Watch(configMapName, func(cm *corev1.ConfigMap) {
+			cfg, err := NewConfigFromConfigMap(cm)
+			if err != nil {
+				logger.Errorw("Failed to parse the data-plane autoscaling configuration", "configmap", configMapName, "error", err)
+				return
+			}
+			previous := r.getConfig()
+			r.setConfig(cfg)
+			reportMetrics()
+			// Enqueue the removed Deployments too, so that their state is cleaned up.
+			for name := range previous {
+				impl.EnqueueKey(types.NamespacedName{Namespace: namespace, Name: name})
+			}
+			for name := range cfg {
+				impl.EnqueueKey(types.NamespacedName{Namespace: namespace, Name: name})
+			}
+		})
+
+		return impl
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DispatchMetricName is the Prometheus name of the kn.eventing.dispatch.duration
+// histogram, emitted by the broker ingress and filter and the channel dispatchers.
+const DispatchMetricName = "kn_eventing_dispatch_duration_seconds"
+
+// scrapeFunc returns the total number of events dispatched by the pod.
+type scrapeFunc func(ctx context.Context, pod *corev1.Pod, port int32) (float64, error)
+
+// httpScraper scrapes the Prometheus metrics of the pods.
+type httpScraper struct {
+	client *http.Client
+}
+
+func (s *httpScraper) scrape(ctx context.Context, pod *corev1.Pod, port int32) (float64, error) {
+	url := "http://" + net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(port))) + "/metrics"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status code %d scraping %s", resp.StatusCode, url)
+	}
+
+	parser := expfmt.NewTextParser(model.UTF8Validation)
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse metrics of %s: %w", url, err)
+	}
+	return dispatchedEvents(families[DispatchMetricName]), nil
+}
+
+// dispatchedEvents returns the number of samples of the dispatch histogram, across all
+// the label sets.
+func dispatchedEvents(family *dto.MetricFamily) float64 {
+	if family == nil {
+		return 0
+	}
+	total := float64(0)
+	for _, m := range family.GetMetric() {
+		if h := m.GetHistogram(); h != nil {
+			total += float64(h.GetSampleCount())
+		}
+	}
+	return total
+}
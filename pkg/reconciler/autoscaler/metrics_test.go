@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaler
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const metricsText = `# HELP kn_eventing_dispatch_duration_seconds The duration to dispatch the event
+# TYPE kn_eventing_dispatch_duration_seconds histogram
+kn_eventing_dispatch_duration_seconds_bucket{kn_broker_name="a",le="0.1"} 3
+kn_eventing_dispatch_duration_seconds_bucket{kn_broker_name="a",le="+Inf"} 5
+kn_eventing_dispatch_duration_seconds_sum{kn_broker_name="a"} 0.7
+kn_eventing_dispatch_duration_seconds_count{kn_broker_name="a"} 5
+kn_eventing_dispatch_duration_seconds_bucket{kn_broker_name="b",le="0.1"} 7
+kn_eventing_dispatch_duration_seconds_bucket{kn_broker_name="b",le="+Inf"} 7
+kn_eventing_dispatch_duration_seconds_sum{kn_broker_name="b"} 0.2
+kn_eventing_dispatch_duration_seconds_count{kn_broker_name="b"} 7
+# HELP other_total Another metric
+# TYPE other_total counter
+other_total 42
+`
+
+func TestHTTPScraper(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		status  int
+		want    float64
+		wantErr bool
+	}{{
+		name:   "dispatch histogram",
+		body:   metricsText,
+		status: http.StatusOK,
+		want:   12,
+	}, {
+		name:   "no dispatch histogram",
+		body:   "# TYPE other_total counter\nother_total 42\n",
+		status: http.StatusOK,
+		want:   0,
+	}, {
+		name:    "error status",
+		status:  http.StatusInternalServerError,
+		wantErr: true,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/metrics" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			host, port, err := net.SplitHostPort(srv.Listener.Addr().String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			p, _ := strconv.Atoi(port)
+
+			s := &httpScraper{client: srv.Client()}
+			pod := &corev1.Pod{Status: corev1.PodStatus{PodIP: host}}
+			got, err := s.scrape(context.Background(), pod, int32(p))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("scrape() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("scrape() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
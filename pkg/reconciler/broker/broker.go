@@ -37,7 +37,6 @@ import (
 	discoveryv1listers "k8s.io/client-go/listers/discovery/v1"
 	"k8s.io/utils/pointer"
 	"knative.dev/pkg/kmeta"
-	"knative.dev/pkg/resolver"
 
 	"knative.dev/pkg/apis"
 	duckapis "knative.dev/pkg/apis/duck"
@@ -62,6 +61,7 @@ import (
 	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/eventing/pkg/reconciler/broker/resources"
 	"knative.dev/eventing/pkg/reconciler/names"
+	"knative.dev/eventing/pkg/resolver"
 )
 
 const (
@@ -87,6 +87,8 @@ type Reconciler struct {
 	brokerClass string
 
 	eventPolicyLister eventingv1alpha1listers.EventPolicyLister
+
+	certificateExpiryMonitor *eventingtls.CertificateExpiryMonitor
 }
 
 // Check that our Reconciler implements Interface
@@ -278,6 +280,8 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, b *eventingv1.Broker) pk
 
 	b.GetConditionSet().Manage(b.GetStatus()).MarkTrue(eventingv1.BrokerConditionAddressable)
 
+	r.reconcileCertificateExpiry(ctx, b, featureFlags)
+
 	err = auth.UpdateStatusWithEventPolicies(featureFlags, &b.Status.AppliedEventPoliciesStatus, &b.Status, r.eventPolicyLister, eventingv1.SchemeGroupVersion.WithKind("Broker"), b.ObjectMeta)
 	if err != nil {
 		return fmt.Errorf("could not update broker status with EventPolicies: %v", err)
@@ -541,6 +545,21 @@ func (r *Reconciler) getCaCerts() (*string, error) {
 	return pointer.String(string(caCerts)), nil
 }
 
+// reconcileCertificateExpiry reports the expiry of the ingress server certificates and of the
+// trust bundles on the Broker status.
+func (r *Reconciler) reconcileCertificateExpiry(ctx context.Context, b *eventingv1.Broker, featureFlags feature.Flags) {
+	var exp *eventingtls.CertificateExpiry
+	if !featureFlags.IsDisabledTransportEncryption() {
+		var err error
+		exp, err = eventingtls.ServerCertificateExpiry(r.secretLister.Secrets(system.Namespace()), ingressServerTLSSecretName, r.configmapLister.ConfigMaps(system.Namespace()))
+		if err != nil {
+			logging.FromContext(ctx).Warnw("Failed to get certificate expiry", zap.Error(err))
+			return
+		}
+	}
+	r.certificateExpiryMonitor.Reconcile(ctx, eventingv1.SchemeGroupVersion.WithKind("Broker"), b, b.GetStatus(), b.GetConditionSet(), exp)
+}
+
 func (r *Reconciler) httpAddress(b *eventingv1.Broker) pkgduckv1.Addressable {
 	// http address uses path-based routing
 	httpAddress := pkgduckv1.Addressable{
@@ -62,6 +62,7 @@ import (
 	_ "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/trigger/fake"
 	"knative.dev/eventing/pkg/reconciler/broker/resources"
 	. "knative.dev/eventing/pkg/reconciler/testing/v1"
+	eventingresolver "knative.dev/eventing/pkg/resolver"
 )
 
 const (
@@ -1110,7 +1111,7 @@ func TestReconcile(t *testing.T) {
 			configmapLister:     listers.GetConfigMapLister(),
 			secretLister:        listers.GetSecretLister(),
 			channelableTracker:  duck.NewListableTrackerFromTracker(ctx, channelable.Get, tracker.New(func(types.NamespacedName) {}, 0)),
-			uriResolver:         resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
+			uriResolver:         &eventingresolver.URIResolver{URIResolver: resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0))},
 			eventPolicyLister:   listers.GetEventPolicyLister(),
 		}
 		return broker.NewReconciler(ctx, logger,
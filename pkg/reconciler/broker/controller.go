@@ -21,6 +21,7 @@ import (
 
 	"knative.dev/eventing/pkg/auth"
 
+	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	"k8s.io/client-go/tools/cache"
 	"knative.dev/pkg/apis"
@@ -32,7 +33,6 @@ import (
 	namespacedinformerfactory "knative.dev/pkg/injection/clients/namespacedkube/informers/factory"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
-	"knative.dev/pkg/resolver"
 	"knative.dev/pkg/system"
 
 	"knative.dev/eventing/pkg/apis/eventing"
@@ -45,6 +45,8 @@ import (
 	subscriptioninformer "knative.dev/eventing/pkg/client/injection/informers/messaging/v1/subscription"
 	brokerreconciler "knative.dev/eventing/pkg/client/injection/reconciler/eventing/v1/broker"
 	"knative.dev/eventing/pkg/duck"
+	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/resolver"
 )
 
 const (
@@ -91,6 +93,11 @@ func NewController(
 		eventingv1.BrokerConditionEventPoliciesReady,
 	))
 
+	certificateExpiryMonitor, err := eventingtls.NewCertificateExpiryMonitor(otel.GetMeterProvider())
+	if err != nil {
+		logger.Fatalw("Failed to create certificate expiry monitor", zap.Error(err))
+	}
+
 	brokerFilter := pkgreconciler.AnnotationFilterFunc(brokerreconciler.ClassAnnotationKey, eventing.MTChannelBrokerClassValue, false /*allowUnset*/)
 
 	r := &Reconciler{
@@ -102,6 +109,8 @@ func NewController(
 		configmapLister:     configmapInformer.Lister(),
 		secretLister:        secretInformer.Lister(),
 		eventPolicyLister:   eventPolicyInformer.Lister(),
+
+		certificateExpiryMonitor: certificateExpiryMonitor,
 	}
 	impl := brokerreconciler.NewImpl(ctx, r, eventing.MTChannelBrokerClassValue, func(impl *controller.Impl) controller.Options {
 		return controller.Options{
@@ -111,7 +120,7 @@ func NewController(
 	})
 
 	r.channelableTracker = duck.NewListableTrackerFromTracker(ctx, channelable.Get, impl.Tracker)
-	r.uriResolver = resolver.NewURIResolverFromTracker(ctx, impl.Tracker)
+	r.uriResolver = resolver.NewURIResolver(ctx, cmw, impl.Tracker)
 
 	brokerInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: brokerFilter,
@@ -136,6 +145,14 @@ func NewController(
 		FilterFunc: controller.FilterWithName(ingressServerTLSSecretName),
 		Handler:    controller.HandleAll(globalResync),
 	})
+	// Resync for changes to trust bundles, as their expiry is reported on the Broker status.
+	configmapInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: pkgreconciler.ChainFilterFuncs(
+			pkgreconciler.NamespaceFilterFunc(system.Namespace()),
+			pkgreconciler.LabelFilterFunc(eventingtls.TrustBundleLabelKey, eventingtls.TrustBundleLabelValue, false),
+		),
+		Handler: controller.HandleAll(globalResync),
+	})
 
 	brokerGK := eventingv1.SchemeGroupVersion.WithKind("Broker").GroupKind()
 
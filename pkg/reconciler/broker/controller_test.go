@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/client/injection/ducks/duck/v1/kresource"
 	"knative.dev/pkg/configmap"
 	. "knative.dev/pkg/reconciler/testing"
 	"knative.dev/pkg/system"
@@ -41,6 +42,7 @@ import (
 
 func TestNew(t *testing.T) {
 	ctx, _ := SetupFakeContext(t)
+	ctx = kresource.WithDuck(ctx)
 
 	secret := types.NamespacedName{
 		Namespace: system.Namespace(),
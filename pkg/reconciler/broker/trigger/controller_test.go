@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"testing"
 
+	"knative.dev/pkg/client/injection/ducks/duck/v1/kresource"
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/ptr"
 
@@ -60,8 +61,12 @@ import (
 
 func TestNew(t *testing.T) {
 	ctx, _ := SetupFakeContext(t, SetUpInformerSelector)
+	ctx = kresource.WithDuck(ctx)
 
-	c := NewController(ctx, configmap.NewStaticWatcher(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config-features"}}))
+	c := NewController(ctx, configmap.NewStaticWatcher(
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config-features"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config-kreference-mapping"}},
+	))
 
 	if c == nil {
 		t.Fatal("Expected NewController to return a non-nil value")
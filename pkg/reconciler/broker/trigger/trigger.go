@@ -37,7 +37,6 @@ import (
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
-	"knative.dev/pkg/resolver"
 	"knative.dev/pkg/system"
 
 	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
@@ -54,6 +53,7 @@ import (
 	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/eventing/pkg/reconciler/broker/resources"
 	"knative.dev/eventing/pkg/reconciler/sugar/trigger/path"
+	"knative.dev/eventing/pkg/resolver"
 )
 
 var brokerGVK = eventingv1.SchemeGroupVersion.WithKind("Broker")
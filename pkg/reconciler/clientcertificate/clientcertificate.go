@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientcertificate
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	cmv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/reconciler"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/certificates"
+	"knative.dev/eventing/pkg/eventingtls"
+)
+
+// Reconciler issues a client certificate for every eventing identity while client
+// certificate authentication is enabled, and deletes it otherwise.
+type Reconciler struct {
+	reconciler.LeaderAwareFuncs
+
+	kubeClient kubernetes.Interface
+	cmClient   cmclient.Interface
+
+	serviceAccountLister corev1listers.ServiceAccountLister
+	secretLister         corev1listers.SecretLister
+	cmCertificateLister  *atomic.Pointer[cmlisters.CertificateLister]
+
+	configStore *feature.Store
+}
+
+// Check that our Reconciler implements controller.Reconciler.
+var _ reconciler.LeaderAware = (*Reconciler)(nil)
+
+// Reconcile implements controller.Reconciler.
+func (r *Reconciler) Reconcile(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		logging.FromContext(ctx).Errorw("Invalid resource key", "key", key)
+		return nil
+	}
+	if !r.IsLeaderFor(types.NamespacedName{Namespace: namespace, Name: name}) {
+		return nil
+	}
+	ctx = r.configStore.ToContext(ctx)
+
+	sa, err := r.serviceAccountLister.ServiceAccounts(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return r.deleteClientCertificate(ctx, namespace, name)
+	}
+	if err != nil {
+		return err
+	}
+	if !sa.DeletionTimestamp.IsZero() || !feature.FromContext(ctx).IsClientCertAuthentication() {
+		return r.deleteClientCertificate(ctx, namespace, name)
+	}
+	return r.reconcileClientCertificate(ctx, sa)
+}
+
+func (r *Reconciler) reconcileClientCertificate(ctx context.Context, sa *corev1.ServiceAccount) error {
+	expected := makeClientCertificate(sa)
+
+	cmCertificateLister := r.cmCertificateLister.Load()
+	if cmCertificateLister == nil || *cmCertificateLister == nil {
+		return fmt.Errorf("no cert-manager certificate lister created yet, this should rarely happen and recover")
+	}
+
+	curr, err := (*cmCertificateLister).Certificates(expected.GetNamespace()).Get(expected.GetName())
+	if apierrors.IsNotFound(err) {
+		if _, err := r.cmClient.CertmanagerV1().Certificates(expected.GetNamespace()).Create(ctx, expected, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create client certificate %s/%s: %w", expected.GetNamespace(), expected.GetName(), err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get client certificate %s/%s: %w", expected.GetNamespace(), expected.GetName(), err)
+	}
+	if equality.Semantic.DeepDerivative(expected.Spec, curr.Spec) &&
+		equality.Semantic.DeepDerivative(expected.Labels, curr.Labels) {
+		return nil
+	}
+	expected.ResourceVersion = curr.ResourceVersion
+	if _, err := r.cmClient.CertmanagerV1().Certificates(expected.GetNamespace()).Update(ctx, expected, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update client certificate %s/%s: %w", expected.GetNamespace(), expected.GetName(), err)
+	}
+	return nil
+}
+
+// deleteClientCertificate deletes the Certificate and the Secret of the given service account,
+// cert-manager doesn't delete the Secret of a deleted Certificate.
+func (r *Reconciler) deleteClientCertificate(ctx context.Context, namespace, serviceAccountName string) error {
+	name := eventingtls.ClientCertificateSecretName(serviceAccountName)
+
+	cmCertificateLister := r.cmCertificateLister.Load()
+	if cmCertificateLister != nil && *cmCertificateLister != nil {
+		_, err := (*cmCertificateLister).Certificates(namespace).Get(name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get client certificate %s/%s: %w", namespace, name, err)
+		}
+		if err == nil {
+			err := r.cmClient.CertmanagerV1().Certificates(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete client certificate %s/%s: %w", namespace, name, err)
+			}
+		}
+	}
+
+	if _, err := r.secretLister.Secrets(namespace).Get(name); apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get client certificate secret %s/%s: %w", namespace, name, err)
+	}
+	err := r.kubeClient.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete client certificate secret %s/%s: %w", namespace, name, err)
+	}
+	return nil
+}
+
+// serviceAccount makes a ServiceAccount a kmeta.OwnerRefableAccessor.
+type serviceAccount struct {
+	*corev1.ServiceAccount
+}
+
+func (serviceAccount) GetGroupVersionKind() schema.GroupVersionKind {
+	return corev1.SchemeGroupVersion.WithKind("ServiceAccount")
+}
+
+func makeClientCertificate(sa *corev1.ServiceAccount) *cmv1.Certificate {
+	name := eventingtls.ClientCertificateSecretName(sa.Name)
+	return certificates.MakeCertificate(serviceAccount{sa},
+		func(cert *cmv1.Certificate) {
+			cert.Name = name
+			cert.Spec.SecretName = name
+			cert.Spec.SecretTemplate.Labels[eventingtls.ClientCertificateLabelKey] = "enabled"
+		},
+		certificates.WithURIs(eventingtls.ClientCertificateURI(types.NamespacedName{Namespace: sa.Namespace, Name: sa.Name})),
+		certificates.WithUsages(cmv1.UsageClientAuth, cmv1.UsageDigitalSignature, cmv1.UsageKeyEncipherment),
+		certificates.WithIssuer(eventingtls.ClientCAIssuerName),
+	)
+}
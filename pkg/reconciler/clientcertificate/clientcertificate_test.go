@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientcertificate
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	cmv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	logtesting "knative.dev/pkg/logging/testing"
+	"knative.dev/pkg/system"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	cmclient "knative.dev/eventing/pkg/client/certmanager/injection/client/fake"
+	"knative.dev/eventing/pkg/eventingtls"
+	kubeclient "knative.dev/pkg/client/injection/kube/client/fake"
+
+	. "knative.dev/eventing/pkg/reconciler/testing/v1"
+	. "knative.dev/pkg/reconciler/testing"
+)
+
+const (
+	testNS = "test-namespace"
+	testSA = "test-trigger-oidc-identity"
+)
+
+func TestReconcile(t *testing.T) {
+	secretName := eventingtls.ClientCertificateSecretName(testSA)
+
+	table := TableTest{{
+		Name: "bad workqueue key",
+		Key:  "too/many/parts",
+		Objects: []runtime.Object{
+			features(feature.Disabled),
+		},
+	}, {
+		Name: "service account not found",
+		Key:  testNS + "/" + testSA,
+		Objects: []runtime.Object{
+			features(feature.Enabled),
+		},
+	}, {
+		Name: "client certificate created",
+		Key:  testNS + "/" + testSA,
+		Objects: []runtime.Object{
+			features(feature.Enabled),
+			testServiceAccount(),
+		},
+		WantCreates: []runtime.Object{
+			makeClientCertificate(testServiceAccount()),
+		},
+	}, {
+		Name: "client certificate up to date",
+		Key:  testNS + "/" + testSA,
+		Objects: []runtime.Object{
+			features(feature.Enabled),
+			testServiceAccount(),
+			makeClientCertificate(testServiceAccount()),
+			secret(secretName),
+		},
+	}, {
+		Name: "client certificate updated",
+		Key:  testNS + "/" + testSA,
+		Objects: []runtime.Object{
+			features(feature.Enabled),
+			testServiceAccount(),
+			withURIs(makeClientCertificate(testServiceAccount()), "system:serviceaccount:other:other"),
+		},
+		WantUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: makeClientCertificate(testServiceAccount()),
+		}},
+	}, {
+		Name: "feature disabled, client certificate deleted",
+		Key:  testNS + "/" + testSA,
+		Objects: []runtime.Object{
+			features(feature.Disabled),
+			testServiceAccount(),
+			makeClientCertificate(testServiceAccount()),
+			secret(secretName),
+		},
+		WantDeletes: []clientgotesting.DeleteActionImpl{
+			deleteCertificate(secretName),
+			deleteSecret(secretName),
+		},
+	}, {
+		Name: "service account deleted, client certificate deleted",
+		Key:  testNS + "/" + testSA,
+		Objects: []runtime.Object{
+			features(feature.Enabled),
+			makeClientCertificate(testServiceAccount()),
+			secret(secretName),
+		},
+		WantDeletes: []clientgotesting.DeleteActionImpl{
+			deleteCertificate(secretName),
+			deleteSecret(secretName),
+		},
+	}}
+
+	logger := logtesting.TestLogger(t)
+	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
+		cmCertificateLister := &atomic.Pointer[cmlisters.CertificateLister]{}
+		certificateLister := listers.GetCertificateLister()
+		cmCertificateLister.Store(&certificateLister)
+
+		r := &Reconciler{
+			kubeClient:           kubeclient.Get(ctx),
+			cmClient:             cmclient.Get(ctx),
+			serviceAccountLister: listers.GetServiceAccountLister(),
+			secretLister:         listers.GetSecretLister(),
+			cmCertificateLister:  cmCertificateLister,
+			configStore:          feature.NewStore(logger.Named("config-store")),
+		}
+		r.configStore.WatchConfigs(cmw)
+		return r
+	}, false, logger))
+}
+
+func features(clientCertAuthentication feature.Flag) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      feature.FlagsConfigName,
+			Namespace: system.Namespace(),
+		},
+		Data: map[string]string{
+			feature.TransportEncryption:      string(feature.Strict),
+			feature.ClientCertAuthentication: string(clientCertAuthentication),
+		},
+	}
+}
+
+func testServiceAccount() *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testSA,
+			Namespace: testNS,
+			UID:       "sa-uid",
+			Labels: map[string]string{
+				"eventing.knative.dev/oidc": "enabled",
+			},
+		},
+	}
+}
+
+func secret(name string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNS,
+			Labels: map[string]string{
+				eventingtls.ClientCertificateLabelKey: "enabled",
+			},
+		},
+	}
+}
+
+func withURIs(cert *cmv1.Certificate, uris ...string) *cmv1.Certificate {
+	cert.Spec.URIs = uris
+	return cert
+}
+
+func deleteCertificate(name string) clientgotesting.DeleteActionImpl {
+	return clientgotesting.DeleteActionImpl{
+		ActionImpl: clientgotesting.ActionImpl{
+			Namespace: testNS,
+			Resource:  cmv1.SchemeGroupVersion.WithResource("certificates"),
+		},
+		Name: name,
+	}
+}
+
+func deleteSecret(name string) clientgotesting.DeleteActionImpl {
+	return clientgotesting.DeleteActionImpl{
+		ActionImpl: clientgotesting.ActionImpl{
+			Namespace: testNS,
+			Resource:  corev1.SchemeGroupVersion.WithResource("secrets"),
+		},
+		Name: name,
+	}
+}
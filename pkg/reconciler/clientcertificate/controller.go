@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientcertificate
+
+import (
+	"context"
+
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
+	secretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered"
+	serviceaccountinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/serviceaccount/filtered"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/reconciler"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/auth"
+	"knative.dev/eventing/pkg/certificates"
+	"knative.dev/eventing/pkg/eventingtls"
+)
+
+const (
+	// ReconcilerName is the name of the reconciler.
+	ReconcilerName = "ClientCertificates"
+)
+
+// NewController initializes the controller issuing the client certificates of the
+// eventing identities, that is the OIDC service accounts.
+func NewController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	logger := logging.FromContext(ctx)
+
+	serviceAccountInformer := serviceaccountinformer.Get(ctx, auth.OIDCLabelSelector)
+	secretInformer := secretinformer.Get(ctx, eventingtls.ClientCertificateLabelSelector)
+	dynamicCertificatesInformer := certificates.NewDynamicCertificatesInformer()
+
+	r := &Reconciler{
+		kubeClient:           kubeclient.Get(ctx),
+		cmClient:             cmclient.NewForConfigOrDie(injection.GetConfig(ctx)),
+		serviceAccountLister: serviceAccountInformer.Lister(),
+		secretLister:         secretInformer.Lister(),
+		cmCertificateLister:  dynamicCertificatesInformer.Lister(),
+	}
+
+	impl := controller.NewContext(ctx, r, controller.ControllerOptions{
+		Logger: logger, WorkQueueName: ReconcilerName,
+	})
+
+	r.LeaderAwareFuncs = reconciler.LeaderAwareFuncs{
+		PromoteFunc: func(bkt reconciler.Bucket, enq func(reconciler.Bucket, types.NamespacedName)) error {
+			all, err := r.serviceAccountLister.List(labels.Everything())
+			if err != nil {
+				return err
+			}
+			for _, sa := range all {
+				enq(bkt, types.NamespacedName{Namespace: sa.Namespace, Name: sa.Name})
+			}
+			return nil
+		},
+	}
+
+	r.configStore = feature.NewStore(logger.Named("feature-config-store"), func(name string, value interface{}) {
+		if features, ok := value.(feature.Flags); ok {
+			// we assume that Cert-Manager is installed in the cluster if the feature flag is enabled
+			if err := dynamicCertificatesInformer.Reconcile(ctx, features, controller.HandleAll(impl.EnqueueControllerOf)); err != nil {
+				logger.Errorw("Failed to start certificates dynamic factory", zap.Error(err))
+			}
+		}
+		impl.GlobalResync(serviceAccountInformer.Informer())
+	})
+	r.configStore.WatchConfigs(cmw)
+
+	serviceAccountInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+
+	return impl
+}
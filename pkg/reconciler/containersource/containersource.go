@@ -19,6 +19,7 @@ package containersource
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"go.uber.org/zap"
 	appsv1 "k8s.io/api/apps/v1"
@@ -28,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	appsv1listers "k8s.io/client-go/listers/apps/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
@@ -63,6 +65,7 @@ type Reconciler struct {
 	containerSourceLister listers.ContainerSourceLister
 	sinkBindingLister     listers.SinkBindingLister
 	deploymentLister      appsv1listers.DeploymentLister
+	podLister             corev1listers.PodLister
 }
 
 // Check that our Reconciler implements Interface
@@ -76,12 +79,17 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, source *v1.ContainerSour
 		return err
 	}
 
-	_, err = r.reconcileReceiveAdapter(ctx, source)
+	ra, err := r.reconcileReceiveAdapter(ctx, source)
 	if err != nil {
 		logging.FromContext(ctx).Errorw("Error reconciling ReceiveAdapter", zap.Error(err))
 		return err
 	}
 
+	if err := r.propagateContainerStatuses(source, ra); err != nil {
+		logging.FromContext(ctx).Errorw("Error propagating container statuses", zap.Error(err))
+		return err
+	}
+
 	return newReconciledNormal(source.Namespace, source.Name)
 }
 
@@ -117,6 +125,29 @@ func (r *Reconciler) reconcileReceiveAdapter(ctx context.Context, source *v1.Con
 	return ra, nil
 }
 
+// propagateContainerStatuses surfaces the restarts and crashes of the containers
+// of the receive adapter pods in the ContainerSource status.
+func (r *Reconciler) propagateContainerStatuses(source *v1.ContainerSource, ra *appsv1.Deployment) error {
+	selector, err := metav1.LabelSelectorAsSelector(ra.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf("parsing Deployment selector: %v", err)
+	}
+	pods, err := r.podLister.Pods(ra.Namespace).List(selector)
+	if err != nil {
+		return fmt.Errorf("listing Pods: %v", err)
+	}
+	// Sort the pods, listed in no particular order, so that the statuses are stable.
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+	items := make([]corev1.Pod, 0, len(pods))
+	for _, pod := range pods {
+		items = append(items, *pod)
+	}
+	source.Status.PropagateContainerStatuses(items)
+	return nil
+}
+
 func (r *Reconciler) reconcileSinkBinding(ctx context.Context, source *v1.ContainerSource) (*v1.SinkBinding, error) {
 
 	expected := resources.MakeSinkBinding(source)
@@ -23,6 +23,7 @@ import (
 
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	deploymentinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment"
+	podinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/pod/filtered"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
@@ -33,6 +34,7 @@ import (
 	containersourceinformer "knative.dev/eventing/pkg/client/injection/informers/sources/v1/containersource"
 	sinkbindinginformer "knative.dev/eventing/pkg/client/injection/informers/sources/v1/sinkbinding"
 	v1containersource "knative.dev/eventing/pkg/client/injection/reconciler/sources/v1/containersource"
+	"knative.dev/eventing/pkg/reconciler/containersource/resources"
 )
 
 // NewController creates a Reconciler for ContainerSource and returns the result of NewImpl.
@@ -46,6 +48,7 @@ func NewController(
 	containersourceInformer := containersourceinformer.Get(ctx)
 	sinkbindingInformer := sinkbindinginformer.Get(ctx)
 	deploymentInformer := deploymentinformer.Get(ctx)
+	podInformer := podinformer.Get(ctx, resources.LabelSelector)
 
 	var globalResync func(obj interface{})
 	featureStore := feature.NewStore(logging.FromContext(ctx).Named("feature-config-store"),
@@ -62,6 +65,7 @@ func NewController(
 		containerSourceLister: containersourceInformer.Lister(),
 		deploymentLister:      deploymentInformer.Lister(),
 		sinkBindingLister:     sinkbindingInformer.Lister(),
+		podLister:             podInformer.Lister(),
 	}
 	impl := v1containersource.NewImpl(ctx, r, func(impl *controller.Impl) controller.Options {
 		return controller.Options{ConfigStore: featureStore}
@@ -83,5 +87,10 @@ func NewController(
 		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
 	})
 
+	// Receive adapter pods are owned by ReplicaSets, so reconcile the ContainerSource
+	// named by their labels when their container statuses change.
+	podInformer.Informer().AddEventHandler(controller.HandleAll(
+		impl.EnqueueLabelOfNamespaceScopedResource("", resources.NameLabelKey)))
+
 	return impl
 }
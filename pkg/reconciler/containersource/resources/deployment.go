@@ -17,12 +17,17 @@ limitations under the License.
 package resources
 
 import (
+	"encoding/json"
 	"maps"
+	"slices"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	v1 "knative.dev/eventing/pkg/apis/sources/v1"
 	"knative.dev/pkg/kmeta"
+
+	adapter "knative.dev/eventing/pkg/adapter/v2"
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
 )
 
 func MakeDeployment(source *v1.ContainerSource) *appsv1.Deployment {
@@ -32,6 +37,9 @@ func MakeDeployment(source *v1.ContainerSource) *appsv1.Deployment {
 	}
 	labels := Labels(source.Name)
 	maps.Copy(template.Labels, labels)
+	if timeout, ok := source.Annotations[v1.ContainerSourceEventLivenessTimeoutAnnotation]; ok {
+		template.Spec.Containers = withEventLivenessEnv(template.Spec.Containers, source, timeout)
+	}
 
 	deploy := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
@@ -56,3 +64,24 @@ func MakeDeployment(source *v1.ContainerSource) *appsv1.Deployment {
 	}
 	return deploy
 }
+
+// withEventLivenessEnv returns a copy of the containers configured to report
+// whether they deliver an event within the given timeout on the source.
+func withEventLivenessEnv(containers []corev1.Container, source *v1.ContainerSource, timeout string) []corev1.Container {
+	ref, _ := json.Marshal(corev1.ObjectReference{
+		APIVersion: v1.SchemeGroupVersion.String(),
+		Kind:       "ContainerSource",
+		Namespace:  source.Namespace,
+		Name:       source.Name,
+		UID:        source.UID,
+	})
+
+	containers = slices.Clone(containers)
+	for i := range containers {
+		containers[i].Env = append(slices.Clone(containers[i].Env),
+			corev1.EnvVar{Name: adapter.EnvConfigEventLivenessTimeout, Value: timeout},
+			corev1.EnvVar{Name: adapter.EnvConfigEventLivenessSource, Value: string(ref)},
+		)
+	}
+	return containers
+}
@@ -419,3 +419,39 @@ func TestMakeDeployment(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeDeploymentEventLiveness(t *testing.T) {
+	source := &v1.ContainerSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "test-namespace",
+			UID:         uid,
+			Annotations: map[string]string{v1.ContainerSourceEventLivenessTimeoutAnnotation: "10m"},
+		},
+		Spec: v1.ContainerSourceSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  "test-source",
+						Image: "test-image",
+						Env:   []corev1.EnvVar{{Name: "test1", Value: "arg1"}},
+					}},
+				},
+			},
+		},
+	}
+
+	got := MakeDeployment(source)
+
+	want := []corev1.EnvVar{
+		{Name: "test1", Value: "arg1"},
+		{Name: "K_EVENT_LIVENESS_TIMEOUT", Value: "10m"},
+		{Name: "K_EVENT_LIVENESS_SOURCE", Value: `{"kind":"ContainerSource","namespace":"test-namespace","name":"test-name","uid":"uid","apiVersion":"sources.knative.dev/v1"}`},
+	}
+	if diff := cmp.Diff(want, got.Spec.Template.Spec.Containers[0].Env); diff != "" {
+		t.Error("unexpected env (-want, +got) =", diff)
+	}
+	if len(source.Spec.Template.Spec.Containers[0].Env) != 1 {
+		t.Error("the ContainerSource template was modified")
+	}
+}
@@ -18,11 +18,20 @@ package resources
 
 const (
 	containerSourceController = "container-source-controller"
+
+	// SourceLabelKey is the label key set on the resources created for ContainerSources.
+	SourceLabelKey = "sources.knative.dev/source"
+	// NameLabelKey is the label key holding the name of the ContainerSource of a resource.
+	NameLabelKey = "sources.knative.dev/containerSource"
+
+	// LabelSelector selects the resources, including the receive adapter pods, created
+	// for ContainerSources.
+	LabelSelector = SourceLabelKey + "=" + containerSourceController
 )
 
 func Labels(name string) map[string]string {
 	return map[string]string{
-		"sources.knative.dev/source":          containerSourceController,
-		"sources.knative.dev/containerSource": name,
+		SourceLabelKey: containerSourceController,
+		NameLabelKey:   name,
 	}
 }
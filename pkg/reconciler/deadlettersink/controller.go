@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadlettersink
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
+	"knative.dev/pkg/system"
+
+	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
+	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/auth"
+	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
+	"knative.dev/eventing/pkg/client/injection/informers/sinks/v1alpha1/deadlettersink"
+	deadlettersinkreconciler "knative.dev/eventing/pkg/client/injection/reconciler/sinks/v1alpha1/deadlettersink"
+	"knative.dev/eventing/pkg/eventingtls"
+)
+
+// NewController initializes the controller and is called by the generated code.
+// Registers event handlers to enqueue events.
+func NewController(
+	ctx context.Context,
+	cmw configmap.Watcher,
+) *controller.Impl {
+	deadLetterSinkInformer := deadlettersink.Get(ctx)
+	secretInformer := secretinformer.Get(ctx)
+	eventPolicyInformer := eventpolicy.Get(ctx)
+	trustBundleConfigMapInformer := configmapinformer.Get(ctx, eventingtls.TrustBundleLabelSelector)
+
+	certificateExpiryMonitor, err := eventingtls.NewCertificateExpiryMonitor(otel.GetMeterProvider())
+	if err != nil {
+		logging.FromContext(ctx).Fatalw("Failed to create certificate expiry monitor", zap.Error(err))
+	}
+
+	r := &Reconciler{
+		systemNamespace:   system.Namespace(),
+		secretLister:      secretInformer.Lister(),
+		eventPolicyLister: eventPolicyInformer.Lister(),
+
+		trustBundleConfigMapLister: trustBundleConfigMapInformer.Lister().ConfigMaps(system.Namespace()),
+		certificateExpiryMonitor:   certificateExpiryMonitor,
+	}
+
+	var globalResync func(obj interface{})
+
+	featureStore := feature.NewStore(logging.FromContext(ctx).Named("feature-config-store"), func(name string, value interface{}) {
+		if globalResync != nil {
+			globalResync(nil)
+		}
+	})
+	featureStore.WatchConfigs(cmw)
+
+	impl := deadlettersinkreconciler.NewImpl(ctx, r, func(impl *controller.Impl) controller.Options {
+		return controller.Options{
+			ConfigStore: featureStore,
+		}
+	})
+
+	deadLetterSinkInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+
+	globalResync = func(interface{}) {
+		impl.GlobalResync(deadLetterSinkInformer.Informer())
+	}
+	secretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithName(eventingtls.DeadLetterSinkServerTLSSecretName),
+		Handler:    controller.HandleAll(globalResync),
+	})
+	// Resync for changes to trust bundles, as their expiry is reported on the DeadLetterSink status.
+	trustBundleConfigMapInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: pkgreconciler.NamespaceFilterFunc(system.Namespace()),
+		Handler:    controller.HandleAll(globalResync),
+	})
+
+	deadLetterSinkGK := sinksv1alpha1.SchemeGroupVersion.WithKind("DeadLetterSink").GroupKind()
+
+	// Enqueue the DeadLetterSink, if we have an EventPolicy which was referencing
+	// or got updated and now is referencing the DeadLetterSink.
+	eventPolicyInformer.Informer().AddEventHandler(auth.EventPolicyEventHandler(
+		deadLetterSinkInformer.Informer().GetIndexer(),
+		deadLetterSinkGK,
+		impl.EnqueueKey,
+	))
+
+	return impl
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadlettersink
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/network"
+	"knative.dev/pkg/reconciler"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/auth"
+	eventingv1alpha1listers "knative.dev/eventing/pkg/client/listers/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/eventingtls"
+)
+
+const (
+	// serviceName is the name of the Service of the dead letter sink data plane.
+	serviceName = "dead-letter-sink"
+)
+
+type Reconciler struct {
+	secretLister      corev1listers.SecretLister
+	eventPolicyLister eventingv1alpha1listers.EventPolicyLister
+	systemNamespace   string
+
+	trustBundleConfigMapLister corev1listers.ConfigMapNamespaceLister
+	certificateExpiryMonitor   *eventingtls.CertificateExpiryMonitor
+}
+
+func (r *Reconciler) ReconcileKind(ctx context.Context, dls *sinksv1alpha1.DeadLetterSink) reconciler.Event {
+	featureFlags := feature.FromContext(ctx)
+
+	if err := r.reconcileAddress(ctx, dls); err != nil {
+		return fmt.Errorf("failed to reconcile address: %w", err)
+	}
+
+	err := auth.UpdateStatusWithEventPolicies(featureFlags, &dls.Status.AppliedEventPoliciesStatus, &dls.Status, r.eventPolicyLister, sinksv1alpha1.SchemeGroupVersion.WithKind("DeadLetterSink"), dls.ObjectMeta)
+	if err != nil {
+		return fmt.Errorf("could not update DeadLetterSink status with EventPolicies: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) getCaCerts() (*string, error) {
+	// Getting the secret called "dead-letter-sink-server-tls" from system namespace
+	secret, err := r.secretLister.Secrets(r.systemNamespace).Get(eventingtls.DeadLetterSinkServerTLSSecretName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CA certs from %s/%s: %w", r.systemNamespace, eventingtls.DeadLetterSinkServerTLSSecretName, err)
+	}
+	caCerts, ok := secret.Data[eventingtls.SecretCACert]
+	if !ok {
+		return nil, nil
+	}
+	return ptr.To(string(caCerts)), nil
+}
+
+func (r *Reconciler) reconcileAddress(ctx context.Context, dls *sinksv1alpha1.DeadLetterSink) error {
+	featureFlags := feature.FromContext(ctx)
+	if featureFlags.IsPermissiveTransportEncryption() {
+		caCerts, err := r.getCaCerts()
+		if err != nil {
+			return err
+		}
+
+		httpAddress := r.httpAddress(dls)
+		httpsAddress := r.httpsAddress(caCerts, dls)
+		dls.Status.Addresses = []duckv1.Addressable{httpsAddress, httpAddress}
+		dls.Status.SetAddress(&httpAddress)
+	} else if featureFlags.IsStrictTransportEncryption() {
+		caCerts, err := r.getCaCerts()
+		if err != nil {
+			return err
+		}
+
+		httpsAddress := r.httpsAddress(caCerts, dls)
+		dls.Status.Addresses = []duckv1.Addressable{httpsAddress}
+		dls.Status.SetAddress(&httpsAddress)
+	} else {
+		httpAddress := r.httpAddress(dls)
+		dls.Status.Addresses = nil
+		dls.Status.SetAddress(&httpAddress)
+	}
+
+	if featureFlags.IsOIDCAuthentication() {
+		audience := auth.GetAudience(sinksv1alpha1.SchemeGroupVersion.WithKind("DeadLetterSink"), dls.ObjectMeta)
+
+		logging.FromContext(ctx).Debugw("Setting the audience", zap.String("audience", audience))
+		dls.Status.Address.Audience = &audience
+		for i := range dls.Status.Addresses {
+			dls.Status.Addresses[i].Audience = &audience
+		}
+	} else {
+		logging.FromContext(ctx).Debug("Clearing the DeadLetterSink audience as OIDC is not enabled")
+		dls.Status.Address.Audience = nil
+		for i := range dls.Status.Addresses {
+			dls.Status.Addresses[i].Audience = nil
+		}
+	}
+
+	r.reconcileCertificateExpiry(ctx, dls, featureFlags)
+
+	return nil
+}
+
+// reconcileCertificateExpiry reports the expiry of the dead letter sink server certificates and of
+// the trust bundles on the DeadLetterSink status.
+func (r *Reconciler) reconcileCertificateExpiry(ctx context.Context, dls *sinksv1alpha1.DeadLetterSink, featureFlags feature.Flags) {
+	var exp *eventingtls.CertificateExpiry
+	if !featureFlags.IsDisabledTransportEncryption() {
+		var err error
+		exp, err = eventingtls.ServerCertificateExpiry(r.secretLister.Secrets(r.systemNamespace), eventingtls.DeadLetterSinkServerTLSSecretName, r.trustBundleConfigMapLister)
+		if err != nil {
+			logging.FromContext(ctx).Warnw("Failed to get certificate expiry", zap.Error(err))
+			return
+		}
+	}
+	r.certificateExpiryMonitor.Reconcile(ctx, sinksv1alpha1.SchemeGroupVersion.WithKind("DeadLetterSink"), dls, dls.GetStatus(), dls.GetConditionSet(), exp)
+}
+
+func (r *Reconciler) httpAddress(dls *sinksv1alpha1.DeadLetterSink) duckv1.Addressable {
+	return duckv1.Addressable{
+		Name: ptr.To("http"),
+		URL: &apis.URL{
+			Scheme: "http",
+			Host:   network.GetServiceHostname(serviceName, r.systemNamespace),
+			Path:   fmt.Sprintf("/%s/%s", dls.GetNamespace(), dls.GetName()),
+		},
+	}
+}
+
+func (r *Reconciler) httpsAddress(certs *string, dls *sinksv1alpha1.DeadLetterSink) duckv1.Addressable {
+	addr := r.httpAddress(dls)
+	addr.Name = ptr.To("https")
+	addr.URL.Scheme = "https"
+	addr.CACerts = certs
+	return addr
+}
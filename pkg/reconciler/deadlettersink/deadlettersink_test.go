@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deadlettersink
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	logtesting "knative.dev/pkg/logging/testing"
+	"knative.dev/pkg/network"
+	. "knative.dev/pkg/reconciler/testing"
+
+	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
+	deadlettersinkreconciler "knative.dev/eventing/pkg/client/injection/reconciler/sinks/v1alpha1/deadlettersink"
+	. "knative.dev/eventing/pkg/reconciler/testing/v1"
+	. "knative.dev/eventing/pkg/reconciler/testing/v1alpha1"
+)
+
+const (
+	testNamespace      = "test-namespace"
+	deadLetterSinkName = "test-dls"
+)
+
+var (
+	testKey = fmt.Sprintf("%s/%s", testNamespace, deadLetterSinkName)
+
+	deadLetterSinkAddressable = duckv1.Addressable{
+		Name: ptr.To("http"),
+		URL: &apis.URL{
+			Scheme: "http",
+			Host:   network.GetServiceHostname("dead-letter-sink", testNamespace),
+			Path:   fmt.Sprintf("/%s/%s", testNamespace, deadLetterSinkName),
+		},
+	}
+)
+
+func TestReconcile(t *testing.T) {
+	table := TableTest{
+		{
+			Name: "bad work queue key",
+			Key:  "too/many/parts",
+		}, {
+			Name: "key not found",
+			// Make sure Reconcile handles good keys that don't exist.
+			Key: "foo/not-found",
+		}, {
+			Name: "Successful reconciliation",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewDeadLetterSink(deadLetterSinkName, testNamespace,
+					WithInitDeadLetterSinkConditions),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewDeadLetterSink(deadLetterSinkName, testNamespace,
+						WithInitDeadLetterSinkConditions,
+						WithDeadLetterSinkAddress(&deadLetterSinkAddressable),
+						WithDeadLetterSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+	}
+
+	logger := logtesting.TestLogger(t)
+	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
+		r := &Reconciler{
+			secretLister:      listers.GetSecretLister(),
+			eventPolicyLister: listers.GetEventPolicyLister(),
+			systemNamespace:   testNamespace,
+		}
+
+		return deadlettersinkreconciler.NewReconciler(ctx, logger,
+			fakeeventingclient.Get(ctx), listers.GetDeadLetterSinkLister(),
+			controller.GetEventRecorder(ctx), r)
+	},
+		false,
+		logger,
+	))
+}
@@ -22,6 +22,8 @@ import (
 	"knative.dev/eventing/pkg/auth"
 
 	"github.com/kelseyhightower/envconfig"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/client-go/tools/cache"
 	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
@@ -30,18 +32,19 @@ import (
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
 	"knative.dev/pkg/system"
 
-	"knative.dev/pkg/resolver"
-
 	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
 	"knative.dev/eventing/pkg/client/injection/informers/messaging/v1/inmemorychannel"
 	inmemorychannelreconciler "knative.dev/eventing/pkg/client/injection/reconciler/messaging/v1/inmemorychannel"
 	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/eventing/pkg/reconciler/inmemorychannel/controller/config"
+	"knative.dev/eventing/pkg/resolver"
 
 	"knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment"
+	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap"
 	"knative.dev/pkg/client/injection/kube/informers/core/v1/service"
 	"knative.dev/pkg/client/injection/kube/informers/core/v1/serviceaccount"
 	"knative.dev/pkg/client/injection/kube/informers/discovery/v1/endpointslice"
@@ -71,6 +74,12 @@ func NewController(
 	roleBindingInformer := rolebinding.Get(ctx)
 	secretInformer := secretinformer.Get(ctx)
 	eventPolicyInformer := eventpolicy.Get(ctx)
+	configmapInformer := configmapinformer.Get(ctx)
+
+	certificateExpiryMonitor, err := eventingtls.NewCertificateExpiryMonitor(otel.GetMeterProvider())
+	if err != nil {
+		logger.Fatalw("Failed to create certificate expiry monitor", zap.Error(err))
+	}
 
 	r := &Reconciler{
 		kubeClientSet:        kubeclient.Get(ctx),
@@ -82,6 +91,9 @@ func NewController(
 		roleBindingLister:    roleBindingInformer.Lister(),
 		secretLister:         secretInformer.Lister(),
 		eventPolicyLister:    eventPolicyInformer.Lister(),
+
+		trustBundleConfigMapLister: configmapInformer.Lister().ConfigMaps(system.Namespace()),
+		certificateExpiryMonitor:   certificateExpiryMonitor,
 	}
 
 	env := &envConfig{}
@@ -109,7 +121,7 @@ func NewController(
 			ConfigStore: featureStore,
 		}
 	})
-	r.uriResolver = resolver.NewURIResolverFromTracker(ctx, impl.Tracker)
+	r.uriResolver = resolver.NewURIResolver(ctx, cmw, impl.Tracker)
 
 	inmemorychannelInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
 
@@ -146,6 +158,14 @@ func NewController(
 		FilterFunc: controller.FilterWithName(eventingtls.IMCDispatcherServerTLSSecretName),
 		Handler:    controller.HandleAll(globalResync),
 	})
+	// Resync for changes to trust bundles, as their expiry is reported on the InMemoryChannel status.
+	configmapInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: pkgreconciler.ChainFilterFuncs(
+			pkgreconciler.NamespaceFilterFunc(system.Namespace()),
+			pkgreconciler.LabelFilterFunc(eventingtls.TrustBundleLabelKey, eventingtls.TrustBundleLabelValue, false),
+		),
+		Handler: controller.HandleAll(globalResync),
+	})
 
 	imcGK := messagingv1.SchemeGroupVersion.WithKind("InMemoryChannel").GroupKind()
 
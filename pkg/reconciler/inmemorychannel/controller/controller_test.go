@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"knative.dev/pkg/client/injection/ducks/duck/v1/kresource"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/system"
 
@@ -46,6 +47,7 @@ import (
 
 func TestNew(t *testing.T) {
 	ctx, _ := SetupFakeContext(t)
+	ctx = kresource.WithDuck(ctx)
 	ctx = v1addr.WithDuck(ctx)
 
 	os.Setenv("DISPATCHER_IMAGE", "animage")
@@ -62,6 +64,12 @@ func TestNew(t *testing.T) {
 				Namespace: "knative-eventing",
 			},
 		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "config-kreference-mapping",
+				Namespace: "knative-eventing",
+			},
+		},
 	)
 
 	secret := types.NamespacedName{
@@ -46,7 +46,6 @@ import (
 	pkgreconciler "knative.dev/pkg/reconciler"
 
 	"knative.dev/pkg/logging"
-	"knative.dev/pkg/resolver"
 
 	eventingduck "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/eventing/pkg/apis/eventing"
@@ -56,6 +55,7 @@ import (
 	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/eventing/pkg/reconciler/inmemorychannel/controller/config"
 	"knative.dev/eventing/pkg/reconciler/inmemorychannel/controller/resources"
+	"knative.dev/eventing/pkg/resolver"
 )
 
 const (
@@ -100,6 +100,9 @@ type Reconciler struct {
 	uriResolver *resolver.URIResolver
 
 	eventPolicyLister v1alpha1.EventPolicyLister
+
+	trustBundleConfigMapLister corev1listers.ConfigMapNamespaceLister
+	certificateExpiryMonitor   *eventingtls.CertificateExpiryMonitor
 }
 
 // Check that our Reconciler implements Interface
@@ -252,6 +255,8 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, imc *v1.InMemoryChannel)
 
 	imc.GetConditionSet().Manage(imc.GetStatus()).MarkTrue(v1.InMemoryChannelConditionAddressable)
 
+	r.reconcileCertificateExpiry(ctx, imc, featureFlags)
+
 	err = auth.UpdateStatusWithEventPolicies(featureFlags, &imc.Status.AppliedEventPoliciesStatus, &imc.Status, r.eventPolicyLister, v1.SchemeGroupVersion.WithKind("InMemoryChannel"), imc.ObjectMeta)
 	if err != nil {
 		return fmt.Errorf("could not update InMemoryChannels status with EventPolicies: %v", err)
@@ -276,6 +281,21 @@ func (r *Reconciler) getCaCerts() (*string, error) {
 	return pointer.String(string(caCerts)), nil
 }
 
+// reconcileCertificateExpiry reports the expiry of the dispatcher server certificates on the
+// InMemoryChannel status.
+func (r *Reconciler) reconcileCertificateExpiry(ctx context.Context, imc *v1.InMemoryChannel, featureFlags feature.Flags) {
+	var exp *eventingtls.CertificateExpiry
+	if !featureFlags.IsDisabledTransportEncryption() {
+		var err error
+		exp, err = eventingtls.ServerCertificateExpiry(r.secretLister.Secrets(r.systemNamespace), eventingtls.IMCDispatcherServerTLSSecretName, r.trustBundleConfigMapLister)
+		if err != nil {
+			logging.FromContext(ctx).Warnw("Failed to get certificate expiry", zap.Error(err))
+			return
+		}
+	}
+	r.certificateExpiryMonitor.Reconcile(ctx, v1.SchemeGroupVersion.WithKind("InMemoryChannel"), imc, imc.GetStatus(), imc.GetConditionSet(), exp)
+}
+
 func (r *Reconciler) httpAddress(svc *corev1.Service) duckv1.Addressable {
 	// http address uses host-based routing
 	httpAddress := duckv1.Addressable{
@@ -63,6 +63,7 @@ import (
 	v1 "knative.dev/eventing/pkg/apis/messaging/v1"
 	"knative.dev/eventing/pkg/reconciler/inmemorychannel/controller/resources"
 	. "knative.dev/eventing/pkg/reconciler/testing/v1"
+	eventingresolver "knative.dev/eventing/pkg/resolver"
 
 	v1addr "knative.dev/pkg/client/injection/ducks/duck/v1/addressable"
 )
@@ -782,7 +783,9 @@ func TestAllCases(t *testing.T) {
 			endpointSliceLister: listers.GetEndpointSliceLister(),
 			secretLister:        listers.GetSecretLister(),
 			eventPolicyLister:   listers.GetEventPolicyLister(),
-			uriResolver:         resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
+			uriResolver:         &eventingresolver.URIResolver{URIResolver: resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0))},
+
+			trustBundleConfigMapLister: listers.GetConfigMapLister().ConfigMaps(testNS),
 		}
 		return inmemorychannel.NewReconciler(ctx, logger,
 			fakeeventingclient.Get(ctx), listers.GetInMemoryChannelLister(),
@@ -898,7 +901,8 @@ func TestInNamespace(t *testing.T) {
 			secretLister:               listers.GetSecretLister(),
 			eventPolicyLister:          listers.GetEventPolicyLister(),
 			eventDispatcherConfigStore: eventDispatcherConfigStore,
-			uriResolver:                resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
+			uriResolver:                &eventingresolver.URIResolver{URIResolver: resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0))},
+			trustBundleConfigMapLister: listers.GetConfigMapLister().ConfigMaps(systemNS),
 		}
 		return inmemorychannel.NewReconciler(ctx, logger,
 			fakeeventingclient.Get(ctx), listers.GetInMemoryChannelLister(),
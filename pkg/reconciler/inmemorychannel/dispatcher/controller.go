@@ -38,6 +38,7 @@ import (
 
 	"go.uber.org/zap"
 	filteredconfigmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
+	filteredsecretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	pkgreconciler "knative.dev/pkg/reconciler"
@@ -104,6 +105,7 @@ func NewController(
 		MaxIdleConns:        env.MaxIdleConns,
 		MaxIdleConnsPerHost: env.MaxIdleConnsPerHost,
 	})
+	kncloudevents.WatchTLSPolicies(logger, cmw)
 
 	sh := multichannelfanout.NewEventHandler(ctx, logger.Desugar())
 
@@ -118,6 +120,8 @@ func NewController(
 
 	clientConfig := eventingtls.ClientConfig{
 		TrustBundleConfigMapLister: trustBundleConfigMapLister,
+		ClientCertificates: eventingtls.NewClientCertificates(
+			filteredsecretinformer.Get(ctx, eventingtls.ClientCertificateLabelSelector).Lister()),
 	}
 
 	var globalResync func(obj interface{})
@@ -187,6 +191,8 @@ func NewController(
 	}
 	serverTLSConfig := eventingtls.NewDefaultServerConfig()
 	serverTLSConfig.GetCertificate = eventingtls.GetCertificateFromSecret(ctx, secretinformer.Get(ctx), kubeclient.Get(ctx), secret)
+	serverTLSConfig.ClientCAs = eventingtls.GetClientCAsFromBundles(
+		filteredconfigmapinformer.Get(ctx, eventingtls.ClientCABundleLabelSelector).Lister().ConfigMaps(system.Namespace()))
 	tlsConfig, err := eventingtls.GetTLSServerConfig(serverTLSConfig)
 	if err != nil {
 		logger.Panicf("unable to get tls config: %s", err)
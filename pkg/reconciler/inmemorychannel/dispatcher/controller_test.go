@@ -24,12 +24,14 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"knative.dev/pkg/logging"
+	"knative.dev/pkg/system"
 
 	"knative.dev/eventing/pkg/apis/eventing"
 	"knative.dev/eventing/pkg/eventingtls"
 
 	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 
+	fakekubeclient "knative.dev/pkg/client/injection/kube/client/fake"
 	configmap "knative.dev/pkg/configmap/informer"
 	. "knative.dev/pkg/reconciler/testing"
 
@@ -37,6 +39,7 @@ import (
 	_ "knative.dev/eventing/pkg/client/injection/client/fake"
 	// Fake injection informers
 	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered/fake"
+	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered/fake"
 	_ "knative.dev/pkg/client/injection/kube/informers/factory/filtered/fake"
 	_ "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret/fake"
 
@@ -58,7 +61,7 @@ func TestNew(t *testing.T) {
 	os.Setenv("CONTAINER_NAME", "testcontainer")
 	os.Setenv("MAX_IDLE_CONNS", "2000")
 	os.Setenv("MAX_IDLE_CONNS_PER_HOST", "200")
-	c := NewController(ctx, &configmap.InformedWatcher{})
+	c := NewController(ctx, configmap.NewInformedWatcher(fakekubeclient.Get(ctx), system.Namespace()))
 
 	if c == nil {
 		t.Fatal("Expected NewController to return a non-nil value")
@@ -77,7 +80,7 @@ func TestNewInNamespace(t *testing.T) {
 	os.Setenv("CONTAINER_NAME", "testcontainer")
 	os.Setenv("MAX_IDLE_CONNS", "2000")
 	os.Setenv("MAX_IDLE_CONNS_PER_HOST", "200")
-	c := NewController(ctx, &configmap.InformedWatcher{})
+	c := NewController(ctx, configmap.NewInformedWatcher(fakekubeclient.Get(ctx), system.Namespace()))
 
 	if c == nil {
 		t.Fatal("Expected NewController to return a non-nil value")
@@ -121,6 +124,6 @@ func TestMaxIdleConnsPerHostEqualToZero(t *testing.T) {
 }
 
 func SetUpInformerSelector(ctx context.Context) context.Context {
-	ctx = filteredFactory.WithSelectors(ctx, eventingtls.TrustBundleLabelSelector)
+	ctx = filteredFactory.WithSelectors(ctx, eventingtls.TrustBundleLabelSelector, eventingtls.ClientCertificateLabelSelector, eventingtls.ClientCABundleLabelSelector)
 	return ctx
 }
@@ -142,6 +142,7 @@ func (r *Reconciler) reconcile(ctx context.Context, imc *v1.InMemoryChannel) rec
 			channel.OIDCTokenVerification(r.authVerifier, audience(imc)),
 			channel.ReceiverWithContextFunc(wc),
 			channel.ReceiverWithGetPoliciesForFunc(r.getAppliedEventPolicyRef),
+			channel.ReceiverWithMaxEventSizeFunc(r.getMaxEventSize),
 		)
 		if err != nil {
 			logging.FromContext(ctx).Error("Failed to create a new fanout.EventHandler", err)
@@ -176,6 +177,7 @@ func (r *Reconciler) reconcile(ctx context.Context, imc *v1.InMemoryChannel) rec
 			channel.OIDCTokenVerification(r.authVerifier, audience(imc)),
 			channel.ReceiverWithContextFunc(wc),
 			channel.ReceiverWithGetPoliciesForFunc(r.getAppliedEventPolicyRef),
+			channel.ReceiverWithMaxEventSizeFunc(r.getMaxEventSize),
 		)
 		if err != nil {
 			logging.FromContext(ctx).Error("Failed to create a new fanout.EventHandler", err)
@@ -306,6 +308,14 @@ func (r *Reconciler) getAppliedEventPolicyRef(channel channel.ChannelReference)
 	return imc.Status.Policies, nil
 }
 
+func (r *Reconciler) getMaxEventSize(channel channel.ChannelReference) int64 {
+	imc, err := r.inMemoryChannelLister.InMemoryChannels(channel.Namespace).Get(channel.Name)
+	if err != nil || imc.Spec.MaxEventSize == nil {
+		return 0
+	}
+	return *imc.Spec.MaxEventSize
+}
+
 func handleSubscribers(subscribers []eventingduckv1.SubscriberSpec, handle func(duckv1.Addressable)) {
 	for _, sub := range subscribers {
 		handle(duckv1.Addressable{
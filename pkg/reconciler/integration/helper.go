@@ -24,6 +24,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 )
 
 func GenerateEnvVarsFromStruct(prefix string, s interface{}) []corev1.EnvVar {
@@ -115,6 +116,13 @@ func MakeSecretEnvVar(name, key, secretName string) corev1.EnvVar {
 	}
 }
 
+// MakeOptionalSecretEnvVar is MakeSecretEnvVar for keys that may be missing from the secret.
+func MakeOptionalSecretEnvVar(name, key, secretName string) corev1.EnvVar {
+	envVar := MakeSecretEnvVar(name, key, secretName)
+	envVar.ValueFrom.SecretKeyRef.Optional = ptr.To(true)
+	return envVar
+}
+
 func MakeSSLEnvVar() []corev1.EnvVar {
 	return []corev1.EnvVar{
 		{
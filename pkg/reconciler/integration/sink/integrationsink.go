@@ -19,6 +19,7 @@ package sink
 import (
 	"context"
 	"fmt"
+	"os"
 	"sync/atomic"
 
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -100,6 +101,12 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, sink *sinks.IntegrationS
 	featureFlags := feature.FromContext(ctx)
 	logger := logging.FromContext(ctx)
 
+	if env := resources.ImageEnvName(sink); os.Getenv(env) == "" {
+		// The controller is restarted when its configuration changes, no need to retry.
+		sink.Status.MarkImageNotConfigured("The image of the sink isn't configured, the %s environment variable of the controller is empty", env)
+		return nil
+	}
+
 	logger.Debugw("Reconciling Trust Bundles")
 	trustBundleConfigMaps, err := r.reconcileIntegrationSinkTrustBundles(ctx, sink)
 	if err != nil {
@@ -78,6 +78,7 @@ var (
 
 func TestReconcile(t *testing.T) {
 	t.Setenv("INTEGRATION_SINK_LOG_IMAGE", logSinkImage)
+	t.Setenv("INTEGRATION_SINK_HTTP_IMAGE", "")
 
 	table := TableTest{
 		{
@@ -88,6 +89,23 @@ func TestReconcile(t *testing.T) {
 			Name: "key not found",
 			// Make sure Reconcile handles good keys that don't exist.
 			Key: "foo/not-found",
+		}, {
+			Name: "image not configured",
+			Objects: []runtime.Object{
+				NewIntegrationSink(sinkName, testNS,
+					WithIntegrationSinkUID(sinkUID),
+					WithIntegrationSinkSpec(makeHTTPIntegrationSinkSpec()),
+				),
+			},
+			Key: testNS + "/" + sinkName,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: NewIntegrationSink(sinkName, testNS,
+					WithIntegrationSinkUID(sinkUID),
+					WithIntegrationSinkSpec(makeHTTPIntegrationSinkSpec()),
+					WithInitIntegrationSinkConditions,
+					WithIntegrationSinkImageNotConfigured("INTEGRATION_SINK_HTTP_IMAGE"),
+				),
+			}},
 		}, {
 			Name: "error creating deployment",
 			Objects: []runtime.Object{
@@ -561,6 +579,14 @@ func makeIntegrationSinkSpec() sinksv1alpha1.IntegrationSinkSpec {
 	}
 }
 
+func makeHTTPIntegrationSinkSpec() sinksv1alpha1.IntegrationSinkSpec {
+	return sinksv1alpha1.IntegrationSinkSpec{
+		HTTP: &sinksv1alpha1.HTTP{
+			URL: "https://example.com/events",
+		},
+	}
+}
+
 func makeDeploymentStatus(ready *corev1.ConditionStatus) *appsv1.Deployment {
 	return &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
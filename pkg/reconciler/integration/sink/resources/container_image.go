@@ -17,8 +17,12 @@ limitations under the License.
 package resources
 
 import (
+	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
+	"slices"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -43,6 +47,10 @@ import (
 
 const (
 	AuthProxyRolebindingName = "eventing-auth-proxy"
+
+	// FileSinkDirectory is the directory the file sinks write to.
+	FileSinkDirectory  = "/var/lib/integration-sink"
+	fileSinkVolumeName = "file-sink"
 )
 
 func MakeDeploymentSpec(sink *v1alpha1.IntegrationSink, authProxyImage string, featureFlags feature.Flags, trustBundleConfigMaps []*corev1.ConfigMap) (*appsv1.Deployment, error) {
@@ -122,6 +130,10 @@ func MakeDeploymentSpec(sink *v1alpha1.IntegrationSink, authProxyImage string, f
 		},
 	}
 
+	if sink.Spec.File != nil {
+		addFileSinkVolume(sink, &deploy.Spec.Template.Spec)
+	}
+
 	if featureFlags.IsOIDCAuthentication() {
 		// add auth-proxy
 		proxyVars := makeAuthProxyEnv(sink, featureFlags)
@@ -463,6 +475,59 @@ func makeEnv(sink *v1alpha1.IntegrationSink, featureFlags feature.Flags) []corev
 		return envVars
 	}
 
+	// HTTP environment variables
+	if sink.Spec.HTTP != nil {
+		envVars = append(envVars, integration.GenerateEnvVarsFromStruct("CAMEL_KAMELET_HTTP_SINK", *sink.Spec.HTTP)...)
+		if len(sink.Spec.HTTP.Headers) > 0 {
+			envVars = append(envVars, corev1.EnvVar{
+				Name:  "CAMEL_KAMELET_HTTP_SINK_HEADERS",
+				Value: marshalMap(sink.Spec.HTTP.Headers),
+			})
+		}
+		if secretName := authSecretName(sink.Spec.HTTP.Auth); secretName != "" {
+			// Either basic or bearer token authentication is configured, hence the keys are optional.
+			envVars = append(envVars, []corev1.EnvVar{
+				integration.MakeOptionalSecretEnvVar("CAMEL_KAMELET_HTTP_SINK_USERNAME", commonv1a1.HTTPUsername, secretName),
+				integration.MakeOptionalSecretEnvVar("CAMEL_KAMELET_HTTP_SINK_PASSWORD", commonv1a1.HTTPPassword, secretName),
+				integration.MakeOptionalSecretEnvVar("CAMEL_KAMELET_HTTP_SINK_TOKEN", commonv1a1.HTTPToken, secretName),
+			}...)
+		}
+		return envVars
+	}
+
+	// Database environment variables
+	if sink.Spec.Database != nil {
+		prefix := "CAMEL_KAMELET_" + strings.ToUpper(sink.Spec.Database.Type) + "_SINK"
+		envVars = append(envVars, integration.GenerateEnvVarsFromStruct(prefix, *sink.Spec.Database)...)
+		envVars = append(envVars, []corev1.EnvVar{
+			{
+				Name:  prefix + "_QUERY",
+				Value: insertQuery(sink.Spec.Database),
+			},
+			{
+				Name:  prefix + "_COLUMNS",
+				Value: marshalMap(sink.Spec.Database.Columns),
+			},
+		}...)
+		if secretName := authSecretName(sink.Spec.Database.Auth); secretName != "" {
+			envVars = append(envVars, []corev1.EnvVar{
+				integration.MakeSecretEnvVar(prefix+"_USERNAME", commonv1a1.DatabaseUsername, secretName),
+				integration.MakeSecretEnvVar(prefix+"_PASSWORD", commonv1a1.DatabasePassword, secretName),
+			}...)
+		}
+		return envVars
+	}
+
+	// File environment variables
+	if sink.Spec.File != nil {
+		envVars = append(envVars, integration.GenerateEnvVarsFromStruct("CAMEL_KAMELET_FILE_SINK", *sink.Spec.File)...)
+		envVars = append(envVars, corev1.EnvVar{
+			Name:  "CAMEL_KAMELET_FILE_SINK_DIRECTORY",
+			Value: FileSinkDirectory,
+		})
+		return envVars
+	}
+
 	// Handle secret name only if AWS is configured
 	var secretName string
 	if sink.Spec.Aws != nil && sink.Spec.Aws.Auth != nil && sink.Spec.Aws.Auth.Secret != nil && sink.Spec.Aws.Auth.Secret.Ref != nil {
@@ -524,6 +589,54 @@ func makeEnv(sink *v1alpha1.IntegrationSink, featureFlags feature.Flags) []corev
 	return envVars
 }
 
+// insertQuery returns the statement inserting the row of an event, with a named
+// parameter for each column.
+func insertQuery(db *v1alpha1.Database) string {
+	columns := slices.Sorted(maps.Keys(db.Columns))
+	params := make([]string, 0, len(columns))
+	for _, c := range columns {
+		params = append(params, ":#"+c)
+	}
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", db.Table, strings.Join(columns, ", "), strings.Join(params, ", "))
+}
+
+// marshalMap encodes the map as a JSON object, with sorted keys.
+func marshalMap(m map[string]string) string {
+	b, _ := json.Marshal(m)
+	return string(b)
+}
+
+func addFileSinkVolume(sink *v1alpha1.IntegrationSink, podSpec *corev1.PodSpec) {
+	volume := corev1.Volume{
+		Name: fileSinkVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+	mount := corev1.VolumeMount{
+		Name:      fileSinkVolumeName,
+		MountPath: FileSinkDirectory,
+	}
+	if v := sink.Spec.File.Volume; v != nil {
+		volume.VolumeSource = corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: v.ClaimName,
+			},
+		}
+		mount.SubPath = v.SubPath
+	}
+
+	podSpec.Volumes = append(podSpec.Volumes, volume)
+	podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, mount)
+}
+
+func authSecretName(auth *commonv1a1.Auth) string {
+	if auth != nil && auth.Secret != nil && auth.Secret.Ref != nil {
+		return auth.Secret.Ref.Name
+	}
+	return ""
+}
+
 func makeServiceAccountName(sink *v1alpha1.IntegrationSink) string {
 	if sink.Spec.Aws != nil && sink.Spec.Aws.Auth != nil && sink.Spec.Aws.Auth.ServiceAccountName != "" {
 		return sink.Spec.Aws.Auth.ServiceAccountName
@@ -531,18 +644,35 @@ func makeServiceAccountName(sink *v1alpha1.IntegrationSink) string {
 	return ""
 }
 
-func selectImage(sink *v1alpha1.IntegrationSink) string {
+// ImageEnvName returns the name of the environment variable holding the image of the
+// given sink kind, it is empty for unknown kinds.
+func ImageEnvName(sink *v1alpha1.IntegrationSink) string {
 	// Injected in ./config/core/deployments/controller.yaml
 	switch {
 	case sink.Spec.Log != nil:
-		return os.Getenv("INTEGRATION_SINK_LOG_IMAGE")
+		return "INTEGRATION_SINK_LOG_IMAGE"
+	case sink.Spec.HTTP != nil:
+		return "INTEGRATION_SINK_HTTP_IMAGE"
+	case sink.Spec.Database != nil && sink.Spec.Database.Type == v1alpha1.DatabaseTypePostgreSQL:
+		return "INTEGRATION_SINK_POSTGRESQL_IMAGE"
+	case sink.Spec.Database != nil && sink.Spec.Database.Type == v1alpha1.DatabaseTypeMySQL:
+		return "INTEGRATION_SINK_MYSQL_IMAGE"
+	case sink.Spec.File != nil:
+		return "INTEGRATION_SINK_FILE_IMAGE"
 	case sink.Spec.Aws != nil && sink.Spec.Aws.S3 != nil:
-		return os.Getenv("INTEGRATION_SINK_AWS_S3_IMAGE")
+		return "INTEGRATION_SINK_AWS_S3_IMAGE"
 	case sink.Spec.Aws != nil && sink.Spec.Aws.SQS != nil:
-		return os.Getenv("INTEGRATION_SINK_AWS_SQS_IMAGE")
+		return "INTEGRATION_SINK_AWS_SQS_IMAGE"
 	case sink.Spec.Aws != nil && sink.Spec.Aws.SNS != nil:
-		return os.Getenv("INTEGRATION_SINK_AWS_SNS_IMAGE")
+		return "INTEGRATION_SINK_AWS_SNS_IMAGE"
 	default:
 		return ""
 	}
 }
+
+func selectImage(sink *v1alpha1.IntegrationSink) string {
+	if env := ImageEnvName(sink); env != "" {
+		return os.Getenv(env)
+	}
+	return ""
+}
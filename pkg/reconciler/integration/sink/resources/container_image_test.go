@@ -216,3 +216,215 @@ func TestNewSQSContainerSink(t *testing.T) {
 		t.Errorf("MakeDeploymentSpec() mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestNewHTTPDatabaseAndFileContainerSinks(t *testing.T) {
+	const (
+		httpImage       = "quay.io/http-image"
+		postgresqlImage = "quay.io/postgresql-image"
+		mysqlImage      = "quay.io/mysql-image"
+		fileImage       = "quay.io/file-image"
+	)
+	t.Setenv("INTEGRATION_SINK_HTTP_IMAGE", httpImage)
+	t.Setenv("INTEGRATION_SINK_POSTGRESQL_IMAGE", postgresqlImage)
+	t.Setenv("INTEGRATION_SINK_MYSQL_IMAGE", mysqlImage)
+	t.Setenv("INTEGRATION_SINK_FILE_IMAGE", fileImage)
+
+	secretAuth := &commonintegrationv1alpha1.Auth{
+		Secret: &commonintegrationv1alpha1.Secret{
+			Ref: &commonintegrationv1alpha1.SecretReference{Name: "my-secret"},
+		},
+	}
+	certVolume := corev1.Volume{
+		Name: "test-integrationsink-server-tls",
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: "test-integrationsink-server-tls",
+				Optional:   ptr.To(true),
+			},
+		},
+	}
+	certMount := corev1.VolumeMount{
+		Name:      "test-integrationsink-server-tls",
+		MountPath: "/etc/test-integrationsink-server-tls",
+		ReadOnly:  true,
+	}
+
+	tests := []struct {
+		name        string
+		spec        v1alpha1.IntegrationSinkSpec
+		wantImage   string
+		wantEnv     []corev1.EnvVar
+		wantVolumes []corev1.Volume
+		wantMounts  []corev1.VolumeMount
+	}{{
+		name: "http",
+		spec: v1alpha1.IntegrationSinkSpec{
+			HTTP: &v1alpha1.HTTP{
+				URL:    "https://example.com/events",
+				Method: "POST",
+				Headers: map[string]string{
+					"X-Event-Type": "${ce.type}",
+					"Accept":       "application/json",
+				},
+				Auth: secretAuth,
+			},
+		},
+		wantImage: httpImage,
+		wantEnv: []corev1.EnvVar{
+			{Name: "CAMEL_KAMELET_HTTP_SINK_URL", Value: "https://example.com/events"},
+			{Name: "CAMEL_KAMELET_HTTP_SINK_METHOD", Value: "POST"},
+			{Name: "CAMEL_KAMELET_HTTP_SINK_TIMEOUT", Value: "0"},
+			{Name: "CAMEL_KAMELET_HTTP_SINK_HEADERS", Value: `{"Accept":"application/json","X-Event-Type":"${ce.type}"}`},
+			integration.MakeOptionalSecretEnvVar("CAMEL_KAMELET_HTTP_SINK_USERNAME", commonintegrationv1alpha1.HTTPUsername, "my-secret"),
+			integration.MakeOptionalSecretEnvVar("CAMEL_KAMELET_HTTP_SINK_PASSWORD", commonintegrationv1alpha1.HTTPPassword, "my-secret"),
+			integration.MakeOptionalSecretEnvVar("CAMEL_KAMELET_HTTP_SINK_TOKEN", commonintegrationv1alpha1.HTTPToken, "my-secret"),
+		},
+		wantVolumes: []corev1.Volume{certVolume},
+		wantMounts:  []corev1.VolumeMount{certMount},
+	}, {
+		name: "postgresql",
+		spec: v1alpha1.IntegrationSinkSpec{
+			Database: &v1alpha1.Database{
+				Type:  v1alpha1.DatabaseTypePostgreSQL,
+				Host:  "db.example.com",
+				Port:  5432,
+				Name:  "knative",
+				Table: "public.events",
+				Columns: map[string]string{
+					"payload": "data",
+					"id":      "ce.id",
+				},
+				Auth: secretAuth,
+			},
+		},
+		wantImage: postgresqlImage,
+		wantEnv: []corev1.EnvVar{
+			{Name: "CAMEL_KAMELET_POSTGRESQL_SINK_TYPE", Value: "postgresql"},
+			{Name: "CAMEL_KAMELET_POSTGRESQL_SINK_SERVERNAME", Value: "db.example.com"},
+			{Name: "CAMEL_KAMELET_POSTGRESQL_SINK_SERVERPORT", Value: "5432"},
+			{Name: "CAMEL_KAMELET_POSTGRESQL_SINK_DATABASENAME", Value: "knative"},
+			{Name: "CAMEL_KAMELET_POSTGRESQL_SINK_TABLE", Value: "public.events"},
+			{Name: "CAMEL_KAMELET_POSTGRESQL_SINK_QUERY", Value: "INSERT INTO public.events (id, payload) VALUES (:#id, :#payload)"},
+			{Name: "CAMEL_KAMELET_POSTGRESQL_SINK_COLUMNS", Value: `{"id":"ce.id","payload":"data"}`},
+			integration.MakeSecretEnvVar("CAMEL_KAMELET_POSTGRESQL_SINK_USERNAME", commonintegrationv1alpha1.DatabaseUsername, "my-secret"),
+			integration.MakeSecretEnvVar("CAMEL_KAMELET_POSTGRESQL_SINK_PASSWORD", commonintegrationv1alpha1.DatabasePassword, "my-secret"),
+		},
+		wantVolumes: []corev1.Volume{certVolume},
+		wantMounts:  []corev1.VolumeMount{certMount},
+	}, {
+		name: "mysql",
+		spec: v1alpha1.IntegrationSinkSpec{
+			Database: &v1alpha1.Database{
+				Type:    v1alpha1.DatabaseTypeMySQL,
+				Host:    "db.example.com",
+				Port:    3306,
+				Name:    "knative",
+				Table:   "events",
+				Columns: map[string]string{"amount": "data.amount"},
+				Auth:    secretAuth,
+			},
+		},
+		wantImage: mysqlImage,
+		wantEnv: []corev1.EnvVar{
+			{Name: "CAMEL_KAMELET_MYSQL_SINK_TYPE", Value: "mysql"},
+			{Name: "CAMEL_KAMELET_MYSQL_SINK_SERVERNAME", Value: "db.example.com"},
+			{Name: "CAMEL_KAMELET_MYSQL_SINK_SERVERPORT", Value: "3306"},
+			{Name: "CAMEL_KAMELET_MYSQL_SINK_DATABASENAME", Value: "knative"},
+			{Name: "CAMEL_KAMELET_MYSQL_SINK_TABLE", Value: "events"},
+			{Name: "CAMEL_KAMELET_MYSQL_SINK_QUERY", Value: "INSERT INTO events (amount) VALUES (:#amount)"},
+			{Name: "CAMEL_KAMELET_MYSQL_SINK_COLUMNS", Value: `{"amount":"data.amount"}`},
+			integration.MakeSecretEnvVar("CAMEL_KAMELET_MYSQL_SINK_USERNAME", commonintegrationv1alpha1.DatabaseUsername, "my-secret"),
+			integration.MakeSecretEnvVar("CAMEL_KAMELET_MYSQL_SINK_PASSWORD", commonintegrationv1alpha1.DatabasePassword, "my-secret"),
+		},
+		wantVolumes: []corev1.Volume{certVolume},
+		wantMounts:  []corev1.VolumeMount{certMount},
+	}, {
+		name: "file on an emptyDir volume",
+		spec: v1alpha1.IntegrationSinkSpec{
+			File: &v1alpha1.File{
+				FileName:    "events.ndjson",
+				MaxFileSize: 1048576,
+			},
+		},
+		wantImage: fileImage,
+		wantEnv: []corev1.EnvVar{
+			{Name: "CAMEL_KAMELET_FILE_SINK_FILENAME", Value: "events.ndjson"},
+			{Name: "CAMEL_KAMELET_FILE_SINK_MAXFILESIZE", Value: "1048576"},
+			{Name: "CAMEL_KAMELET_FILE_SINK_MAXFILEAGE", Value: "0"},
+			{Name: "CAMEL_KAMELET_FILE_SINK_MAXFILES", Value: "0"},
+			{Name: "CAMEL_KAMELET_FILE_SINK_DIRECTORY", Value: FileSinkDirectory},
+		},
+		wantVolumes: []corev1.Volume{certVolume, {
+			Name:         "file-sink",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		}},
+		wantMounts: []corev1.VolumeMount{certMount, {
+			Name:      "file-sink",
+			MountPath: FileSinkDirectory,
+		}},
+	}, {
+		name: "file on a PersistentVolumeClaim",
+		spec: v1alpha1.IntegrationSinkSpec{
+			File: &v1alpha1.File{
+				FileName:   "events.ndjson",
+				MaxFileAge: 3600,
+				MaxFiles:   24,
+				Volume: &v1alpha1.FileVolume{
+					ClaimName: "events",
+					SubPath:   "sink",
+				},
+			},
+		},
+		wantImage: fileImage,
+		wantEnv: []corev1.EnvVar{
+			{Name: "CAMEL_KAMELET_FILE_SINK_FILENAME", Value: "events.ndjson"},
+			{Name: "CAMEL_KAMELET_FILE_SINK_MAXFILESIZE", Value: "0"},
+			{Name: "CAMEL_KAMELET_FILE_SINK_MAXFILEAGE", Value: "3600"},
+			{Name: "CAMEL_KAMELET_FILE_SINK_MAXFILES", Value: "24"},
+			{Name: "CAMEL_KAMELET_FILE_SINK_DIRECTORY", Value: FileSinkDirectory},
+		},
+		wantVolumes: []corev1.Volume{certVolume, {
+			Name: "file-sink",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "events"},
+			},
+		}},
+		wantMounts: []corev1.VolumeMount{certMount, {
+			Name:      "file-sink",
+			MountPath: FileSinkDirectory,
+			SubPath:   "sink",
+		}},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := &v1alpha1.IntegrationSink{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testName,
+					Namespace: testNamespace,
+					UID:       testUID,
+				},
+				Spec: tt.spec,
+			}
+
+			flags := feature.Flags{feature.TransportEncryption: feature.Disabled}
+			got, err := MakeDeploymentSpec(sink, "unused", flags, nil)
+			if err != nil {
+				t.Fatal("MakeDeploymentSpec() =", err)
+			}
+			podSpec := got.Spec.Template.Spec
+			container := podSpec.Containers[0]
+			if container.Image != tt.wantImage {
+				t.Errorf("MakeDeploymentSpec() image = %q, want %q", container.Image, tt.wantImage)
+			}
+			if diff := cmp.Diff(tt.wantEnv, container.Env); diff != "" {
+				t.Errorf("MakeDeploymentSpec() env mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantVolumes, podSpec.Volumes); diff != "" {
+				t.Errorf("MakeDeploymentSpec() volumes mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantMounts, container.VolumeMounts); diff != "" {
+				t.Errorf("MakeDeploymentSpec() volume mounts mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	containersourceinformer "knative.dev/eventing/pkg/client/injection/informers/sources/v1/containersource"
 
 	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
+	serviceinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/service"
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/system"
 
@@ -51,6 +52,7 @@ func NewController(
 	eventingClient := eventingclient.Get(ctx)
 	integrationsourceInformer := integrationsourceinformer.Get(ctx)
 	containerSourceInformer := containersourceinformer.Get(ctx)
+	serviceInformer := serviceinformer.Get(ctx)
 
 	trustBundleConfigMapInformer := configmapinformer.Get(ctx, eventingtls.TrustBundleLabelSelector)
 
@@ -68,6 +70,7 @@ func NewController(
 		eventingClientSet:       eventingClient,
 		containerSourceLister:   containerSourceInformer.Lister(),
 		integrationSourceLister: integrationsourceInformer.Lister(),
+		serviceLister:           serviceInformer.Lister(),
 	}
 
 	impl := v1integrationsource.NewImpl(ctx, r, func(impl *controller.Impl) controller.Options {
@@ -85,6 +88,11 @@ func NewController(
 		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
 	})
 
+	serviceInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterController(&v1alpha1.IntegrationSource{}),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
+
 	trustBundleConfigMapInformer.Informer().AddEventHandler(controller.HandleAll(func(i interface{}) {
 		obj, err := kmeta.DeletionHandlingAccessor(i)
 		if err != nil {
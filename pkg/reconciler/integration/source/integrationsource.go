@@ -19,6 +19,7 @@ package source
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"knative.dev/eventing/pkg/apis/feature"
 
@@ -30,14 +31,17 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	v1 "knative.dev/eventing/pkg/apis/sources/v1"
 	"knative.dev/eventing/pkg/apis/sources/v1alpha1"
 	clientset "knative.dev/eventing/pkg/client/clientset/versioned"
 	"knative.dev/eventing/pkg/client/injection/reconciler/sources/v1alpha1/integrationsource"
 	v1listers "knative.dev/eventing/pkg/client/listers/sources/v1"
 	listers "knative.dev/eventing/pkg/client/listers/sources/v1alpha1"
+	"knative.dev/pkg/apis"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
+	"knative.dev/pkg/network"
 	pkgreconciler "knative.dev/pkg/reconciler"
 )
 
@@ -46,6 +50,9 @@ const (
 	sourceReconciled       = "IntegrationSourceReconciled"
 	containerSourceCreated = "ContainerSourceCreated"
 	containerSourceUpdated = "ContainerSourceUpdated"
+	webhookServiceCreated  = "WebhookServiceCreated"
+	webhookServiceUpdated  = "WebhookServiceUpdated"
+	webhookServiceDeleted  = "WebhookServiceDeleted"
 )
 
 // Reconciler implements controller.Reconciler for ContainerSource resources.
@@ -55,6 +62,7 @@ type Reconciler struct {
 
 	containerSourceLister   v1listers.ContainerSourceLister
 	integrationSourceLister listers.IntegrationSourceLister
+	serviceLister           corev1listers.ServiceLister
 }
 
 // Check that our Reconciler implements Interface
@@ -67,6 +75,11 @@ func newReconciledNormal(namespace, name string) pkgreconciler.Event {
 }
 
 func (r *Reconciler) ReconcileKind(ctx context.Context, source *v1alpha1.IntegrationSource) pkgreconciler.Event {
+	if env := resources.ImageEnvName(source); os.Getenv(env) == "" {
+		// The controller is restarted when its configuration changes, no need to retry.
+		source.Status.MarkImageNotConfigured("The image of the source isn't configured, the %s environment variable of the controller is empty", env)
+		return nil
+	}
 
 	_, err := r.reconcileContainerSource(ctx, source)
 	if err != nil {
@@ -74,6 +87,11 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, source *v1alpha1.Integra
 		return err
 	}
 
+	if err := r.reconcileWebhookService(ctx, source); err != nil {
+		logging.FromContext(ctx).Errorw("Error reconciling webhook Service", zap.Error(err))
+		return err
+	}
+
 	return newReconciledNormal(source.Namespace, source.Name)
 }
 
@@ -108,6 +126,69 @@ func (r *Reconciler) reconcileContainerSource(ctx context.Context, source *v1alp
 	return cs, nil
 }
 
+// reconcileWebhookService exposes the ContainerSource of webhook sources, and deletes
+// the Service of the other sources.
+func (r *Reconciler) reconcileWebhookService(ctx context.Context, source *v1alpha1.IntegrationSource) error {
+	if source.Spec.Webhook == nil {
+		source.Status.WebhookURL = nil
+		return r.deleteWebhookService(ctx, source)
+	}
+
+	expected := resources.MakeWebhookService(source)
+
+	svc, err := r.serviceLister.Services(source.Namespace).Get(expected.Name)
+	if apierrors.IsNotFound(err) {
+		svc, err = r.kubeClientSet.CoreV1().Services(source.Namespace).Create(ctx, expected, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("creating new webhook Service: %v", err)
+		}
+		controller.GetEventRecorder(ctx).Eventf(source, corev1.EventTypeNormal, webhookServiceCreated, "Webhook Service created %q", svc.Name)
+	} else if err != nil {
+		return fmt.Errorf("getting webhook Service: %v", err)
+	} else if !metav1.IsControlledBy(svc, source) {
+		return fmt.Errorf("Service %q is not owned by IntegrationSource %q", svc.Name, source.Name)
+	} else if !equality.Semantic.DeepDerivative(expected.Spec, svc.Spec) {
+		svc = svc.DeepCopy()
+		svc.Spec = expected.Spec
+		svc, err = r.kubeClientSet.CoreV1().Services(source.Namespace).Update(ctx, svc, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("updating webhook Service: %v", err)
+		}
+		controller.GetEventRecorder(ctx).Eventf(source, corev1.EventTypeNormal, webhookServiceUpdated, "Webhook Service updated %q", svc.Name)
+	} else {
+		logging.FromContext(ctx).Debugw("Reusing existing webhook Service", zap.Any("Service", svc.ObjectMeta))
+	}
+
+	path := source.Spec.Webhook.Path
+	if path == "" {
+		path = "/"
+	}
+	source.Status.WebhookURL = &apis.URL{
+		Scheme: "http",
+		Host:   network.GetServiceHostname(svc.Name, svc.Namespace),
+		Path:   path,
+	}
+	return nil
+}
+
+func (r *Reconciler) deleteWebhookService(ctx context.Context, source *v1alpha1.IntegrationSource) error {
+	svc, err := r.serviceLister.Services(source.Namespace).Get(resources.WebhookServiceName(source))
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("getting webhook Service: %v", err)
+	} else if !metav1.IsControlledBy(svc, source) {
+		return nil
+	}
+
+	err = r.kubeClientSet.CoreV1().Services(source.Namespace).Delete(ctx, svc.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("deleting webhook Service: %v", err)
+	}
+	controller.GetEventRecorder(ctx).Eventf(source, corev1.EventTypeNormal, webhookServiceDeleted, "Webhook Service deleted %q", svc.Name)
+	return nil
+}
+
 func (r *Reconciler) containerSourceSpecChanged(have *v1.ContainerSourceSpec, want *v1.ContainerSourceSpec) bool {
 	return !equality.Semantic.DeepDerivative(want, have)
 }
@@ -25,6 +25,7 @@ import (
 	"knative.dev/pkg/ptr"
 
 	"knative.dev/eventing/pkg/reconciler/integration"
+	"knative.dev/eventing/pkg/reconciler/integration/source/resources"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,6 +40,7 @@ import (
 	fakekubeclient "knative.dev/pkg/client/injection/kube/client/fake"
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/logging"
+	"knative.dev/pkg/network"
 
 	"context"
 
@@ -60,13 +62,21 @@ const (
 	sinkName   = "testsink"
 	generation = 1
 
-	timerSourceImage = "quay.io/fake-image/timer-source"
+	timerSourceImage   = "quay.io/fake-image/timer-source"
+	webhookSourceImage = "quay.io/fake-image/webhook-source"
 )
 
 var (
 	conditionTrue = corev1.ConditionTrue
 
 	containerSourceName = fmt.Sprintf("%s-containersource", sourceName)
+	webhookServiceName  = fmt.Sprintf("%s-webhook", sourceName)
+
+	webhookURL = &apis.URL{
+		Scheme: "http",
+		Host:   network.GetServiceHostname(webhookServiceName, testNS),
+		Path:   "/hooks",
+	}
 
 	sinkDest = duckv1.Destination{
 		Ref: &duckv1.KReference{
@@ -79,6 +89,8 @@ var (
 
 func TestReconcile(t *testing.T) {
 	t.Setenv("INTEGRATION_SOURCE_TIMER_IMAGE", timerSourceImage)
+	t.Setenv("INTEGRATION_SOURCE_WEBHOOK_IMAGE", webhookSourceImage)
+	t.Setenv("INTEGRATION_SOURCE_HTTP_IMAGE", "")
 
 	table := TableTest{
 		{
@@ -90,6 +102,24 @@ func TestReconcile(t *testing.T) {
 			// Make sure Reconcile handles good keys that don't exist.
 			Key: "foo/not-found",
 		},
+		{
+			Name: "image not configured",
+			Objects: []runtime.Object{
+				NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+					WithIntegrationSourceSpec(makeHTTPIntegrationSourceSpec(sinkDest)),
+				),
+			},
+			Key: testNS + "/" + sourceName,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+					WithIntegrationSourceSpec(makeHTTPIntegrationSourceSpec(sinkDest)),
+					WithInitIntegrationSourceConditions,
+					WithIntegrationSourceImageNotConfigured("INTEGRATION_SOURCE_HTTP_IMAGE"),
+				),
+			}},
+		},
 		{
 			Name: "error creating containersource",
 			Objects: []runtime.Object{
@@ -200,6 +230,111 @@ func TestReconcile(t *testing.T) {
 			WantEvents: []string{
 				Eventf(corev1.EventTypeNormal, sourceReconciled, `IntegrationSource reconciled: "%s/%s"`, testNS, sourceName),
 			},
+		}, {
+			Name: "webhook source, Service created",
+			Objects: []runtime.Object{
+				NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+					WithIntegrationSourceSpec(makeWebhookIntegrationSourceSpec(sinkDest)),
+				),
+				makeWebhookContainerSource(NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+					WithIntegrationSourceSpec(makeWebhookIntegrationSourceSpec(sinkDest)),
+				)),
+			},
+			Key: testNS + "/" + sourceName,
+			WantEvents: []string{
+				Eventf(corev1.EventTypeNormal, webhookServiceCreated, "Webhook Service created %q", webhookServiceName),
+				Eventf(corev1.EventTypeNormal, sourceReconciled, `IntegrationSource reconciled: "%s/%s"`, testNS, sourceName),
+			},
+			WantCreates: []runtime.Object{
+				resources.MakeWebhookService(NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+					WithIntegrationSourceSpec(makeWebhookIntegrationSourceSpec(sinkDest)),
+				)),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+					WithIntegrationSourceSpec(makeWebhookIntegrationSourceSpec(sinkDest)),
+					WithInitIntegrationSourceConditions,
+					WithIntegrationSourceStatusObservedGeneration(generation),
+					WithIntegrationSourcePropagateContainerSourceStatus(makeContainerSourceStatus(&conditionTrue)),
+					WithIntegrationSourceWebhookURL(webhookURL),
+				),
+			}},
+		}, {
+			Name: "webhook source, error creating Service",
+			Objects: []runtime.Object{
+				NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+					WithIntegrationSourceSpec(makeWebhookIntegrationSourceSpec(sinkDest)),
+				),
+				makeWebhookContainerSource(NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+					WithIntegrationSourceSpec(makeWebhookIntegrationSourceSpec(sinkDest)),
+				)),
+			},
+			Key: testNS + "/" + sourceName,
+			WithReactors: []clientgotesting.ReactionFunc{
+				InduceFailure("create", "services"),
+			},
+			WantErr: true,
+			WantEvents: []string{
+				Eventf(corev1.EventTypeWarning, "InternalError", "creating new webhook Service: inducing failure for %s %s", "create", "services"),
+			},
+			WantCreates: []runtime.Object{
+				resources.MakeWebhookService(NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+					WithIntegrationSourceSpec(makeWebhookIntegrationSourceSpec(sinkDest)),
+				)),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+					WithIntegrationSourceSpec(makeWebhookIntegrationSourceSpec(sinkDest)),
+					WithInitIntegrationSourceConditions,
+					WithIntegrationSourceStatusObservedGeneration(generation),
+					WithIntegrationSourcePropagateContainerSourceStatus(makeContainerSourceStatus(&conditionTrue)),
+				),
+			}},
+		}, {
+			Name: "timer source, stale webhook Service deleted",
+			Objects: []runtime.Object{
+				NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+					WithIntegrationSourceSpec(makeIntegrationSourceSpec(sinkDest)),
+				),
+				makeContainerSource(NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+					WithIntegrationSourceSpec(makeIntegrationSourceSpec(sinkDest)),
+				), &conditionTrue),
+				resources.MakeWebhookService(NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+				)),
+			},
+			Key: testNS + "/" + sourceName,
+			WantEvents: []string{
+				Eventf(corev1.EventTypeNormal, webhookServiceDeleted, "Webhook Service deleted %q", webhookServiceName),
+				Eventf(corev1.EventTypeNormal, sourceReconciled, `IntegrationSource reconciled: "%s/%s"`, testNS, sourceName),
+			},
+			WantDeletes: []clientgotesting.DeleteActionImpl{{
+				ActionImpl: clientgotesting.ActionImpl{
+					Namespace: testNS,
+					Verb:      "delete",
+					Resource:  corev1.SchemeGroupVersion.WithResource("services"),
+				},
+				Name: webhookServiceName,
+			}},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: NewIntegrationSource(sourceName, testNS,
+					WithIntegrationSourceUID(sourceUID),
+					WithIntegrationSourceSpec(makeIntegrationSourceSpec(sinkDest)),
+					WithInitIntegrationSourceConditions,
+					WithIntegrationSourceStatusObservedGeneration(generation),
+					WithIntegrationSourcePropagateContainerSourceStatus(makeContainerSourceStatus(&conditionTrue)),
+				),
+			}},
 		}}
 	logger := logtesting.TestLogger(t)
 
@@ -211,6 +346,7 @@ func TestReconcile(t *testing.T) {
 			eventingClientSet:       fakeeventingclient.Get(ctx),
 			containerSourceLister:   listers.GetContainerSourceLister(),
 			integrationSourceLister: listers.GetIntegrationSourceLister(),
+			serviceLister:           listers.GetServiceLister(),
 		}
 
 		return integrationsource.NewReconciler(ctx, logging.FromContext(ctx), fakeeventingclient.Get(ctx), listers.GetIntegrationSourceLister(), controller.GetEventRecorder(ctx), r)
@@ -365,3 +501,32 @@ func makeIntegrationSourceSpec(sink duckv1.Destination) sourcesv1alpha1.Integrat
 		},
 	}
 }
+
+func makeHTTPIntegrationSourceSpec(sink duckv1.Destination) sourcesv1alpha1.IntegrationSourceSpec {
+	return sourcesv1alpha1.IntegrationSourceSpec{
+		HTTP: &sourcesv1alpha1.HTTP{
+			URL:    "https://example.com/status",
+			Period: 10000,
+		},
+		SourceSpec: duckv1.SourceSpec{
+			Sink: sink,
+		},
+	}
+}
+
+func makeWebhookIntegrationSourceSpec(sink duckv1.Destination) sourcesv1alpha1.IntegrationSourceSpec {
+	return sourcesv1alpha1.IntegrationSourceSpec{
+		Webhook: &sourcesv1alpha1.Webhook{
+			Path: "/hooks",
+		},
+		SourceSpec: duckv1.SourceSpec{
+			Sink: sink,
+		},
+	}
+}
+
+func makeWebhookContainerSource(source *sourcesv1alpha1.IntegrationSource) *sourcesv1.ContainerSource {
+	cs := resources.NewContainerSource(source, false)
+	cs.Status = *makeContainerSourceStatus(&conditionTrue)
+	return cs
+}
@@ -68,7 +68,7 @@ func NewContainerSource(source *v1alpha1.IntegrationSource, oidc bool) *sourcesv
 	}
 }
 
-// Function to create environment variables for Timer or AWS configurations dynamically
+// Function to create environment variables for Timer, HTTP, Webhook or AWS configurations dynamically
 func makeEnv(source *v1alpha1.IntegrationSource, oidc bool) []corev1.EnvVar {
 	var envVars = integration.MakeSSLEnvVar()
 
@@ -91,6 +91,29 @@ func makeEnv(source *v1alpha1.IntegrationSource, oidc bool) []corev1.EnvVar {
 		return envVars
 	}
 
+	// HTTP environment variables
+	if source.Spec.HTTP != nil {
+		envVars = append(envVars, integration.GenerateEnvVarsFromStruct("CAMEL_KAMELET_HTTP_SOURCE", *source.Spec.HTTP)...)
+		if secretName := authSecretName(source.Spec.HTTP.Auth); secretName != "" {
+			// Either basic or bearer token authentication is configured, hence the keys are optional.
+			envVars = append(envVars, []corev1.EnvVar{
+				integration.MakeOptionalSecretEnvVar("CAMEL_KAMELET_HTTP_SOURCE_USERNAME", commonv1a1.HTTPUsername, secretName),
+				integration.MakeOptionalSecretEnvVar("CAMEL_KAMELET_HTTP_SOURCE_PASSWORD", commonv1a1.HTTPPassword, secretName),
+				integration.MakeOptionalSecretEnvVar("CAMEL_KAMELET_HTTP_SOURCE_TOKEN", commonv1a1.HTTPToken, secretName),
+			}...)
+		}
+		return envVars
+	}
+
+	// Webhook environment variables
+	if source.Spec.Webhook != nil {
+		envVars = append(envVars, integration.GenerateEnvVarsFromStruct("CAMEL_KAMELET_WEBHOOK_SOURCE", *source.Spec.Webhook)...)
+		if secretName := authSecretName(source.Spec.Webhook.Auth); secretName != "" {
+			envVars = append(envVars, integration.MakeSecretEnvVar("CAMEL_KAMELET_WEBHOOK_SOURCE_HMACKEY", commonv1a1.WebhookHMACKey, secretName))
+		}
+		return envVars
+	}
+
 	// Handle secret name only if AWS is configured
 	var secretName string
 	if source.Spec.Aws != nil && source.Spec.Aws.Auth != nil && source.Spec.Aws.Auth.Secret != nil && source.Spec.Aws.Auth.Secret.Ref != nil {
@@ -152,6 +175,13 @@ func makeEnv(source *v1alpha1.IntegrationSource, oidc bool) []corev1.EnvVar {
 	return envVars
 }
 
+func authSecretName(auth *commonv1a1.Auth) string {
+	if auth != nil && auth.Secret != nil && auth.Secret.Ref != nil {
+		return auth.Secret.Ref.Name
+	}
+	return ""
+}
+
 func makeServiceAccountName(source *v1alpha1.IntegrationSource) string {
 	if source.Spec.Aws != nil && source.Spec.Aws.Auth != nil && source.Spec.Aws.Auth.ServiceAccountName != "" {
 		return source.Spec.Aws.Auth.ServiceAccountName
@@ -159,18 +189,31 @@ func makeServiceAccountName(source *v1alpha1.IntegrationSource) string {
 	return ""
 }
 
-func selectImage(source *v1alpha1.IntegrationSource) string {
+// ImageEnvName returns the name of the environment variable holding the image of the
+// given source kind, it is empty for unknown kinds.
+func ImageEnvName(source *v1alpha1.IntegrationSource) string {
 	// Injected in ./config/core/deployments/controller.yaml
 	switch {
 	case source.Spec.Timer != nil:
-		return os.Getenv("INTEGRATION_SOURCE_TIMER_IMAGE")
+		return "INTEGRATION_SOURCE_TIMER_IMAGE"
+	case source.Spec.HTTP != nil:
+		return "INTEGRATION_SOURCE_HTTP_IMAGE"
+	case source.Spec.Webhook != nil:
+		return "INTEGRATION_SOURCE_WEBHOOK_IMAGE"
 	case source.Spec.Aws != nil && source.Spec.Aws.S3 != nil:
-		return os.Getenv("INTEGRATION_SOURCE_AWS_S3_IMAGE")
+		return "INTEGRATION_SOURCE_AWS_S3_IMAGE"
 	case source.Spec.Aws != nil && source.Spec.Aws.SQS != nil:
-		return os.Getenv("INTEGRATION_SOURCE_AWS_SQS_IMAGE")
+		return "INTEGRATION_SOURCE_AWS_SQS_IMAGE"
 	case source.Spec.Aws != nil && source.Spec.Aws.DDBStreams != nil:
-		return os.Getenv("INTEGRATION_SOURCE_AWS_DDB_STREAMS_IMAGE")
+		return "INTEGRATION_SOURCE_AWS_DDB_STREAMS_IMAGE"
 	default:
 		return ""
 	}
 }
+
+func selectImage(source *v1alpha1.IntegrationSource) string {
+	if env := ImageEnvName(source); env != "" {
+		return os.Getenv(env)
+	}
+	return ""
+}
@@ -312,3 +312,111 @@ func TestNewSQSContainerSource(t *testing.T) {
 		t.Errorf("NewContainerSource() mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestNewHTTPAndWebhookContainerSources(t *testing.T) {
+	const (
+		httpImage    = "quay.io/http-image"
+		webhookImage = "quay.io/webhook-image"
+	)
+	t.Setenv("INTEGRATION_SOURCE_HTTP_IMAGE", httpImage)
+	t.Setenv("INTEGRATION_SOURCE_WEBHOOK_IMAGE", webhookImage)
+
+	secretAuth := &commonintegrationv1alpha1.Auth{
+		Secret: &commonintegrationv1alpha1.Secret{
+			Ref: &commonintegrationv1alpha1.SecretReference{Name: "my-secret"},
+		},
+	}
+	sslEnv := integration.MakeSSLEnvVar()
+
+	tests := []struct {
+		name      string
+		spec      v1alpha1.IntegrationSourceSpec
+		wantImage string
+		wantEnv   []corev1.EnvVar
+	}{{
+		name: "http",
+		spec: v1alpha1.IntegrationSourceSpec{
+			HTTP: &v1alpha1.HTTP{
+				URL:    "https://example.com/status",
+				Method: "GET",
+				Period: 5000,
+			},
+		},
+		wantImage: httpImage,
+		wantEnv: append(sslEnv,
+			corev1.EnvVar{Name: "CAMEL_KAMELET_HTTP_SOURCE_URL", Value: "https://example.com/status"},
+			corev1.EnvVar{Name: "CAMEL_KAMELET_HTTP_SOURCE_METHOD", Value: "GET"},
+			corev1.EnvVar{Name: "CAMEL_KAMELET_HTTP_SOURCE_PERIOD", Value: "5000"},
+			corev1.EnvVar{Name: "CAMEL_KAMELET_HTTP_SOURCE_EMITUNCHANGED", Value: "false"},
+		),
+	}, {
+		name: "http with auth",
+		spec: v1alpha1.IntegrationSourceSpec{
+			HTTP: &v1alpha1.HTTP{
+				URL:           "https://example.com/status",
+				EmitUnchanged: true,
+				Auth:          secretAuth,
+			},
+		},
+		wantImage: httpImage,
+		wantEnv: append(sslEnv,
+			corev1.EnvVar{Name: "CAMEL_KAMELET_HTTP_SOURCE_URL", Value: "https://example.com/status"},
+			corev1.EnvVar{Name: "CAMEL_KAMELET_HTTP_SOURCE_PERIOD", Value: "0"},
+			corev1.EnvVar{Name: "CAMEL_KAMELET_HTTP_SOURCE_EMITUNCHANGED", Value: "true"},
+			integration.MakeOptionalSecretEnvVar("CAMEL_KAMELET_HTTP_SOURCE_USERNAME", commonintegrationv1alpha1.HTTPUsername, "my-secret"),
+			integration.MakeOptionalSecretEnvVar("CAMEL_KAMELET_HTTP_SOURCE_PASSWORD", commonintegrationv1alpha1.HTTPPassword, "my-secret"),
+			integration.MakeOptionalSecretEnvVar("CAMEL_KAMELET_HTTP_SOURCE_TOKEN", commonintegrationv1alpha1.HTTPToken, "my-secret"),
+		),
+	}, {
+		name: "webhook",
+		spec: v1alpha1.IntegrationSourceSpec{
+			Webhook: &v1alpha1.Webhook{
+				Path:      "/hooks",
+				EventType: "com.example.hook",
+			},
+		},
+		wantImage: webhookImage,
+		wantEnv: append(sslEnv,
+			corev1.EnvVar{Name: "CAMEL_KAMELET_WEBHOOK_SOURCE_PATH", Value: "/hooks"},
+			corev1.EnvVar{Name: "CAMEL_KAMELET_WEBHOOK_SOURCE_EVENTTYPE", Value: "com.example.hook"},
+		),
+	}, {
+		name: "webhook with signature verification",
+		spec: v1alpha1.IntegrationSourceSpec{
+			Webhook: &v1alpha1.Webhook{
+				SignatureHeader:    "X-Hub-Signature-256",
+				SignatureAlgorithm: "HmacSHA256",
+				SignaturePrefix:    "sha256=",
+				Auth:               secretAuth,
+			},
+		},
+		wantImage: webhookImage,
+		wantEnv: append(sslEnv,
+			corev1.EnvVar{Name: "CAMEL_KAMELET_WEBHOOK_SOURCE_SIGNATUREHEADER", Value: "X-Hub-Signature-256"},
+			corev1.EnvVar{Name: "CAMEL_KAMELET_WEBHOOK_SOURCE_SIGNATUREALGORITHM", Value: "HmacSHA256"},
+			corev1.EnvVar{Name: "CAMEL_KAMELET_WEBHOOK_SOURCE_SIGNATUREPREFIX", Value: "sha256="},
+			integration.MakeSecretEnvVar("CAMEL_KAMELET_WEBHOOK_SOURCE_HMACKEY", commonintegrationv1alpha1.WebhookHMACKey, "my-secret"),
+		),
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &v1alpha1.IntegrationSource{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testName,
+					Namespace: testNamespace,
+					UID:       testUID,
+				},
+				Spec: tt.spec,
+			}
+
+			got := NewContainerSource(source, false)
+			container := got.Spec.Template.Spec.Containers[0]
+			if container.Image != tt.wantImage {
+				t.Errorf("NewContainerSource() image = %q, want %q", container.Image, tt.wantImage)
+			}
+			if diff := cmp.Diff(tt.wantEnv, container.Env); diff != "" {
+				t.Errorf("NewContainerSource() env mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -24,3 +24,7 @@ import (
 func ContainerSourceName(source *v1alpha1.IntegrationSource) string {
 	return kmeta.ChildName(source.Name, "-containersource")
 }
+
+func WebhookServiceName(source *v1alpha1.IntegrationSource) string {
+	return kmeta.ChildName(source.Name, "-webhook")
+}
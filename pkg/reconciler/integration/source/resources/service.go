@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"knative.dev/pkg/kmeta"
+
+	"knative.dev/eventing/pkg/apis/sources/v1alpha1"
+	containersourceresources "knative.dev/eventing/pkg/reconciler/containersource/resources"
+	"knative.dev/eventing/pkg/reconciler/integration"
+)
+
+// MakeWebhookService returns the Service receiving the webhooks of a webhook IntegrationSource.
+func MakeWebhookService(source *v1alpha1.IntegrationSource) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Service",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      WebhookServiceName(source),
+			Namespace: source.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*kmeta.NewControllerRef(source),
+			},
+			Labels: integration.Labels(source.Name),
+		},
+		Spec: corev1.ServiceSpec{
+			// Select the pods of the ContainerSource Deployment.
+			Selector: containersourceresources.Labels(ContainerSourceName(source)),
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       80,
+					TargetPort: intstr.FromInt32(8080),
+				},
+			},
+		},
+	}
+}
@@ -18,7 +18,10 @@ package jobsink
 
 import (
 	"context"
+	"time"
 
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/types"
 	"knative.dev/pkg/kmeta"
 	"knative.dev/pkg/logging"
@@ -26,9 +29,14 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
+	pkgreconciler "knative.dev/pkg/reconciler"
 	"knative.dev/pkg/system"
 
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	jobinformer "knative.dev/pkg/client/injection/kube/informers/batch/v1/job/filtered"
+	configmapinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/filtered"
+	filteredsecretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered"
+	serviceaccountinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/serviceaccount/filtered"
 	secretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
 
 	"knative.dev/eventing/pkg/apis/feature"
@@ -39,6 +47,8 @@ import (
 	"knative.dev/eventing/pkg/client/injection/informers/sinks/v1alpha1/jobsink"
 	jobsinkreconciler "knative.dev/eventing/pkg/client/injection/reconciler/sinks/v1alpha1/jobsink"
 	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/eventing/pkg/resolver"
 )
 
 // NewController initializes the controller and is called by the generated code.
@@ -51,12 +61,33 @@ func NewController(
 	secretInformer := secretinformer.Get(ctx)
 	jobInformer := jobinformer.Get(ctx, sinks.JobSinkJobsLabelSelector)
 	eventPolicyInformer := eventpolicy.Get(ctx)
+	trustBundleConfigMapInformer := configmapinformer.Get(ctx, eventingtls.TrustBundleLabelSelector)
+	clientCertificateSecretInformer := filteredsecretinformer.Get(ctx, eventingtls.ClientCertificateLabelSelector)
+	oidcServiceaccountInformer := serviceaccountinformer.Get(ctx, auth.OIDCLabelSelector)
+
+	clientConfig := eventingtls.ClientConfig{
+		TrustBundleConfigMapLister: trustBundleConfigMapInformer.Lister().ConfigMaps(system.Namespace()),
+		ClientCertificates:         eventingtls.NewClientCertificates(clientCertificateSecretInformer.Lister()),
+	}
+
+	certificateExpiryMonitor, err := eventingtls.NewCertificateExpiryMonitor(otel.GetMeterProvider())
+	if err != nil {
+		logging.FromContext(ctx).Fatalw("Failed to create certificate expiry monitor", zap.Error(err))
+	}
 
 	r := &Reconciler{
-		systemNamespace:   system.Namespace(),
-		secretLister:      secretInformer.Lister(),
-		jobLister:         jobInformer.Lister(),
-		eventPolicyLister: eventPolicyInformer.Lister(),
+		kubeClient:           kubeclient.Get(ctx),
+		systemNamespace:      system.Namespace(),
+		secretLister:         secretInformer.Lister(),
+		serviceAccountLister: oidcServiceaccountInformer.Lister(),
+		jobLister:            jobInformer.Lister(),
+		eventPolicyLister:    eventPolicyInformer.Lister(),
+		eventDispatcher:      kncloudevents.NewDispatcher(clientConfig, auth.NewOIDCTokenProvider(ctx)),
+		jobEventSender:       newJobEventSender(),
+		now:                  time.Now,
+
+		trustBundleConfigMapLister: clientConfig.TrustBundleConfigMapLister,
+		certificateExpiryMonitor:   certificateExpiryMonitor,
 	}
 
 	var globalResync func(obj interface{})
@@ -68,12 +99,18 @@ func NewController(
 	})
 	featureStore.WatchConfigs(cmw)
 
+	// Watch the TLS policies applied to the JobSink sinks and dead letter sinks.
+	kncloudevents.WatchTLSPolicies(logging.FromContext(ctx), cmw)
+
 	impl := jobsinkreconciler.NewImpl(ctx, r, func(impl *controller.Impl) controller.Options {
 		return controller.Options{
 			ConfigStore: featureStore,
 		}
 	})
 
+	r.uriResolver = resolver.NewURIResolver(ctx, cmw, impl.Tracker)
+	r.enqueueAfter = impl.EnqueueKeyAfter
+
 	jobSinkInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
 
 	globalResync = func(interface{}) {
@@ -83,6 +120,11 @@ func NewController(
 		FilterFunc: controller.FilterWithName(eventingtls.JobSinkDispatcherServerTLSSecretName),
 		Handler:    controller.HandleAll(globalResync),
 	})
+	// Resync for changes to trust bundles, as their expiry is reported on the JobSink status.
+	trustBundleConfigMapInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: pkgreconciler.NamespaceFilterFunc(system.Namespace()),
+		Handler:    controller.HandleAll(globalResync),
+	})
 
 	jobInformer.Informer().AddEventHandler(controller.HandleAll(func(i interface{}) {
 		obj, err := kmeta.DeletionHandlingAccessor(i)
@@ -99,6 +141,11 @@ func NewController(
 		})
 	}))
 
+	oidcServiceaccountInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterController(&sinksv1alpha1.JobSink{}),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
+
 	jobSinkGK := sinksv1alpha1.SchemeGroupVersion.WithKind("JobSink").GroupKind()
 
 	// Enqueue the JobSink, if we have an EventPolicy which was referencing
@@ -0,0 +1,439 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jobsink
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/logging"
+
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/apis/sinks"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/kncloudevents"
+)
+
+// reconcileFinishedJobs reports the result of finished Jobs to the sink, forwards the
+// events of failed Jobs to the dead letter sink and deletes the finished Jobs exceeding the history limits.
+func (r *Reconciler) reconcileFinishedJobs(ctx context.Context, js *sinksv1alpha1.JobSink) error {
+	selector := labels.SelectorFromSet(labels.Set{sinks.JobSinkNameLabel: js.GetName()})
+	jobs, err := r.jobLister.Jobs(js.GetNamespace()).List(selector)
+	if err != nil {
+		return fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	var succeeded, failed []*batchv1.Job
+	for _, job := range jobs {
+		if job.DeletionTimestamp != nil {
+			continue
+		}
+		if isJobConditionTrue(job, batchv1.JobFailed) {
+			failed = append(failed, job)
+		} else if isJobConditionTrue(job, batchv1.JobComplete) {
+			succeeded = append(succeeded, job)
+		}
+	}
+
+	for _, job := range succeeded {
+		if err := r.reportJobResult(ctx, js, job, sinksv1alpha1.JobSinkJobSucceededEventType); err != nil {
+			return err
+		}
+	}
+	for _, job := range failed {
+		if err := r.reportJobResult(ctx, js, job, sinksv1alpha1.JobSinkJobFailedEventType); err != nil {
+			return err
+		}
+		if err := r.deadLetterJobEvent(ctx, js, job); err != nil {
+			return err
+		}
+	}
+
+	succeeded, err = r.deleteExpiredJobs(ctx, js, succeeded)
+	if err != nil {
+		return err
+	}
+	failed, err = r.deleteExpiredJobs(ctx, js, failed)
+	if err != nil {
+		return err
+	}
+
+	if err := r.deleteJobsOverLimit(ctx, js, succeeded, js.Spec.SuccessfulJobsHistoryLimit); err != nil {
+		return err
+	}
+	return r.deleteJobsOverLimit(ctx, js, failed, js.Spec.FailedJobsHistoryLimit)
+}
+
+// jobEventSendRetryDelay is the delay after which a JobSink is reconciled again when an event
+// related to one of its Jobs can't be sent.
+const jobEventSendRetryDelay = 30 * time.Second
+
+// jobEventSender runs the sends of the events related to finished Jobs in the background,
+// so that slow or unavailable destinations don't block the reconciliation of JobSinks.
+type jobEventSender struct {
+	mu       sync.Mutex
+	inFlight map[string]struct{}
+
+	// run runs a send, sends are run synchronously in tests.
+	run func(send func())
+}
+
+func newJobEventSender() *jobEventSender {
+	return &jobEventSender{
+		inFlight: make(map[string]struct{}),
+		run:      func(send func()) { go send() },
+	}
+}
+
+// send runs the given send unless a send with the same key is in flight.
+func (s *jobEventSender) send(key string, send func()) {
+	s.mu.Lock()
+	if _, ok := s.inFlight[key]; ok {
+		s.mu.Unlock()
+		return
+	}
+	s.inFlight[key] = struct{}{}
+	s.mu.Unlock()
+
+	s.run(func() {
+		defer func() {
+			s.mu.Lock()
+			delete(s.inFlight, key)
+			s.mu.Unlock()
+		}()
+		send()
+	})
+}
+
+// sendJobEvent sends the given event related to the given Job in the background, on behalf of
+// the JobSink, and sets the given annotation on the Job once sent. The JobSink is reconciled
+// again when the event can't be sent.
+func (r *Reconciler) sendJobEvent(ctx context.Context, js *sinksv1alpha1.JobSink, job *batchv1.Job, annotation string, event cloudevents.Event, dest duckv1.Addressable, opts ...kncloudevents.SendOption) {
+	logger := logging.FromContext(ctx).With(zap.String("job", job.GetName()), zap.String("destination", dest.URL.String()))
+	key := types.NamespacedName{Namespace: js.GetNamespace(), Name: js.GetName()}
+
+	if js.Status.Auth != nil && js.Status.Auth.ServiceAccountName != nil {
+		opts = append(opts, kncloudevents.WithOIDCAuthentication(&types.NamespacedName{
+			Namespace: js.GetNamespace(),
+			Name:      *js.Status.Auth.ServiceAccountName,
+		}))
+	}
+
+	r.jobEventSender.send(string(job.GetUID())+"/"+annotation, func() {
+		if _, err := r.eventDispatcher.SendEvent(ctx, event, dest, opts...); err != nil {
+			logger.Warnw("Failed to send event of job", zap.String("type", event.Type()), zap.Error(err))
+			r.enqueueAfter(key, jobEventSendRetryDelay)
+			return
+		}
+		logger.Debugw("Sent event of job", zap.String("type", event.Type()))
+
+		if err := r.annotateJob(ctx, job, annotation); err != nil {
+			logger.Warnw("Failed to annotate job", zap.String("annotation", annotation), zap.Error(err))
+			r.enqueueAfter(key, jobEventSendRetryDelay)
+		}
+	})
+}
+
+// deadLetterJobEvent sends the event of the given failed Job to the JobSink dead letter sink,
+// at most once per Job.
+func (r *Reconciler) deadLetterJobEvent(ctx context.Context, js *sinksv1alpha1.JobSink, job *batchv1.Job) error {
+	if !needsDeadLettering(js, job) {
+		return nil
+	}
+
+	event, err := r.getJobEvent(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	retryConfig, err := kncloudevents.RetryConfigFromDeliverySpec(*js.Spec.Delivery)
+	if err != nil {
+		return fmt.Errorf("failed to create retry config: %w", err)
+	}
+
+	dls := duckv1.Addressable{
+		URL:      js.Status.DeadLetterSinkURI,
+		CACerts:  js.Status.DeadLetterSinkCACerts,
+		Audience: js.Status.DeadLetterSinkAudience,
+	}
+	r.sendJobEvent(ctx, js, job, sinks.JobSinkDeadLetteredAnnotation, *event, dls, kncloudevents.WithRetryConfig(&retryConfig))
+	return nil
+}
+
+// jobResultDelivery is the delivery of the events reporting the result of Jobs to the sink.
+// The JobSink is reconciled again to retry sending the result once the retries are exhausted.
+var jobResultDelivery = eventingduckv1.DeliverySpec{
+	Retry:         ptr.To[int32](3),
+	BackoffPolicy: ptr.To(eventingduckv1.BackoffPolicyExponential),
+	BackoffDelay:  ptr.To("PT1S"),
+}
+
+// jobResult is the data of the CloudEvents reporting the result of a Job.
+type jobResult struct {
+	Job                string            `json:"job"`
+	Status             batchv1.JobStatus `json:"status"`
+	TerminationMessage string            `json:"terminationMessage,omitempty"`
+}
+
+// reportJobResult sends an event of the given type with the result of the given finished Job
+// to the JobSink sink, at most once per Job.
+func (r *Reconciler) reportJobResult(ctx context.Context, js *sinksv1alpha1.JobSink, job *batchv1.Job, eventType string) error {
+	if !needsReporting(js, job) {
+		return nil
+	}
+
+	event := cloudevents.NewEvent()
+	event.SetID(string(job.GetUID()))
+	event.SetType(eventType)
+	event.SetSource(sinksv1alpha1.JobSinkSource(js.GetNamespace(), js.GetName()))
+	event.SetSubject(job.GetName())
+
+	if id, ok := job.GetAnnotations()[sinks.JobSinkEventIDAnnotation]; ok {
+		event.SetExtension(sinksv1alpha1.JobSinkEventIDExtension, id)
+	}
+	if source, ok := job.GetAnnotations()[sinks.JobSinkEventSourceAnnotation]; ok {
+		event.SetExtension(sinksv1alpha1.JobSinkEventSourceExtension, source)
+	}
+
+	result := jobResult{
+		Job:                job.GetName(),
+		Status:             job.Status,
+		TerminationMessage: r.getJobTerminationMessage(ctx, job),
+	}
+	if err := event.SetData(cloudevents.ApplicationJSON, result); err != nil {
+		return fmt.Errorf("failed to set data of the result event of job %s: %w", job.GetName(), err)
+	}
+
+	// The delivery of the JobSink applies to the dead letter sink only.
+	retryConfig, err := kncloudevents.RetryConfigFromDeliverySpec(jobResultDelivery)
+	if err != nil {
+		return fmt.Errorf("failed to create retry config: %w", err)
+	}
+
+	sink := duckv1.Addressable{
+		URL:      js.Status.SinkURI,
+		CACerts:  js.Status.SinkCACerts,
+		Audience: js.Status.SinkAudience,
+	}
+	r.sendJobEvent(ctx, js, job, sinks.JobSinkResultReportedAnnotation, event, sink, kncloudevents.WithRetryConfig(&retryConfig))
+	return nil
+}
+
+// getJobTerminationMessage returns the message of the most recently terminated container
+// of the given Job, if any.
+func (r *Reconciler) getJobTerminationMessage(ctx context.Context, job *batchv1.Job) string {
+	selector := labels.SelectorFromSet(labels.Set{batchv1.JobNameLabel: job.GetName()})
+	pods, err := r.kubeClient.CoreV1().Pods(job.GetNamespace()).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		logging.FromContext(ctx).Debugw("Failed to list pods of job", zap.String("job", job.GetName()), zap.Error(err))
+		return ""
+	}
+
+	var message string
+	var finishedAt time.Time
+	for _, pod := range pods.Items {
+		for _, cs := range pod.Status.ContainerStatuses {
+			terminated := cs.State.Terminated
+			if terminated == nil || terminated.Message == "" || terminated.FinishedAt.Time.Before(finishedAt) {
+				continue
+			}
+			message = terminated.Message
+			finishedAt = terminated.FinishedAt.Time
+		}
+	}
+	return message
+}
+
+// annotateJob sets the given annotation on the given Job.
+func (r *Reconciler) annotateJob(ctx context.Context, job *batchv1.Job, annotation string) error {
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:"true"}}}`, annotation)
+	_, err := r.kubeClient.BatchV1().Jobs(job.GetNamespace()).Patch(ctx, job.GetName(), types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to annotate job %s: %w", job.GetName(), err)
+	}
+	return nil
+}
+
+// getJobEvent returns the event the given Job was created for from the event storage of the Job.
+func (r *Reconciler) getJobEvent(ctx context.Context, job *batchv1.Job) (*cloudevents.Event, error) {
+	var data []byte
+	switch jobEventStorageType(job) {
+	case sinksv1alpha1.ConfigMapEventStorage:
+		cm, err := r.kubeClient.CoreV1().ConfigMaps(job.GetNamespace()).Get(ctx, job.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get event of job %s: %w", job.GetName(), err)
+		}
+		data = cm.BinaryData[sinks.JobSinkEventKey]
+	case sinksv1alpha1.SecretEventStorage:
+		secret, err := r.kubeClient.CoreV1().Secrets(job.GetNamespace()).Get(ctx, job.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get event of job %s: %w", job.GetName(), err)
+		}
+		data = secret.Data[sinks.JobSinkEventKey]
+	default:
+		return nil, fmt.Errorf("the %s event storage does not retain the event of job %s", jobEventStorageType(job), job.GetName())
+	}
+
+	event := cloudevents.NewEvent()
+	if err := event.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal event of job %s: %w", job.GetName(), err)
+	}
+	return &event, nil
+}
+
+// deleteJobsOverLimit deletes the oldest of the given finished Jobs so that at most limit Jobs remain.
+func (r *Reconciler) deleteJobsOverLimit(ctx context.Context, js *sinksv1alpha1.JobSink, jobs []*batchv1.Job, limit *int32) error {
+	if limit == nil || len(jobs) <= int(*limit) {
+		return nil
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobFinishTime(jobs[i]).Before(jobFinishTime(jobs[j]))
+	})
+
+	for _, job := range jobs[:len(jobs)-int(*limit)] {
+		if !isJobSettled(js, job) {
+			continue
+		}
+		if err := r.deleteJob(ctx, job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteExpiredJobs deletes the given finished Jobs whose JobSink TTLSecondsAfterFinished has
+// expired, and returns the remaining Jobs. The JobSink is reconciled again once the next Job expires.
+func (r *Reconciler) deleteExpiredJobs(ctx context.Context, js *sinksv1alpha1.JobSink, jobs []*batchv1.Job) ([]*batchv1.Job, error) {
+	if js.Spec.TTLSecondsAfterFinished == nil {
+		return jobs, nil
+	}
+	ttl := time.Duration(*js.Spec.TTLSecondsAfterFinished) * time.Second
+	now := r.now
+	if now == nil {
+		now = time.Now
+	}
+
+	var remaining []*batchv1.Job
+	var nextExpiry time.Duration
+	for _, job := range jobs {
+		if !isJobSettled(js, job) {
+			// The Job is reconciled again once settled.
+			remaining = append(remaining, job)
+			continue
+		}
+		if expiry := jobFinishTime(job).Add(ttl).Sub(now()); expiry > 0 {
+			if nextExpiry == 0 || expiry < nextExpiry {
+				nextExpiry = expiry
+			}
+			remaining = append(remaining, job)
+			continue
+		}
+		if err := r.deleteJob(ctx, job); err != nil {
+			return nil, err
+		}
+	}
+
+	if nextExpiry > 0 && r.enqueueAfter != nil {
+		r.enqueueAfter(types.NamespacedName{Namespace: js.GetNamespace(), Name: js.GetName()}, nextExpiry)
+	}
+	return remaining, nil
+}
+
+// deleteJob deletes the given finished Job, and its event with it.
+func (r *Reconciler) deleteJob(ctx context.Context, job *batchv1.Job) error {
+	err := r.kubeClient.BatchV1().Jobs(job.GetNamespace()).Delete(ctx, job.GetName(), metav1.DeleteOptions{
+		PropagationPolicy: ptr.To(metav1.DeletePropagationBackground),
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete job %s: %w", job.GetName(), err)
+	}
+	return nil
+}
+
+// isJobSettled returns true when the result of the given finished Job has been reported and
+// its event has been sent to the dead letter sink, Jobs are kept until then.
+func isJobSettled(js *sinksv1alpha1.JobSink, job *batchv1.Job) bool {
+	return !needsReporting(js, job) && !(isJobConditionTrue(job, batchv1.JobFailed) && needsDeadLettering(js, job))
+}
+
+// needsDeadLettering returns true when the event of the given failed Job has not been sent
+// to the JobSink dead letter sink yet.
+func needsDeadLettering(js *sinksv1alpha1.JobSink, job *batchv1.Job) bool {
+	if js.Status.DeadLetterSinkURI == nil {
+		return false
+	}
+	// The event of Jobs created with the EmptyDir event storage is not retained.
+	if jobEventStorageType(job) == sinksv1alpha1.EmptyDirEventStorage {
+		return false
+	}
+	_, ok := job.GetAnnotations()[sinks.JobSinkDeadLetteredAnnotation]
+	return !ok
+}
+
+// jobEventStorageType returns the type of storage of the event the given Job was created for,
+// Jobs created before the storage type was recorded use a Secret.
+func jobEventStorageType(job *batchv1.Job) sinksv1alpha1.EventStorageType {
+	if t, ok := job.GetAnnotations()[sinks.JobSinkEventStorageAnnotation]; ok && t != "" {
+		return sinksv1alpha1.EventStorageType(t)
+	}
+	return sinksv1alpha1.SecretEventStorage
+}
+
+// needsReporting returns true when the result of the given finished Job has not been sent
+// to the JobSink sink yet.
+func needsReporting(js *sinksv1alpha1.JobSink, job *batchv1.Job) bool {
+	if js.Status.SinkURI == nil {
+		return false
+	}
+	_, ok := job.GetAnnotations()[sinks.JobSinkResultReportedAnnotation]
+	return !ok
+}
+
+func isJobConditionTrue(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == conditionType && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func jobFinishTime(job *batchv1.Job) time.Time {
+	if job.Status.CompletionTime != nil {
+		return job.Status.CompletionTime.Time
+	}
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			return c.LastTransitionTime.Time
+		}
+	}
+	return job.CreationTimestamp.Time
+}
@@ -19,8 +19,11 @@ package jobsink
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	batchlisters "k8s.io/client-go/listers/batch/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/utils/ptr"
@@ -30,18 +33,35 @@ import (
 	"knative.dev/pkg/network"
 	"knative.dev/pkg/reconciler"
 
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	"knative.dev/eventing/pkg/apis/feature"
 	sinks "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
 	"knative.dev/eventing/pkg/auth"
 	eventingv1alpha1listers "knative.dev/eventing/pkg/client/listers/eventing/v1alpha1"
 	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/kncloudevents"
+	"knative.dev/eventing/pkg/resolver"
 )
 
 type Reconciler struct {
-	jobLister         batchlisters.JobLister
-	secretLister      corev1listers.SecretLister
-	eventPolicyLister eventingv1alpha1listers.EventPolicyLister
-	systemNamespace   string
+	kubeClient           kubernetes.Interface
+	jobLister            batchlisters.JobLister
+	secretLister         corev1listers.SecretLister
+	serviceAccountLister corev1listers.ServiceAccountLister
+	eventPolicyLister    eventingv1alpha1listers.EventPolicyLister
+	systemNamespace      string
+
+	uriResolver     *resolver.URIResolver
+	eventDispatcher *kncloudevents.Dispatcher
+	jobEventSender  *jobEventSender
+
+	// enqueueAfter enqueues a JobSink after the given duration, to retry sending the events
+	// of its Jobs and to delete its finished Jobs once their TTL expires.
+	enqueueAfter func(key types.NamespacedName, delay time.Duration)
+	now          func() time.Time
+
+	trustBundleConfigMapLister corev1listers.ConfigMapNamespaceLister
+	certificateExpiryMonitor   *eventingtls.CertificateExpiryMonitor
 }
 
 func (r *Reconciler) ReconcileKind(ctx context.Context, js *sinks.JobSink) reconciler.Event {
@@ -49,15 +69,74 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, js *sinks.JobSink) recon
 
 	r.reconcileJob(js)
 
+	// OIDC authentication of the events sent to the sink and to the dead letter sink.
+	if err := auth.SetupOIDCServiceAccount(ctx, featureFlags, r.serviceAccountLister, r.kubeClient, sinks.SchemeGroupVersion.WithKind("JobSink"), js.ObjectMeta, &js.Status, func(as *duckv1.AuthStatus) {
+		js.Status.Auth = as
+	}); err != nil {
+		return err
+	}
+
 	if err := r.reconcileAddress(ctx, js); err != nil {
 		return fmt.Errorf("failed to reconcile address: %w", err)
 	}
 
+	if err := r.resolveDeadLetterSink(ctx, js); err != nil {
+		return err
+	}
+
+	if err := r.resolveSink(ctx, js); err != nil {
+		return err
+	}
+
 	err := auth.UpdateStatusWithEventPolicies(featureFlags, &js.Status.AppliedEventPoliciesStatus, &js.Status, r.eventPolicyLister, sinks.SchemeGroupVersion.WithKind("JobSink"), js.ObjectMeta)
 	if err != nil {
 		return fmt.Errorf("could not update JobSink status with EventPolicies: %v", err)
 	}
 
+	if err := r.reconcileFinishedJobs(ctx, js); err != nil {
+		return fmt.Errorf("failed to reconcile finished jobs: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) resolveDeadLetterSink(ctx context.Context, js *sinks.JobSink) error {
+	if js.Spec.Delivery == nil || js.Spec.Delivery.DeadLetterSink == nil {
+		js.Status.MarkDeadLetterSinkNotConfigured()
+		return nil
+	}
+
+	dls := js.Spec.Delivery.DeadLetterSink.DeepCopy()
+	if dls.Ref != nil && dls.Ref.Namespace == "" {
+		dls.Ref.Namespace = js.GetNamespace()
+	}
+	deadLetterSinkAddr, err := r.uriResolver.AddressableFromDestinationV1(ctx, *dls, js)
+	if err != nil {
+		logging.FromContext(ctx).Errorw("Unable to get the dead letter sink's URI", zap.Error(err))
+		js.Status.MarkDeadLetterSinkResolvedFailed("Unable to get the dead letter sink's URI", "%v", err)
+		return err
+	}
+	js.Status.MarkDeadLetterSinkResolvedSucceeded(eventingduckv1.NewDeliveryStatusFromAddressable(deadLetterSinkAddr))
+	return nil
+}
+
+func (r *Reconciler) resolveSink(ctx context.Context, js *sinks.JobSink) error {
+	if js.Spec.Sink == nil {
+		js.Status.MarkSinkNotConfigured()
+		return nil
+	}
+
+	dest := js.Spec.Sink.DeepCopy()
+	if dest.Ref != nil && dest.Ref.Namespace == "" {
+		dest.Ref.Namespace = js.GetNamespace()
+	}
+	sinkAddr, err := r.uriResolver.AddressableFromDestinationV1(ctx, *dest, js)
+	if err != nil {
+		logging.FromContext(ctx).Errorw("Unable to get the sink's URI", zap.Error(err))
+		js.Status.MarkSinkResolvedFailed("Unable to get the sink's URI", "%v", err)
+		return err
+	}
+	js.Status.MarkSinkResolved(sinkAddr)
 	return nil
 }
 
@@ -128,9 +207,26 @@ func (r *Reconciler) reconcileAddress(ctx context.Context, js *sinks.JobSink) er
 
 	js.GetConditionSet().Manage(js.GetStatus()).MarkTrue(sinks.JobSinkConditionAddressable)
 
+	r.reconcileCertificateExpiry(ctx, js, featureFlags)
+
 	return nil
 }
 
+// reconcileCertificateExpiry reports the expiry of the job sink server certificates and of the
+// trust bundles on the JobSink status.
+func (r *Reconciler) reconcileCertificateExpiry(ctx context.Context, js *sinks.JobSink, featureFlags feature.Flags) {
+	var exp *eventingtls.CertificateExpiry
+	if !featureFlags.IsDisabledTransportEncryption() {
+		var err error
+		exp, err = eventingtls.ServerCertificateExpiry(r.secretLister.Secrets(r.systemNamespace), eventingtls.JobSinkDispatcherServerTLSSecretName, r.trustBundleConfigMapLister)
+		if err != nil {
+			logging.FromContext(ctx).Warnw("Failed to get certificate expiry", zap.Error(err))
+			return
+		}
+	}
+	r.certificateExpiryMonitor.Reconcile(ctx, sinks.SchemeGroupVersion.WithKind("JobSink"), js, js.GetStatus(), js.GetConditionSet(), exp)
+}
+
 func (r *Reconciler) httpAddress(js *sinks.JobSink) duckv1.Addressable {
 	// http address uses host-based routing
 	httpAddress := duckv1.Addressable{
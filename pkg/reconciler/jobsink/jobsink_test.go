@@ -19,29 +19,45 @@ package jobsink
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	clientgotesting "k8s.io/client-go/testing"
 	"k8s.io/utils/ptr"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	v1 "knative.dev/pkg/client/injection/ducks/duck/v1/addressable"
+	fakekubeclient "knative.dev/pkg/client/injection/kube/client/fake"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	logtesting "knative.dev/pkg/logging/testing"
 	"knative.dev/pkg/network"
 	. "knative.dev/pkg/reconciler/testing"
+	"knative.dev/pkg/resolver"
+	"knative.dev/pkg/tracker"
 
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
+	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/apis/sinks"
 	"knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+	"knative.dev/eventing/pkg/auth"
 	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
 	jobsinkreconciler "knative.dev/eventing/pkg/client/injection/reconciler/sinks/v1alpha1/jobsink"
+	"knative.dev/eventing/pkg/eventingtls"
+	"knative.dev/eventing/pkg/kncloudevents"
 	. "knative.dev/eventing/pkg/reconciler/testing/v1"
 	. "knative.dev/eventing/pkg/reconciler/testing/v1alpha1"
+	eventingresolver "knative.dev/eventing/pkg/resolver"
 )
 
 const (
@@ -57,6 +73,9 @@ const (
 var (
 	testKey = fmt.Sprintf("%s/%s", testNamespace, jobSinkName)
 
+	// testNow is the current time of the reconciler, finished jobs finish at the Unix epoch.
+	testNow = time.Unix(100, 0)
+
 	jobSinkAddressable = duckv1.Addressable{
 		Name: ptr.To("http"),
 		URL: &apis.URL{
@@ -66,6 +85,19 @@ var (
 		},
 	}
 
+	jobSinkOIDCAddressable = duckv1.Addressable{
+		Name: ptr.To("http"),
+		URL: &apis.URL{
+			Scheme: "http",
+			Host:   network.GetServiceHostname("job-sink", testNamespace),
+			Path:   fmt.Sprintf("/%s/%s", testNamespace, jobSinkName),
+		},
+		Audience: ptr.To(auth.GetAudience(v1alpha1.SchemeGroupVersion.WithKind("JobSink"), metav1.ObjectMeta{
+			Name:      jobSinkName,
+			Namespace: testNamespace,
+		})),
+	}
+
 	jobSinkGVK = metav1.GroupVersionKind{
 		Group:   "sinks.knative.dev",
 		Version: "v1alpha1",
@@ -78,6 +110,24 @@ func TestReconcile(t *testing.T) {
 	seed := int64(42)
 	utilrand.Seed(seed)
 
+	dls := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer dls.Close()
+	dlsURL, _ := apis.ParseURL(dls.URL)
+
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Ce-Type"); got != v1alpha1.JobSinkJobSucceededEventType {
+			t.Errorf("unexpected event type %q, want %q", got, v1alpha1.JobSinkJobSucceededEventType)
+		}
+		if got := r.Header.Get("Ce-" + v1alpha1.JobSinkEventIDExtension); got != "1234" {
+			t.Errorf("unexpected correlated event id %q, want %q", got, "1234")
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer sink.Close()
+	sinkURL, _ := apis.ParseURL(sink.URL)
+
 	table := TableTest{
 		{
 			Name: "bad work queue key",
@@ -107,8 +157,11 @@ func TestReconcile(t *testing.T) {
 					Object: NewJobSink(jobSinkName, testNamespace,
 						WithJobSinkJob(testJob("")),
 						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 						WithJobSinkJobStatusSelector(),
 						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkDeadLetterSinkNotConfigured(),
+						WithJobSinkSinkNotConfigured(),
 						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
 				},
 			},
@@ -133,8 +186,11 @@ func TestReconcile(t *testing.T) {
 					Object: NewJobSink(jobSinkName, testNamespace,
 						WithJobSinkJob(testJob("")),
 						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 						WithJobSinkJobStatusSelector(),
 						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkDeadLetterSinkNotConfigured(),
+						WithJobSinkSinkNotConfigured(),
 						WithJobSinkEventPoliciesReady(),
 						WithJobSinkEventPoliciesListed(readyEventPolicyName),
 					),
@@ -161,8 +217,11 @@ func TestReconcile(t *testing.T) {
 					Object: NewJobSink(jobSinkName, testNamespace,
 						WithJobSinkJob(testJob("")),
 						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 						WithJobSinkJobStatusSelector(),
 						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkDeadLetterSinkNotConfigured(),
+						WithJobSinkSinkNotConfigured(),
 						WithJobSinkEventPoliciesNotReady("EventPoliciesNotReady", fmt.Sprintf("event policies %s are not ready", unreadyEventPolicyName)),
 					),
 				},
@@ -192,8 +251,11 @@ func TestReconcile(t *testing.T) {
 					Object: NewJobSink(jobSinkName, testNamespace,
 						WithJobSinkJob(testJob("")),
 						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 						WithJobSinkJobStatusSelector(),
 						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkDeadLetterSinkNotConfigured(),
+						WithJobSinkSinkNotConfigured(),
 						WithJobSinkEventPoliciesNotReady("EventPoliciesNotReady", fmt.Sprintf("event policies %s are not ready", unreadyEventPolicyName)),
 						WithJobSinkEventPoliciesListed(readyEventPolicyName),
 					),
@@ -220,8 +282,11 @@ func TestReconcile(t *testing.T) {
 					Object: NewJobSink(jobSinkName, testNamespace,
 						WithJobSinkJob(testJob("")),
 						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 						WithJobSinkJobStatusSelector(),
 						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkDeadLetterSinkNotConfigured(),
+						WithJobSinkSinkNotConfigured(),
 						func(sink *v1alpha1.JobSink) {
 							sink.Generation = 4242
 							sink.Status.ObservedGeneration = 4242
@@ -230,16 +295,330 @@ func TestReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Delete finished jobs over history limits",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkHistoryLimits(ptr.To[int32](1), ptr.To[int32](0)),
+					WithInitJobSinkConditions),
+				finishedJob("succeeded-old", batchv1.JobComplete, 1),
+				finishedJob("succeeded-new", batchv1.JobComplete, 2),
+				finishedJob("failed", batchv1.JobFailed, 1),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				testJob("test-jobSinkrxg2r"),
+			},
+			WantDeletes: []clientgotesting.DeleteActionImpl{
+				deleteJob("succeeded-old"),
+				deleteJob("failed"),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkHistoryLimits(ptr.To[int32](1), ptr.To[int32](0)),
+						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkDeadLetterSinkNotConfigured(),
+						WithJobSinkSinkNotConfigured(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Send event of failed job to dead letter sink",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkDelivery(&eventingduckv1.DeliverySpec{DeadLetterSink: &duckv1.Destination{URI: dlsURL}}),
+					WithJobSinkHistoryLimits(nil, ptr.To[int32](0)),
+					WithInitJobSinkConditions),
+				finishedJob("failed", batchv1.JobFailed, 1),
+				jobEventSecret("failed"),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				testJob("test-jobSinkkv22d"),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchJobAnnotation("failed", sinks.JobSinkDeadLetteredAnnotation),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkDelivery(&eventingduckv1.DeliverySpec{DeadLetterSink: &duckv1.Destination{URI: dlsURL}}),
+						WithJobSinkHistoryLimits(nil, ptr.To[int32](0)),
+						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkDeadLetterSinkResolved(&duckv1.Addressable{URL: dlsURL}),
+						WithJobSinkSinkNotConfigured(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Send event of failed job to dead letter sink from the job event storage",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkDelivery(&eventingduckv1.DeliverySpec{DeadLetterSink: &duckv1.Destination{URI: dlsURL}}),
+					WithJobSinkHistoryLimits(nil, ptr.To[int32](0)),
+					WithInitJobSinkConditions),
+				withEventStorage(finishedJob("failed", batchv1.JobFailed, 1), v1alpha1.ConfigMapEventStorage),
+				jobEventConfigMap("failed"),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				testJob("test-jobSinkb54mc"),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchJobAnnotation("failed", sinks.JobSinkDeadLetteredAnnotation),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkDelivery(&eventingduckv1.DeliverySpec{DeadLetterSink: &duckv1.Destination{URI: dlsURL}}),
+						WithJobSinkHistoryLimits(nil, ptr.To[int32](0)),
+						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkDeadLetterSinkResolved(&duckv1.Addressable{URL: dlsURL}),
+						WithJobSinkSinkNotConfigured(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Delete dead lettered failed job",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkDelivery(&eventingduckv1.DeliverySpec{DeadLetterSink: &duckv1.Destination{URI: dlsURL}}),
+					WithJobSinkHistoryLimits(nil, ptr.To[int32](0)),
+					WithInitJobSinkConditions),
+				deadLetteredJob(finishedJob("failed", batchv1.JobFailed, 1)),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				testJob("test-jobSinkg2j5q"),
+			},
+			WantDeletes: []clientgotesting.DeleteActionImpl{
+				deleteJob("failed"),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkDelivery(&eventingduckv1.DeliverySpec{DeadLetterSink: &duckv1.Destination{URI: dlsURL}}),
+						WithJobSinkHistoryLimits(nil, ptr.To[int32](0)),
+						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkDeadLetterSinkResolved(&duckv1.Addressable{URL: dlsURL}),
+						WithJobSinkSinkNotConfigured(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Send result of succeeded job to sink",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkSink(&duckv1.Destination{URI: sinkURL}),
+					WithInitJobSinkConditions),
+				withEventAnnotations(finishedJob("succeeded", batchv1.JobComplete, 1)),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				testJob("test-jobSink7ddzv"),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchJobAnnotation("succeeded", sinks.JobSinkResultReportedAnnotation),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkSink(&duckv1.Destination{URI: sinkURL}),
+						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkDeadLetterSinkNotConfigured(),
+						WithJobSinkSinkResolved(&duckv1.Addressable{URL: sinkURL}),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Keep finished job until its result is reported",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkSink(&duckv1.Destination{URI: sinkURL}),
+					WithJobSinkHistoryLimits(ptr.To[int32](0), nil),
+					WithInitJobSinkConditions),
+				withEventAnnotations(finishedJob("succeeded", batchv1.JobComplete, 1)),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				testJob("test-jobSinkdbrc9"),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchJobAnnotation("succeeded", sinks.JobSinkResultReportedAnnotation),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkSink(&duckv1.Destination{URI: sinkURL}),
+						WithJobSinkHistoryLimits(ptr.To[int32](0), nil),
+						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkDeadLetterSinkNotConfigured(),
+						WithJobSinkSinkResolved(&duckv1.Addressable{URL: sinkURL}),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Delete finished jobs after their TTL",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkTTLSecondsAfterFinished(50),
+					WithInitJobSinkConditions),
+				finishedJob("succeeded-expired", batchv1.JobComplete, 1),
+				finishedJob("succeeded", batchv1.JobComplete, 90),
+				finishedJob("failed-expired", batchv1.JobFailed, 1),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				testJob("test-jobSinkcf4rt"),
+			},
+			WantDeletes: []clientgotesting.DeleteActionImpl{
+				deleteJob("succeeded-expired"),
+				deleteJob("failed-expired"),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkTTLSecondsAfterFinished(50),
+						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkDeadLetterSinkNotConfigured(),
+						WithJobSinkSinkNotConfigured(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "Keep expired failed job until its event is dead lettered",
+			Key:  testKey,
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithJobSinkDelivery(&eventingduckv1.DeliverySpec{DeadLetterSink: &duckv1.Destination{URI: dlsURL}}),
+					WithJobSinkTTLSecondsAfterFinished(0),
+					WithInitJobSinkConditions),
+				finishedJob("failed", batchv1.JobFailed, 1),
+				jobEventSecret("failed"),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				testJob("test-jobSinksd7bx"),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchJobAnnotation("failed", sinks.JobSinkDeadLetteredAnnotation),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkDelivery(&eventingduckv1.DeliverySpec{DeadLetterSink: &duckv1.Destination{URI: dlsURL}}),
+						WithJobSinkTTLSecondsAfterFinished(0),
+						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkAddressable),
+						WithJobSinkDeadLetterSinkResolved(&duckv1.Addressable{URL: dlsURL}),
+						WithJobSinkSinkNotConfigured(),
+						WithJobSinkEventPoliciesReadyBecauseOIDCDisabled()),
+				},
+			},
+		},
+		{
+			Name: "OIDC: creates OIDC service account",
+			Key:  testKey,
+			Ctx: feature.ToContext(context.Background(), feature.Flags{
+				feature.OIDCAuthentication:       feature.Enabled,
+				feature.AuthorizationDefaultMode: feature.AuthorizationAllowSameNamespace,
+			}),
+			Objects: []runtime.Object{
+				NewJobSink(jobSinkName, testNamespace,
+					WithJobSinkJob(testJob("")),
+					WithInitJobSinkConditions),
+			},
+			WantErr: false,
+			WantCreates: []runtime.Object{
+				makeJobSinkOIDCServiceAccount(),
+				testJob("test-jobSinkjsjw9"),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{
+				{
+					Object: NewJobSink(jobSinkName, testNamespace,
+						WithJobSinkJob(testJob("")),
+						WithJobSinkAddressableReady(),
+						WithJobSinkOIDCIdentityCreatedSucceeded(),
+						WithJobSinkOIDCServiceAccountName(makeJobSinkOIDCServiceAccount().Name),
+						WithJobSinkJobStatusSelector(),
+						WithJobSinkAddress(&jobSinkOIDCAddressable),
+						WithJobSinkDeadLetterSinkNotConfigured(),
+						WithJobSinkSinkNotConfigured(),
+						WithJobSinkEventPoliciesReadyBecauseNoPolicyAndOIDCEnabled()),
+				},
+			},
+		},
 	}
 
 	logger := logtesting.TestLogger(t)
 	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
 		ctx = v1.WithDuck(ctx)
 		r := &Reconciler{
-			jobLister:         listers.GetJobLister(),
-			secretLister:      listers.GetSecretLister(),
-			eventPolicyLister: listers.GetEventPolicyLister(),
-			systemNamespace:   testNamespace,
+			kubeClient:           fakekubeclient.Get(ctx),
+			jobLister:            listers.GetJobLister(),
+			secretLister:         listers.GetSecretLister(),
+			serviceAccountLister: listers.GetServiceAccountLister(),
+			eventPolicyLister:    listers.GetEventPolicyLister(),
+			systemNamespace:      testNamespace,
+			uriResolver:          &eventingresolver.URIResolver{URIResolver: resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0))},
+			eventDispatcher:      kncloudevents.NewDispatcher(eventingtls.ClientConfig{}, nil),
+			jobEventSender:       newSyncJobEventSender(),
+			enqueueAfter:         func(types.NamespacedName, time.Duration) {},
+			now:                  func() time.Time { return testNow },
 		}
 
 		return jobsinkreconciler.NewReconciler(ctx, logger,
@@ -251,6 +630,20 @@ func TestReconcile(t *testing.T) {
 	))
 }
 
+// newSyncJobEventSender returns a jobEventSender running the sends synchronously.
+func newSyncJobEventSender() *jobEventSender {
+	s := newJobEventSender()
+	s.run = func(send func()) { send() }
+	return s
+}
+
+func makeJobSinkOIDCServiceAccount() *corev1.ServiceAccount {
+	return auth.GetOIDCServiceAccountForResource(v1alpha1.SchemeGroupVersion.WithKind("JobSink"), metav1.ObjectMeta{
+		Name:      jobSinkName,
+		Namespace: testNamespace,
+	})
+}
+
 func testJob(name string) *batchv1.Job {
 	return &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
@@ -273,3 +666,126 @@ func testJob(name string) *batchv1.Job {
 		},
 	}
 }
+
+func finishedJob(name string, conditionType batchv1.JobConditionType, finishedAt int64) *batchv1.Job {
+	job := testJob(name)
+	job.Labels = map[string]string{
+		sinks.JobSinkJobLabel:  "true",
+		sinks.JobSinkNameLabel: jobSinkName,
+	}
+	finishTime := metav1.NewTime(time.Unix(finishedAt, 0))
+	job.Status.Conditions = []batchv1.JobCondition{{
+		Type:               conditionType,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: finishTime,
+	}}
+	if conditionType == batchv1.JobComplete {
+		job.Status.CompletionTime = &finishTime
+	}
+	return job
+}
+
+func withEventAnnotations(job *batchv1.Job) *batchv1.Job {
+	job.Annotations = map[string]string{
+		sinks.JobSinkEventIDAnnotation:     "1234",
+		sinks.JobSinkEventSourceAnnotation: "/test/source",
+	}
+	return job
+}
+
+func deadLetteredJob(job *batchv1.Job) *batchv1.Job {
+	job.Annotations = map[string]string{
+		sinks.JobSinkDeadLetteredAnnotation: "true",
+	}
+	return job
+}
+
+func withEventStorage(job *batchv1.Job, storage v1alpha1.EventStorageType) *batchv1.Job {
+	job.Annotations = map[string]string{
+		sinks.JobSinkEventStorageAnnotation: string(storage),
+	}
+	return job
+}
+
+func jobEventSecret(name string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+		Data: map[string][]byte{"event": testEventBytes()},
+	}
+}
+
+func jobEventConfigMap(name string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+		BinaryData: map[string][]byte{"event": testEventBytes()},
+	}
+}
+
+func testEventBytes() []byte {
+	event := cloudevents.NewEvent()
+	event.SetID("1234")
+	event.SetSource("/test/source")
+	event.SetType("test.type")
+	eventBytes, _ := event.MarshalJSON()
+	return eventBytes
+}
+
+func deleteJob(name string) clientgotesting.DeleteActionImpl {
+	return clientgotesting.DeleteActionImpl{
+		ActionImpl: clientgotesting.ActionImpl{
+			Namespace: testNamespace,
+			Resource:  batchv1.SchemeGroupVersion.WithResource("jobs"),
+		},
+		Name: name,
+	}
+}
+
+func patchJobAnnotation(name, annotation string) clientgotesting.PatchActionImpl {
+	action := clientgotesting.PatchActionImpl{}
+	action.Name = name
+	action.Namespace = testNamespace
+	action.Patch = []byte(fmt.Sprintf(`{"metadata":{"annotations":{%q:"true"}}}`, annotation))
+	return action
+}
+
+func TestJobEventSenderSkipsInFlightSends(t *testing.T) {
+	s := newJobEventSender()
+	release := make(chan struct{})
+	done := make(chan struct{})
+	sends := 0
+	s.send("job/annotation", func() {
+		sends++
+		<-release
+		close(done)
+	})
+	// The first send is still in flight.
+	s.send("job/annotation", func() { t.Error("unexpected concurrent send") })
+	close(release)
+	<-done
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s.mu.Lock()
+		inFlight := len(s.inFlight)
+		s.mu.Unlock()
+		if inFlight == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("send still in flight")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	s.run = func(send func()) { send() }
+	s.send("job/annotation", func() { sends++ })
+	if sends != 2 {
+		t.Errorf("sends = %d, want 2", sends)
+	}
+}
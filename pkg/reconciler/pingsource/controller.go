@@ -28,6 +28,7 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
 
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	deploymentinformer "knative.dev/pkg/client/injection/kube/informers/apps/v1/deployment"
@@ -83,8 +84,9 @@ func NewController(
 	r := &Reconciler{
 		kubeClientSet:        kubeclient.Get(ctx),
 		leConfig:             leConfig,
-		configAcc:            reconcilersource.WatchConfigurations(ctx, component, cmw),
+		configAcc:            reconcilersource.WatchConfigurations(ctx, component, cmw, reconcilersource.WithLogging, reconcilersource.WithObservability, reconcilersource.WithTLSPolicies),
 		serviceAccountLister: oidcServiceaccountInformer.Lister(),
+		clock:                clock.RealClock{},
 	}
 
 	impl := pingsourcereconciler.NewImpl(ctx, r, func(impl *controller.Impl) controller.Options {
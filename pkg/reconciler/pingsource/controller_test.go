@@ -23,9 +23,11 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"knative.dev/eventing/pkg/apis/feature"
+	"knative.dev/eventing/pkg/eventingtls"
 
 	"knative.dev/eventing/pkg/auth"
 	o11yconfigmap "knative.dev/eventing/pkg/observability/configmap"
+	"knative.dev/pkg/client/injection/ducks/duck/v1/kresource"
 	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/logging"
@@ -43,6 +45,7 @@ import (
 
 func TestNew(t *testing.T) {
 	ctx, _ := SetupFakeContext(t, SetUpInformerSelector)
+	ctx = kresource.WithDuck(ctx)
 	c := NewController(ctx, configmap.NewStaticWatcher(
 		&corev1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
@@ -52,6 +55,11 @@ func TestNew(t *testing.T) {
 			Data: map[string]string{
 				"_example": "test-config",
 			},
+		}, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      eventingtls.TLSPoliciesConfigName,
+				Namespace: "knative-eventing",
+			},
 		}, &corev1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      logging.ConfigMapName(),
@@ -31,13 +31,13 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
-	"knative.dev/pkg/resolver"
 	"knative.dev/pkg/system"
 	"knative.dev/pkg/tracker"
 
@@ -49,6 +49,7 @@ import (
 	pingsourcereconciler "knative.dev/eventing/pkg/client/injection/reconciler/sources/v1/pingsource"
 	"knative.dev/eventing/pkg/reconciler/pingsource/resources"
 	reconcilersource "knative.dev/eventing/pkg/reconciler/source"
+	"knative.dev/eventing/pkg/resolver"
 )
 
 const (
@@ -80,6 +81,9 @@ type Reconciler struct {
 	leConfig string
 
 	serviceAccountLister v1.ServiceAccountLister
+
+	// clock is used to compute the schedule times reported in the status
+	clock clock.PassiveClock
 }
 
 // Check that our Reconciler implements ReconcileKind
@@ -146,7 +150,7 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, source *sourcesv1.PingSo
 		Source: sourcesv1.PingSourceSource(source.Namespace, source.Name),
 	}}
 
-	return nil
+	return r.reconcileScheduleTimes(source)
 }
 
 func (r *Reconciler) FinalizeKind(ctx context.Context, source *sourcesv1.PingSource) pkgreconciler.Event {
@@ -156,6 +160,22 @@ func (r *Reconciler) FinalizeKind(ctx context.Context, source *sourcesv1.PingSou
 	return nil
 }
 
+// reconcileScheduleTimes computes the schedule times reported in the status
+// when the spec has changed since it was last observed. The times are not
+// refreshed as events are sent, which would cost a status update per tick.
+func (r *Reconciler) reconcileScheduleTimes(source *sourcesv1.PingSource) error {
+	if source.Status.ObservedGeneration == source.Generation {
+		return nil
+	}
+
+	schedule, err := source.Spec.CronSchedule()
+	if err != nil {
+		return fmt.Errorf("failed to parse schedule: %w", err)
+	}
+	source.Status.PropagateScheduleTimes(schedule, r.clock.Now())
+	return nil
+}
+
 func (r *Reconciler) reconcileReceiveAdapter(ctx context.Context, source *sourcesv1.PingSource) (*appsv1.Deployment, error) {
 	args := resources.Args{
 		ConfigEnvVars:   r.configAcc.ToEnvVars(),
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/eventing/pkg/auth"
@@ -56,6 +57,7 @@ import (
 	"knative.dev/eventing/pkg/eventingtls/eventingtlstesting"
 	"knative.dev/eventing/pkg/reconciler/pingsource/resources"
 	reconcilersource "knative.dev/eventing/pkg/reconciler/source"
+	eventingresolver "knative.dev/eventing/pkg/resolver"
 
 	_ "knative.dev/pkg/client/injection/ducks/duck/v1beta1/addressable/fake"
 	. "knative.dev/pkg/reconciler/testing"
@@ -93,6 +95,10 @@ var (
 		URL:      sinkURL,
 		Audience: &sinkAudience,
 	}
+	testNow              = time.Date(2026, time.January, 1, 0, 1, 0, 0, time.UTC)
+	testNextScheduleTime = time.Date(2026, time.January, 1, 0, 2, 0, 0, time.UTC)
+	testRunAt            = time.Date(2026, time.January, 1, 9, 0, 0, 0, time.UTC)
+
 	sinkOIDCDest = duckv1.Destination{
 		Ref: &duckv1.KReference{
 			Name:       sinkName,
@@ -301,6 +307,7 @@ func TestAllCases(t *testing.T) {
 					rtv1.WithPingSourceDeployed,
 					rtv1.WithPingSourceSink(sinkAddressable),
 					rtv1.WithPingSourceCloudEventAttributes,
+					rtv1.WithPingSourceNextScheduleTime(testNextScheduleTime),
 					rtv1.WithPingSourceStatusObservedGeneration(generation),
 					rtv1.WithPingSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 				),
@@ -358,6 +365,7 @@ func TestAllCases(t *testing.T) {
 						CACerts: pointer.String(string(eventingtlstesting.CA)),
 					}),
 					rtv1.WithPingSourceCloudEventAttributes,
+					rtv1.WithPingSourceNextScheduleTime(testNextScheduleTime),
 					rtv1.WithPingSourceStatusObservedGeneration(generation),
 					rtv1.WithPingSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 				),
@@ -414,6 +422,7 @@ func TestAllCases(t *testing.T) {
 					rtv1.WithPingSourceDeployed,
 					rtv1.WithPingSourceSink(sinkAddressable),
 					rtv1.WithPingSourceCloudEventAttributes,
+					rtv1.WithPingSourceNextScheduleTime(testNextScheduleTime),
 					rtv1.WithPingSourceStatusObservedGeneration(generation),
 					rtv1.WithPingSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 				),
@@ -460,6 +469,161 @@ func TestAllCases(t *testing.T) {
 					rtv1.WithPingSourceDeployed,
 					rtv1.WithPingSourceSink(sinkAddressable),
 					rtv1.WithPingSourceCloudEventAttributes,
+					rtv1.WithPingSourceNextScheduleTime(testNextScheduleTime),
+					rtv1.WithPingSourceStatusObservedGeneration(generation),
+					rtv1.WithPingSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+				),
+			}},
+			WantEvents: []string{
+				Eventf(corev1.EventTypeNormal, "FinalizerUpdate", "Updated %q finalizers", sourceName),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(sourceName, testNS),
+			},
+		}, {
+			Name: "valid with elapsed schedule time",
+			Objects: []runtime.Object{
+				rtv1.NewPingSource(sourceName, testNS,
+					rtv1.WithPingSourceSpec(sourcesv1.PingSourceSpec{
+						Schedule:    testSchedule,
+						ContentType: testContentType,
+						Data:        testData,
+						SourceSpec: duckv1.SourceSpec{
+							Sink: sinkDest,
+						},
+					}),
+					rtv1.WithPingSource(sourceUID),
+					rtv1.WithPingSourceObjectMetaGeneration(generation),
+					rtv1.WithPingSourceNextScheduleTime(testNow),
+				),
+				rtv1.NewChannel(sinkName, testNS,
+					rtv1.WithInitChannelConditions,
+					rtv1.WithChannelAddress(sinkAddressable),
+				),
+				makeAvailableMTAdapter(),
+			},
+			Key: testNS + "/" + sourceName,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: rtv1.NewPingSource(sourceName, testNS,
+					rtv1.WithPingSourceSpec(sourcesv1.PingSourceSpec{
+						Schedule:    testSchedule,
+						ContentType: testContentType,
+						Data:        testData,
+						SourceSpec: duckv1.SourceSpec{
+							Sink: sinkDest,
+						},
+					}),
+					rtv1.WithPingSource(sourceUID),
+					rtv1.WithPingSourceObjectMetaGeneration(generation),
+					// Status Update:
+					rtv1.WithInitPingSourceConditions,
+					rtv1.WithPingSourceDeployed,
+					rtv1.WithPingSourceSink(sinkAddressable),
+					rtv1.WithPingSourceCloudEventAttributes,
+					rtv1.WithPingSourceLastScheduleTime(testNow),
+					rtv1.WithPingSourceNextScheduleTime(testNextScheduleTime),
+					rtv1.WithPingSourceStatusObservedGeneration(generation),
+					rtv1.WithPingSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+				),
+			}},
+			WantEvents: []string{
+				Eventf(corev1.EventTypeNormal, "FinalizerUpdate", "Updated %q finalizers", sourceName),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(sourceName, testNS),
+			},
+		}, {
+			Name: "elapsed schedule time is kept for the observed generation",
+			Objects: []runtime.Object{
+				rtv1.NewPingSource(sourceName, testNS,
+					rtv1.WithPingSourceSpec(sourcesv1.PingSourceSpec{
+						Schedule:    testSchedule,
+						ContentType: testContentType,
+						Data:        testData,
+						SourceSpec: duckv1.SourceSpec{
+							Sink: sinkDest,
+						},
+					}),
+					rtv1.WithPingSource(sourceUID),
+					rtv1.WithPingSourceObjectMetaGeneration(generation),
+					rtv1.WithPingSourceStatusObservedGeneration(generation),
+					rtv1.WithPingSourceNextScheduleTime(testNow),
+				),
+				rtv1.NewChannel(sinkName, testNS,
+					rtv1.WithInitChannelConditions,
+					rtv1.WithChannelAddress(sinkAddressable),
+				),
+				makeAvailableMTAdapter(),
+			},
+			Key: testNS + "/" + sourceName,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: rtv1.NewPingSource(sourceName, testNS,
+					rtv1.WithPingSourceSpec(sourcesv1.PingSourceSpec{
+						Schedule:    testSchedule,
+						ContentType: testContentType,
+						Data:        testData,
+						SourceSpec: duckv1.SourceSpec{
+							Sink: sinkDest,
+						},
+					}),
+					rtv1.WithPingSource(sourceUID),
+					rtv1.WithPingSourceObjectMetaGeneration(generation),
+					// Status Update:
+					rtv1.WithInitPingSourceConditions,
+					rtv1.WithPingSourceDeployed,
+					rtv1.WithPingSourceSink(sinkAddressable),
+					rtv1.WithPingSourceCloudEventAttributes,
+					rtv1.WithPingSourceNextScheduleTime(testNow),
+					rtv1.WithPingSourceStatusObservedGeneration(generation),
+					rtv1.WithPingSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
+				),
+			}},
+			WantEvents: []string{
+				Eventf(corev1.EventTypeNormal, "FinalizerUpdate", "Updated %q finalizers", sourceName),
+			},
+			WantPatches: []clientgotesting.PatchActionImpl{
+				patchFinalizers(sourceName, testNS),
+			},
+		}, {
+			Name: "valid with runAt",
+			Objects: []runtime.Object{
+				rtv1.NewPingSource(sourceName, testNS,
+					rtv1.WithPingSourceSpec(sourcesv1.PingSourceSpec{
+						RunAt:       &metav1.Time{Time: testRunAt},
+						ContentType: testContentType,
+						Data:        testData,
+						SourceSpec: duckv1.SourceSpec{
+							Sink: sinkDest,
+						},
+					}),
+					rtv1.WithPingSource(sourceUID),
+					rtv1.WithPingSourceObjectMetaGeneration(generation),
+				),
+				rtv1.NewChannel(sinkName, testNS,
+					rtv1.WithInitChannelConditions,
+					rtv1.WithChannelAddress(sinkAddressable),
+				),
+				makeAvailableMTAdapter(),
+			},
+			Key: testNS + "/" + sourceName,
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: rtv1.NewPingSource(sourceName, testNS,
+					rtv1.WithPingSourceSpec(sourcesv1.PingSourceSpec{
+						RunAt:       &metav1.Time{Time: testRunAt},
+						ContentType: testContentType,
+						Data:        testData,
+						SourceSpec: duckv1.SourceSpec{
+							Sink: sinkDest,
+						},
+					}),
+					rtv1.WithPingSource(sourceUID),
+					rtv1.WithPingSourceObjectMetaGeneration(generation),
+					// Status Update:
+					rtv1.WithInitPingSourceConditions,
+					rtv1.WithPingSourceDeployed,
+					rtv1.WithPingSourceSink(sinkAddressable),
+					rtv1.WithPingSourceCloudEventAttributes,
+					rtv1.WithPingSourceNextScheduleTime(testRunAt),
 					rtv1.WithPingSourceStatusObservedGeneration(generation),
 					rtv1.WithPingSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 				),
@@ -509,6 +673,7 @@ func TestAllCases(t *testing.T) {
 					rtv1.WithPingSourceDeployed,
 					rtv1.WithPingSourceSink(sinkAddressable),
 					rtv1.WithPingSourceCloudEventAttributes,
+					rtv1.WithPingSourceNextScheduleTime(testNextScheduleTime),
 					rtv1.WithPingSourceStatusObservedGeneration(generation),
 					rtv1.WithPingSourceOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled(),
 				),
@@ -562,6 +727,7 @@ func TestAllCases(t *testing.T) {
 					rtv1.WithPingSourceDeployed,
 					rtv1.WithPingSourceSink(sinkOIDCAddressable),
 					rtv1.WithPingSourceCloudEventAttributes,
+					rtv1.WithPingSourceNextScheduleTime(testNextScheduleTime),
 					rtv1.WithPingSourceStatusObservedGeneration(generation),
 					rtv1.WithPingSourceOIDCIdentityCreatedSucceeded(),
 					rtv1.WithPingSourceOIDCServiceAccountName(makePingSourceOIDCServiceAccount().Name),
@@ -641,8 +807,9 @@ func TestAllCases(t *testing.T) {
 			kubeClientSet:        fakekubeclient.Get(ctx),
 			tracker:              tracker.New(func(types.NamespacedName) {}, 0),
 			serviceAccountLister: listers.GetServiceAccountLister(),
+			clock:                fakeClock{now: testNow},
 		}
-		r.sinkResolver = resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0))
+		r.sinkResolver = &eventingresolver.URIResolver{URIResolver: resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0))}
 
 		return pingsource.NewReconciler(ctx, logging.FromContext(ctx),
 			fakeeventingclient.Get(ctx), listers.GetPingSourceLister(),
@@ -653,6 +820,18 @@ func TestAllCases(t *testing.T) {
 	))
 }
 
+type fakeClock struct {
+	now time.Time
+}
+
+func (c fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c fakeClock) Since(t time.Time) time.Duration {
+	return c.now.Sub(t)
+}
+
 func MakeMTAdapter() *appsv1.Deployment {
 	args := resources.Args{
 		ConfigEnvVars:   (&reconcilersource.EmptyVarsGenerator{}).ToEnvVars(),
@@ -19,6 +19,7 @@ package requestreply
 import (
 	"context"
 
+	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -28,15 +29,18 @@ import (
 	secretinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/secret/filtered"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
+	namespacedsecretinformer "knative.dev/pkg/injection/clients/namespacedkube/informers/core/v1/secret"
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/system"
 
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker"
 	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1/trigger"
 	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/requestreply"
 	requestreplyreconciler "knative.dev/eventing/pkg/client/injection/reconciler/eventing/v1alpha1/requestreply"
 	eventingv1alpha1listers "knative.dev/eventing/pkg/client/listers/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/eventingtls"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 )
 
@@ -48,8 +52,14 @@ func NewController(
 	brokerInformer := broker.Get(ctx)
 	statefulSetInformer := statefulsetinformer.Get(ctx)
 	triggerInformer := trigger.Get(ctx)
+	serverTLSSecretInformer := namespacedsecretinformer.Get(ctx)
 	logger := logging.FromContext(ctx)
 
+	certificateExpiryMonitor, err := eventingtls.NewCertificateExpiryMonitor(otel.GetMeterProvider())
+	if err != nil {
+		logger.Fatalw("Failed to create certificate expiry monitor", zap.Error(err))
+	}
+
 	r := &Reconciler{
 		kubeClient:        kubeclient.Get(ctx),
 		eventingClient:    eventingclient.Get(ctx),
@@ -58,12 +68,30 @@ func NewController(
 		brokerLister:      brokerInformer.Lister(),
 		statefulSetLister: statefulSetInformer.Lister(),
 		deleteContext:     ctx,
+
+		serverTLSSecretLister:    serverTLSSecretInformer.Lister(),
+		certificateExpiryMonitor: certificateExpiryMonitor,
 	}
 
+	var globalResync func(obj any)
+
+	featureStore := feature.NewStore(logger.Named("feature-config-store"), func(_ string, _ any) {
+		if globalResync != nil {
+			globalResync(nil)
+		}
+	})
+	featureStore.WatchConfigs(cmw)
+
 	impl := requestreplyreconciler.NewImpl(ctx, r, func(impl *controller.Impl) controller.Options {
-		return controller.Options{}
+		return controller.Options{
+			ConfigStore: featureStore,
+		}
 	})
 
+	globalResync = func(_ any) {
+		impl.GlobalResync(requestReplyInformer.Informer())
+	}
+
 	requestReplyInformer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
 
 	statefulSetInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
@@ -88,6 +116,11 @@ func NewController(
 
 	brokerInformer.Informer().AddEventHandler(enqueueRequestRepliesForBroker(requestReplyInformer.Lister(), impl.Enqueue))
 
+	serverTLSSecretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterWithName(eventingtls.RequestReplyServerTLSSecretName),
+		Handler:    controller.HandleAll(globalResync),
+	})
+
 	return impl
 }
 
@@ -38,8 +38,10 @@ import (
 	"k8s.io/utils/ptr"
 	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
 	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/apis/feature"
 	clientset "knative.dev/eventing/pkg/client/clientset/versioned"
 	eventingv1listers "knative.dev/eventing/pkg/client/listers/eventing/v1"
+	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/kmeta"
@@ -69,6 +71,9 @@ type Reconciler struct {
 	brokerLister      eventingv1listers.BrokerLister
 	statefulSetLister appsv1listers.StatefulSetLister
 	deleteContext     context.Context // used to delete triggers in a async cleanup operation
+
+	serverTLSSecretLister    corev1listers.SecretLister
+	certificateExpiryMonitor *eventingtls.CertificateExpiryMonitor
 }
 
 func (r *Reconciler) ReconcileKind(ctx context.Context, rr *v1alpha1.RequestReply) reconciler.Event {
@@ -96,6 +101,8 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, rr *v1alpha1.RequestRepl
 
 	r.reconcileAddress(ctx, rr)
 
+	r.reconcileCertificateExpiry(ctx, rr)
+
 	rr.Status.MarkEventPoliciesTrueWithReason("NotImplemented", "Event policies not implemented for RequestReply yet")
 	return nil
 }
@@ -298,6 +305,21 @@ func (r *Reconciler) reconcileAddress(_ context.Context, rr *v1alpha1.RequestRep
 	rr.Status.SetAddress(address)
 }
 
+// reconcileCertificateExpiry reports the expiry of the request reply server certificates on the
+// RequestReply status.
+func (r *Reconciler) reconcileCertificateExpiry(ctx context.Context, rr *v1alpha1.RequestReply) {
+	var exp *eventingtls.CertificateExpiry
+	if !feature.FromContext(ctx).IsDisabledTransportEncryption() && r.serverTLSSecretLister != nil {
+		var err error
+		exp, err = eventingtls.ServerCertificateExpiry(r.serverTLSSecretLister.Secrets(system.Namespace()), eventingtls.RequestReplyServerTLSSecretName, nil)
+		if err != nil {
+			logging.FromContext(ctx).Warnw("Failed to get certificate expiry", zap.Error(err))
+			return
+		}
+	}
+	r.certificateExpiryMonitor.Reconcile(ctx, v1alpha1.SchemeGroupVersion.WithKind("RequestReply"), rr, rr.GetStatus(), rr.GetConditionSet(), exp)
+}
+
 func (r *Reconciler) createTrigger(ctx context.Context, idx int, rr *v1alpha1.RequestReply, ss *appsv1.StatefulSet) (*eventingv1.Trigger, error) {
 	replicaCount := int(*rr.Status.DesiredReplicas)
 	podName := fmt.Sprintf("%s-%d", ss.Name, idx)
@@ -32,7 +32,6 @@ import (
 	"knative.dev/pkg/client/injection/ducks/duck/v1/podspecable"
 	"knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
 	"knative.dev/pkg/reconciler"
-	"knative.dev/pkg/resolver"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -51,11 +50,13 @@ import (
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection/clients/dynamicclient"
 	"knative.dev/pkg/logging"
+	pkgresolver "knative.dev/pkg/resolver"
 	"knative.dev/pkg/tracker"
 	"knative.dev/pkg/webhook/psbinding"
 
 	"knative.dev/eventing/pkg/apis/feature"
 	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/resolver"
 )
 
 const (
@@ -85,6 +86,8 @@ func NewController(
 	secretInformer := secretinformer.Get(ctx, auth.OIDCLabelSelector)
 	trustBundleConfigMapInformer := configmapinformer.Get(ctx, eventingtls.TrustBundleLabelSelector)
 	trustBundleConfigMapLister := trustBundleConfigMapInformer.Lister()
+	outputConfigMapInformer := configmapinformer.Get(ctx, v1.SinkBindingOutputLabelSelector)
+	outputSecretInformer := secretinformer.Get(ctx, v1.SinkBindingOutputLabelSelector)
 
 	var globalResync func()
 	featureStore := feature.NewStore(logging.FromContext(ctx).Named("feature-config-store"), func(name string, value interface{}) {
@@ -120,7 +123,11 @@ func NewController(
 		Recorder:        createRecorder(ctx, controllerAgentName),
 		NamespaceLister: namespaceInformer.Lister(),
 	}
-	impl := controller.NewContext(ctx, c, controller.ControllerOptions{
+	r := &Reconciler{
+		BaseReconciler:    c,
+		sinkBindingLister: sbLister,
+	}
+	impl := controller.NewContext(ctx, r, controller.ControllerOptions{
 		WorkQueueName: "SinkBindings",
 		Logger:        logger,
 	})
@@ -138,7 +145,7 @@ func NewController(
 		enqueueInNamespace(sbLister, ns.GetName(), impl)
 	}))
 
-	sbResolver := resolver.NewURIResolverFromTracker(ctx, impl.Tracker)
+	sbResolver := resolver.NewURIResolver(ctx, cmw, impl.Tracker)
 	c.SubResourcesReconciler = &SinkBindingSubResourcesReconciler{
 		res:                        sbResolver,
 		tracker:                    impl.Tracker,
@@ -148,10 +155,14 @@ func NewController(
 		featureStore:               featureStore,
 		tokenProvider:              auth.NewOIDCTokenProvider(ctx),
 		trustBundleConfigMapLister: trustBundleConfigMapLister,
+		outputConfigMapLister:      outputConfigMapInformer.Lister(),
+		outputSecretLister:         outputSecretInformer.Lister(),
 	}
 
 	c.WithContext = func(ctx context.Context, b psbinding.Bindable) (context.Context, error) {
-		return v1.WithTrustBundleConfigMapLister(v1.WithURIResolver(ctx, sbResolver), trustBundleConfigMapLister), nil
+		// Pass the SinkBinding as parent to the KReference mappings.
+		ctx = resolver.WithParent(ctx, b)
+		return v1.WithTrustBundleConfigMapLister(v1.WithURIResolver(ctx, sbResolver.URIResolver), trustBundleConfigMapLister), nil
 	}
 	c.Tracker = impl.Tracker
 	c.Factory = &duck.CachedInformerFactory{
@@ -167,6 +178,16 @@ func NewController(
 		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
 	})
 
+	// Reconcile SinkBinding when its output ConfigMap or Secret changes
+	outputConfigMapInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterController(&v1.SinkBinding{}),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
+	outputSecretInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterController(&v1.SinkBinding{}),
+		Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+	})
+
 	// do a periodic reync of all sinkbindings to renew the token secrets eventually
 	go periodicResync(ctx, globalResync)
 
@@ -216,7 +237,10 @@ func ListAll(ctx context.Context, handler cache.ResourceEventHandler) psbinding.
 		}
 		bl := make([]psbinding.Bindable, 0, len(l))
 		for _, elt := range l {
-			bl = append(bl, elt)
+			// SinkBindings without subject only have an output.
+			if elt.Spec.HasSubject() {
+				bl = append(bl, elt)
+			}
 		}
 		return bl, nil
 	}
@@ -224,7 +248,7 @@ func ListAll(ctx context.Context, handler cache.ResourceEventHandler) psbinding.
 }
 
 func WithContextFactory(ctx context.Context, lister corev1listers.ConfigMapLister, handler func(types.NamespacedName)) psbinding.BindableContext {
-	r := resolver.NewURIResolverFromTracker(ctx, tracker.New(handler, controller.GetTrackerLease(ctx)))
+	r := pkgresolver.NewURIResolverFromTracker(ctx, tracker.New(handler, controller.GetTrackerLease(ctx)))
 
 	return func(ctx context.Context, b psbinding.Bindable) (context.Context, error) {
 		return v1.WithTrustBundleConfigMapLister(v1.WithURIResolver(ctx, r), lister), nil
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sinkbinding
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/webhook/psbinding"
+
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	listers "knative.dev/eventing/pkg/client/listers/sources/v1"
+)
+
+// Reconciler reconciles SinkBindings. SinkBindings with a subject go through
+// the psbinding flow binding the subject, SinkBindings without subject only
+// deliver their sink through their output ConfigMap or Secret.
+type Reconciler struct {
+	*psbinding.BaseReconciler
+
+	sinkBindingLister listers.SinkBindingLister
+}
+
+// Reconcile implements controller.Reconciler
+func (r *Reconciler) Reconcile(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return r.BaseReconciler.Reconcile(ctx, key)
+	}
+	original, err := r.sinkBindingLister.SinkBindings(namespace).Get(name)
+	if err != nil || original.Spec.HasSubject() {
+		return r.BaseReconciler.Reconcile(ctx, key)
+	}
+
+	// Only the leader should reconcile binding resources.
+	if !r.IsLeaderFor(types.NamespacedName{Namespace: namespace, Name: name}) {
+		return controller.NewSkipKey(key)
+	}
+
+	// Don't modify the informers copy.
+	sb := original.DeepCopy()
+	reconcileErr := r.reconcileOutputOnly(ctx, sb)
+	if !equality.Semantic.DeepEqual(original.Status, sb.Status) {
+		if err := r.UpdateStatus(ctx, sb); err != nil {
+			logging.FromContext(ctx).Warnw("Failed to update resource status", zap.Error(err))
+			r.Recorder.Eventf(sb, corev1.EventTypeWarning, "UpdateFailed",
+				"Failed to update status for %q: %v", sb.Name, err)
+			return err
+		}
+	}
+	if reconcileErr != nil {
+		r.Recorder.Event(sb, corev1.EventTypeWarning, "InternalError", reconcileErr.Error())
+	}
+	return reconcileErr
+}
+
+func (r *Reconciler) reconcileOutputOnly(ctx context.Context, sb *v1.SinkBinding) error {
+	if sb.DeletionTimestamp != nil {
+		// The finalizer is left by a subject which has since been removed
+		// from the spec.
+		if r.IsFinalizing(ctx, sb) {
+			return r.RemoveFinalizer(ctx, sb)
+		}
+		return nil
+	}
+
+	sb.Status.InitializeConditions()
+	if err := r.SubResourcesReconciler.Reconcile(ctx, sb); err != nil {
+		return err
+	}
+	sb.Status.MarkBindingAvailable()
+	sb.Status.SetObservedGeneration(sb.Generation)
+	return nil
+}
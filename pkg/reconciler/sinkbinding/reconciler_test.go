@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sinkbinding
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/apis/duck"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/client/injection/ducks/duck/v1/addressable"
+	"knative.dev/pkg/controller"
+	_ "knative.dev/pkg/injection/clients/dynamicclient/fake"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/reconciler"
+	rectesting "knative.dev/pkg/reconciler/testing"
+	pkgresolver "knative.dev/pkg/resolver"
+	_ "knative.dev/pkg/system/testing"
+	"knative.dev/pkg/tracker"
+	"knative.dev/pkg/webhook/psbinding"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	listers "knative.dev/eventing/pkg/client/listers/sources/v1"
+	"knative.dev/eventing/pkg/resolver"
+)
+
+const (
+	testNamespace = "test-namespace"
+	testName      = "test-sinkbinding"
+	testOutput    = "test-output"
+)
+
+var sinkBindingGVR = v1.SchemeGroupVersion.WithResource("sinkbindings")
+
+func newOutputSinkBinding(output *v1.SinkBindingOutput) *v1.SinkBinding {
+	return &v1.SinkBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1.SchemeGroupVersion.String(),
+			Kind:       "SinkBinding",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testName,
+			UID:       "sinkbinding-uid",
+		},
+		Spec: v1.SinkBindingSpec{
+			SourceSpec: duckv1.SourceSpec{
+				Sink: duckv1.Destination{
+					URI: apis.HTTP("sink.example.com"),
+				},
+			},
+			Output: output,
+		},
+	}
+}
+
+// newOwnedOutput returns an output object of the SinkBinding, of the given kind.
+func newOwnedOutput(sb *v1.SinkBinding, kind, name string) runtime.Object {
+	meta := metav1.ObjectMeta{
+		Namespace: testNamespace,
+		Name:      name,
+		UID:       types.UID(name + "-uid"),
+		Labels:    map[string]string{v1.SinkBindingOutputLabelKey: sb.Name},
+		OwnerReferences: []metav1.OwnerReference{
+			*metav1.NewControllerRef(sb, v1.SchemeGroupVersion.WithKind("SinkBinding")),
+		},
+	}
+	if kind == v1.SinkBindingOutputSecret {
+		return &corev1.Secret{ObjectMeta: meta}
+	}
+	return &corev1.ConfigMap{ObjectMeta: meta}
+}
+
+type testReconciler struct {
+	*Reconciler
+	kubeClient    *kubefake.Clientset
+	dynamicClient *dynamicfake.FakeDynamicClient
+}
+
+func newTestReconciler(t *testing.T, sb *v1.SinkBinding, objs ...runtime.Object) *testReconciler {
+	t.Helper()
+
+	ctx, _ := rectesting.SetupFakeContext(t)
+	ctx = addressable.WithDuck(ctx)
+
+	indexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+	sbIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, indexers)
+	configMapIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, indexers)
+	secretIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, indexers)
+	if err := sbIndexer.Add(sb); err != nil {
+		t.Fatal(err)
+	}
+	for _, obj := range objs {
+		indexer := configMapIndexer
+		if _, ok := obj.(*corev1.Secret); ok {
+			indexer = secretIndexer
+		}
+		if err := indexer.Add(obj); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sbLister := listers.NewSinkBindingLister(sbIndexer)
+
+	u, err := duck.ToUnstructured(sb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{sinkBindingGVR: "SinkBindingList"}, u)
+	kubeClient := kubefake.NewClientset(objs...)
+
+	base := &psbinding.BaseReconciler{
+		GVR: sinkBindingGVR,
+		Get: func(namespace string, name string) (psbinding.Bindable, error) {
+			return sbLister.SinkBindings(namespace).Get(name)
+		},
+		DynamicClient: dynamicClient,
+		Recorder:      record.NewFakeRecorder(10),
+		SubResourcesReconciler: &SinkBindingSubResourcesReconciler{
+			res: &resolver.URIResolver{
+				URIResolver: pkgresolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
+			},
+			tracker:                    tracker.New(func(types.NamespacedName) {}, 0),
+			kubeclient:                 kubeClient,
+			featureStore:               feature.NewStore(logging.FromContext(ctx)),
+			trustBundleConfigMapLister: corev1listers.NewConfigMapLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, indexers)),
+			outputConfigMapLister:      corev1listers.NewConfigMapLister(configMapIndexer),
+			outputSecretLister:         corev1listers.NewSecretLister(secretIndexer),
+		},
+	}
+	if err := base.Promote(reconciler.UniversalBucket(), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	return &testReconciler{
+		Reconciler: &Reconciler{
+			BaseReconciler:    base,
+			sinkBindingLister: sbLister,
+		},
+		kubeClient:    kubeClient,
+		dynamicClient: dynamicClient,
+	}
+}
+
+// updatedSinkBinding returns the SinkBinding with the status updated by the reconciler.
+func (r *testReconciler) updatedSinkBinding(t *testing.T) *v1.SinkBinding {
+	t.Helper()
+
+	u, err := r.dynamicClient.Resource(sinkBindingGVR).Namespace(testNamespace).Get(context.Background(), testName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sb := &v1.SinkBinding{}
+	if err := duck.FromUnstructured(u, sb); err != nil {
+		t.Fatal(err)
+	}
+	return sb
+}
+
+func TestReconcileOutputOnly(t *testing.T) {
+	ctx := context.Background()
+	sb := newOutputSinkBinding(&v1.SinkBindingOutput{Kind: v1.SinkBindingOutputConfigMap, Name: testOutput})
+	r := newTestReconciler(t, sb)
+
+	if err := r.Reconcile(ctx, testNamespace+"/"+testName); err != nil {
+		t.Fatal("Reconcile() =", err)
+	}
+
+	cm, err := r.kubeClient.CoreV1().ConfigMaps(testNamespace).Get(ctx, testOutput, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal("output ConfigMap not created:", err)
+	}
+	if got, want := cm.Data["K_SINK"], "http://sink.example.com"; got != want {
+		t.Errorf("unexpected K_SINK %q, want %q", got, want)
+	}
+	if got := cm.Labels[v1.SinkBindingOutputLabelKey]; got != testName {
+		t.Errorf("unexpected %s label %q", v1.SinkBindingOutputLabelKey, got)
+	}
+	if !metav1.IsControlledBy(cm, sb) {
+		t.Errorf("expected the output to be controlled by the SinkBinding, got owner references %v", cm.OwnerReferences)
+	}
+
+	updated := r.updatedSinkBinding(t)
+	if !updated.Status.IsReady() {
+		t.Errorf("expected the SinkBinding to be ready, got conditions %v", updated.Status.Conditions)
+	}
+	if updated.Status.SinkURI.String() != "http://sink.example.com" {
+		t.Errorf("unexpected sink URI %v", updated.Status.SinkURI)
+	}
+}
+
+func TestReconcileOutputOnlyDeletesStaleOutputs(t *testing.T) {
+	ctx := context.Background()
+	sb := newOutputSinkBinding(&v1.SinkBindingOutput{Kind: v1.SinkBindingOutputSecret, Name: testOutput})
+	other := newOutputSinkBinding(nil)
+	other.Name, other.UID = "other", "other-uid"
+	r := newTestReconciler(t, sb,
+		newOwnedOutput(sb, v1.SinkBindingOutputConfigMap, testOutput),
+		newOwnedOutput(sb, v1.SinkBindingOutputSecret, "previous"),
+		newOwnedOutput(other, v1.SinkBindingOutputConfigMap, "other-output"),
+	)
+
+	if err := r.Reconcile(ctx, testNamespace+"/"+testName); err != nil {
+		t.Fatal("Reconcile() =", err)
+	}
+
+	if _, err := r.kubeClient.CoreV1().Secrets(testNamespace).Get(ctx, testOutput, metav1.GetOptions{}); err != nil {
+		t.Error("output Secret not created:", err)
+	}
+	if _, err := r.kubeClient.CoreV1().ConfigMaps(testNamespace).Get(ctx, testOutput, metav1.GetOptions{}); !apierrs.IsNotFound(err) {
+		t.Errorf("expected the previous output ConfigMap to be deleted, got %v", err)
+	}
+	if _, err := r.kubeClient.CoreV1().Secrets(testNamespace).Get(ctx, "previous", metav1.GetOptions{}); !apierrs.IsNotFound(err) {
+		t.Errorf("expected the previous output Secret to be deleted, got %v", err)
+	}
+	if _, err := r.kubeClient.CoreV1().ConfigMaps(testNamespace).Get(ctx, "other-output", metav1.GetOptions{}); err != nil {
+		t.Errorf("expected the output of another SinkBinding to be kept, got %v", err)
+	}
+}
+
+func TestReconcileOutputOnlyNotOwned(t *testing.T) {
+	ctx := context.Background()
+	sb := newOutputSinkBinding(&v1.SinkBindingOutput{Kind: v1.SinkBindingOutputConfigMap, Name: testOutput})
+	// The existing ConfigMap isn't labeled, so it is only found with the client.
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: testOutput},
+		Data:       map[string]string{"key": "value"},
+	}
+	r := newTestReconciler(t, sb)
+	if _, err := r.kubeClient.CoreV1().ConfigMaps(testNamespace).Create(ctx, existing, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Reconcile(ctx, testNamespace+"/"+testName); err == nil {
+		t.Fatal("expected Reconcile() to fail for an output it doesn't own")
+	}
+
+	cm, err := r.kubeClient.CoreV1().ConfigMaps(testNamespace).Get(ctx, testOutput, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cm.OwnerReferences) != 0 || cm.Data["key"] != "value" {
+		t.Errorf("expected the existing ConfigMap to be left untouched, got %v", cm)
+	}
+	updated := r.updatedSinkBinding(t)
+	if cond := updated.Status.GetCondition(v1.SinkBindingConditionAvailable); cond == nil || !cond.IsFalse() || cond.Reason != "OutputFailed" {
+		t.Errorf("unexpected %s condition %v", v1.SinkBindingConditionAvailable, cond)
+	}
+}
+
+func TestReconcileOutputOnlyNotLeader(t *testing.T) {
+	sb := newOutputSinkBinding(&v1.SinkBindingOutput{Kind: v1.SinkBindingOutputConfigMap, Name: testOutput})
+	r := newTestReconciler(t, sb)
+	r.Demote(reconciler.UniversalBucket())
+
+	err := r.Reconcile(context.Background(), testNamespace+"/"+testName)
+	if !controller.IsSkipKey(err) {
+		t.Errorf("expected the key to be skipped, got %v", err)
+	}
+	if actions := r.kubeClient.Actions(); len(actions) != 0 {
+		t.Errorf("unexpected actions %v", actions)
+	}
+}
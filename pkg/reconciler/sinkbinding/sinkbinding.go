@@ -24,11 +24,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/kmeta"
-	"knative.dev/pkg/resolver"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	applyconfigurationcorev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	applyconfigurationmetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
@@ -44,6 +44,7 @@ import (
 
 	"knative.dev/eventing/pkg/apis/feature"
 	v1 "knative.dev/eventing/pkg/apis/sources/v1"
+	"knative.dev/eventing/pkg/resolver"
 )
 
 type SinkBindingSubResourcesReconciler struct {
@@ -55,6 +56,8 @@ type SinkBindingSubResourcesReconciler struct {
 	featureStore               *feature.Store
 	tokenProvider              *auth.OIDCTokenProvider
 	trustBundleConfigMapLister corev1listers.ConfigMapLister
+	outputConfigMapLister      corev1listers.ConfigMapLister
+	outputSecretLister         corev1listers.SecretLister
 }
 
 func (s *SinkBindingSubResourcesReconciler) Reconcile(ctx context.Context, b psbinding.Bindable) error {
@@ -123,6 +126,11 @@ func (s *SinkBindingSubResourcesReconciler) Reconcile(ctx context.Context, b psb
 		sb.Status.OIDCTokenSecretName = nil
 	}
 
+	if err := s.reconcileOutput(ctx, sb); err != nil {
+		sb.Status.MarkBindingUnavailable("OutputFailed", err.Error())
+		return err
+	}
+
 	return nil
 }
 
@@ -183,7 +191,6 @@ func (s *SinkBindingSubResourcesReconciler) renewOIDCTokenSecret(ctx context.Con
 		return fmt.Errorf("could not create token for SinkBinding %s/%s: %w", sb.Name, sb.Namespace, err)
 	}
 
-	apiVersion := fmt.Sprintf("%s/%s", v1.SchemeGroupVersion.Group, v1.SchemeGroupVersion.Version)
 	applyConfig := new(applyconfigurationcorev1.SecretApplyConfiguration).
 		WithLabels(map[string]string{
 			auth.OIDCLabelKey: "enabled",
@@ -193,14 +200,7 @@ func (s *SinkBindingSubResourcesReconciler) renewOIDCTokenSecret(ctx context.Con
 		WithType(corev1.SecretTypeOpaque).
 		WithKind("Secret").
 		WithAPIVersion("v1").
-		WithOwnerReferences(&applyconfigurationmetav1.OwnerReferenceApplyConfiguration{
-			APIVersion:         &apiVersion,
-			Kind:               pointer.String("SinkBinding"),
-			Name:               &sb.Name,
-			UID:                &sb.UID,
-			Controller:         pointer.Bool(true),
-			BlockOwnerDeletion: pointer.Bool(false),
-		}).
+		WithOwnerReferences(ownerReference(sb)).
 		WithStringData(map[string]string{
 			"token": token,
 		})
@@ -217,6 +217,141 @@ func (s *SinkBindingSubResourcesReconciler) renewOIDCTokenSecret(ctx context.Con
 	return nil
 }
 
+// reconcileOutput writes the resolved sink to the output ConfigMap or Secret
+// of the SinkBinding, and deletes its previous outputs.
+func (s *SinkBindingSubResourcesReconciler) reconcileOutput(ctx context.Context, sb *v1.SinkBinding) error {
+	if err := s.deleteStaleOutputs(ctx, sb); err != nil {
+		return err
+	}
+
+	output := sb.Spec.Output
+	if output == nil {
+		return nil
+	}
+
+	data, err := sb.OutputData()
+	if err != nil {
+		return err
+	}
+	labels := map[string]string{
+		v1.SinkBindingOutputLabelKey: sb.Name,
+	}
+
+	// Never take over an object the SinkBinding does not own, its deletion
+	// would be cascaded to it. Objects missing from the listers may exist
+	// without the output label.
+	var existing metav1.Object
+	switch output.Kind {
+	case v1.SinkBindingOutputConfigMap:
+		existing, err = s.outputConfigMapLister.ConfigMaps(sb.Namespace).Get(output.Name)
+		if apierrs.IsNotFound(err) {
+			existing, err = s.kubeclient.CoreV1().ConfigMaps(sb.Namespace).Get(ctx, output.Name, metav1.GetOptions{})
+		}
+	case v1.SinkBindingOutputSecret:
+		existing, err = s.outputSecretLister.Secrets(sb.Namespace).Get(output.Name)
+		if apierrs.IsNotFound(err) {
+			existing, err = s.kubeclient.CoreV1().Secrets(sb.Namespace).Get(ctx, output.Name, metav1.GetOptions{})
+		}
+	default:
+		return fmt.Errorf("unsupported output kind %q", output.Kind)
+	}
+	if err != nil && !apierrs.IsNotFound(err) {
+		return fmt.Errorf("could not check if %s %q exists already: %w", output.Kind, output.Name, err)
+	}
+	if err == nil && !metav1.IsControlledBy(existing, sb) {
+		return fmt.Errorf("%s %q is not owned by SinkBinding %q", output.Kind, output.Name, sb.Name)
+	}
+
+	switch output.Kind {
+	case v1.SinkBindingOutputConfigMap:
+		applyConfig := new(applyconfigurationcorev1.ConfigMapApplyConfiguration).
+			WithLabels(labels).
+			WithName(output.Name).
+			WithNamespace(sb.Namespace).
+			WithKind("ConfigMap").
+			WithAPIVersion("v1").
+			WithOwnerReferences(ownerReference(sb)).
+			WithData(data)
+		_, err = s.kubeclient.CoreV1().ConfigMaps(sb.Namespace).Apply(ctx, applyConfig, metav1.ApplyOptions{FieldManager: controllerAgentName})
+	case v1.SinkBindingOutputSecret:
+		applyConfig := new(applyconfigurationcorev1.SecretApplyConfiguration).
+			WithLabels(labels).
+			WithName(output.Name).
+			WithNamespace(sb.Namespace).
+			WithType(corev1.SecretTypeOpaque).
+			WithKind("Secret").
+			WithAPIVersion("v1").
+			WithOwnerReferences(ownerReference(sb)).
+			WithStringData(data)
+		_, err = s.kubeclient.CoreV1().Secrets(sb.Namespace).Apply(ctx, applyConfig, metav1.ApplyOptions{FieldManager: controllerAgentName})
+	}
+	if err != nil {
+		return fmt.Errorf("could not create or update output %s for SinkBinding %s/%s: %w", output.Kind, sb.Name, sb.Namespace, err)
+	}
+
+	return nil
+}
+
+// deleteStaleOutputs deletes the ConfigMaps and Secrets the SinkBinding owns
+// as output, other than its current output.
+func (s *SinkBindingSubResourcesReconciler) deleteStaleOutputs(ctx context.Context, sb *v1.SinkBinding) error {
+	selector := labels.SelectorFromSet(labels.Set{v1.SinkBindingOutputLabelKey: sb.Name})
+	isStale := func(kind string, obj metav1.Object) bool {
+		if !metav1.IsControlledBy(obj, sb) || obj.GetDeletionTimestamp() != nil {
+			return false
+		}
+		return sb.Spec.Output == nil || sb.Spec.Output.Kind != kind || sb.Spec.Output.Name != obj.GetName()
+	}
+
+	configMaps, err := s.outputConfigMapLister.ConfigMaps(sb.Namespace).List(selector)
+	if err != nil {
+		return fmt.Errorf("could not list output ConfigMaps: %w", err)
+	}
+	for _, cm := range configMaps {
+		if !isStale(v1.SinkBindingOutputConfigMap, cm) {
+			continue
+		}
+		logging.FromContext(ctx).Debugf("Deleting stale output ConfigMap %s/%s", cm.Namespace, cm.Name)
+		err := s.kubeclient.CoreV1().ConfigMaps(cm.Namespace).Delete(ctx, cm.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &cm.UID},
+		})
+		if err != nil && !apierrs.IsNotFound(err) {
+			return fmt.Errorf("could not delete stale output ConfigMap %q: %w", cm.Name, err)
+		}
+	}
+
+	secrets, err := s.outputSecretLister.Secrets(sb.Namespace).List(selector)
+	if err != nil {
+		return fmt.Errorf("could not list output Secrets: %w", err)
+	}
+	for _, secret := range secrets {
+		if !isStale(v1.SinkBindingOutputSecret, secret) {
+			continue
+		}
+		logging.FromContext(ctx).Debugf("Deleting stale output Secret %s/%s", secret.Namespace, secret.Name)
+		err := s.kubeclient.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &secret.UID},
+		})
+		if err != nil && !apierrs.IsNotFound(err) {
+			return fmt.Errorf("could not delete stale output Secret %q: %w", secret.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func ownerReference(sb *v1.SinkBinding) *applyconfigurationmetav1.OwnerReferenceApplyConfiguration {
+	apiVersion := fmt.Sprintf("%s/%s", v1.SchemeGroupVersion.Group, v1.SchemeGroupVersion.Version)
+	return &applyconfigurationmetav1.OwnerReferenceApplyConfiguration{
+		APIVersion:         &apiVersion,
+		Kind:               pointer.String("SinkBinding"),
+		Name:               &sb.Name,
+		UID:                &sb.UID,
+		Controller:         pointer.Bool(true),
+		BlockOwnerDeletion: pointer.Bool(false),
+	}
+}
+
 func (s *SinkBindingSubResourcesReconciler) oidcTokenSecretName(sb *v1.SinkBinding) string {
 	return kmeta.ChildName(sb.Name, "-oidc-token")
 }
@@ -27,6 +27,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"knative.dev/eventing/pkg/eventingtls"
 	"knative.dev/eventing/pkg/observability"
 	o11yconfigmap "knative.dev/eventing/pkg/observability/configmap"
 	pkgutils "knative.dev/eventing/pkg/utils"
@@ -38,6 +39,7 @@ const (
 	EnvLoggingCfg       = "K_LOGGING_CONFIG"
 	EnvObservabilityCfg = "K_OBSERVABILITY_CONFIG"
 	EnvKlogVerbosity    = "K_KLOG_VERBOSITY"
+	EnvTLSPoliciesCfg   = "K_TLS_POLICIES_CONFIG"
 )
 
 type ConfigAccessor interface {
@@ -58,6 +60,7 @@ type ConfigWatcher struct {
 	// configurations remain nil if disabled
 	loggingCfg       *logging.Config
 	observabilityCfg *observability.Config
+	tlsPoliciesCfg   *eventingtls.TLSPolicies
 
 	klogVerbosity string
 }
@@ -100,6 +103,13 @@ func WithObservability(cw *ConfigWatcher, cmw configmap.Watcher) {
 	watchConfigMap(cmw, o11yconfigmap.Name(), cw.updateFromObservabilityConfigMap)
 }
 
+// WithTLSPolicies observes the TLS policies ConfigMap, the policies are passed to
+// the adapters which apply them to their sink.
+func WithTLSPolicies(cw *ConfigWatcher, cmw configmap.Watcher) {
+	cw.tlsPoliciesCfg = &eventingtls.TLSPolicies{}
+	watchConfigMap(cmw, eventingtls.TLSPoliciesConfigName, cw.updateFromTLSPoliciesConfigMap)
+}
+
 func watchConfigMap(cmw configmap.Watcher, cmName string, obs configmap.Observer) {
 	if dcmw, ok := cmw.(configmap.DefaultingWatcher); ok {
 		dcmw.WatchWithDefault(corev1.ConfigMap{
@@ -163,14 +173,40 @@ func (cw *ConfigWatcher) updateFromObservabilityConfigMap(cfg *corev1.ConfigMap)
 	cw.logger.Debugw("Updated observability config from ConfigMap", zap.Any("ConfigMap", cfg))
 }
 
+// TLSPoliciesConfig returns the TLS policies from the ConfigWatcher.
+func (cw *ConfigWatcher) TLSPoliciesConfig() *eventingtls.TLSPolicies {
+	if cw == nil {
+		return nil
+	}
+
+	return cw.tlsPoliciesCfg
+}
+
+func (cw *ConfigWatcher) updateFromTLSPoliciesConfigMap(cfg *corev1.ConfigMap) {
+	if cfg == nil {
+		return
+	}
+
+	policies, err := eventingtls.NewTLSPoliciesFromConfigMap(cfg)
+	if err != nil {
+		cw.logger.Warnw("failed to create TLS policies from ConfigMap", zap.String("cfg.Name", cfg.Name), zap.Error(err))
+		return
+	}
+
+	cw.tlsPoliciesCfg = policies
+
+	cw.logger.Debugw("Updated TLS policies from ConfigMap", zap.Any("ConfigMap", cfg))
+}
+
 // ToEnvVars serializes the contents of the ConfigWatcher to individual
 // environment variables.
 func (cw *ConfigWatcher) ToEnvVars() []corev1.EnvVar {
-	envs := make([]corev1.EnvVar, 0, 3)
+	envs := make([]corev1.EnvVar, 0, 4)
 
 	envs = maybeAppendEnvVar(envs, cw.loggingConfigEnvVar(), cw.LoggingConfig() != nil)
 	envs = maybeAppendEnvVar(envs, cw.klogVerbosityEnvVar(), cw.LoggingConfig() != nil)
 	envs = maybeAppendEnvVar(envs, cw.observabilityConfigEnvVar(), cw.ObservabilityConfig() != nil)
+	envs = maybeAppendEnvVar(envs, cw.tlsPoliciesConfigEnvVar(), cw.TLSPoliciesConfig() != nil && len(cw.TLSPoliciesConfig().Policies) > 0)
 
 	return envs
 }
@@ -242,6 +278,25 @@ func (cw *ConfigWatcher) observabilityConfigEnvVar() corev1.EnvVar {
 	}
 }
 
+// tlsPoliciesConfigEnvVar returns an EnvVar containing the serialized TLS
+// policies from the ConfigWatcher.
+func (cw *ConfigWatcher) tlsPoliciesConfigEnvVar() corev1.EnvVar {
+	var policies []eventingtls.TLSPolicy
+	if cfg := cw.TLSPoliciesConfig(); cfg != nil {
+		policies = cfg.Policies
+	}
+
+	cfg, err := json.Marshal(policies)
+	if err != nil {
+		cw.logger.Warnw("Error while serializing TLS policies", zap.Error(err))
+	}
+
+	return corev1.EnvVar{
+		Name:  EnvTLSPoliciesCfg,
+		Value: string(cfg),
+	}
+}
+
 // overrideLoggingLevel returns cfg with the given logging level applied.
 func overrideLoggingLevel(cfg *logging.Config, lvl zapcore.Level) (*logging.Config, error) {
 	tmpCfg := &zapConfig{}
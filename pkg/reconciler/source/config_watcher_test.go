@@ -29,6 +29,7 @@ import (
 	"knative.dev/pkg/logging"
 	loggingtesting "knative.dev/pkg/logging/testing"
 
+	"knative.dev/eventing/pkg/eventingtls"
 	o11yconfigmap "knative.dev/eventing/pkg/observability/configmap"
 )
 
@@ -85,6 +86,29 @@ func TestLoggingConfigWithCustomLoggingLevel(t *testing.T) {
 	assert.Contains(t, envs[0].Value, expectLoggingContains)
 }
 
+func TestTLSPoliciesConfig(t *testing.T) {
+	ctx := loggingtesting.TestContextWithLogger(t)
+
+	cw := WatchConfigurations(ctx, testComponent, configmap.NewStaticWatcher(
+		newTestConfigMap(eventingtls.TLSPoliciesConfigName, nil),
+	), WithTLSPolicies)
+	require.Empty(t, cw.ToEnvVars(), "no env var without policies")
+
+	cw = WatchConfigurations(ctx, testComponent, configmap.NewStaticWatcher(
+		newTestConfigMap(eventingtls.TLSPoliciesConfigName, map[string]string{
+			eventingtls.TLSPoliciesKey: `[{name: tls13, hosts: [sink.example.com], minVersion: "1.3"}]`,
+		}),
+	), WithTLSPolicies)
+
+	envs := cw.ToEnvVars()
+	require.Len(t, envs, 1)
+	assert.Equal(t, EnvTLSPoliciesCfg, envs[0].Name)
+
+	policies, err := eventingtls.ParseTLSPolicies(envs[0].Value)
+	require.NoError(t, err)
+	assert.Equal(t, cw.TLSPoliciesConfig(), policies)
+}
+
 func TestEmptyVarsGenerator(t *testing.T) {
 	g := &EmptyVarsGenerator{}
 	envs := g.ToEnvVars()
@@ -96,10 +120,12 @@ func TestNilSafeMethods(t *testing.T) {
 	var cw *ConfigWatcher
 	assert.Nil(t, cw.LoggingConfig(), "logging config should be disabled")
 	assert.Nil(t, cw.ObservabilityConfig(), "tracing config should be disabled")
+	assert.Nil(t, cw.TLSPoliciesConfig(), "TLS policies should be disabled")
 
 	assert.NotPanics(t, func() {
 		cw.updateFromLoggingConfigMap(nil)
 		cw.updateFromObservabilityConfigMap(nil)
+		cw.updateFromTLSPoliciesConfigMap(nil)
 	}, "can update nil cfg")
 
 }
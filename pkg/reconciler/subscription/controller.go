@@ -28,7 +28,6 @@ import (
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/kref"
 	"knative.dev/pkg/logging"
-	"knative.dev/pkg/resolver"
 
 	messagingv1 "knative.dev/eventing/pkg/apis/messaging/v1"
 	"knative.dev/eventing/pkg/client/injection/ducks/duck/v1/channelable"
@@ -36,6 +35,7 @@ import (
 	"knative.dev/eventing/pkg/client/injection/informers/messaging/v1/subscription"
 	subscriptionreconciler "knative.dev/eventing/pkg/client/injection/reconciler/messaging/v1/subscription"
 	"knative.dev/eventing/pkg/duck"
+	"knative.dev/eventing/pkg/resolver"
 	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	serviceaccountinformer "knative.dev/pkg/client/injection/kube/informers/core/v1/serviceaccount/filtered"
 	"knative.dev/pkg/injection/clients/dynamicclient"
@@ -84,7 +84,7 @@ func NewController(
 	// Trackers used to notify us when the resources Subscription depends on change, so that the
 	// Subscription needs to reconcile again.
 	r.channelableTracker = duck.NewListableTrackerFromTracker(ctx, channelable.Get, impl.Tracker)
-	r.destinationResolver = resolver.NewURIResolverFromTracker(ctx, impl.Tracker)
+	r.destinationResolver = resolver.NewURIResolver(ctx, cmw, impl.Tracker)
 
 	// Track changes to Channels.
 	r.tracker = impl.Tracker
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"knative.dev/eventing/pkg/auth"
+	"knative.dev/pkg/client/injection/ducks/duck/v1/kresource"
 	filteredFactory "knative.dev/pkg/client/injection/kube/informers/factory/filtered"
 
 	corev1 "k8s.io/api/core/v1"
@@ -42,6 +43,7 @@ import (
 
 func TestNew(t *testing.T) {
 	ctx, _ := SetupFakeContext(t, SetUpInformerSelector)
+	ctx = kresource.WithDuck(ctx)
 
 	c := NewController(ctx, configmap.NewStaticWatcher(
 		&corev1.ConfigMap{
@@ -49,6 +51,11 @@ func TestNew(t *testing.T) {
 				Name: feature.FlagsConfigName,
 			},
 		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "config-kreference-mapping",
+			},
+		},
 	))
 
 	if c == nil {
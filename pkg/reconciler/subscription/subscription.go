@@ -37,7 +37,6 @@ import (
 	"knative.dev/pkg/kref"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
-	"knative.dev/pkg/resolver"
 	"knative.dev/pkg/tracker"
 
 	corev1listers "k8s.io/client-go/listers/core/v1"
@@ -48,6 +47,7 @@ import (
 	subscriptionreconciler "knative.dev/eventing/pkg/client/injection/reconciler/messaging/v1/subscription"
 	listers "knative.dev/eventing/pkg/client/listers/messaging/v1"
 	eventingduck "knative.dev/eventing/pkg/duck"
+	"knative.dev/eventing/pkg/resolver"
 )
 
 const (
@@ -531,6 +531,7 @@ func (r *Reconciler) updateChannelAddSubscriptionSpec(channel *eventingduckv1.Ch
 			channel.Spec.Subscribers[i].ReplyCACerts = sub.Status.PhysicalSubscription.ReplyCACerts
 			channel.Spec.Subscribers[i].ReplyAudience = sub.Status.PhysicalSubscription.ReplyAudience
 			channel.Spec.Subscribers[i].Delivery = deliverySpec(sub, channel)
+			channel.Spec.Subscribers[i].Transform = sub.Spec.Transform
 			channel.Spec.Subscribers[i].Auth = sub.Status.Auth
 			return
 		}
@@ -547,6 +548,7 @@ func (r *Reconciler) updateChannelAddSubscriptionSpec(channel *eventingduckv1.Ch
 		ReplyCACerts:       sub.Status.PhysicalSubscription.ReplyCACerts,
 		ReplyAudience:      sub.Status.PhysicalSubscription.ReplyAudience,
 		Delivery:           deliverySpec(sub, channel),
+		Transform:          sub.Spec.Transform,
 		Auth:               sub.Status.Auth,
 	}
 
@@ -567,7 +569,8 @@ func deliverySpec(sub *v1.Subscription, channel *eventingduckv1.Channelable) (de
 			channel.Spec.Delivery.Retry != nil ||
 			channel.Spec.Delivery.BackoffPolicy != nil ||
 			channel.Spec.Delivery.Timeout != nil ||
-			channel.Spec.Delivery.RetryAfterMax != nil {
+			channel.Spec.Delivery.RetryAfterMax != nil ||
+			channel.Spec.Delivery.DeadLetterFormat != nil {
 			if delivery == nil {
 				delivery = &eventingduckv1.DeliverySpec{}
 			}
@@ -576,6 +579,7 @@ func deliverySpec(sub *v1.Subscription, channel *eventingduckv1.Channelable) (de
 			delivery.BackoffDelay = channel.Spec.Delivery.BackoffDelay
 			delivery.Timeout = channel.Spec.Delivery.Timeout
 			delivery.RetryAfterMax = channel.Spec.Delivery.RetryAfterMax
+			delivery.DeadLetterFormat = channel.Spec.Delivery.DeadLetterFormat
 		}
 		return
 	}
@@ -593,7 +597,8 @@ func deliverySpec(sub *v1.Subscription, channel *eventingduckv1.Channelable) (de
 			sub.Spec.Delivery.Retry != nil ||
 			sub.Spec.Delivery.BackoffPolicy != nil ||
 			sub.Spec.Delivery.Timeout != nil ||
-			sub.Spec.Delivery.RetryAfterMax != nil) {
+			sub.Spec.Delivery.RetryAfterMax != nil ||
+			sub.Spec.Delivery.DeadLetterFormat != nil) {
 		if delivery == nil {
 			delivery = &eventingduckv1.DeliverySpec{}
 		}
@@ -602,6 +607,7 @@ func deliverySpec(sub *v1.Subscription, channel *eventingduckv1.Channelable) (de
 		delivery.BackoffDelay = sub.Spec.Delivery.BackoffDelay
 		delivery.Timeout = sub.Spec.Delivery.Timeout
 		delivery.RetryAfterMax = sub.Spec.Delivery.RetryAfterMax
+		delivery.DeadLetterFormat = sub.Spec.Delivery.DeadLetterFormat
 	}
 	return
 }
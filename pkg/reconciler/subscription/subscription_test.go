@@ -32,6 +32,7 @@ import (
 	"k8s.io/client-go/rest"
 	clientgotesting "k8s.io/client-go/testing"
 	"k8s.io/utils/pointer"
+	"k8s.io/utils/ptr"
 
 	eventingtesting "knative.dev/eventing/pkg/reconciler/testing"
 	"knative.dev/pkg/apis"
@@ -59,6 +60,7 @@ import (
 	"knative.dev/eventing/pkg/client/injection/reconciler/messaging/v1/subscription"
 	"knative.dev/eventing/pkg/duck"
 	. "knative.dev/eventing/pkg/reconciler/testing/v1"
+	eventingresolver "knative.dev/eventing/pkg/resolver"
 	fakekubeclient "knative.dev/pkg/client/injection/kube/client/fake"
 )
 
@@ -2039,8 +2041,9 @@ func TestAllCases(t *testing.T) {
 					WithInMemoryChannelAddress(channelDNS),
 					WithInMemoryChannelReadySubscriber("a-"+subscriptionUID),
 					WithInMemoryChannelDelivery(&eventingduck.DeliverySpec{
-						Timeout:       pointer.String("PT1S"),
-						RetryAfterMax: pointer.String("PT2S"),
+						Timeout:          pointer.String("PT1S"),
+						RetryAfterMax:    pointer.String("PT2S"),
+						DeadLetterFormat: ptr.To(eventingduck.DeadLetterFormatAttempts),
 					}),
 					WithInMemoryChannelStatusDLS(dlcStatus),
 				),
@@ -2071,8 +2074,9 @@ func TestAllCases(t *testing.T) {
 						UID:           "a-" + subscriptionUID,
 						SubscriberURI: serviceURI,
 						Delivery: &eventingduck.DeliverySpec{
-							Timeout:       pointer.String("PT1S"),
-							RetryAfterMax: pointer.String("PT2S"),
+							Timeout:          pointer.String("PT1S"),
+							RetryAfterMax:    pointer.String("PT2S"),
+							DeadLetterFormat: ptr.To(eventingduck.DeadLetterFormatAttempts),
 						},
 						Name: pointer.String("a-" + subscriptionName),
 					},
@@ -2592,7 +2596,7 @@ func TestAllCases(t *testing.T) {
 			subscriptionLister:   listers.GetSubscriptionLister(),
 			channelLister:        listers.GetMessagingChannelLister(),
 			channelableTracker:   duck.NewListableTrackerFromTracker(ctx, channelable.Get, tracker.New(func(types.NamespacedName) {}, 0)),
-			destinationResolver:  resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0)),
+			destinationResolver:  &eventingresolver.URIResolver{URIResolver: resolver.NewURIResolverFromTracker(ctx, tracker.New(func(types.NamespacedName) {}, 0))},
 			kreferenceResolver:   kref.NewKReferenceResolver(listers.GetCustomResourceDefinitionLister()),
 			tracker:              &FakeTracker{},
 			serviceAccountLister: listers.GetServiceAccountLister(),
@@ -21,14 +21,19 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"knative.dev/eventing/pkg/apis/feature"
 	sugarconfig "knative.dev/eventing/pkg/apis/sugar"
 	eventingclient "knative.dev/eventing/pkg/client/injection/client"
 	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker"
+	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1/trigger"
+	"knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy"
+	"knative.dev/eventing/pkg/reconciler/sugar/resources"
 	"knative.dev/pkg/client/injection/kube/informers/core/v1/namespace"
 	namespacereconciler "knative.dev/pkg/client/injection/kube/reconciler/core/v1/namespace"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
+	pkgreconciler "knative.dev/pkg/reconciler"
 )
 
 // NewController initializes the controller and is called by the generated code
@@ -40,15 +45,26 @@ func NewController(
 
 	namespaceInformer := namespace.Get(ctx)
 	brokerInformer := broker.Get(ctx)
+	eventPolicyInformer := eventpolicy.Get(ctx)
+	triggerInformer := trigger.Get(ctx)
 
 	r := &Reconciler{
 		eventingClientSet: eventingclient.Get(ctx),
 		brokerLister:      brokerInformer.Lister(),
+		eventPolicyLister: eventPolicyInformer.Lister(),
+		triggerLister:     triggerInformer.Lister(),
 	}
 
+	r.featureStore = feature.NewStore(logging.FromContext(ctx).Named("feature-config-store"))
+	r.featureStore.WatchConfigs(cmw)
+
 	impl := namespacereconciler.NewImpl(ctx, r, func(impl *controller.Impl) controller.Options {
 
-		configStore := sugarconfig.NewStore(logging.FromContext(ctx).Named("config-sugar-store"))
+		// Resync the namespaces when the templates change, so that the resources
+		// generated from removed templates are deleted.
+		configStore := sugarconfig.NewStore(logging.FromContext(ctx).Named("config-sugar-store"), func(string, interface{}) {
+			impl.GlobalResync(namespaceInformer.Informer())
+		})
 		configStore.WatchConfigs(cmw)
 
 		return controller.Options{
@@ -72,6 +88,15 @@ func NewController(
 	// Resync on deleting of brokers.
 	brokerInformer.Informer().AddEventHandler(HandleOnlyDelete(grCb))
 
+	// Reconcile the drift of injected resources.
+	injectedHandler := cache.FilteringResourceEventHandler{
+		FilterFunc: pkgreconciler.LabelFilterFunc(resources.InjectedResourceLabel, "true", false),
+		Handler:    controller.HandleAll(impl.EnqueueNamespaceOf),
+	}
+	brokerInformer.Informer().AddEventHandler(injectedHandler)
+	eventPolicyInformer.Informer().AddEventHandler(injectedHandler)
+	triggerInformer.Informer().AddEventHandler(injectedHandler)
+
 	return impl
 }
 
@@ -21,6 +21,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/eventing/pkg/apis/sugar"
 	"knative.dev/pkg/configmap"
 	. "knative.dev/pkg/reconciler/testing"
@@ -28,6 +29,8 @@ import (
 	// Fake injection informers
 	_ "knative.dev/eventing/pkg/client/injection/client/fake"
 	_ "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/broker/fake"
+	_ "knative.dev/eventing/pkg/client/injection/informers/eventing/v1/trigger/fake"
+	_ "knative.dev/eventing/pkg/client/injection/informers/eventing/v1alpha1/eventpolicy/fake"
 	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/namespace/fake"
 )
 
@@ -44,6 +47,12 @@ func TestNew(t *testing.T) {
 				"_example": "test-config",
 			},
 		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      feature.FlagsConfigName,
+				Namespace: "knative-eventing",
+			},
+		},
 	))
 
 	if c == nil {
@@ -21,29 +21,54 @@ import (
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubelabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 
+	eventingv1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/feature"
 	sugarconfig "knative.dev/eventing/pkg/apis/sugar"
 	clientset "knative.dev/eventing/pkg/client/clientset/versioned"
 	eventinglisters "knative.dev/eventing/pkg/client/listers/eventing/v1"
+	eventingv1alpha1listers "knative.dev/eventing/pkg/client/listers/eventing/v1alpha1"
 	"knative.dev/eventing/pkg/reconciler/sugar/resources"
+	"knative.dev/pkg/apis"
 	namespacereconciler "knative.dev/pkg/client/injection/kube/reconciler/core/v1/namespace"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	pkgreconciler "knative.dev/pkg/reconciler"
 )
 
 const (
 	// Name of the corev1.Events emitted from the reconciliation process.
-	brokerCreated = "BrokerCreated"
+	brokerCreated      = "BrokerCreated"
+	brokerUpdated      = "BrokerUpdated"
+	brokerDeleted      = "BrokerDeleted"
+	eventPolicyCreated = "EventPolicyCreated"
+	eventPolicyUpdated = "EventPolicyUpdated"
+	eventPolicyDeleted = "EventPolicyDeleted"
+	triggerCreated     = "TriggerCreated"
+	triggerUpdated     = "TriggerUpdated"
+	triggerRecreated   = "TriggerRecreated"
+	triggerDeleted     = "TriggerDeleted"
 )
 
+// templateSelector selects the resources generated from a template.
+var templateSelector = kubelabels.SelectorFromSet(resources.TemplateLabels())
+
 type Reconciler struct {
 	eventingClientSet clientset.Interface
 
 	// listers index properties about resources
-	brokerLister eventinglisters.BrokerLister
+	brokerLister      eventinglisters.BrokerLister
+	eventPolicyLister eventingv1alpha1listers.EventPolicyLister
+	triggerLister     eventinglisters.TriggerLister
+
+	// featureStore holds the feature flags the generated resources are
+	// defaulted with.
+	featureStore *feature.Store
 }
 
 // Check that our Reconciler implements namespacereconciler.Interface
@@ -63,24 +88,271 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, ns *corev1.Namespace) pk
 		logging.FromContext(ctx).Debugf("Sugar Controller enabled for Namespace:%s in configmap 'config-sugar'", ns.Name)
 	}
 
-	_, err = r.brokerLister.Brokers(ns.Name).Get(resources.DefaultBrokerName)
+	// we want the events created in the namespace, and while ns is a cluster
+	// wide object, if don't do this we'll end with the events created
+	// in the default namespace, which is a bad UX in our case.
+	ns.SetNamespace(ns.Name)
+
+	// The generated resources are defaulted like the webhook does, so that the
+	// fields it sets are not considered as drift.
+	ctx = r.featureStore.ToContext(ctx)
+
+	eventPolicies := sets.New[string]()
+	for i := range cfg.EventPolicies {
+		if err := r.reconcileEventPolicy(ctx, ns, &cfg.EventPolicies[i]); err != nil {
+			return err
+		}
+		eventPolicies.Insert(cfg.EventPolicies[i].Name)
+	}
+	triggers := sets.New[string]()
+	for i := range cfg.Triggers {
+		if err := r.reconcileTrigger(ctx, ns, cfg.BrokerTemplate.GetName(), &cfg.Triggers[i]); err != nil {
+			return err
+		}
+		triggers.Insert(cfg.Triggers[i].Name)
+	}
+
+	// Delete the resources generated from templates that were removed.
+	if err := r.deleteStaleEventPolicies(ctx, ns, eventPolicies); err != nil {
+		return err
+	}
+	if err := r.deleteStaleTriggers(ctx, ns, triggers); err != nil {
+		return err
+	}
+	if err := r.deleteStaleBrokers(ctx, ns, cfg.BrokerTemplate.GetName()); err != nil {
+		return err
+	}
+	return r.reconcileBroker(ctx, ns, cfg.BrokerTemplate)
+}
+
+func (r *Reconciler) reconcileBroker(ctx context.Context, ns *corev1.Namespace, template *sugarconfig.BrokerTemplate) pkgreconciler.Event {
+	expected := resources.MakeBrokerFromTemplate(ns.Name, template)
+
+	b, err := r.brokerLister.Brokers(ns.Name).Get(expected.Name)
 
 	// If the resource doesn't exist, we'll create it.
 	if k8serrors.IsNotFound(err) {
 		_, err = r.eventingClientSet.EventingV1().Brokers(ns.Name).Create(
-			ctx, resources.MakeBroker(ns.Name, resources.DefaultBrokerName), metav1.CreateOptions{})
+			ctx, expected, metav1.CreateOptions{})
 		if err != nil {
 			return fmt.Errorf("unable to create Broker: %w", err)
 		}
-		// we want the event created in the namespace, and while ns is a cluster
-		// wide object, if don't do this we'll end with the event created
-		// in the default namespace, which is a bad UX in our case.
-		ns.SetNamespace(ns.Name)
 		return pkgreconciler.NewEvent(corev1.EventTypeNormal, brokerCreated,
 			"Default eventing.knative.dev Broker created.")
 	} else if err != nil {
 		return fmt.Errorf("Unable to list Brokers: %w", err)
 	}
 
+	// Brokers not injected by us are left alone.
+	if !isInjected(b) || template == nil {
+		return nil
+	}
+
+	// Only the delivery spec and metadata of a Broker are mutable.
+	delete(expected.Annotations, eventingv1.BrokerClassAnnotationKey)
+	deliveryDrifted := template.Delivery != nil && !equality.Semantic.DeepEqual(b.Spec.Delivery, expected.Spec.Delivery)
+	if !deliveryDrifted && !drifted(b.Labels, expected.Labels) && !drifted(b.Annotations, expected.Annotations) {
+		return nil
+	}
+
+	b = b.DeepCopy()
+	if deliveryDrifted {
+		b.Spec.Delivery = expected.Spec.Delivery
+	}
+	b.Labels = merge(b.Labels, expected.Labels)
+	b.Annotations = merge(b.Annotations, expected.Annotations)
+	if _, err := r.eventingClientSet.EventingV1().Brokers(ns.Name).Update(ctx, b, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("unable to update Broker: %w", err)
+	}
+	return pkgreconciler.NewEvent(corev1.EventTypeNormal, brokerUpdated,
+		"Default eventing.knative.dev Broker updated.")
+}
+
+func (r *Reconciler) reconcileEventPolicy(ctx context.Context, ns *corev1.Namespace, template *sugarconfig.EventPolicyTemplate) error {
+	expected := resources.MakeEventPolicy(ns.Name, template)
+
+	ep, err := r.eventPolicyLister.EventPolicies(ns.Name).Get(expected.Name)
+	if k8serrors.IsNotFound(err) {
+		_, err = r.eventingClientSet.EventingV1alpha1().EventPolicies(ns.Name).Create(
+			ctx, expected, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("unable to create EventPolicy: %w", err)
+		}
+		controller.GetEventRecorder(ctx).Eventf(ns, corev1.EventTypeNormal, eventPolicyCreated,
+			"Default EventPolicy %q created.", expected.Name)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to list EventPolicies: %w", err)
+	}
+
+	// EventPolicies not injected by us are left alone.
+	if !isInjected(ep) {
+		return nil
+	}
+
+	expected.SetDefaults(ctx)
+	if equality.Semantic.DeepEqual(ep.Spec, expected.Spec) && !drifted(ep.Labels, expected.Labels) && !drifted(ep.Annotations, expected.Annotations) {
+		return nil
+	}
+
+	ep = ep.DeepCopy()
+	ep.Spec = expected.Spec
+	ep.Labels = merge(ep.Labels, expected.Labels)
+	ep.Annotations = merge(ep.Annotations, expected.Annotations)
+	if _, err := r.eventingClientSet.EventingV1alpha1().EventPolicies(ns.Name).Update(ctx, ep, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("unable to update EventPolicy: %w", err)
+	}
+	controller.GetEventRecorder(ctx).Eventf(ns, corev1.EventTypeNormal, eventPolicyUpdated,
+		"Default EventPolicy %q updated.", expected.Name)
+	return nil
+}
+
+func (r *Reconciler) reconcileTrigger(ctx context.Context, ns *corev1.Namespace, brokerName string, template *sugarconfig.TriggerTemplate) error {
+	expected := resources.MakeTrigger(ns.Name, brokerName, template)
+
+	t, err := r.triggerLister.Triggers(ns.Name).Get(expected.Name)
+	if k8serrors.IsNotFound(err) {
+		_, err = r.eventingClientSet.EventingV1().Triggers(ns.Name).Create(
+			ctx, expected, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("unable to create Trigger: %w", err)
+		}
+		controller.GetEventRecorder(ctx).Eventf(ns, corev1.EventTypeNormal, triggerCreated,
+			"Default Trigger %q created.", expected.Name)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to list Triggers: %w", err)
+	}
+
+	// Triggers not injected by us are left alone.
+	if !isInjected(t) {
+		return nil
+	}
+
+	// The Broker of a Trigger is immutable, the Trigger is recreated when it
+	// changes.
+	if t.Spec.Broker != expected.Spec.Broker {
+		err := r.eventingClientSet.EventingV1().Triggers(ns.Name).Delete(ctx, t.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &t.UID},
+		})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete Trigger: %w", err)
+		}
+		if _, err := r.eventingClientSet.EventingV1().Triggers(ns.Name).Create(ctx, expected, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("unable to create Trigger: %w", err)
+		}
+		controller.GetEventRecorder(ctx).Eventf(ns, corev1.EventTypeNormal, triggerRecreated,
+			"Default Trigger %q recreated for Broker %q.", expected.Name, expected.Spec.Broker)
+		return nil
+	}
+
+	// Compare against the defaulted Trigger, so that fields set by the
+	// webhook are not considered as drift.
+	expected.Spec.SetDefaults(apis.WithinParent(ctx, expected.ObjectMeta))
+	if equality.Semantic.DeepEqual(t.Spec, expected.Spec) && !drifted(t.Labels, expected.Labels) && !drifted(t.Annotations, expected.Annotations) {
+		return nil
+	}
+
+	t = t.DeepCopy()
+	t.Spec = expected.Spec
+	t.Labels = merge(t.Labels, expected.Labels)
+	t.Annotations = merge(t.Annotations, expected.Annotations)
+	if _, err := r.eventingClientSet.EventingV1().Triggers(ns.Name).Update(ctx, t, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("unable to update Trigger: %w", err)
+	}
+	controller.GetEventRecorder(ctx).Eventf(ns, corev1.EventTypeNormal, triggerUpdated,
+		"Default Trigger %q updated.", expected.Name)
+	return nil
+}
+
+// deleteStaleBrokers deletes the Brokers generated from a template other than
+// the Broker with the given name.
+func (r *Reconciler) deleteStaleBrokers(ctx context.Context, ns *corev1.Namespace, name string) error {
+	brokers, err := r.brokerLister.Brokers(ns.Name).List(templateSelector)
+	if err != nil {
+		return fmt.Errorf("unable to list Brokers: %w", err)
+	}
+	for _, b := range brokers {
+		if b.Name == name {
+			continue
+		}
+		err := r.eventingClientSet.EventingV1().Brokers(ns.Name).Delete(ctx, b.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete Broker: %w", err)
+		}
+		controller.GetEventRecorder(ctx).Eventf(ns, corev1.EventTypeNormal, brokerDeleted,
+			"Default Broker %q deleted.", b.Name)
+	}
 	return nil
 }
+
+// deleteStaleEventPolicies deletes the EventPolicies generated from a template
+// other than the given ones.
+func (r *Reconciler) deleteStaleEventPolicies(ctx context.Context, ns *corev1.Namespace, names sets.Set[string]) error {
+	eventPolicies, err := r.eventPolicyLister.EventPolicies(ns.Name).List(templateSelector)
+	if err != nil {
+		return fmt.Errorf("unable to list EventPolicies: %w", err)
+	}
+	for _, ep := range eventPolicies {
+		if names.Has(ep.Name) {
+			continue
+		}
+		err := r.eventingClientSet.EventingV1alpha1().EventPolicies(ns.Name).Delete(ctx, ep.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete EventPolicy: %w", err)
+		}
+		controller.GetEventRecorder(ctx).Eventf(ns, corev1.EventTypeNormal, eventPolicyDeleted,
+			"Default EventPolicy %q deleted.", ep.Name)
+	}
+	return nil
+}
+
+// deleteStaleTriggers deletes the Triggers generated from a template other than
+// the given ones.
+func (r *Reconciler) deleteStaleTriggers(ctx context.Context, ns *corev1.Namespace, names sets.Set[string]) error {
+	triggers, err := r.triggerLister.Triggers(ns.Name).List(templateSelector)
+	if err != nil {
+		return fmt.Errorf("unable to list Triggers: %w", err)
+	}
+	for _, t := range triggers {
+		if names.Has(t.Name) {
+			continue
+		}
+		err := r.eventingClientSet.EventingV1().Triggers(ns.Name).Delete(ctx, t.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete Trigger: %w", err)
+		}
+		controller.GetEventRecorder(ctx).Eventf(ns, corev1.EventTypeNormal, triggerDeleted,
+			"Default Trigger %q deleted.", t.Name)
+	}
+	return nil
+}
+
+func isInjected(obj metav1.Object) bool {
+	return obj.GetLabels()[resources.InjectedResourceLabel] == "true"
+}
+
+// drifted returns true when the given labels or annotations are missing or
+// differ from the expected ones.
+func drifted(actual, expected map[string]string) bool {
+	for k, v := range expected {
+		if got, ok := actual[k]; !ok || got != v {
+			return true
+		}
+	}
+	return false
+}
+
+func merge(actual, expected map[string]string) map[string]string {
+	if len(expected) == 0 {
+		return actual
+	}
+	out := make(map[string]string, len(actual)+len(expected))
+	for k, v := range actual {
+		out[k] = v
+	}
+	for k, v := range expected {
+		out[k] = v
+	}
+	return out
+}
@@ -25,16 +25,21 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgotesting "k8s.io/client-go/testing"
 	"knative.dev/eventing/pkg/apis/config"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	v1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	"knative.dev/eventing/pkg/apis/feature"
 	sugarconfig "knative.dev/eventing/pkg/apis/sugar"
 	fakeeventingclient "knative.dev/eventing/pkg/client/injection/client/fake"
 	"knative.dev/eventing/pkg/reconciler/sugar/resources"
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 	fakekubeclient "knative.dev/pkg/client/injection/kube/client/fake"
 	namespacereconciler "knative.dev/pkg/client/injection/kube/reconciler/core/v1/namespace"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	logtesting "knative.dev/pkg/logging/testing"
+	"knative.dev/pkg/ptr"
 
 	. "knative.dev/eventing/pkg/reconciler/testing/v1"
 	. "knative.dev/pkg/reconciler/testing"
@@ -218,6 +223,9 @@ func TestEnabled(t *testing.T) {
 		r := &Reconciler{
 			eventingClientSet: fakeeventingclient.Get(ctx),
 			brokerLister:      listers.GetBrokerLister(),
+			eventPolicyLister: listers.GetEventPolicyLister(),
+			triggerLister:     listers.GetTriggerLister(),
+			featureStore:      feature.NewStore(logger),
 		}
 
 		sugarCfg := &sugarconfig.Config{}
@@ -307,6 +315,9 @@ func TestDisabled(t *testing.T) {
 		r := &Reconciler{
 			eventingClientSet: fakeeventingclient.Get(ctx),
 			brokerLister:      listers.GetBrokerLister(),
+			eventPolicyLister: listers.GetEventPolicyLister(),
+			triggerLister:     listers.GetTriggerLister(),
+			featureStore:      feature.NewStore(logger),
 		}
 
 		sugarCfg := &sugarconfig.Config{}
@@ -324,3 +335,229 @@ func TestDisabled(t *testing.T) {
 			})
 	}, false, logger))
 }
+
+func TestTemplates(t *testing.T) {
+	brokerTemplate := &sugarconfig.BrokerTemplate{
+		Name:        "tenant",
+		Class:       "MTChannelBasedBroker",
+		Annotations: map[string]string{"team": "platform"},
+		Config: &duckv1.KReference{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Namespace:  "knative-eventing",
+			Name:       "config-br-default-channel",
+		},
+		Delivery: &eventingduckv1.DeliverySpec{
+			Retry: ptr.Int32(5),
+		},
+	}
+	eventPolicyTemplate := sugarconfig.EventPolicyTemplate{
+		Name: "allow-gateway",
+		Spec: v1alpha1.EventPolicySpec{
+			From: []v1alpha1.EventPolicySpecFrom{{
+				Sub: ptr.String("system:serviceaccount:gateway:*"),
+			}},
+		},
+	}
+	triggerTemplate := sugarconfig.TriggerTemplate{
+		Name:        "audit",
+		Annotations: map[string]string{"team": "platform"},
+		Spec: v1.TriggerSpec{
+			Filter: &v1.TriggerFilter{},
+			Subscriber: duckv1.Destination{
+				URI: apis.HTTP("audit.audit.svc.cluster.local"),
+			},
+		},
+	}
+	sugarCfg := &sugarconfig.Config{
+		NamespaceSelector: &metav1.LabelSelector{},
+		BrokerTemplate:    brokerTemplate,
+		EventPolicies:     []sugarconfig.EventPolicyTemplate{eventPolicyTemplate},
+		Triggers:          []sugarconfig.TriggerTemplate{triggerTemplate},
+	}
+
+	broker := resources.MakeBrokerFromTemplate(testNS, brokerTemplate)
+	eventPolicy := resources.MakeEventPolicy(testNS, &eventPolicyTemplate)
+	trigger := resources.MakeTrigger(testNS, "tenant", &triggerTemplate)
+
+	driftedBroker := broker.DeepCopy()
+	driftedBroker.Spec.Delivery.Retry = ptr.Int32(1)
+	delete(driftedBroker.Annotations, "team")
+
+	driftedTrigger := trigger.DeepCopy()
+	driftedTrigger.Spec.Subscriber.URI = apis.HTTP("other.audit.svc.cluster.local")
+
+	userTrigger := driftedTrigger.DeepCopy()
+	userTrigger.Labels = nil
+
+	unlabelledTrigger := trigger.DeepCopy()
+	unlabelledTrigger.Labels = resources.Labels()
+
+	movedTrigger := trigger.DeepCopy()
+	movedTrigger.UID = "moved-trigger"
+	movedTrigger.Spec.Broker = "default"
+
+	staleBroker := broker.DeepCopy()
+	staleBroker.Name = "old-tenant"
+	staleEventPolicy := eventPolicy.DeepCopy()
+	staleEventPolicy.Name = "allow-old-gateway"
+	staleTrigger := trigger.DeepCopy()
+	staleTrigger.Name = "old-audit"
+	legacyTrigger := unlabelledTrigger.DeepCopy()
+	legacyTrigger.Name = "legacy-audit"
+
+	table := TableTest{{
+		Name: "Create resources from templates",
+		Objects: []runtime.Object{
+			NewNamespace(testNS),
+		},
+		Key:                     testNS,
+		SkipNamespaceValidation: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "EventPolicyCreated", `Default EventPolicy "allow-gateway" created.`),
+			Eventf(corev1.EventTypeNormal, "TriggerCreated", `Default Trigger "audit" created.`),
+			Eventf(corev1.EventTypeNormal, "BrokerCreated", "Default eventing.knative.dev Broker created."),
+		},
+		WantCreates: []runtime.Object{
+			eventPolicy,
+			trigger,
+			broker,
+		},
+	}, {
+		Name: "Resources up to date",
+		Objects: []runtime.Object{
+			NewNamespace(testNS),
+			broker,
+			eventPolicy,
+			trigger,
+		},
+		Key:                     testNS,
+		SkipNamespaceValidation: true,
+	}, {
+		Name: "Reconcile drift of injected resources",
+		Objects: []runtime.Object{
+			NewNamespace(testNS),
+			driftedBroker,
+			eventPolicy,
+			driftedTrigger,
+		},
+		Key:                     testNS,
+		SkipNamespaceValidation: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "TriggerUpdated", `Default Trigger "audit" updated.`),
+			Eventf(corev1.EventTypeNormal, "BrokerUpdated", "Default eventing.knative.dev Broker updated."),
+		},
+		WantUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: trigger,
+		}, {
+			Object: broker,
+		}},
+	}, {
+		Name: "Resources not injected are left alone",
+		Objects: []runtime.Object{
+			NewNamespace(testNS),
+			broker,
+			eventPolicy,
+			userTrigger,
+		},
+		Key:                     testNS,
+		SkipNamespaceValidation: true,
+	}, {
+		Name: "Label injected resources generated from templates",
+		Objects: []runtime.Object{
+			NewNamespace(testNS),
+			broker,
+			eventPolicy,
+			unlabelledTrigger,
+		},
+		Key:                     testNS,
+		SkipNamespaceValidation: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "TriggerUpdated", `Default Trigger "audit" updated.`),
+		},
+		WantUpdates: []clientgotesting.UpdateActionImpl{{
+			Object: trigger,
+		}},
+	}, {
+		Name: "Recreate trigger whose broker changed",
+		Objects: []runtime.Object{
+			NewNamespace(testNS),
+			broker,
+			eventPolicy,
+			movedTrigger,
+		},
+		Key:                     testNS,
+		SkipNamespaceValidation: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "TriggerRecreated", `Default Trigger "audit" recreated for Broker "tenant".`),
+		},
+		WantDeletes: []clientgotesting.DeleteActionImpl{{
+			ActionImpl: clientgotesting.ActionImpl{
+				Namespace: testNS,
+				Resource:  v1.SchemeGroupVersion.WithResource("triggers"),
+			},
+			Name: trigger.Name,
+		}},
+		WantCreates: []runtime.Object{
+			trigger,
+		},
+	}, {
+		Name: "Delete resources whose template was removed",
+		Objects: []runtime.Object{
+			NewNamespace(testNS),
+			broker,
+			eventPolicy,
+			trigger,
+			staleBroker,
+			staleEventPolicy,
+			staleTrigger,
+			legacyTrigger,
+		},
+		Key:                     testNS,
+		SkipNamespaceValidation: true,
+		WantEvents: []string{
+			Eventf(corev1.EventTypeNormal, "EventPolicyDeleted", `Default EventPolicy "allow-old-gateway" deleted.`),
+			Eventf(corev1.EventTypeNormal, "TriggerDeleted", `Default Trigger "old-audit" deleted.`),
+			Eventf(corev1.EventTypeNormal, "BrokerDeleted", `Default Broker "old-tenant" deleted.`),
+		},
+		WantDeletes: []clientgotesting.DeleteActionImpl{{
+			ActionImpl: clientgotesting.ActionImpl{
+				Namespace: testNS,
+				Resource:  v1alpha1.SchemeGroupVersion.WithResource("eventpolicies"),
+			},
+			Name: staleEventPolicy.Name,
+		}, {
+			ActionImpl: clientgotesting.ActionImpl{
+				Namespace: testNS,
+				Resource:  v1.SchemeGroupVersion.WithResource("triggers"),
+			},
+			Name: staleTrigger.Name,
+		}, {
+			ActionImpl: clientgotesting.ActionImpl{
+				Namespace: testNS,
+				Resource:  v1.SchemeGroupVersion.WithResource("brokers"),
+			},
+			Name: staleBroker.Name,
+		}},
+	}}
+
+	logger := logtesting.TestLogger(t)
+	table.Test(t, MakeFactory(func(ctx context.Context, listers *Listers, cmw configmap.Watcher) controller.Reconciler {
+		r := &Reconciler{
+			eventingClientSet: fakeeventingclient.Get(ctx),
+			brokerLister:      listers.GetBrokerLister(),
+			eventPolicyLister: listers.GetEventPolicyLister(),
+			triggerLister:     listers.GetTriggerLister(),
+			featureStore:      feature.NewStore(logger),
+		}
+
+		return namespacereconciler.NewReconciler(ctx, logger,
+			fakekubeclient.Get(ctx), listers.GetNamespaceLister(),
+			controller.GetEventRecorder(ctx), r, controller.Options{
+				SkipStatusUpdates: true,
+				ConfigStore: &testConfigStore{
+					config: sugarCfg,
+				},
+			})
+	}, false, logger))
+}
@@ -19,10 +19,11 @@ package resources
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	sugarconfig "knative.dev/eventing/pkg/apis/sugar"
 )
 
 const (
-	DefaultBrokerName = "default"
+	DefaultBrokerName = sugarconfig.DefaultBrokerName
 )
 
 func MakeBroker(namespace, name string) *v1.Broker {
@@ -34,3 +35,25 @@ func MakeBroker(namespace, name string) *v1.Broker {
 		},
 	}
 }
+
+// MakeBrokerFromTemplate generates the Broker described by the given template.
+func MakeBrokerFromTemplate(namespace string, template *sugarconfig.BrokerTemplate) *v1.Broker {
+	b := MakeBroker(namespace, template.GetName())
+	if template == nil {
+		return b
+	}
+	b.Labels = TemplateLabels()
+
+	if len(template.Annotations) > 0 || template.Class != "" {
+		b.Annotations = make(map[string]string, len(template.Annotations)+1)
+		for k, v := range template.Annotations {
+			b.Annotations[k] = v
+		}
+		if template.Class != "" {
+			b.Annotations[v1.BrokerClassAnnotationKey] = template.Class
+		}
+	}
+	b.Spec.Config = template.Config.DeepCopy()
+	b.Spec.Delivery = template.Delivery.DeepCopy()
+	return b
+}
@@ -21,7 +21,11 @@ import (
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	eventingduckv1 "knative.dev/eventing/pkg/apis/duck/v1"
 	v1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	sugarconfig "knative.dev/eventing/pkg/apis/sugar"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/ptr"
 )
 
 func TestMakeBroker(t *testing.T) {
@@ -57,3 +61,68 @@ func TestMakeBroker(t *testing.T) {
 		})
 	}
 }
+
+func TestMakeBrokerFromTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template *sugarconfig.BrokerTemplate
+		want     *v1.Broker
+	}{
+		{
+			name: "no template",
+			want: &v1.Broker{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      DefaultBrokerName,
+					Labels:    Labels(),
+				},
+			},
+		},
+		{
+			name: "template",
+			template: &sugarconfig.BrokerTemplate{
+				Name:        "tenant",
+				Class:       "MTChannelBasedBroker",
+				Annotations: map[string]string{"team": "platform"},
+				Config: &duckv1.KReference{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+					Namespace:  "knative-eventing",
+					Name:       "config-br-default-channel",
+				},
+				Delivery: &eventingduckv1.DeliverySpec{
+					Retry: ptr.Int32(5),
+				},
+			},
+			want: &v1.Broker{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "tenant",
+					Labels:    TemplateLabels(),
+					Annotations: map[string]string{
+						"team":                      "platform",
+						v1.BrokerClassAnnotationKey: "MTChannelBasedBroker",
+					},
+				},
+				Spec: v1.BrokerSpec{
+					Config: &duckv1.KReference{
+						APIVersion: "v1",
+						Kind:       "ConfigMap",
+						Namespace:  "knative-eventing",
+						Name:       "config-br-default-channel",
+					},
+					Delivery: &eventingduckv1.DeliverySpec{
+						Retry: ptr.Int32(5),
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MakeBrokerFromTemplate("default", tt.template); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MakeBrokerFromTemplate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
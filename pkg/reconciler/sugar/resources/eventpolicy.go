@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/eventing/pkg/apis/eventing/v1alpha1"
+	sugarconfig "knative.dev/eventing/pkg/apis/sugar"
+)
+
+// MakeEventPolicy generates the EventPolicy described by the given template.
+func MakeEventPolicy(namespace string, template *sugarconfig.EventPolicyTemplate) *v1alpha1.EventPolicy {
+	ep := &v1alpha1.EventPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        template.Name,
+			Labels:      TemplateLabels(),
+			Annotations: copyAnnotations(template.Annotations),
+		},
+	}
+	template.Spec.DeepCopyInto(&ep.Spec)
+	return ep
+}
@@ -19,6 +19,10 @@ package resources
 const (
 	// InjectedResourceLabel tells us the resource was injected by the sugar controller.
 	InjectedResourceLabel = "eventing.knative.dev/injected"
+
+	// TemplateResourceLabel tells us the resource was generated from a template
+	// of the sugar controller, it is deleted once it no longer matches one.
+	TemplateResourceLabel = "eventing.knative.dev/injected-from-template"
 )
 
 // Labels generates the labels present on injected broker resources.
@@ -27,3 +31,12 @@ func Labels() map[string]string {
 		InjectedResourceLabel: "true",
 	}
 }
+
+// TemplateLabels generates the labels present on the resources generated from
+// a template.
+func TemplateLabels() map[string]string {
+	return map[string]string{
+		InjectedResourceLabel: "true",
+		TemplateResourceLabel: "true",
+	}
+}
@@ -41,3 +41,13 @@ func TestLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplateLabels(t *testing.T) {
+	want := map[string]string{
+		"eventing.knative.dev/injected":               "true",
+		"eventing.knative.dev/injected-from-template": "true",
+	}
+	if got := TemplateLabels(); !reflect.DeepEqual(got, want) {
+		t.Errorf("TemplateLabels() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	sugarconfig "knative.dev/eventing/pkg/apis/sugar"
+)
+
+// MakeTrigger generates the Trigger described by the given template. Triggers
+// without a Broker refer to the given Broker.
+func MakeTrigger(namespace, brokerName string, template *sugarconfig.TriggerTemplate) *v1.Trigger {
+	t := &v1.Trigger{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        template.Name,
+			Labels:      TemplateLabels(),
+			Annotations: copyAnnotations(template.Annotations),
+		},
+	}
+	template.Spec.DeepCopyInto(&t.Spec)
+	if t.Spec.Broker == "" {
+		t.Spec.Broker = brokerName
+	}
+	return t
+}
+
+func copyAnnotations(annotations map[string]string) map[string]string {
+	if len(annotations) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(annotations))
+	for k, v := range annotations {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "knative.dev/eventing/pkg/apis/eventing/v1"
+	sugarconfig "knative.dev/eventing/pkg/apis/sugar"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+func TestMakeTrigger(t *testing.T) {
+	subscriber := duckv1.Destination{URI: apis.HTTP("audit.audit.svc.cluster.local")}
+
+	tests := []struct {
+		name     string
+		template *sugarconfig.TriggerTemplate
+		want     *v1.Trigger
+	}{
+		{
+			name: "template broker",
+			template: &sugarconfig.TriggerTemplate{
+				Name:        "audit",
+				Annotations: map[string]string{"team": "platform"},
+				Spec:        v1.TriggerSpec{Subscriber: subscriber},
+			},
+			want: &v1.Trigger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "default",
+					Name:        "audit",
+					Labels:      TemplateLabels(),
+					Annotations: map[string]string{"team": "platform"},
+				},
+				Spec: v1.TriggerSpec{Broker: "tenant", Subscriber: subscriber},
+			},
+		},
+		{
+			name: "explicit broker",
+			template: &sugarconfig.TriggerTemplate{
+				Name: "audit",
+				Spec: v1.TriggerSpec{Broker: "other", Subscriber: subscriber},
+			},
+			want: &v1.Trigger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "audit",
+					Labels:    TemplateLabels(),
+				},
+				Spec: v1.TriggerSpec{Broker: "other", Subscriber: subscriber},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MakeTrigger("default", "tenant", tt.template); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MakeTrigger() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -68,8 +68,13 @@ func (r *Reconciler) ReconcileKind(ctx context.Context, t *v1.Trigger) reconcile
 
 	// If the resource doesn't exist, we'll create it.
 	if k8serrors.IsNotFound(err) {
+		b := resources.MakeBroker(t.Namespace, t.Spec.Broker)
+		// The Broker template applies to the Broker it names.
+		if cfg.BrokerTemplate != nil && cfg.BrokerTemplate.GetName() == t.Spec.Broker {
+			b = resources.MakeBrokerFromTemplate(t.Namespace, cfg.BrokerTemplate)
+		}
 		_, err = r.eventingClientSet.EventingV1().Brokers(t.Namespace).Create(
-			ctx, resources.MakeBroker(t.Namespace, t.Spec.Broker), metav1.CreateOptions{})
+			ctx, b, metav1.CreateOptions{})
 		if err != nil {
 			return fmt.Errorf("Unable to create Broker: %w", err)
 		}
@@ -24,6 +24,7 @@ import (
 	v1 "knative.dev/eventing/pkg/apis/sources/v1"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -63,6 +64,12 @@ func WithContainerSourcePropagateReceiveAdapterStatus(d *appsv1.Deployment) Cont
 	}
 }
 
+func WithContainerSourcePropagateContainerStatuses(pods ...corev1.Pod) ContainerSourceOption {
+	return func(s *v1.ContainerSource) {
+		s.Status.PropagateContainerStatuses(pods)
+	}
+}
+
 func WithContainerSourcePropagateSinkbindingStatus(status *v1.SinkBindingStatus) ContainerSourceOption {
 	return func(s *v1.ContainerSource) {
 		s.Status.PropagateSinkBindingStatus(status)
@@ -141,6 +141,10 @@ func (l *Listers) GetJobSinkLister() sinkslisters.JobSinkLister {
 	return sinkslisters.NewJobSinkLister(l.indexerFor(&sinksv1alpha1.JobSink{}))
 }
 
+func (l *Listers) GetDeadLetterSinkLister() sinkslisters.DeadLetterSinkLister {
+	return sinkslisters.NewDeadLetterSinkLister(l.indexerFor(&sinksv1alpha1.DeadLetterSink{}))
+}
+
 func (l *Listers) GetEventTransformLister() eventingv1alpha1listers.EventTransformLister {
 	return eventingv1alpha1listers.NewEventTransformLister(l.indexerFor(&eventingv1alpha1.EventTransform{}))
 }
@@ -82,6 +82,18 @@ func WithPingSourceCloudEventAttributes(s *v1.PingSource) {
 	}}
 }
 
+func WithPingSourceNextScheduleTime(t time.Time) PingSourceOption {
+	return func(s *v1.PingSource) {
+		s.Status.NextScheduleTime = &metav1.Time{Time: t}
+	}
+}
+
+func WithPingSourceLastScheduleTime(t time.Time) PingSourceOption {
+	return func(s *v1.PingSource) {
+		s.Status.LastScheduleTime = &metav1.Time{Time: t}
+	}
+}
+
 func WithPingSourceSpec(spec v1.PingSourceSpec) PingSourceOption {
 	return func(c *v1.PingSource) {
 		c.Spec = spec
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"knative.dev/eventing/pkg/apis/feature"
+	sinksv1alpha1 "knative.dev/eventing/pkg/apis/sinks/v1alpha1"
+)
+
+// DeadLetterSinkOption enables further configuration of a DeadLetterSink.
+type DeadLetterSinkOption func(*sinksv1alpha1.DeadLetterSink)
+
+// NewDeadLetterSink creates a DeadLetterSink with DeadLetterSinkOptions.
+func NewDeadLetterSink(name, namespace string, o ...DeadLetterSinkOption) *sinksv1alpha1.DeadLetterSink {
+	dls := &sinksv1alpha1.DeadLetterSink{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+	}
+	for _, opt := range o {
+		opt(dls)
+	}
+	dls.SetDefaults(context.Background())
+	return dls
+}
+
+// WithInitDeadLetterSinkConditions initializes the DeadLetterSink's conditions.
+func WithInitDeadLetterSinkConditions(dls *sinksv1alpha1.DeadLetterSink) {
+	dls.Status.InitializeConditions()
+}
+
+// WithDeadLetterSinkMaxEvents sets the DeadLetterSink's maximum number of events.
+func WithDeadLetterSinkMaxEvents(maxEvents int32) DeadLetterSinkOption {
+	return func(dls *sinksv1alpha1.DeadLetterSink) {
+		dls.Spec.MaxEvents = &maxEvents
+	}
+}
+
+// WithDeadLetterSinkRetention sets the DeadLetterSink's retention.
+func WithDeadLetterSinkRetention(retention string) DeadLetterSinkOption {
+	return func(dls *sinksv1alpha1.DeadLetterSink) {
+		dls.Spec.Retention = &retention
+	}
+}
+
+// WithDeadLetterSinkAddress sets the DeadLetterSink's address.
+func WithDeadLetterSinkAddress(addr *duckv1.Addressable) DeadLetterSinkOption {
+	return func(dls *sinksv1alpha1.DeadLetterSink) {
+		dls.Status.SetAddress(addr)
+	}
+}
+
+// WithDeadLetterSinkEventPoliciesReadyBecauseOIDCDisabled sets the DeadLetterSink's EventPoliciesReady condition to true with reason.
+func WithDeadLetterSinkEventPoliciesReadyBecauseOIDCDisabled() DeadLetterSinkOption {
+	return func(dls *sinksv1alpha1.DeadLetterSink) {
+		dls.Status.MarkEventPoliciesTrueWithReason("OIDCDisabled", "Feature %q must be enabled to support Authorization", feature.OIDCAuthentication)
+	}
+}
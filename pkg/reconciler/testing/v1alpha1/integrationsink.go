@@ -63,6 +63,12 @@ func WithIntegrationSinkPropagateDeploymenteStatus(deployment *appsv1.Deployment
 	}
 }
 
+func WithIntegrationSinkImageNotConfigured(env string) IntegrationSinkOption {
+	return func(s *v1alpha1.IntegrationSink) {
+		s.Status.MarkImageNotConfigured("The image of the sink isn't configured, the %s environment variable of the controller is empty", env)
+	}
+}
+
 func WithIntegrationSinkAddressableReady() IntegrationSinkOption {
 	return func(s *v1alpha1.IntegrationSink) {
 		s.Status.MarkAddressableReady()
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"context"
 
+	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -74,6 +75,18 @@ func WithIntegrationSourceSpec(spec v1alpha1.IntegrationSourceSpec) IntegrationS
 	}
 }
 
+func WithIntegrationSourceImageNotConfigured(env string) IntegrationSourceOption {
+	return func(s *v1alpha1.IntegrationSource) {
+		s.Status.MarkImageNotConfigured("The image of the source isn't configured, the %s environment variable of the controller is empty", env)
+	}
+}
+
+func WithIntegrationSourceWebhookURL(url *apis.URL) IntegrationSourceOption {
+	return func(s *v1alpha1.IntegrationSource) {
+		s.Status.WebhookURL = url
+	}
+}
+
 func WithIntegrationSourceOIDCServiceAccountName(name string) IntegrationSourceOption {
 	return func(s *v1alpha1.IntegrationSource) {
 		if s.Status.Auth == nil {
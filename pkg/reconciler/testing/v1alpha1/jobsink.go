@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
 
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -133,3 +134,85 @@ func WithJobSinkAddress(addr *duckv1.Addressable) JobSinkOption {
 		js.Status.SetAddress(addr)
 	}
 }
+
+// WithJobSinkDeadLetterSinkNotConfigured marks the JobSink's DeadLetterSinkResolved condition as not configured.
+func WithJobSinkDeadLetterSinkNotConfigured() JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Status.MarkDeadLetterSinkNotConfigured()
+	}
+}
+
+// WithJobSinkDeadLetterSinkResolved sets the JobSink's resolved dead letter sink.
+func WithJobSinkDeadLetterSinkResolved(addr *duckv1.Addressable) JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Status.MarkDeadLetterSinkResolvedSucceeded(eventingduckv1.NewDeliveryStatusFromAddressable(addr))
+	}
+}
+
+// WithJobSinkDelivery sets the JobSink's delivery spec.
+func WithJobSinkDelivery(delivery *eventingduckv1.DeliverySpec) JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Spec.Delivery = delivery
+	}
+}
+
+// WithJobSinkHistoryLimits sets the JobSink's history limits.
+func WithJobSinkHistoryLimits(successful, failed *int32) JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Spec.SuccessfulJobsHistoryLimit = successful
+		js.Spec.FailedJobsHistoryLimit = failed
+	}
+}
+
+// WithJobSinkSink sets the JobSink's sink.
+func WithJobSinkSink(sink *duckv1.Destination) JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Spec.Sink = sink
+	}
+}
+
+// WithJobSinkSinkNotConfigured marks the JobSink's SinkResolved condition as not configured.
+func WithJobSinkSinkNotConfigured() JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Status.MarkSinkNotConfigured()
+	}
+}
+
+// WithJobSinkSinkResolved sets the JobSink's resolved sink.
+func WithJobSinkSinkResolved(addr *duckv1.Addressable) JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Status.MarkSinkResolved(addr)
+	}
+}
+
+// WithJobSinkTTLSecondsAfterFinished sets the JobSink's TTL of finished Jobs.
+func WithJobSinkTTLSecondsAfterFinished(ttl int32) JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Spec.TTLSecondsAfterFinished = &ttl
+	}
+}
+
+// WithJobSinkOIDCIdentityCreatedSucceeded marks the JobSink's OIDCIdentityCreated condition as True.
+func WithJobSinkOIDCIdentityCreatedSucceeded() JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Status.MarkOIDCIdentityCreatedSucceeded()
+	}
+}
+
+// WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled marks the JobSink's
+// OIDCIdentityCreated condition as True as OIDC is disabled.
+func WithJobSinkOIDCIdentityCreatedSucceededBecauseOIDCFeatureDisabled() JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		js.Status.MarkOIDCIdentityCreatedSucceededWithReason(fmt.Sprintf("%s feature disabled", feature.OIDCAuthentication), "")
+	}
+}
+
+// WithJobSinkOIDCServiceAccountName sets the JobSink's OIDC service account name.
+func WithJobSinkOIDCServiceAccountName(name string) JobSinkOption {
+	return func(js *sinksv1alpha1.JobSink) {
+		if js.Status.Auth == nil {
+			js.Status.Auth = &duckv1.AuthStatus{}
+		}
+		js.Status.Auth.ServiceAccountName = &name
+	}
+}
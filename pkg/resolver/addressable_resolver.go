@@ -19,15 +19,46 @@ package resolver
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/resolver"
 	"knative.dev/pkg/tracker"
 )
 
+// URIResolver resolves Destinations into a URI. It passes the parent to the custom
+// resolvers through the context, see WithParent.
+type URIResolver struct {
+	*resolver.URIResolver
+}
+
 // NewURIResolver constructs a new URIResolver with context and a callback
 // for a given listableType (Listable) passed to the URIResolver's tracker.
-func NewURIResolver(ctx context.Context, cmw configmap.Watcher, t tracker.Interface) *resolver.URIResolver {
+func NewURIResolver(ctx context.Context, cmw configmap.Watcher, t tracker.Interface) *URIResolver {
 	mr := NewMappingResolver(ctx, cmw, t)
 
-	return resolver.NewURIResolverFromTracker(ctx, t, mr.MappingURIFromObjectReference)
+	return &URIResolver{
+		URIResolver: resolver.NewURIResolverFromTracker(ctx, t, mr.MappingURIFromObjectReference),
+	}
+}
+
+// AddressableFromDestinationV1 resolves a v1.Destination into an Addressable.
+func (r *URIResolver) AddressableFromDestinationV1(ctx context.Context, dest duckv1.Destination, parent interface{}) (*duckv1.Addressable, error) {
+	return r.URIResolver.AddressableFromDestinationV1(WithParent(ctx, parent), dest, parent)
+}
+
+// URIFromDestinationV1 resolves a v1.Destination into a URL.
+func (r *URIResolver) URIFromDestinationV1(ctx context.Context, dest duckv1.Destination, parent interface{}) (*apis.URL, error) {
+	return r.URIResolver.URIFromDestinationV1(WithParent(ctx, parent), dest, parent)
+}
+
+// URIFromKReference resolves a KReference into a URL.
+func (r *URIResolver) URIFromKReference(ctx context.Context, ref *duckv1.KReference, parent interface{}) (*apis.URL, error) {
+	return r.URIResolver.URIFromKReference(WithParent(ctx, parent), ref, parent)
+}
+
+// URIFromObjectReference resolves an ObjectReference into a URL.
+func (r *URIResolver) URIFromObjectReference(ctx context.Context, ref *corev1.ObjectReference, parent interface{}) (*apis.URL, error) {
+	return r.URIResolver.URIFromObjectReference(WithParent(ctx, parent), ref, parent)
 }
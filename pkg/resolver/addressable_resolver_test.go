@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	_ "knative.dev/pkg/client/injection/ducks/duck/v1/addressable/fake"
+	"knative.dev/pkg/client/injection/ducks/duck/v1/kresource"
 	"knative.dev/pkg/configmap"
 	_ "knative.dev/pkg/injection/clients/dynamicclient/fake"
 	. "knative.dev/pkg/reconciler/testing"
@@ -30,6 +31,7 @@ import (
 
 func TestNewURIResolver(t *testing.T) {
 	ctx, _ := SetupFakeContext(t)
+	ctx = kresource.WithDuck(ctx)
 	mw := &configmap.ManualWatcher{}
 	track := tracker.New(func(types.NamespacedName) {}, 0)
 
@@ -19,15 +19,25 @@ package resolver
 import (
 	"bytes"
 	"context"
+	"fmt"
+	"strings"
+	"sync"
 	"text/template"
+	"text/template/parse"
 
 	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 	"knative.dev/eventing/pkg/apis/feature"
 	"knative.dev/pkg/apis"
+	pkgapisduck "knative.dev/pkg/apis/duck"
+	"knative.dev/pkg/client/injection/ducks/duck/v1/kresource"
 	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/system"
 	"knative.dev/pkg/tracker"
@@ -35,12 +45,35 @@ import (
 
 const (
 	ConfigMapName = "config-kreference-mapping"
+
+	// namespaceSeparator separates the namespace from the <kind>.<version>(.<group>)
+	// in the keys of namespace-scoped mappings, e.g. "my-namespace_Pod.v1".
+	namespaceSeparator = "_"
 )
 
 type MappingResolver struct {
-	logger    *zap.SugaredLogger
-	templates map[schema.GroupVersionKind]*template.Template
-	tracker   tracker.Interface
+	logger  *zap.SugaredLogger
+	tracker tracker.Interface
+
+	listerFactory func(schema.GroupVersionResource) (cache.GenericLister, error)
+
+	templatesMu sync.RWMutex
+	templates   map[mappingKey]*mappingTemplate
+}
+
+// mappingKey identifies a mapping, mappings with a namespace only apply to
+// references in that namespace and take precedence over mappings without namespace.
+type mappingKey struct {
+	namespace string
+	gvk       schema.GroupVersionKind
+}
+
+type mappingTemplate struct {
+	*template.Template
+
+	// usesObject is true when the template uses the labels or annotations of
+	// the referenced object, which then needs to be retrieved.
+	usesObject bool
 }
 
 type MappingResolverTemplateValues struct {
@@ -48,16 +81,56 @@ type MappingResolverTemplateValues struct {
 	Namespace       string
 	SystemNamespace string
 	UID             types.UID
+
+	// Labels and Annotations of the referenced object. They are only retrieved when
+	// the template uses them, and are empty when the referenced object doesn't exist.
+	Labels      map[string]string
+	Annotations map[string]string
+
+	// Parent is the object referencing the resolved object, when known.
+	Parent MappingResolverParentValues
+}
+
+type MappingResolverParentValues struct {
+	Name        string
+	Namespace   string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+type parentKey struct{}
+
+// WithParent returns a context carrying the parent object referencing the objects
+// resolved with it. The MappingResolver tracks the references for the parent and
+// exposes it to templates.
+func WithParent(ctx context.Context, parent interface{}) context.Context {
+	return context.WithValue(ctx, parentKey{}, parent)
+}
+
+func parentFromContext(ctx context.Context) interface{} {
+	return ctx.Value(parentKey{})
 }
 
 func NewMappingResolver(ctx context.Context, cmw configmap.Watcher, t tracker.Interface) *MappingResolver {
-	resolver := MappingResolver{
+	resolver := &MappingResolver{
 		logger:  logging.FromContext(ctx),
 		tracker: t,
 	}
+
+	informerFactory := &pkgapisduck.CachedInformerFactory{
+		Delegate: &pkgapisduck.EnqueueInformerFactory{
+			Delegate:     kresource.Get(ctx),
+			EventHandler: controller.HandleAll(t.OnChanged),
+		},
+	}
+	resolver.listerFactory = func(gvr schema.GroupVersionResource) (cache.GenericLister, error) {
+		_, l, err := informerFactory.Get(ctx, gvr)
+		return l, err
+	}
+
 	cmw.Watch(ConfigMapName, resolver.updateFromConfigMap)
 
-	return &resolver
+	return resolver
 }
 
 func (mr *MappingResolver) MappingURIFromObjectReference(ctx context.Context, ref *corev1.ObjectReference) (bool, *apis.URL, error) {
@@ -68,23 +141,8 @@ func (mr *MappingResolver) MappingURIFromObjectReference(ctx context.Context, re
 		return false, nil, nil // not handled.
 	}
 
-	// TODO: needs the parent object.
-	//if err := mr.tracker.TrackReference(tracker.Reference{
-	//	APIVersion: ref.APIVersion,
-	//	Kind:       ref.Kind,
-	//	Namespace:  ref.Namespace,
-	//	Name:       ref.Name,
-	//}, parent); err != nil {
-	//	return nil, apierrs.NewNotFound(gvr.GroupResource(), ref.Name)
-	//}
-
-	if mr.templates == nil {
-		mr.logger.Infow("reference not handled", zap.Any("gvk", ref.GroupVersionKind()))
-		return false, nil, nil
-	}
-
-	tmpl, ok := mr.templates[ref.GroupVersionKind()]
-	if !ok {
+	tmpl := mr.templateFor(ref)
+	if tmpl == nil {
 		mr.logger.Infow("reference not handled", zap.Any("gvk", ref.GroupVersionKind()))
 		return false, nil, nil
 	}
@@ -96,6 +154,36 @@ func (mr *MappingResolver) MappingURIFromObjectReference(ctx context.Context, re
 		SystemNamespace: system.Namespace(),
 	}
 
+	if parent := parentFromContext(ctx); parent != nil {
+		if err := mr.tracker.TrackReference(tracker.Reference{
+			APIVersion: ref.APIVersion,
+			Kind:       ref.Kind,
+			Namespace:  ref.Namespace,
+			Name:       ref.Name,
+		}, parent); err != nil {
+			return true, nil, fmt.Errorf("failed to track reference %s %s/%s: %w", ref.GroupVersionKind().String(), ref.Namespace, ref.Name, err)
+		}
+		// Tracking only notifies the parent when an informer watches the tracked kind.
+		if _, err := mr.listerFor(ref); err != nil {
+			mr.logger.Warnw("failed to watch tracked reference", zap.Any("gvk", ref.GroupVersionKind()), zap.Error(err))
+		}
+
+		if p, err := meta.Accessor(parent); err == nil {
+			data.Parent = MappingResolverParentValues{
+				Name:        p.GetName(),
+				Namespace:   p.GetNamespace(),
+				Labels:      p.GetLabels(),
+				Annotations: p.GetAnnotations(),
+			}
+		}
+	}
+
+	if tmpl.usesObject {
+		if err := mr.setObjectValues(ref, &data); err != nil {
+			return true, nil, err
+		}
+	}
+
 	buf := bytes.Buffer{}
 	if err := tmpl.Execute(&buf, data); err != nil {
 		// Configuration error
@@ -111,13 +199,65 @@ func (mr *MappingResolver) MappingURIFromObjectReference(ctx context.Context, re
 	return true, url, nil
 }
 
+// templateFor returns the template of the mapping of the referenced object, the
+// mapping of its namespace takes precedence. It returns nil if there is no mapping.
+func (mr *MappingResolver) templateFor(ref *corev1.ObjectReference) *mappingTemplate {
+	mr.templatesMu.RLock()
+	defer mr.templatesMu.RUnlock()
+
+	gvk := ref.GroupVersionKind()
+	if tmpl, ok := mr.templates[mappingKey{namespace: ref.Namespace, gvk: gvk}]; ok {
+		return tmpl
+	}
+	return mr.templates[mappingKey{gvk: gvk}]
+}
+
+// setObjectValues sets the UID, labels and annotations of the referenced object.
+func (mr *MappingResolver) setObjectValues(ref *corev1.ObjectReference, data *MappingResolverTemplateValues) error {
+	lister, err := mr.listerFor(ref)
+	if err != nil {
+		return err
+	}
+
+	obj, err := lister.ByNamespace(ref.Namespace).Get(ref.Name)
+	if apierrs.IsNotFound(err) {
+		// Mappings may refer to objects which don't exist.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get object %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+
+	o, err := meta.Accessor(obj)
+	if err != nil {
+		return fmt.Errorf("failed to access object %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	if data.UID == "" {
+		data.UID = o.GetUID()
+	}
+	data.Labels = o.GetLabels()
+	data.Annotations = o.GetAnnotations()
+	return nil
+}
+
+// listerFor returns the lister of the kind of the referenced object, starting its
+// informer when needed. The informer notifies the tracker of changes.
+func (mr *MappingResolver) listerFor(ref *corev1.ObjectReference) (cache.GenericLister, error) {
+	gvr, _ := meta.UnsafeGuessKindToResource(ref.GroupVersionKind())
+	lister, err := mr.listerFactory(gvr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lister for %s: %w", gvr.String(), err)
+	}
+	return lister, nil
+}
+
 func (mr *MappingResolver) updateFromConfigMap(cfg *corev1.ConfigMap) {
 	if cfg == nil {
 		return
 	}
 	mr.logger.Infow("loading kreference mapping configmap")
 
-	mr.templates = make(map[schema.GroupVersionKind]*template.Template)
+	templates := make(map[mappingKey]*mappingTemplate)
 
 	for key, stmpl := range cfg.Data {
 		if key == "_example" {
@@ -125,13 +265,18 @@ func (mr *MappingResolver) updateFromConfigMap(cfg *corev1.ConfigMap) {
 		}
 		mr.logger.Infow("processing mapping", zap.String("key", key), zap.String("template", stmpl))
 
-		gvk, gk := schema.ParseKindArg(key)
+		namespace, kindArg, found := strings.Cut(key, namespaceSeparator)
+		if !found {
+			namespace, kindArg = "", key
+		}
+
+		gvk, gk := schema.ParseKindArg(kindArg)
 
 		if gvk == nil {
 			// Try <kind>.<version> (core k8s API)
 			if gk.Group == "" {
 				// Wrong key format
-				mr.logger.Warnw("failed to parse kreference mapping key. Must be of the form <kind>.<version>(.<group>)?", zap.String("key", key))
+				mr.logger.Warnw("failed to parse kreference mapping key. Must be of the form (<namespace>_)?<kind>.<version>(.<group>)?", zap.String("key", key))
 				continue
 			}
 
@@ -147,7 +292,83 @@ func (mr *MappingResolver) updateFromConfigMap(cfg *corev1.ConfigMap) {
 			continue
 		}
 
-		mr.templates[*gvk] = tmpl
+		templates[mappingKey{namespace: namespace, gvk: *gvk}] = &mappingTemplate{
+			Template:   tmpl,
+			usesObject: usesObjectValues(tmpl),
+		}
 		mr.logger.Infow("mapping template loaded", zap.String("template", stmpl), zap.Error(err))
 	}
+
+	mr.templatesMu.Lock()
+	mr.templates = templates
+	mr.templatesMu.Unlock()
+}
+
+// usesObjectValues returns true when the template uses the labels or annotations of
+// the referenced object, as opposed to the ones of the parent. Templates passing the
+// whole data to a function or another template are assumed to use them.
+func usesObjectValues(tmpl *template.Template) bool {
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil && nodeUsesObjectValues(t.Tree.Root) {
+			return true
+		}
+	}
+	return false
+}
+
+func nodeUsesObjectValues(node parse.Node) bool {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return false
+		}
+		for _, c := range n.Nodes {
+			if nodeUsesObjectValues(c) {
+				return true
+			}
+		}
+	case *parse.ActionNode:
+		return nodeUsesObjectValues(n.Pipe)
+	case *parse.PipeNode:
+		if n == nil {
+			return false
+		}
+		for _, c := range n.Cmds {
+			if nodeUsesObjectValues(c) {
+				return true
+			}
+		}
+	case *parse.CommandNode:
+		for _, a := range n.Args {
+			if nodeUsesObjectValues(a) {
+				return true
+			}
+		}
+	case *parse.ChainNode:
+		return nodeUsesObjectValues(n.Node)
+	case *parse.IfNode:
+		return branchUsesObjectValues(&n.BranchNode)
+	case *parse.RangeNode:
+		return branchUsesObjectValues(&n.BranchNode)
+	case *parse.WithNode:
+		return branchUsesObjectValues(&n.BranchNode)
+	case *parse.TemplateNode:
+		return nodeUsesObjectValues(n.Pipe)
+	case *parse.FieldNode:
+		return isObjectValuesField(n.Ident)
+	case *parse.VariableNode:
+		// Other variables are declared by pipelines, which are inspected.
+		return n.Ident[0] == "$" && (len(n.Ident) == 1 || isObjectValuesField(n.Ident[1:]))
+	case *parse.DotNode:
+		return true
+	}
+	return false
+}
+
+func branchUsesObjectValues(n *parse.BranchNode) bool {
+	return nodeUsesObjectValues(n.Pipe) || nodeUsesObjectValues(n.List) || nodeUsesObjectValues(n.ElseList)
+}
+
+func isObjectValuesField(ident []string) bool {
+	return len(ident) > 0 && (ident[0] == "Labels" || ident[0] == "Annotations")
 }
@@ -18,12 +18,19 @@ package resolver
 
 import (
 	"testing"
+	"text/template"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/cache"
 
+	"knative.dev/pkg/client/injection/ducks/duck/v1/kresource"
 	"knative.dev/pkg/configmap"
+	fakedynamicclient "knative.dev/pkg/injection/clients/dynamicclient/fake"
 	. "knative.dev/pkg/reconciler/testing"
 	"knative.dev/pkg/tracker"
 
@@ -37,9 +44,13 @@ func TestMappingResolver(t *testing.T) {
 		enabled     bool
 		ref         *corev1.ObjectReference
 		cm          *corev1.ConfigMap
+		objects     []runtime.Object
+		parent      *corev1.Pod
 		wantHandled bool
 		wantURI     string
 		wantErr     bool
+		wantTracked bool
+		wantWatched bool
 	}{
 		"experimental feature not enabled": {
 			enabled:     false,
@@ -81,6 +92,95 @@ func TestMappingResolver(t *testing.T) {
 			wantHandled: true,
 			wantURI:     "https://aservice.testns.svc.cluster.local/e23097c8-15a8-487b-b5a3-76fdc4a48c46",
 		},
+		"enabled, handled, namespace mapping takes precedence": {
+			enabled: true,
+			ref:     serviceObjRef(testingNamespace),
+			cm: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "config-kreference-mapping",
+					Namespace: testingNamespace,
+				},
+				Data: map[string]string{
+					"Service.v1":         "https://{{ .Name }}.{{ .Namespace }}.svc.cluster.local",
+					"testns_Service.v1":  "https://{{ .Name }}.gateway.{{ .Namespace }}.svc.cluster.local",
+					"otherns_Service.v1": "https://{{ .Name }}.other.svc.cluster.local",
+				},
+			},
+			wantHandled: true,
+			wantURI:     "https://aservice.gateway.testns.svc.cluster.local",
+		},
+		"enabled, handled, namespace mapping of another namespace": {
+			enabled: true,
+			ref:     serviceObjRef(testingNamespace),
+			cm: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "config-kreference-mapping",
+					Namespace: testingNamespace,
+				},
+				Data: map[string]string{
+					"otherns_Service.v1": "https://{{ .Name }}.other.svc.cluster.local",
+				},
+			},
+			wantHandled: false,
+		},
+		"enabled, handled, with parent": {
+			enabled: true,
+			ref:     serviceObjRef(testingNamespace),
+			cm: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "config-kreference-mapping",
+					Namespace: testingNamespace,
+				},
+				Data: map[string]string{
+					"Service.v1": "https://{{ .Name }}.{{ .Namespace }}.svc.cluster.local/{{ .Parent.Name }}?tenant={{ .Parent.Labels.tenant }}",
+				},
+			},
+			parent:      parentPod(testingNamespace),
+			wantHandled: true,
+			wantURI:     "https://aservice.testns.svc.cluster.local/apod?tenant=blue",
+			wantTracked: true,
+			wantWatched: true,
+		},
+		"enabled, handled, with object labels and annotations": {
+			enabled: true,
+			ref:     serviceObjRef(testingNamespace),
+			cm: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "config-kreference-mapping",
+					Namespace: testingNamespace,
+				},
+				Data: map[string]string{
+					"Service.v1": "https://{{ .Labels.tenant }}.{{ .Namespace }}.svc.cluster.local/{{ index .Annotations \"example.com/path\" }}",
+				},
+			},
+			objects: []runtime.Object{
+				&corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "aservice",
+						Namespace:   testingNamespace,
+						Labels:      map[string]string{"tenant": "green"},
+						Annotations: map[string]string{"example.com/path": "events"},
+					},
+				},
+			},
+			wantHandled: true,
+			wantURI:     "https://green.testns.svc.cluster.local/events",
+		},
+		"enabled, handled, with object labels of a missing object": {
+			enabled: true,
+			ref:     serviceObjRef(testingNamespace),
+			cm: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "config-kreference-mapping",
+					Namespace: testingNamespace,
+				},
+				Data: map[string]string{
+					"Service.v1": "https://{{ .Name }}.{{ .Namespace }}.svc.cluster.local/{{ index .Labels \"tenant\" }}",
+				},
+			},
+			wantHandled: true,
+			wantURI:     "https://aservice.testns.svc.cluster.local/",
+		},
 		"enabled, handled, with error (broken template)": {
 			enabled: true,
 			ref:     serviceObjRef(testingNamespace),
@@ -118,8 +218,11 @@ func TestMappingResolver(t *testing.T) {
 
 		t.Run(n, func(t *testing.T) {
 			ctx, _ := SetupFakeContext(t)
+			ctx, _ = fakedynamicclient.With(ctx, scheme.Scheme, tc.objects...)
+			ctx = kresource.WithDuck(ctx)
 			mw := &configmap.ManualWatcher{Namespace: testingNamespace}
-			track := tracker.New(func(types.NamespacedName) {}, 0)
+			var tracked []types.NamespacedName
+			track := tracker.New(func(key types.NamespacedName) { tracked = append(tracked, key) }, 0)
 
 			if tc.enabled {
 				ctx = feature.ToContext(ctx, feature.Flags{feature.KReferenceMapping: feature.Enabled})
@@ -128,7 +231,19 @@ func TestMappingResolver(t *testing.T) {
 			resolver := NewMappingResolver(ctx, mw, track)
 			mw.OnChange(tc.cm)
 
-			handled, uri, err := resolver.MappingURIFromObjectReference(ctx, tc.ref)
+			var watched []schema.GroupVersionResource
+			listerFactory := resolver.listerFactory
+			resolver.listerFactory = func(gvr schema.GroupVersionResource) (cache.GenericLister, error) {
+				watched = append(watched, gvr)
+				return listerFactory(gvr)
+			}
+
+			rctx := ctx
+			if tc.parent != nil {
+				rctx = WithParent(ctx, tc.parent)
+			}
+
+			handled, uri, err := resolver.MappingURIFromObjectReference(rctx, tc.ref)
 			if tc.wantHandled != handled {
 				t.Errorf("Unexpected handled value. Got %t, want  %t", handled, tc.wantHandled)
 			}
@@ -149,10 +264,41 @@ func TestMappingResolver(t *testing.T) {
 				}
 			}
 
+			if tc.ref != nil {
+				track.OnChanged(&corev1.Service{
+					TypeMeta:   metav1.TypeMeta{APIVersion: tc.ref.APIVersion, Kind: tc.ref.Kind},
+					ObjectMeta: metav1.ObjectMeta{Name: tc.ref.Name, Namespace: tc.ref.Namespace},
+				})
+			}
+			if gotTracked := len(tracked) > 0; tc.wantTracked != gotTracked {
+				t.Errorf("Unexpected tracking. Got %v, want tracked %t", tracked, tc.wantTracked)
+			}
+			if tc.wantWatched && len(watched) == 0 {
+				t.Error("Expected an informer for the tracked reference")
+			}
 		})
 	}
 }
 
+func TestUsesObjectValues(t *testing.T) {
+	tests := map[string]bool{
+		"https://{{ .Name }}.{{ .Namespace }}.svc.cluster.local":                            false,
+		"https://{{ .Name }}.{{ .Parent.Labels.tenant }}.svc.cluster.local":                 false,
+		"https://{{ .Name }}.svc.cluster.local/.Labels":                                     false,
+		"https://{{ .Name }}.{{ .Labels.tenant }}.svc.cluster.local":                        true,
+		"https://{{ index .Annotations \"host\" }}/{{ .Parent.Annotations }}":               true,
+		"https://{{ if .Parent.Name }}{{ $.Labels.tenant }}{{ end }}.svc.cluster.local":     true,
+		"https://{{ with $l := .Labels }}{{ $l.tenant }}{{ end }}.svc.cluster.local":        true,
+		"https://{{ with .Parent }}{{ .Name }}{{ end }}.{{ .Namespace }}.svc.cluster.local": false,
+	}
+	for stmpl, want := range tests {
+		tmpl := template.Must(template.New("test").Parse(stmpl))
+		if got := usesObjectValues(tmpl); got != want {
+			t.Errorf("usesObjectValues(%q) = %t, want %t", stmpl, got, want)
+		}
+	}
+}
+
 func serviceObjRef(ns string) *corev1.ObjectReference {
 	return &corev1.ObjectReference{
 		Kind:       "Service",
@@ -162,3 +308,17 @@ func serviceObjRef(ns string) *corev1.ObjectReference {
 		UID:        "e23097c8-15a8-487b-b5a3-76fdc4a48c46",
 	}
 }
+
+func parentPod(ns string) *corev1.Pod {
+	return &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "apod",
+			Namespace: ns,
+			Labels:    map[string]string{"tenant": "blue"},
+		},
+	}
+}
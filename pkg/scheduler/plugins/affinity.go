@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	st "knative.dev/eventing/pkg/scheduler/state"
+)
+
+// nodeAntiAffinity keeps the vreplicas of a vpod on distinct nodes while there are
+// enough nodes, so that losing a node doesn't stop the whole vpod.
+type nodeAntiAffinity struct{}
+
+var _ FilterPlugin = &nodeAntiAffinity{}
+
+func (a *nodeAntiAffinity) Name() string {
+	return NodeAntiAffinity
+}
+
+func (a *nodeAntiAffinity) Filter(_ context.Context, args *Args, candidates []int32) []int32 {
+	nodes := sets.New[string]()
+	for _, p := range args.Placements {
+		if p.VReplicas > 0 {
+			nodes.Insert(args.Domain(st.OrdinalFromPodName(p.PodName), corev1.LabelHostname))
+		}
+	}
+
+	filtered := make([]int32, 0, len(candidates))
+	for _, c := range candidates {
+		if !nodes.Has(args.Domain(c, corev1.LabelHostname)) {
+			filtered = append(filtered, c)
+		}
+	}
+	if len(filtered) == 0 {
+		// Every candidate node holds a vreplica of the vpod, prefer availability.
+		return candidates
+	}
+	return filtered
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+
+	st "knative.dev/eventing/pkg/scheduler/state"
+)
+
+// binpack prefers the pods holding the most vreplicas so that the free pods can be
+// scaled down.
+type binpack struct{}
+
+var _ ScorePlugin = &binpack{}
+
+func (b *binpack) Name() string {
+	return Binpack
+}
+
+func (b *binpack) Score(_ context.Context, args *Args, candidate int32) int64 {
+	return int64(args.Reserved[st.PodNameFromOrdinal(args.State.StatefulSetName, candidate)])
+}
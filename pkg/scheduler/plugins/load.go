@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"math"
+)
+
+// leastLoaded prefers the pods with the lowest load so that busy vpods don't share
+// the same pods.
+type leastLoaded struct{}
+
+var _ ScorePlugin = &leastLoaded{}
+
+func (l *leastLoaded) Name() string {
+	return LeastLoaded
+}
+
+func (l *leastLoaded) Score(_ context.Context, args *Args, candidate int32) int64 {
+	// Scores are integers, keep 3 decimals of the load.
+	return -int64(math.Round(args.State.LoadOf(candidate) * 1000))
+}
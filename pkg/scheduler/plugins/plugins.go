@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plugins contains the plugins ranking the candidate pods of the vreplicas
+// placed by the statefulset scheduler.
+package plugins
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+
+	duckv1alpha1 "knative.dev/eventing/pkg/apis/duck/v1alpha1"
+	st "knative.dev/eventing/pkg/scheduler/state"
+)
+
+const (
+	// NodeSpread prefers the nodes holding the fewest vreplicas of the vpod.
+	NodeSpread = "NodeSpread"
+	// ZoneSpread prefers the zones holding the fewest vreplicas of the vpod.
+	ZoneSpread = "ZoneSpread"
+	// NodeAntiAffinity excludes the nodes already holding a vreplica of the vpod,
+	// unless every candidate node holds one.
+	NodeAntiAffinity = "NodeAntiAffinity"
+	// Binpack prefers the pods holding the most vreplicas, across all vpods.
+	Binpack = "Binpack"
+	// LeastLoaded prefers the pods with the lowest load (see statefulset.Config.PodLoadCapacity).
+	LeastLoaded = "LeastLoaded"
+)
+
+// Plugin is a scheduling plugin. A plugin implements FilterPlugin, ScorePlugin or both.
+type Plugin interface {
+	// Name returns the name of the plugin.
+	Name() string
+}
+
+// FilterPlugin removes the candidate pods that must not hold the next vreplica of the vpod.
+type FilterPlugin interface {
+	Plugin
+
+	// Filter returns the subset of candidates (pod ordinals) that can hold the next vreplica.
+	Filter(ctx context.Context, args *Args, candidates []int32) []int32
+}
+
+// ScorePlugin ranks the candidate pods for the next vreplica of the vpod.
+type ScorePlugin interface {
+	Plugin
+
+	// Score returns the score of the candidate (pod ordinal). Higher scores are preferred.
+	Score(ctx context.Context, args *Args, candidate int32) int64
+}
+
+// Args are the inputs of the plugins while placing one vreplica.
+type Args struct {
+	// State is the scheduler state.
+	State *st.State
+	// VPod is the key of the vpod being scheduled.
+	VPod types.NamespacedName
+	// Placements are the placements of the vpod computed so far.
+	Placements []duckv1alpha1.Placement
+	// Reserved is the number of vreplicas, of all vpods, placed on each pod.
+	Reserved map[string]int32
+	// NodeLister lists the nodes of the pods. Optional.
+	NodeLister corev1listers.NodeLister
+
+	// domains caches the topology domains by topology key and pod ordinal.
+	domains map[string]map[int32]string
+}
+
+// Domain returns the value of the topology key for the pod with the given ordinal.
+//
+// The node name is used for the corev1.LabelHostname key. Otherwise, the pod labels
+// are used first, then the labels of the node when a NodeLister is set. Pods without
+// the topology key share the empty domain.
+func (a *Args) Domain(ordinal int32, key string) string {
+	if d, ok := a.domains[key][ordinal]; ok {
+		return d
+	}
+	d := a.domain(ordinal, key)
+	if a.domains == nil {
+		a.domains = make(map[string]map[int32]string, 1)
+	}
+	if a.domains[key] == nil {
+		a.domains[key] = make(map[int32]string)
+	}
+	a.domains[key][ordinal] = d
+	return d
+}
+
+func (a *Args) domain(ordinal int32, key string) string {
+	if a.State == nil || a.State.PodLister == nil {
+		return ""
+	}
+	pod, err := a.State.PodLister.Get(st.PodNameFromOrdinal(a.State.StatefulSetName, ordinal))
+	if err != nil {
+		return ""
+	}
+	if key == corev1.LabelHostname {
+		return pod.Spec.NodeName
+	}
+	if v, ok := pod.Labels[key]; ok {
+		return v
+	}
+	if a.NodeLister == nil || pod.Spec.NodeName == "" {
+		return ""
+	}
+	node, err := a.NodeLister.Get(pod.Spec.NodeName)
+	if err != nil {
+		return ""
+	}
+	return node.Labels[key]
+}
+
+// New returns the plugins with the given names, in the same order.
+func New(names []string) ([]Plugin, error) {
+	plugins := make([]Plugin, 0, len(names))
+	for _, name := range names {
+		var p Plugin
+		switch name {
+		case NodeSpread:
+			p = &spread{name: NodeSpread, key: corev1.LabelHostname}
+		case ZoneSpread:
+			p = &spread{name: ZoneSpread, key: corev1.LabelTopologyZone}
+		case NodeAntiAffinity:
+			p = &nodeAntiAffinity{}
+		case Binpack:
+			p = &binpack{}
+		case LeastLoaded:
+			p = &leastLoaded{}
+		default:
+			return nil, fmt.Errorf("unknown scheduler plugin %q", name)
+		}
+		plugins = append(plugins, p)
+	}
+	return plugins, nil
+}
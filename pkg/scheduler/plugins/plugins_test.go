@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	duckv1alpha1 "knative.dev/eventing/pkg/apis/duck/v1alpha1"
+	listers "knative.dev/eventing/pkg/reconciler/testing/v1"
+	st "knative.dev/eventing/pkg/scheduler/state"
+	tscheduler "knative.dev/eventing/pkg/scheduler/testing"
+)
+
+const (
+	testNs  = "test-ns"
+	sfsName = "statefulset-name"
+)
+
+func TestNew(t *testing.T) {
+	ps, err := New([]string{NodeSpread, ZoneSpread, NodeAntiAffinity, Binpack})
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+	names := make([]string, 0, len(ps))
+	for _, p := range ps {
+		names = append(names, p.Name())
+	}
+	if want := []string{NodeSpread, ZoneSpread, NodeAntiAffinity, Binpack}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got plugins %v, want %v", names, want)
+	}
+
+	if _, err := New([]string{NodeSpread, "Unknown"}); err == nil {
+		t.Error("expected error for unknown plugin, got none")
+	}
+}
+
+func TestDomain(t *testing.T) {
+	pod0 := tscheduler.MakePod(testNs, sfsName+"-0", "node-a")
+	pod0.Labels = map[string]string{corev1.LabelTopologyZone: "zone-pod"}
+	pod1 := tscheduler.MakePod(testNs, sfsName+"-1", "node-b")
+	pod2 := tscheduler.MakePod(testNs, sfsName+"-2", "node-c")
+	nodeB := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node-b",
+			Labels: map[string]string{corev1.LabelTopologyZone: "zone-node"},
+		},
+	}
+	ls := listers.NewListers([]runtime.Object{pod0, pod1, pod2, nodeB})
+
+	args := &Args{
+		State: &st.State{
+			StatefulSetName: sfsName,
+			PodLister:       ls.GetPodLister().Pods(testNs),
+		},
+		NodeLister: ls.GetNodeLister(),
+	}
+
+	tests := []struct {
+		ordinal int32
+		key     string
+		want    string
+	}{
+		{ordinal: 0, key: corev1.LabelHostname, want: "node-a"},
+		{ordinal: 0, key: corev1.LabelTopologyZone, want: "zone-pod"},
+		{ordinal: 1, key: corev1.LabelTopologyZone, want: "zone-node"},
+		{ordinal: 2, key: corev1.LabelTopologyZone, want: ""},
+		{ordinal: 3, key: corev1.LabelHostname, want: ""},
+	}
+	for _, tc := range tests {
+		if got := args.Domain(tc.ordinal, tc.key); got != tc.want {
+			t.Errorf("Domain(%d, %s) = %q, want %q", tc.ordinal, tc.key, got, tc.want)
+		}
+	}
+}
+
+func TestNodeAntiAffinity(t *testing.T) {
+	pods := []runtime.Object{
+		tscheduler.MakePod(testNs, sfsName+"-0", "node-a"),
+		tscheduler.MakePod(testNs, sfsName+"-1", "node-a"),
+		tscheduler.MakePod(testNs, sfsName+"-2", "node-b"),
+	}
+	ls := listers.NewListers(pods)
+	state := &st.State{
+		StatefulSetName: sfsName,
+		PodLister:       ls.GetPodLister().Pods(testNs),
+	}
+
+	tests := []struct {
+		name       string
+		placements []duckv1alpha1.Placement
+		want       []int32
+	}{{
+		name: "no placements",
+		want: []int32{0, 1, 2},
+	}, {
+		name:       "placed on node-a",
+		placements: []duckv1alpha1.Placement{{PodName: sfsName + "-0", VReplicas: 1}},
+		want:       []int32{2},
+	}, {
+		name: "placed on every node",
+		placements: []duckv1alpha1.Placement{
+			{PodName: sfsName + "-1", VReplicas: 1},
+			{PodName: sfsName + "-2", VReplicas: 1},
+		},
+		want: []int32{0, 1, 2},
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			args := &Args{State: state, Placements: tc.placements}
+			got := (&nodeAntiAffinity{}).Filter(context.Background(), args, []int32{0, 1, 2})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got candidates %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+
+	st "knative.dev/eventing/pkg/scheduler/state"
+)
+
+// spread prefers the topology domains holding the fewest vreplicas of the vpod.
+type spread struct {
+	name string
+	key  string
+}
+
+var _ ScorePlugin = &spread{}
+
+func (s *spread) Name() string {
+	return s.name
+}
+
+func (s *spread) Score(_ context.Context, args *Args, candidate int32) int64 {
+	domain := args.Domain(candidate, s.key)
+	count := int64(0)
+	for _, p := range args.Placements {
+		if args.Domain(st.OrdinalFromPodName(p.PodName), s.key) == domain {
+			count += int64(p.VReplicas)
+		}
+	}
+	return -count
+}
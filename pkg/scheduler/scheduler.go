@@ -39,6 +39,10 @@ const (
 // VPodLister is the function signature for returning a list of VPods
 type VPodLister func() ([]VPod, error)
 
+// VPodLoadLister is the function signature for returning the measured load of
+// each VPod, for example the number of events per second reported by the adapters.
+type VPodLoadLister func() (map[types.NamespacedName]float64, error)
+
 // Evictor allows for vreplicas to be evicted.
 // For instance, the evictor is used by the statefulset scheduler to
 // move vreplicas to pod with a lower ordinal.
@@ -81,6 +85,15 @@ type VPod interface {
 	GetResourceVersion() string
 }
 
+// WeightedVPod is a VPod whose vreplicas have a static load (weight), used when
+// no measured load is available for the VPod.
+type WeightedVPod interface {
+	VPod
+
+	// GetVReplicaWeight returns the load of one virtual replica.
+	GetVReplicaWeight() float64
+}
+
 type ScaleClient interface {
 	GetScale(ctx context.Context, name string, options metav1.GetOptions) (*autoscalingv1.Scale, error)
 	UpdateScale(ctx context.Context, name string, scale *autoscalingv1.Scale, options metav1.UpdateOptions) (*autoscalingv1.Scale, error)
@@ -71,6 +71,16 @@ type State struct {
 
 	// ExpectedVReplicaByVPod is the expected virtual replicas for each vpod key
 	ExpectedVReplicaByVPod map[types.NamespacedName]int32
+
+	// LoadCapacity is the maximum load of each pod. Zero when the scheduling isn't load-aware.
+	LoadCapacity float64
+
+	// Load tracks the load of each pod, determined by the placements in the vpod status
+	// and the load of each vreplica.
+	Load []float64
+
+	// VReplicaLoad is the load of one vreplica for each vpod key.
+	VReplicaLoad map[types.NamespacedName]float64
 }
 
 // Free safely returns the free capacity at the given ordinal
@@ -97,6 +107,42 @@ func (s *State) FreeCapacity() int32 {
 	return t
 }
 
+// LoadOf safely returns the load at the given ordinal
+func (s *State) LoadOf(ordinal int32) float64 {
+	if len(s.Load) <= int(ordinal) {
+		return 0
+	}
+	return s.Load[ordinal]
+}
+
+// AddLoad safely adds load at the given ordinal
+func (s *State) AddLoad(ordinal int32, load float64) {
+	for len(s.Load) <= int(ordinal) {
+		s.Load = append(s.Load, 0)
+	}
+	s.Load[ordinal] += load
+}
+
+// FitsLoad returns whether a vreplica of the given vpod can be placed at the given ordinal
+// without exceeding the load capacity. Empty pods always fit so that vreplicas heavier
+// than the capacity can still be placed.
+func (s *State) FitsLoad(ordinal int32, vpod types.NamespacedName) bool {
+	if s.LoadCapacity <= 0 {
+		return true
+	}
+	load := s.LoadOf(ordinal)
+	return load == 0 || load+s.VReplicaLoad[vpod] <= s.LoadCapacity
+}
+
+// TotalExpectedLoad returns the load of all the expected vreplicas.
+func (s *State) TotalExpectedLoad() float64 {
+	t := float64(0)
+	for k, v := range s.ExpectedVReplicaByVPod {
+		t += float64(v) * s.VReplicaLoad[k]
+	}
+	return t
+}
+
 func (s *State) IsSchedulablePod(ordinal int32) bool {
 	for _, x := range s.SchedulablePods {
 		if x == ordinal {
@@ -113,18 +159,37 @@ type stateBuilder struct {
 	statefulSetCache *scheduler.ScaleCache
 	statefulSetName  string
 	podLister        corev1.PodNamespaceLister
+	loadCapacity     float64
+	loadLister       scheduler.VPodLoadLister
+}
+
+// StateBuilderOption configures the StateAccessor returned by NewStateBuilder.
+type StateBuilderOption func(*stateBuilder)
+
+// WithLoad makes the state load-aware. loadCapacity is the maximum load of each pod and
+// loadLister, optional, returns the measured load of the vpods. The vpods without
+// measured load use their weight (see scheduler.WeightedVPod) and otherwise have no load.
+func WithLoad(loadCapacity float64, loadLister scheduler.VPodLoadLister) StateBuilderOption {
+	return func(s *stateBuilder) {
+		s.loadCapacity = loadCapacity
+		s.loadLister = loadLister
+	}
 }
 
 // NewStateBuilder returns a StateAccessor recreating the state from scratch each time it is requested
-func NewStateBuilder(sfsname string, lister scheduler.VPodLister, podCapacity int32, podlister corev1.PodNamespaceLister, statefulSetCache *scheduler.ScaleCache) StateAccessor {
+func NewStateBuilder(sfsname string, lister scheduler.VPodLister, podCapacity int32, podlister corev1.PodNamespaceLister, statefulSetCache *scheduler.ScaleCache, opts ...StateBuilderOption) StateAccessor {
 
-	return &stateBuilder{
+	s := &stateBuilder{
 		vpodLister:       lister,
 		capacity:         podCapacity,
 		statefulSetCache: statefulSetCache,
 		statefulSetName:  sfsname,
 		podLister:        podlister,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *stateBuilder) State(ctx context.Context) (*State, error) {
@@ -208,11 +273,47 @@ func (s *stateBuilder) State(ctx context.Context) (*State, error) {
 		ExpectedVReplicaByVPod: expectedVReplicasByVPod,
 	}
 
+	if s.loadCapacity > 0 {
+		s.updateLoad(logger, state, vpods)
+	}
+
 	logger.Infow("cluster state info", zap.Any("state", state))
 
 	return state, nil
 }
 
+func (s *stateBuilder) updateLoad(logger *zap.SugaredLogger, state *State, vpods []scheduler.VPod) {
+	var measured map[types.NamespacedName]float64
+	if s.loadLister != nil {
+		var err error
+		measured, err = s.loadLister()
+		if err != nil {
+			// Don't block the scheduling, fall back to the vpod weights.
+			logger.Warnw("Failed to list vpods load", zap.Error(err))
+		}
+	}
+
+	state.LoadCapacity = s.loadCapacity
+	state.Load = make([]float64, 0, len(state.FreeCap))
+	state.VReplicaLoad = make(map[types.NamespacedName]float64, len(vpods))
+
+	for _, vpod := range vpods {
+		vreplicaLoad := float64(0)
+		scheduled := scheduler.GetTotalVReplicas(vpod.GetPlacements())
+		if load, ok := measured[vpod.GetKey()]; ok && scheduled > 0 {
+			// The measured load is the load of the placed vreplicas.
+			vreplicaLoad = load / float64(scheduled)
+		} else if w, ok := vpod.(scheduler.WeightedVPod); ok {
+			vreplicaLoad = w.GetVReplicaWeight()
+		}
+		state.VReplicaLoad[vpod.GetKey()] = vreplicaLoad
+
+		for _, p := range vpod.GetPlacements() {
+			state.AddLoad(OrdinalFromPodName(p.PodName), float64(p.VReplicas)*vreplicaLoad)
+		}
+	}
+}
+
 func pendingFromVPod(vpod scheduler.VPod) int32 {
 	expected := vpod.GetVReplicas()
 	scheduled := scheduler.GetTotalVReplicas(vpod.GetPlacements())
@@ -286,6 +387,9 @@ func (s *State) MarshalJSON() ([]byte, error) {
 		PodSpread              map[string]map[string]int32 `json:"podSpread"`
 		Pending                map[string]int32            `json:"pending"`
 		ExpectedVReplicaByVPod map[string]int32            `json:"expectedVReplicaByVPod"`
+		LoadCapacity           float64                     `json:"loadCapacity,omitempty"`
+		Load                   []float64                   `json:"load,omitempty"`
+		VReplicaLoad           map[string]float64          `json:"vreplicaLoad,omitempty"`
 	}
 
 	sj := S{
@@ -297,6 +401,14 @@ func (s *State) MarshalJSON() ([]byte, error) {
 		PodSpread:              ToJSONable(s.PodSpread),
 		Pending:                toJSONablePending(s.Pending),
 		ExpectedVReplicaByVPod: toJSONablePending(s.ExpectedVReplicaByVPod),
+		LoadCapacity:           s.LoadCapacity,
+		Load:                   s.Load,
+	}
+	if s.VReplicaLoad != nil {
+		sj.VReplicaLoad = make(map[string]float64, len(s.VReplicaLoad))
+		for k, v := range s.VReplicaLoad {
+			sj.VReplicaLoad[k.String()] = v
+		}
 	}
 
 	return json.Marshal(sj)
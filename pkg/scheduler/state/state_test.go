@@ -300,3 +300,70 @@ func TestStateBuilder(t *testing.T) {
 		})
 	}
 }
+
+func TestStateBuilderLoad(t *testing.T) {
+	ctx, _ := tscheduler.SetupFakeContext(t)
+	vpodClient := tscheduler.NewVPodClient()
+
+	measured := types.NamespacedName{Namespace: vpodNs, Name: vpodName + "-measured"}
+	weighted := types.NamespacedName{Namespace: vpodNs, Name: vpodName + "-weighted"}
+	unknown := types.NamespacedName{Namespace: vpodNs, Name: vpodName + "-unknown"}
+
+	vpodClient.Create(measured.Namespace, measured.Name, 4, []duckv1alpha1.Placement{
+		{PodName: "statefulset-name-0", VReplicas: 1},
+		{PodName: "statefulset-name-1", VReplicas: 3},
+	})
+	vpodClient.Append(tscheduler.NewWeightedVPod(weighted.Namespace, weighted.Name, 3, []duckv1alpha1.Placement{
+		{PodName: "statefulset-name-1", VReplicas: 2},
+	}, 5))
+	vpodClient.Create(unknown.Namespace, unknown.Name, 2, []duckv1alpha1.Placement{
+		{PodName: "statefulset-name-0", VReplicas: 2},
+	})
+
+	podlist := make([]runtime.Object, 0, 2)
+	for i := int32(0); i < 2; i++ {
+		pod, err := kubeclient.Get(ctx).CoreV1().Pods(testNs).Create(ctx, tscheduler.MakePod(testNs, PodNameFromOrdinal(sfsName, i), "node-"+fmt.Sprint(i)), metav1.CreateOptions{})
+		if err != nil {
+			t.Fatal("unexpected error", err)
+		}
+		podlist = append(podlist, pod)
+	}
+	if _, err := kubeclient.Get(ctx).AppsV1().StatefulSets(testNs).Create(ctx, tscheduler.MakeStatefulset(testNs, sfsName, 2), metav1.CreateOptions{}); err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	lsp := listers.NewListers(podlist)
+	scaleCache := scheduler.NewScaleCache(ctx, testNs, kubeclient.Get(ctx).AppsV1().StatefulSets(testNs), scheduler.ScaleCacheConfig{RefreshPeriod: time.Minute * 5})
+	loadLister := func() (map[types.NamespacedName]float64, error) {
+		return map[types.NamespacedName]float64{measured: 40}, nil
+	}
+
+	stateBuilder := NewStateBuilder(sfsName, vpodClient.List, int32(10), lsp.GetPodLister().Pods(testNs), scaleCache, WithLoad(45, loadLister))
+	state, err := stateBuilder.State(ctx)
+	if err != nil {
+		t.Fatal("unexpected error", err)
+	}
+
+	if state.LoadCapacity != 45 {
+		t.Errorf("unexpected load capacity, got %v, want 45", state.LoadCapacity)
+	}
+	wantVReplicaLoad := map[types.NamespacedName]float64{measured: 10, weighted: 5, unknown: 0}
+	if diff := cmp.Diff(wantVReplicaLoad, state.VReplicaLoad); diff != "" {
+		t.Errorf("unexpected vreplica load (-want, +got)\n%s", diff)
+	}
+	if diff := cmp.Diff([]float64{10, 40}, state.Load); diff != "" {
+		t.Errorf("unexpected load (-want, +got)\n%s", diff)
+	}
+	if got := state.TotalExpectedLoad(); got != 55 {
+		t.Errorf("unexpected total expected load, got %v, want 55", got)
+	}
+	if !state.FitsLoad(0, measured) {
+		t.Error("expected vreplica to fit on pod 0")
+	}
+	if state.FitsLoad(1, measured) {
+		t.Error("expected vreplica not to fit on pod 1")
+	}
+	if !state.FitsLoad(2, measured) {
+		t.Error("expected vreplica to fit on empty pod 2")
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"knative.dev/pkg/logging"
 	"knative.dev/pkg/reconciler"
 
+	duckv1alpha1 "knative.dev/eventing/pkg/apis/duck/v1alpha1"
 	"knative.dev/eventing/pkg/scheduler"
 	st "knative.dev/eventing/pkg/scheduler/state"
 )
@@ -81,6 +82,9 @@ type autoscaler struct {
 	// getReserved returns reserved replicas.
 	getReserved GetReserved
 
+	// releaseReserved releases reserved replicas.
+	releaseReserved ReleaseReserved
+
 	lastCompactAttempt time.Time
 }
 
@@ -122,6 +126,7 @@ func newAutoscaler(cfg *Config, stateAccessor st.StateAccessor, statefulSetCache
 		lock:             new(sync.Mutex),
 		isLeader:         atomic.Bool{},
 		getReserved:      cfg.getReserved,
+		releaseReserved:  cfg.releaseReserved,
 		// Anything that is less than now() - refreshPeriod, so that we will try to compact
 		// as soon as we start.
 		lastCompactAttempt: time.Now().
@@ -206,6 +211,10 @@ func (a *autoscaler) doautoscale(ctx context.Context, attemptScaleDown bool) err
 	}
 
 	newReplicas := integer.Int32Max(int32(math.Ceil(float64(state.TotalExpectedVReplicas())/float64(state.Capacity))), a.minReplicas)
+	if state.LoadCapacity > 0 {
+		// Enough pods to hold the expected load.
+		newReplicas = integer.Int32Max(int32(math.Ceil(state.TotalExpectedLoad()/state.LoadCapacity)), newReplicas)
+	}
 
 	// Only scale down if permitted
 	if !attemptScaleDown && newReplicas < scale.Spec.Replicas {
@@ -260,6 +269,12 @@ func (a *autoscaler) mayCompact(logger *zap.SugaredLogger, s *st.State) error {
 		if err != nil {
 			return fmt.Errorf("vreplicas compaction failed: %w", err)
 		}
+	} else if s.LoadCapacity > 0 {
+		a.lastCompactAttempt = time.Now()
+		err := a.rebalance(s)
+		if err != nil {
+			return fmt.Errorf("vreplicas rebalancing failed: %w", err)
+		}
 	}
 
 	// only do 1 replica at a time to avoid overloading the scheduler with too many
@@ -294,3 +309,60 @@ func (a *autoscaler) compact(s *st.State) error {
 	}
 	return nil
 }
+
+// rebalance evicts the placement with the highest load from the first overloaded pod
+// holding more than one vreplica, so that it gets rescheduled to pods with spare load
+// capacity.
+func (a *autoscaler) rebalance(s *st.State) error {
+	vpods, err := a.vpodLister()
+	if err != nil {
+		return err
+	}
+
+	for _, ordinal := range s.SchedulablePods {
+		if s.LoadOf(ordinal) <= s.LoadCapacity {
+			continue
+		}
+
+		podName := st.PodNameFromOrdinal(s.StatefulSetName, ordinal)
+		var (
+			evictVPod      scheduler.VPod
+			evictPlacement *duckv1alpha1.Placement
+			evictLoad      float64
+			vreplicas      int32
+		)
+		for _, vpod := range vpods {
+			placements := vpod.GetPlacements()
+			for i := range placements {
+				if placements[i].PodName != podName {
+					continue
+				}
+				vreplicas += placements[i].VReplicas
+				if load := float64(placements[i].VReplicas) * s.VReplicaLoad[vpod.GetKey()]; evictPlacement == nil || load > evictLoad {
+					evictVPod, evictPlacement, evictLoad = vpod, &placements[i], load
+				}
+			}
+		}
+		// A single vreplica heavier than the capacity can't be rebalanced.
+		if evictPlacement == nil || vreplicas <= 1 {
+			continue
+		}
+
+		pod, err := s.PodLister.Get(podName)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get pod %s: %w", podName, err)
+		}
+		if err := a.evictor(pod, evictVPod, evictPlacement); err != nil {
+			return fmt.Errorf("failed to evict pod %s: %w", podName, err)
+		}
+		// The pod is still schedulable, don't keep the evicted vreplicas reserved.
+		if a.releaseReserved != nil {
+			a.releaseReserved(evictVPod.GetKey(), podName)
+		}
+
+		// only rebalance 1 pod at a time to avoid overloading the scheduler with too many
+		// rescheduling requests.
+		return nil
+	}
+	return nil
+}
@@ -56,6 +56,8 @@ func TestAutoscaler(t *testing.T) {
 		scaleDown    bool
 		wantReplicas int32
 		reserved     map[types.NamespacedName]map[string]int32
+		loadCapacity float64
+		loads        map[types.NamespacedName]float64
 	}{
 		{
 			name:     "no replicas, no placements, no pending",
@@ -284,6 +286,29 @@ func TestAutoscaler(t *testing.T) {
 			},
 			wantReplicas: int32(5),
 		},
+		{
+			name:     "with replicas, with placements, load exceeds capacity",
+			replicas: int32(1),
+			vpods: []scheduler.VPod{
+				tscheduler.NewVPod(testNs, "vpod-1", 3, []duckv1alpha1.Placement{
+					{PodName: "statefulset-name-0", VReplicas: int32(2)}}),
+			},
+			loadCapacity: 100,
+			loads: map[types.NamespacedName]float64{
+				{Name: "vpod-1", Namespace: testNs}: 150,
+			},
+			wantReplicas: int32(3),
+		},
+		{
+			name:     "with replicas, with weighted placements, load within capacity",
+			replicas: int32(1),
+			vpods: []scheduler.VPod{
+				tscheduler.NewWeightedVPod(testNs, "vpod-1", 3, []duckv1alpha1.Placement{
+					{PodName: "statefulset-name-0", VReplicas: int32(3)}}, 20),
+			},
+			loadCapacity: 100,
+			wantReplicas: int32(1),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -311,7 +336,10 @@ func TestAutoscaler(t *testing.T) {
 
 			scaleCache := scheduler.NewScaleCache(ctx, testNs, kubeclient.Get(ctx).AppsV1().StatefulSets(testNs), scheduler.ScaleCacheConfig{RefreshPeriod: time.Minute * 5})
 
-			stateAccessor := state.NewStateBuilder(sfsName, vpodClient.List, 10, lspp, scaleCache)
+			loadLister := func() (map[types.NamespacedName]float64, error) {
+				return tc.loads, nil
+			}
+			stateAccessor := state.NewStateBuilder(sfsName, vpodClient.List, 10, lspp, scaleCache, state.WithLoad(tc.loadCapacity, loadLister))
 
 			sfsClient := kubeclient.Get(ctx).AppsV1().StatefulSets(testNs)
 			_, err := sfsClient.Create(ctx, tscheduler.MakeStatefulset(testNs, sfsName, tc.replicas), metav1.CreateOptions{})
@@ -433,6 +461,7 @@ func TestCompactor(t *testing.T) {
 		replicas      int32
 		vpods         []scheduler.VPod
 		wantEvictions map[types.NamespacedName][]duckv1alpha1.Placement
+		loadCapacity  float64
 	}{
 		{
 			name:     "no replicas, no placements, no pending",
@@ -543,6 +572,35 @@ func TestCompactor(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:     "two vpods, overloaded pod, rebalanced",
+			replicas: int32(2),
+			vpods: []scheduler.VPod{
+				tscheduler.NewWeightedVPod(testNs, "vpod-1", 2, []duckv1alpha1.Placement{
+					{PodName: "statefulset-name-0", VReplicas: int32(2)},
+				}, 30),
+				tscheduler.NewWeightedVPod(testNs, "vpod-2", 1, []duckv1alpha1.Placement{
+					{PodName: "statefulset-name-0", VReplicas: int32(1)},
+				}, 50),
+			},
+			loadCapacity: 100,
+			wantEvictions: map[types.NamespacedName][]duckv1alpha1.Placement{
+				{Name: "vpod-1", Namespace: testNs}: {
+					{PodName: "statefulset-name-0", VReplicas: int32(2)},
+				},
+			},
+		},
+		{
+			name:     "one vpod, single vreplica heavier than capacity, not rebalanced",
+			replicas: int32(2),
+			vpods: []scheduler.VPod{
+				tscheduler.NewWeightedVPod(testNs, "vpod-1", 1, []duckv1alpha1.Placement{
+					{PodName: "statefulset-name-0", VReplicas: int32(1)},
+				}, 150),
+			},
+			loadCapacity:  100,
+			wantEvictions: nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -569,7 +627,7 @@ func TestCompactor(t *testing.T) {
 
 			lsp := listers.NewListers(podlist)
 			scaleCache := scheduler.NewScaleCache(ctx, testNs, kubeclient.Get(ctx).AppsV1().StatefulSets(testNs), scheduler.ScaleCacheConfig{RefreshPeriod: time.Minute * 5})
-			stateAccessor := state.NewStateBuilder(sfsName, vpodClient.List, 10, lsp.GetPodLister().Pods(testNs), scaleCache)
+			stateAccessor := state.NewStateBuilder(sfsName, vpodClient.List, 10, lsp.GetPodLister().Pods(testNs), scaleCache, state.WithLoad(tc.loadCapacity, nil))
 
 			evictions := make(map[types.NamespacedName][]duckv1alpha1.Placement)
 			recordEviction := func(pod *corev1.Pod, vpod scheduler.VPod, from *duckv1alpha1.Placement) error {
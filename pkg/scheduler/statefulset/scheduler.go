@@ -40,11 +40,15 @@ import (
 
 	duckv1alpha1 "knative.dev/eventing/pkg/apis/duck/v1alpha1"
 	"knative.dev/eventing/pkg/scheduler"
+	"knative.dev/eventing/pkg/scheduler/plugins"
 	st "knative.dev/eventing/pkg/scheduler/state"
 )
 
 type GetReserved func() map[types.NamespacedName]map[string]int32
 
+// ReleaseReserved releases the vreplicas of a vpod reserved on a pod.
+type ReleaseReserved func(vpod types.NamespacedName, podName string)
+
 type Config struct {
 	StatefulSetNamespace string `json:"statefulSetNamespace"`
 	StatefulSetName      string `json:"statefulSetName"`
@@ -54,6 +58,11 @@ type Config struct {
 	PodCapacity int32 `json:"podCapacity"`
 	// MinReplicas is the minimum replicas of the statefulset.
 	MinReplicas int32 `json:"minReplicas"`
+	// PodLoadCapacity is the maximum load of each StatefulSet's pod, in the unit of
+	// VPodLoadLister or of the vpods weight (see scheduler.WeightedVPod).
+	// When set, vreplicas aren't placed on pods exceeding it and the autoscaler
+	// scales and rebalances based on the load. Optional.
+	PodLoadCapacity float64 `json:"podLoadCapacity"`
 	// Autoscaler refresh period
 	RefreshPeriod time.Duration `json:"refreshPeriod"`
 	// Autoscaler retry period
@@ -62,11 +71,28 @@ type Config struct {
 	Evictor scheduler.Evictor `json:"-"`
 
 	VPodLister scheduler.VPodLister `json:"-"`
+	// VPodLoadLister returns the measured load of the vpods, for example the number
+	// of events per second reported by the adapters. Optional.
+	VPodLoadLister scheduler.VPodLoadLister `json:"-"`
 	// Pod lister for statefulset: StatefulSetNamespace / StatefulSetName
 	PodLister corev1listers.PodNamespaceLister `json:"-"`
+	// NodeLister is used by the plugins to read the topology labels of the nodes. Optional.
+	NodeLister corev1listers.NodeLister `json:"-"`
+
+	// Plugins are the names of the scheduling plugins (see the plugins package).
+	// Filter plugins are applied in order. Score plugins rank the candidate pods in
+	// order: later plugins break the ties of earlier ones.
+	// When empty, vreplicas are spread over the lowest ordinals first.
+	Plugins []string `json:"plugins"`
 
 	// getReserved returns reserved replicas
 	getReserved GetReserved
+
+	// releaseReserved releases reserved replicas
+	releaseReserved ReleaseReserved
+
+	// plugins are the plugins resolved from Plugins
+	plugins []plugins.Plugin
 }
 
 func New(ctx context.Context, cfg *Config) (scheduler.Scheduler, error) {
@@ -75,14 +101,25 @@ func New(ctx context.Context, cfg *Config) (scheduler.Scheduler, error) {
 		return nil, fmt.Errorf("Config.PodLister is required")
 	}
 
+	ps, err := plugins.New(cfg.Plugins)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Config.Plugins: %w", err)
+	}
+	cfg.plugins = ps
+
 	scaleCache := scheduler.NewScaleCache(ctx, cfg.StatefulSetNamespace, kubeclient.Get(ctx).AppsV1().StatefulSets(cfg.StatefulSetNamespace), cfg.ScaleCacheConfig)
 
-	stateAccessor := st.NewStateBuilder(cfg.StatefulSetName, cfg.VPodLister, cfg.PodCapacity, cfg.PodLister, scaleCache)
+	stateAccessor := st.NewStateBuilder(cfg.StatefulSetName, cfg.VPodLister, cfg.PodCapacity, cfg.PodLister, scaleCache,
+		st.WithLoad(cfg.PodLoadCapacity, cfg.VPodLoadLister))
 
 	var getReserved GetReserved
 	cfg.getReserved = func() map[types.NamespacedName]map[string]int32 {
 		return getReserved()
 	}
+	var releaseReserved ReleaseReserved
+	cfg.releaseReserved = func(vpod types.NamespacedName, podName string) {
+		releaseReserved(vpod, podName)
+	}
 
 	autoscaler := newAutoscaler(cfg, stateAccessor, scaleCache)
 
@@ -95,6 +132,7 @@ func New(ctx context.Context, cfg *Config) (scheduler.Scheduler, error) {
 
 	s := newStatefulSetScheduler(ctx, cfg, stateAccessor, autoscaler)
 	getReserved = s.Reserved
+	releaseReserved = s.releaseReserved
 	wg.Done()
 
 	return s, nil
@@ -111,6 +149,11 @@ type StatefulSetScheduler struct {
 	lock                 sync.Locker
 	stateAccessor        st.StateAccessor
 	autoscaler           Autoscaler
+	nodeLister           corev1listers.NodeLister
+
+	// filters and scorers are the configured scheduling plugins.
+	filters []plugins.FilterPlugin
+	scorers []plugins.ScorePlugin
 
 	// replicas is the (cached) number of statefulset replicas.
 	replicas int32
@@ -220,17 +263,8 @@ func newStatefulSetScheduler(ctx context.Context,
 	stateAccessor st.StateAccessor,
 	autoscaler Autoscaler) *StatefulSetScheduler {
 
-	s := &StatefulSetScheduler{
-		statefulSetNamespace: cfg.StatefulSetNamespace,
-		statefulSetName:      cfg.StatefulSetName,
-		statefulSetClient:    kubeclient.Get(ctx).AppsV1().StatefulSets(cfg.StatefulSetNamespace),
-		vpodLister:           cfg.VPodLister,
-		lock:                 new(sync.Mutex),
-		stateAccessor:        stateAccessor,
-		reserved:             make(map[types.NamespacedName]map[string]int32),
-		autoscaler:           autoscaler,
-		minReplicas:          cfg.MinReplicas,
-	}
+	s := newScheduler(cfg, stateAccessor, autoscaler)
+	s.statefulSetClient = kubeclient.Get(ctx).AppsV1().StatefulSets(cfg.StatefulSetNamespace)
 
 	// Monitor our statefulset
 	c := kubeclient.Get(ctx)
@@ -272,6 +306,31 @@ func newStatefulSetScheduler(ctx context.Context,
 	return s
 }
 
+// newScheduler returns a StatefulSetScheduler not watching the statefulset.
+func newScheduler(cfg *Config, stateAccessor st.StateAccessor, autoscaler Autoscaler) *StatefulSetScheduler {
+	s := &StatefulSetScheduler{
+		statefulSetNamespace: cfg.StatefulSetNamespace,
+		statefulSetName:      cfg.StatefulSetName,
+		vpodLister:           cfg.VPodLister,
+		lock:                 new(sync.Mutex),
+		stateAccessor:        stateAccessor,
+		reserved:             make(map[types.NamespacedName]map[string]int32),
+		autoscaler:           autoscaler,
+		minReplicas:          cfg.MinReplicas,
+		nodeLister:           cfg.NodeLister,
+	}
+
+	for _, p := range cfg.plugins {
+		if f, ok := p.(plugins.FilterPlugin); ok {
+			s.filters = append(s.filters, f)
+		}
+		if sc, ok := p.(plugins.ScorePlugin); ok {
+			s.scorers = append(s.scorers, sc)
+		}
+	}
+	return s
+}
+
 func (s *StatefulSetScheduler) Schedule(ctx context.Context, vpod scheduler.VPod) ([]duckv1alpha1.Placement, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -425,7 +484,7 @@ func (s *StatefulSetScheduler) scheduleVPod(ctx context.Context, vpod scheduler.
 		zap.Any("placements", placements),
 		zap.Any("existingPlacements", existingPlacements))
 
-	placements, left := s.addReplicas(state, reservedByPodName, vpod, target-tr, placements)
+	placements, left := s.addReplicas(ctx, state, reservedByPodName, vpod, target-tr, placements)
 
 	if left > 0 {
 		// Give time for the autoscaler to do its job
@@ -462,7 +521,7 @@ func (s *StatefulSetScheduler) removeReplicas(diff int32, placements []duckv1alp
 	return newPlacements
 }
 
-func (s *StatefulSetScheduler) addReplicas(states *st.State, reservedByPodName map[string]int32, vpod scheduler.VPod, diff int32, placements []duckv1alpha1.Placement) ([]duckv1alpha1.Placement, int32) {
+func (s *StatefulSetScheduler) addReplicas(ctx context.Context, states *st.State, reservedByPodName map[string]int32, vpod scheduler.VPod, diff int32, placements []duckv1alpha1.Placement) ([]duckv1alpha1.Placement, int32) {
 	if states.Replicas <= 0 {
 		return placements, diff
 	}
@@ -476,6 +535,10 @@ func (s *StatefulSetScheduler) addReplicas(states *st.State, reservedByPodName m
 
 	candidates := s.candidatesOrdered(states, vpod, placements)
 
+	if len(s.filters) > 0 || len(s.scorers) > 0 {
+		return s.addReplicasWithPlugins(ctx, states, reservedByPodName, vpod, diff, candidates, newPlacements)
+	}
+
 	// Spread replicas in as many candidates as possible.
 	foundFreeCandidate := true
 	for diff > 0 && foundFreeCandidate {
@@ -488,7 +551,7 @@ func (s *StatefulSetScheduler) addReplicas(states *st.State, reservedByPodName m
 			podName := st.PodNameFromOrdinal(states.StatefulSetName, ordinal)
 			reserved := reservedByPodName[podName]
 			// Is there space?
-			if states.Capacity-reserved > 0 {
+			if states.Capacity-reserved > 0 && states.FitsLoad(ordinal, vpod.GetKey()) {
 				foundFreeCandidate = true
 				allocation := int32(1)
 
@@ -499,6 +562,7 @@ func (s *StatefulSetScheduler) addReplicas(states *st.State, reservedByPodName m
 
 				diff -= allocation
 				reservedByPodName[podName] += allocation
+				states.AddLoad(ordinal, float64(allocation)*states.VReplicaLoad[vpod.GetKey()])
 			}
 		}
 	}
@@ -509,6 +573,78 @@ func (s *StatefulSetScheduler) addReplicas(states *st.State, reservedByPodName m
 	return newPlacements, diff
 }
 
+// addReplicasWithPlugins places the vreplicas one at a time on the candidate with
+// the best scores among the candidates with free capacity accepted by the filters.
+// The remaining ties are broken by the candidates order.
+func (s *StatefulSetScheduler) addReplicasWithPlugins(ctx context.Context, states *st.State, reservedByPodName map[string]int32, vpod scheduler.VPod, diff int32, candidates []int32, newPlacements []duckv1alpha1.Placement) ([]duckv1alpha1.Placement, int32) {
+	args := &plugins.Args{
+		State:      states,
+		VPod:       vpod.GetKey(),
+		Reserved:   reservedByPodName,
+		NodeLister: s.nodeLister,
+	}
+
+	for diff > 0 {
+		feasible := make([]int32, 0, len(candidates))
+		for _, ordinal := range candidates {
+			if states.Capacity-reservedByPodName[st.PodNameFromOrdinal(states.StatefulSetName, ordinal)] > 0 && states.FitsLoad(ordinal, vpod.GetKey()) {
+				feasible = append(feasible, ordinal)
+			}
+		}
+
+		args.Placements = newPlacements
+		for _, f := range s.filters {
+			feasible = f.Filter(ctx, args, feasible)
+		}
+		if len(feasible) == 0 {
+			break
+		}
+
+		ordinal := s.bestCandidate(ctx, args, feasible)
+		podName := st.PodNameFromOrdinal(states.StatefulSetName, ordinal)
+		newPlacements = upsertPlacements(newPlacements, duckv1alpha1.Placement{
+			PodName:   podName,
+			VReplicas: 1,
+		})
+
+		diff--
+		reservedByPodName[podName]++
+		states.AddLoad(ordinal, states.VReplicaLoad[vpod.GetKey()])
+	}
+
+	if len(newPlacements) == 0 {
+		return nil, diff
+	}
+	return newPlacements, diff
+}
+
+// bestCandidate returns the first candidate with the highest scores, compared in the
+// order of the score plugins.
+func (s *StatefulSetScheduler) bestCandidate(ctx context.Context, args *plugins.Args, candidates []int32) int32 {
+	best := candidates[0]
+	bestScores := s.score(ctx, args, best)
+	for _, c := range candidates[1:] {
+		scores := s.score(ctx, args, c)
+		for i := range scores {
+			if scores[i] != bestScores[i] {
+				if scores[i] > bestScores[i] {
+					best, bestScores = c, scores
+				}
+				break
+			}
+		}
+	}
+	return best
+}
+
+func (s *StatefulSetScheduler) score(ctx context.Context, args *plugins.Args, candidate int32) []int64 {
+	scores := make([]int64, len(s.scorers))
+	for i, sc := range s.scorers {
+		scores[i] = sc.Score(ctx, args, candidate)
+	}
+	return scores
+}
+
 func (s *StatefulSetScheduler) candidatesOrdered(states *st.State, vpod scheduler.VPod, placements []duckv1alpha1.Placement) []int32 {
 	existingPlacements := sets.New[string]()
 	candidates := make([]int32, len(states.SchedulablePods))
@@ -624,6 +760,15 @@ func (s *StatefulSetScheduler) Reserved() map[types.NamespacedName]map[string]in
 	return r
 }
 
+// releaseReserved releases the vreplicas of the vpod reserved on the pod, so that they
+// are not used as starting point when the vpod is rescheduled after an eviction.
+func (s *StatefulSetScheduler) releaseReserved(vpod types.NamespacedName, podName string) {
+	s.reservedMu.Lock()
+	defer s.reservedMu.Unlock()
+
+	delete(s.reserved[vpod], podName)
+}
+
 func upsertPlacements(placements []duckv1alpha1.Placement, placement duckv1alpha1.Placement) []duckv1alpha1.Placement {
 	found := false
 	for i := range placements {
@@ -24,6 +24,7 @@ import (
 	"testing"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,6 +38,7 @@ import (
 	duckv1alpha1 "knative.dev/eventing/pkg/apis/duck/v1alpha1"
 	listers "knative.dev/eventing/pkg/reconciler/testing/v1"
 	"knative.dev/eventing/pkg/scheduler"
+	"knative.dev/eventing/pkg/scheduler/plugins"
 	"knative.dev/eventing/pkg/scheduler/state"
 	tscheduler "knative.dev/eventing/pkg/scheduler/testing"
 )
@@ -61,6 +63,11 @@ func TestStatefulsetScheduler(t *testing.T) {
 		unschedulablePods sets.Set[int32]
 		capacity          int32
 		minReplicas       int32
+		plugins           []string
+		nodes             []string
+		zones             []string
+		loadCapacity      float64
+		loads             map[types.NamespacedName]float64
 	}{
 		{
 			name:      "no replicas, no vreplicas",
@@ -770,6 +777,130 @@ func TestStatefulsetScheduler(t *testing.T) {
 				{PodName: "statefulset-name-0", VReplicas: 1},
 			},
 		},
+		{
+			name:      "zone spread, 4 replicas in 2 zones, 2 vreplicas",
+			vreplicas: 2,
+			replicas:  int32(4),
+			plugins:   []string{plugins.ZoneSpread},
+			zones:     []string{"zone-a", "zone-a", "zone-b", "zone-b"},
+			expected: []duckv1alpha1.Placement{
+				{PodName: "statefulset-name-0", VReplicas: 1},
+				{PodName: "statefulset-name-2", VReplicas: 1},
+			},
+		},
+		{
+			name:      "zone spread, 4 replicas in 2 zones, 5 vreplicas",
+			vreplicas: 5,
+			replicas:  int32(4),
+			plugins:   []string{plugins.ZoneSpread},
+			zones:     []string{"zone-a", "zone-b", "zone-b", "zone-b"},
+			expected: []duckv1alpha1.Placement{
+				{PodName: "statefulset-name-0", VReplicas: 3},
+				{PodName: "statefulset-name-1", VReplicas: 2},
+			},
+		},
+		{
+			name:      "node anti-affinity, 4 replicas on 2 nodes, 2 vreplicas",
+			vreplicas: 2,
+			replicas:  int32(4),
+			plugins:   []string{plugins.NodeAntiAffinity},
+			nodes:     []string{"node-a", "node-a", "node-b", "node-b"},
+			expected: []duckv1alpha1.Placement{
+				{PodName: "statefulset-name-0", VReplicas: 1},
+				{PodName: "statefulset-name-2", VReplicas: 1},
+			},
+		},
+		{
+			name:      "node anti-affinity and node spread, 4 replicas on 2 nodes, 4 vreplicas",
+			vreplicas: 4,
+			replicas:  int32(4),
+			plugins:   []string{plugins.NodeAntiAffinity, plugins.NodeSpread},
+			nodes:     []string{"node-a", "node-a", "node-b", "node-b"},
+			expected: []duckv1alpha1.Placement{
+				{PodName: "statefulset-name-0", VReplicas: 2},
+				{PodName: "statefulset-name-2", VReplicas: 2},
+			},
+		},
+		{
+			name:      "binpack, 3 replicas, 3 vreplicas",
+			vreplicas: 3,
+			replicas:  int32(3),
+			plugins:   []string{plugins.Binpack},
+			initialReserved: map[types.NamespacedName]map[string]int32{
+				{Name: vpodName + "-0", Namespace: vpodNamespace}: {
+					"statefulset-name-1": 5,
+				},
+			},
+			expected: []duckv1alpha1.Placement{
+				{PodName: "statefulset-name-1", VReplicas: 3},
+			},
+		},
+		{
+			name:      "binpack, 2 replicas, 7 vreplicas, overflow",
+			vreplicas: 7,
+			replicas:  int32(2),
+			plugins:   []string{plugins.Binpack},
+			initialReserved: map[types.NamespacedName]map[string]int32{
+				{Name: vpodName + "-0", Namespace: vpodNamespace}: {
+					"statefulset-name-1": 5,
+				},
+			},
+			expected: []duckv1alpha1.Placement{
+				{PodName: "statefulset-name-0", VReplicas: 2},
+				{PodName: "statefulset-name-1", VReplicas: 5},
+			},
+		},
+		{
+			name:      "load-aware, 2 replicas, pod 0 overloaded, 3 vreplicas",
+			vreplicas: 3,
+			replicas:  int32(2),
+			placements: []duckv1alpha1.Placement{
+				{PodName: "statefulset-name-0", VReplicas: 1},
+			},
+			initialReserved: map[types.NamespacedName]map[string]int32{
+				{Name: vpodName, Namespace: vpodNamespace}: {
+					"statefulset-name-0": 1,
+				},
+				{Name: vpodName + "-0", Namespace: vpodNamespace}: {
+					"statefulset-name-0": 2,
+				},
+			},
+			loadCapacity: 100,
+			loads: map[types.NamespacedName]float64{
+				{Name: vpodName, Namespace: vpodNamespace}:        40,
+				{Name: vpodName + "-0", Namespace: vpodNamespace}: 90,
+			},
+			expected: []duckv1alpha1.Placement{
+				{PodName: "statefulset-name-0", VReplicas: 1},
+				{PodName: "statefulset-name-1", VReplicas: 2},
+			},
+		},
+		{
+			name:      "load-aware, 2 replicas, not enough load capacity",
+			vreplicas: 4,
+			replicas:  int32(2),
+			placements: []duckv1alpha1.Placement{
+				{PodName: "statefulset-name-0", VReplicas: 1},
+			},
+			initialReserved: map[types.NamespacedName]map[string]int32{
+				{Name: vpodName, Namespace: vpodNamespace}: {
+					"statefulset-name-0": 1,
+				},
+				{Name: vpodName + "-0", Namespace: vpodNamespace}: {
+					"statefulset-name-0": 2,
+				},
+			},
+			loadCapacity: 100,
+			loads: map[types.NamespacedName]float64{
+				{Name: vpodName, Namespace: vpodNamespace}:        40,
+				{Name: vpodName + "-0", Namespace: vpodNamespace}: 90,
+			},
+			err: controller.NewRequeueAfter(5 * time.Second),
+			expected: []duckv1alpha1.Placement{
+				{PodName: "statefulset-name-0", VReplicas: 1},
+				{PodName: "statefulset-name-1", VReplicas: 2},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -798,10 +929,17 @@ func TestStatefulsetScheduler(t *testing.T) {
 			for i := int32(0); i < tc.replicas; i++ {
 				nodeName := "node" + fmt.Sprint(i)
 				podName := sfsName + "-" + fmt.Sprint(i)
+				if tc.nodes != nil {
+					nodeName = tc.nodes[i]
+				}
 				if tc.unschedulablePods.Has(i) {
 					nodeName = ""
 				}
-				pod, err := kubeclient.Get(ctx).CoreV1().Pods(testNs).Create(ctx, tscheduler.MakePod(testNs, podName, nodeName), metav1.CreateOptions{})
+				pod := tscheduler.MakePod(testNs, podName, nodeName)
+				if tc.zones != nil {
+					pod.Labels = map[string]string{corev1.LabelTopologyZone: tc.zones[i]}
+				}
+				pod, err := kubeclient.Get(ctx).CoreV1().Pods(testNs).Create(ctx, pod, metav1.CreateOptions{})
 				if err != nil {
 					t.Fatal("unexpected error", err)
 				}
@@ -819,12 +957,20 @@ func TestStatefulsetScheduler(t *testing.T) {
 			}
 			lsp := listers.NewListers(podlist)
 			scaleCache := scheduler.NewScaleCache(ctx, testNs, kubeclient.Get(ctx).AppsV1().StatefulSets(testNs), scheduler.ScaleCacheConfig{RefreshPeriod: time.Minute * 5})
-			sa := state.NewStateBuilder(sfsName, vpodClient.List, capacity, lsp.GetPodLister().Pods(testNs), scaleCache)
+			loadLister := func() (map[types.NamespacedName]float64, error) {
+				return tc.loads, nil
+			}
+			sa := state.NewStateBuilder(sfsName, vpodClient.List, capacity, lsp.GetPodLister().Pods(testNs), scaleCache, state.WithLoad(tc.loadCapacity, loadLister))
 			cfg := &Config{
 				StatefulSetNamespace: testNs,
 				StatefulSetName:      sfsName,
 				VPodLister:           vpodClient.List,
 				MinReplicas:          tc.minReplicas,
+				Plugins:              tc.plugins,
+			}
+			cfg.plugins, err = plugins.New(cfg.Plugins)
+			if err != nil {
+				t.Fatal("unexpected error", err)
 			}
 			s := newStatefulSetScheduler(ctx, cfg, sa, nil)
 			err = s.Promote(reconciler.UniversalBucket(), func(bucket reconciler.Bucket, name types.NamespacedName) {})
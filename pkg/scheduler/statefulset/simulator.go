@@ -0,0 +1,399 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statefulset
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	duckv1alpha1 "knative.dev/eventing/pkg/apis/duck/v1alpha1"
+	"knative.dev/eventing/pkg/scheduler"
+	"knative.dev/eventing/pkg/scheduler/plugins"
+	st "knative.dev/eventing/pkg/scheduler/state"
+)
+
+// maxSimulationRounds bounds the scheduling and autoscaling rounds of a simulation.
+const maxSimulationRounds = 20
+
+// Snapshot is a snapshot of the cluster used to simulate the scheduler.
+type Snapshot struct {
+	// Config is the scheduler configuration. StatefulSetName is required.
+	Config Config `json:"config"`
+	// Replicas is the current number of statefulset replicas.
+	Replicas int32 `json:"replicas"`
+	// Pods are the statefulset pods. The pods not listed are running on a node of their own.
+	Pods []SnapshotPod `json:"pods,omitempty"`
+	// Nodes are the nodes of the pods, used for their topology labels.
+	Nodes []SnapshotNode `json:"nodes,omitempty"`
+	// VPods are the vpods to schedule, with their current placements.
+	VPods []SnapshotVPod `json:"vpods,omitempty"`
+}
+
+// SnapshotPod is a statefulset pod.
+type SnapshotPod struct {
+	Name string `json:"name"`
+	// NodeName is the node of the pod. Pods without node are pending.
+	NodeName string `json:"nodeName,omitempty"`
+	// Unschedulable marks the pod as being evicted (see scheduler.PodAnnotationKey).
+	Unschedulable bool              `json:"unschedulable,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+}
+
+// SnapshotNode is a node.
+type SnapshotNode struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// SnapshotVPod is a vpod.
+type SnapshotVPod struct {
+	Namespace  string                   `json:"namespace"`
+	Name       string                   `json:"name"`
+	VReplicas  int32                    `json:"vreplicas"`
+	Placements []duckv1alpha1.Placement `json:"placements,omitempty"`
+	// Weight is the static load of one vreplica (see scheduler.WeightedVPod).
+	Weight float64 `json:"weight,omitempty"`
+	// Load is the measured load of the vpod (see scheduler.VPodLoadLister).
+	Load *float64 `json:"load,omitempty"`
+}
+
+// SimulationResult is the outcome of a simulation.
+type SimulationResult struct {
+	// Replicas is the resulting number of statefulset replicas.
+	Replicas int32 `json:"replicas"`
+	// Rounds is the number of scheduling and autoscaling rounds until the placements,
+	// the evictions and the replicas were stable.
+	Rounds int `json:"rounds"`
+	// VPods are the resulting placements of the vpods.
+	VPods []SimulatedVPod `json:"vpods,omitempty"`
+	// Evictions are the placements evicted by the autoscaler, in order.
+	Evictions []SimulatedEviction `json:"evictions,omitempty"`
+}
+
+// SimulatedVPod is the scheduling outcome of a vpod.
+type SimulatedVPod struct {
+	Namespace  string                   `json:"namespace"`
+	Name       string                   `json:"name"`
+	VReplicas  int32                    `json:"vreplicas"`
+	Placements []duckv1alpha1.Placement `json:"placements,omitempty"`
+	// Pending is the number of vreplicas that couldn't be placed.
+	Pending int32 `json:"pending,omitempty"`
+	// Error is the last scheduling error.
+	Error string `json:"error,omitempty"`
+}
+
+// SimulatedEviction is a placement evicted by the autoscaler.
+type SimulatedEviction struct {
+	Namespace string                 `json:"namespace"`
+	Name      string                 `json:"name"`
+	Placement duckv1alpha1.Placement `json:"placement"`
+}
+
+// Simulate runs the scheduler and the autoscaler on the snapshot until the placements,
+// the evictions and the replicas are stable, without accessing the API server.
+// Evicted placements are removed from the vpods and rescheduled in the next round.
+func Simulate(ctx context.Context, snapshot *Snapshot) (*SimulationResult, error) {
+	cfg := snapshot.Config
+	if cfg.StatefulSetName == "" {
+		return nil, fmt.Errorf("config.statefulSetName is required")
+	}
+	if cfg.PodCapacity <= 0 {
+		return nil, fmt.Errorf("config.podCapacity must be greater than 0")
+	}
+	ps, err := plugins.New(cfg.Plugins)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config.plugins: %w", err)
+	}
+	cfg.plugins = ps
+
+	sim, err := newSimulation(&cfg, snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.VPodLister = sim.listVPods
+	cfg.PodLister = corev1listers.NewPodLister(sim.pods).Pods(cfg.StatefulSetNamespace)
+	cfg.NodeLister = corev1listers.NewNodeLister(sim.nodes)
+	cfg.Evictor = sim.evict
+
+	scaleCache := scheduler.NewScaleCache(ctx, cfg.StatefulSetNamespace, sim, cfg.ScaleCacheConfig)
+	stateAccessor := st.NewStateBuilder(cfg.StatefulSetName, cfg.VPodLister, cfg.PodCapacity, cfg.PodLister, scaleCache,
+		st.WithLoad(cfg.PodLoadCapacity, sim.listLoads))
+
+	s := newScheduler(&cfg, stateAccessor, nil)
+	if err := s.initReserved(); err != nil {
+		return nil, err
+	}
+	s.isLeader.Store(true)
+	cfg.getReserved = s.Reserved
+	cfg.releaseReserved = s.releaseReserved
+
+	a := newAutoscaler(&cfg, stateAccessor, scaleCache)
+	a.isLeader.Store(true)
+
+	result := &SimulationResult{}
+	for result.Rounds < maxSimulationRounds {
+		result.Rounds++
+
+		replicas := sim.replicas
+		evictions := len(sim.evictions)
+		s.replicas = replicas
+
+		changed := false
+		for _, vpod := range sim.vpods {
+			placements, err := s.Schedule(ctx, vpod)
+			if !reflect.DeepEqual(placements, vpod.placements) {
+				changed = true
+			}
+			vpod.placements = placements
+			vpod.err = err
+		}
+
+		// Allow compaction in every round.
+		a.lastCompactAttempt = time.Time{}
+		if err := a.doautoscale(ctx, true); err != nil {
+			return nil, fmt.Errorf("failed to autoscale: %w", err)
+		}
+
+		if !changed && replicas == sim.replicas && evictions == len(sim.evictions) {
+			break
+		}
+	}
+
+	result.Replicas = sim.replicas
+	result.Evictions = sim.evictions
+	for _, vpod := range sim.vpods {
+		v := SimulatedVPod{
+			Namespace:  vpod.key.Namespace,
+			Name:       vpod.key.Name,
+			VReplicas:  vpod.vreplicas,
+			Placements: vpod.placements,
+			Pending:    vpod.vreplicas - scheduler.GetTotalVReplicas(vpod.placements),
+		}
+		if v.Pending < 0 {
+			v.Pending = 0
+		}
+		if vpod.err != nil {
+			v.Error = vpod.err.Error()
+		}
+		result.VPods = append(result.VPods, v)
+	}
+	return result, nil
+}
+
+// simulation is the in-memory cluster of a simulation. It implements scheduler.ScaleClient.
+type simulation struct {
+	mu sync.Mutex
+
+	statefulSetName string
+	namespace       string
+	replicas        int32
+	pods            cache.Indexer
+	nodes           cache.Indexer
+	vpods           []*simulatedVPod
+	evictions       []SimulatedEviction
+}
+
+var _ scheduler.ScaleClient = &simulation{}
+
+func newSimulation(cfg *Config, snapshot *Snapshot) (*simulation, error) {
+	sim := &simulation{
+		statefulSetName: cfg.StatefulSetName,
+		namespace:       cfg.StatefulSetNamespace,
+		replicas:        snapshot.Replicas,
+		pods:            cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+		nodes:           cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}),
+	}
+
+	for _, p := range snapshot.Pods {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      p.Name,
+				Namespace: sim.namespace,
+				Labels:    p.Labels,
+			},
+			Spec: corev1.PodSpec{
+				NodeName: p.NodeName,
+			},
+		}
+		if p.Unschedulable {
+			pod.Annotations = map[string]string{scheduler.PodAnnotationKey: "true"}
+		}
+		if err := sim.pods.Add(pod); err != nil {
+			return nil, err
+		}
+	}
+	sim.addPods(sim.replicas)
+
+	for _, n := range snapshot.Nodes {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   n.Name,
+				Labels: n.Labels,
+			},
+		}
+		if err := sim.nodes.Add(node); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, v := range snapshot.VPods {
+		sim.vpods = append(sim.vpods, &simulatedVPod{
+			key:        types.NamespacedName{Namespace: v.Namespace, Name: v.Name},
+			vreplicas:  v.VReplicas,
+			placements: v.Placements,
+			weight:     v.Weight,
+			load:       v.Load,
+		})
+	}
+	sort.SliceStable(sim.vpods, func(i, j int) bool {
+		return sim.vpods[i].key.String() < sim.vpods[j].key.String()
+	})
+
+	return sim, nil
+}
+
+// addPods adds the missing pods up to the given number of replicas, each running on
+// a node of its own.
+func (sim *simulation) addPods(replicas int32) {
+	for ordinal := int32(0); ordinal < replicas; ordinal++ {
+		name := st.PodNameFromOrdinal(sim.statefulSetName, ordinal)
+		if _, err := corev1listers.NewPodLister(sim.pods).Pods(sim.namespace).Get(name); err == nil {
+			continue
+		}
+		_ = sim.pods.Add(&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: sim.namespace,
+			},
+			Spec: corev1.PodSpec{
+				NodeName: name + "-node",
+			},
+		})
+	}
+}
+
+func (sim *simulation) listVPods() ([]scheduler.VPod, error) {
+	vpods := make([]scheduler.VPod, 0, len(sim.vpods))
+	for _, vpod := range sim.vpods {
+		vpods = append(vpods, vpod)
+	}
+	return vpods, nil
+}
+
+func (sim *simulation) listLoads() (map[types.NamespacedName]float64, error) {
+	loads := make(map[types.NamespacedName]float64, len(sim.vpods))
+	for _, vpod := range sim.vpods {
+		if vpod.load != nil {
+			loads[vpod.key] = *vpod.load
+		}
+	}
+	return loads, nil
+}
+
+func (sim *simulation) evict(_ *corev1.Pod, vpod scheduler.VPod, from *duckv1alpha1.Placement) error {
+	sim.evictions = append(sim.evictions, SimulatedEviction{
+		Namespace: vpod.GetKey().Namespace,
+		Name:      vpod.GetKey().Name,
+		Placement: *from,
+	})
+
+	v := vpod.(*simulatedVPod)
+	placements := make([]duckv1alpha1.Placement, 0, len(v.placements))
+	for _, p := range v.placements {
+		if p.PodName != from.PodName {
+			placements = append(placements, p)
+		}
+	}
+	v.placements = placements
+	return nil
+}
+
+// GetScale implements scheduler.ScaleClient.
+func (sim *simulation) GetScale(_ context.Context, name string, _ metav1.GetOptions) (*autoscalingv1.Scale, error) {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+
+	return &autoscalingv1.Scale{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: sim.namespace,
+		},
+		Spec:   autoscalingv1.ScaleSpec{Replicas: sim.replicas},
+		Status: autoscalingv1.ScaleStatus{Replicas: sim.replicas},
+	}, nil
+}
+
+// UpdateScale implements scheduler.ScaleClient.
+func (sim *simulation) UpdateScale(_ context.Context, _ string, scale *autoscalingv1.Scale, _ metav1.UpdateOptions) (*autoscalingv1.Scale, error) {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+
+	sim.replicas = scale.Spec.Replicas
+	sim.addPods(sim.replicas)
+
+	updated := scale.DeepCopy()
+	updated.Status.Replicas = sim.replicas
+	return updated, nil
+}
+
+// simulatedVPod is a vpod of a simulation.
+type simulatedVPod struct {
+	key        types.NamespacedName
+	vreplicas  int32
+	placements []duckv1alpha1.Placement
+	weight     float64
+	load       *float64
+	err        error
+}
+
+var _ scheduler.WeightedVPod = &simulatedVPod{}
+
+func (v *simulatedVPod) GetDeletionTimestamp() *metav1.Time {
+	return nil
+}
+
+func (v *simulatedVPod) GetKey() types.NamespacedName {
+	return v.key
+}
+
+func (v *simulatedVPod) GetVReplicas() int32 {
+	return v.vreplicas
+}
+
+func (v *simulatedVPod) GetPlacements() []duckv1alpha1.Placement {
+	return v.placements
+}
+
+func (v *simulatedVPod) GetResourceVersion() string {
+	return ""
+}
+
+func (v *simulatedVPod) GetVReplicaWeight() float64 {
+	return v.weight
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statefulset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	duckv1alpha1 "knative.dev/eventing/pkg/apis/duck/v1alpha1"
+)
+
+func TestSimulate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		snapshot Snapshot
+		want     *SimulationResult
+		wantErr  bool
+	}{
+		{
+			name: "missing statefulset name",
+			snapshot: Snapshot{
+				Config: Config{PodCapacity: 10},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown plugin",
+			snapshot: Snapshot{
+				Config: Config{StatefulSetName: sfsName, PodCapacity: 10, Plugins: []string{"Unknown"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "pending vreplicas, scale up",
+			snapshot: Snapshot{
+				Config:   Config{StatefulSetName: sfsName, PodCapacity: 10},
+				Replicas: 1,
+				VPods: []SnapshotVPod{
+					{Namespace: testNs, Name: "vpod-1", VReplicas: 15},
+				},
+			},
+			want: &SimulationResult{
+				Replicas: 2,
+				Rounds:   3,
+				VPods: []SimulatedVPod{{
+					Namespace: testNs,
+					Name:      "vpod-1",
+					VReplicas: 15,
+					Placements: []duckv1alpha1.Placement{
+						{PodName: "statefulset-name-0", VReplicas: 10},
+						{PodName: "statefulset-name-1", VReplicas: 5},
+					},
+				}},
+			},
+		},
+		{
+			name: "not enough capacity, pending pod",
+			snapshot: Snapshot{
+				Config:   Config{StatefulSetName: sfsName, PodCapacity: 10},
+				Replicas: 2,
+				Pods: []SnapshotPod{
+					{Name: "statefulset-name-1"},
+				},
+				VPods: []SnapshotVPod{
+					{Namespace: testNs, Name: "vpod-1", VReplicas: 15},
+				},
+			},
+			want: &SimulationResult{
+				Replicas: 2,
+				Rounds:   2,
+				VPods: []SimulatedVPod{{
+					Namespace: testNs,
+					Name:      "vpod-1",
+					VReplicas: 15,
+					Placements: []duckv1alpha1.Placement{
+						{PodName: "statefulset-name-0", VReplicas: 10},
+					},
+					Pending: 5,
+					Error:   "insufficient running pods replicas for StatefulSet /statefulset-name to schedule resource replicas (left: 5): retry requeue after: 5s",
+				}},
+			},
+		},
+		{
+			name: "unused replicas, scale down",
+			snapshot: Snapshot{
+				Config:   Config{StatefulSetName: sfsName, PodCapacity: 10},
+				Replicas: 3,
+				VPods: []SnapshotVPod{
+					{Namespace: testNs, Name: "vpod-1", VReplicas: 2, Placements: []duckv1alpha1.Placement{
+						{PodName: "statefulset-name-0", VReplicas: 1},
+						{PodName: "statefulset-name-2", VReplicas: 1},
+					}},
+				},
+			},
+			want: &SimulationResult{
+				Replicas: 1,
+				Rounds:   3,
+				VPods: []SimulatedVPod{{
+					Namespace: testNs,
+					Name:      "vpod-1",
+					VReplicas: 2,
+					Placements: []duckv1alpha1.Placement{
+						{PodName: "statefulset-name-0", VReplicas: 2},
+					},
+				}},
+			},
+		},
+		{
+			name: "overloaded pod, rebalanced",
+			snapshot: Snapshot{
+				Config:   Config{StatefulSetName: sfsName, PodCapacity: 10, PodLoadCapacity: 100},
+				Replicas: 2,
+				VPods: []SnapshotVPod{
+					{Namespace: testNs, Name: "vpod-1", VReplicas: 2, Weight: 30, Placements: []duckv1alpha1.Placement{
+						{PodName: "statefulset-name-0", VReplicas: 2},
+					}},
+					{Namespace: testNs, Name: "vpod-2", VReplicas: 1, Weight: 50, Placements: []duckv1alpha1.Placement{
+						{PodName: "statefulset-name-0", VReplicas: 1},
+					}},
+				},
+			},
+			want: &SimulationResult{
+				Replicas: 2,
+				Rounds:   3,
+				VPods: []SimulatedVPod{{
+					Namespace: testNs,
+					Name:      "vpod-1",
+					VReplicas: 2,
+					Placements: []duckv1alpha1.Placement{
+						{PodName: "statefulset-name-0", VReplicas: 1},
+						{PodName: "statefulset-name-1", VReplicas: 1},
+					},
+				}, {
+					Namespace: testNs,
+					Name:      "vpod-2",
+					VReplicas: 1,
+					Placements: []duckv1alpha1.Placement{
+						{PodName: "statefulset-name-0", VReplicas: 1},
+					},
+				}},
+				Evictions: []SimulatedEviction{{
+					Namespace: testNs,
+					Name:      "vpod-1",
+					Placement: duckv1alpha1.Placement{PodName: "statefulset-name-0", VReplicas: 2},
+				}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Simulate(context.Background(), &tc.snapshot)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("unexpected error, got %v, want error %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected result (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
@@ -77,6 +77,22 @@ func (d *sampleVPod) GetResourceVersion() string {
 	return d.rsrcversion
 }
 
+type sampleWeightedVPod struct {
+	*sampleVPod
+	weight float64
+}
+
+func NewWeightedVPod(ns, name string, vreplicas int32, placements []duckv1alpha1.Placement, weight float64) *sampleWeightedVPod {
+	return &sampleWeightedVPod{
+		sampleVPod: NewVPod(ns, name, vreplicas, placements),
+		weight:     weight,
+	}
+}
+
+func (d *sampleWeightedVPod) GetVReplicaWeight() float64 {
+	return d.weight
+}
+
 func MakeStatefulset(ns, name string, replicas int32) *appsv1.StatefulSet {
 	obj := &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package jsonpath implements the subset of JSONPath used to select parts of
+// the JSON data of events: the root "$", child names with the dot ("$.a.b")
+// or bracket ("$['a']") notation, array indexes ("$.a[0]", "$.a[-1]") and
+// wildcards ("$.a[*]", "$.a.*").
+package jsonpath
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type segmentKind int
+
+const (
+	childSegment segmentKind = iota
+	indexSegment
+	wildcardSegment
+)
+
+type segment struct {
+	kind  segmentKind
+	name  string
+	index int
+}
+
+// Path is a parsed JSONPath expression.
+type Path struct {
+	expr     string
+	segments []segment
+	wildcard bool
+}
+
+// Parse parses a JSONPath expression.
+func Parse(expr string) (*Path, error) {
+	if !strings.HasPrefix(expr, "$") {
+		return nil, fmt.Errorf("expression %q must start with $", expr)
+	}
+
+	p := &Path{expr: expr}
+	rest := expr[1:]
+	for len(rest) > 0 {
+		var s segment
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			name := rest[:end]
+			rest = rest[end:]
+			switch name {
+			case "":
+				return nil, fmt.Errorf("expression %q contains an empty name", expr)
+			case "*":
+				s = segment{kind: wildcardSegment}
+			default:
+				s = segment{kind: childSegment, name: name}
+			}
+		case '[':
+			if len(rest) > 1 && (rest[1] == '\'' || rest[1] == '"') {
+				end := strings.IndexByte(rest[2:], rest[1])
+				if end == -1 || !strings.HasPrefix(rest[2+end+1:], "]") {
+					return nil, fmt.Errorf("expression %q contains an unterminated name", expr)
+				}
+				s = segment{kind: childSegment, name: rest[2 : 2+end]}
+				rest = rest[2+end+2:]
+				break
+			}
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("expression %q contains an unterminated index", expr)
+			}
+			inner := rest[1:end]
+			rest = rest[end+1:]
+			if inner == "*" {
+				s = segment{kind: wildcardSegment}
+				break
+			}
+			index, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, fmt.Errorf("expression %q contains an invalid index %q", expr, inner)
+			}
+			s = segment{kind: indexSegment, index: index}
+		default:
+			return nil, fmt.Errorf("expression %q contains an unexpected character %q", expr, rest[0])
+		}
+		if s.kind == wildcardSegment {
+			p.wildcard = true
+		}
+		p.segments = append(p.segments, s)
+	}
+	return p, nil
+}
+
+// String returns the expression of the path.
+func (p *Path) String() string {
+	return p.expr
+}
+
+// Select returns the value selected by the path in the given decoded JSON
+// document and whether it was found. Paths with wildcards select the list of
+// the matching values.
+func (p *Path) Select(document interface{}) (interface{}, bool) {
+	nodes := []interface{}{document}
+	for _, s := range p.segments {
+		var next []interface{}
+		for _, n := range nodes {
+			next = append(next, s.selectFrom(n)...)
+		}
+		nodes = next
+	}
+
+	if p.wildcard {
+		if nodes == nil {
+			return nil, false
+		}
+		return nodes, true
+	}
+	if len(nodes) == 0 {
+		return nil, false
+	}
+	return nodes[0], true
+}
+
+// Remove removes the values selected by the path from the given decoded JSON
+// document and returns whether a value was found. Array elements are replaced
+// by null, so that the position of the other elements doesn't change. The
+// root of the document can't be removed.
+func (p *Path) Remove(document interface{}) bool {
+	return p.update(document, nil)
+}
+
+// Replace replaces the values selected by the path in the given decoded JSON
+// document by the result of replace and returns whether a value was found.
+// The root of the document can't be replaced.
+func (p *Path) Replace(document interface{}, replace func(interface{}) interface{}) bool {
+	return p.update(document, replace)
+}
+
+func (p *Path) update(document interface{}, replace func(interface{}) interface{}) bool {
+	if len(p.segments) == 0 {
+		return false
+	}
+
+	parents := []interface{}{document}
+	for _, s := range p.segments[:len(p.segments)-1] {
+		var next []interface{}
+		for _, n := range parents {
+			next = append(next, s.selectFrom(n)...)
+		}
+		parents = next
+	}
+
+	last := p.segments[len(p.segments)-1]
+	found := false
+	for _, n := range parents {
+		if last.updateIn(n, replace) {
+			found = true
+		}
+	}
+	return found
+}
+
+func (s segment) selectFrom(node interface{}) []interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		switch s.kind {
+		case childSegment:
+			if v, ok := n[s.name]; ok {
+				return []interface{}{v}
+			}
+		case wildcardSegment:
+			keys := make([]string, 0, len(n))
+			for k := range n {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			values := make([]interface{}, 0, len(n))
+			for _, k := range keys {
+				values = append(values, n[k])
+			}
+			return values
+		}
+	case []interface{}:
+		switch s.kind {
+		case indexSegment:
+			index := s.index
+			if index < 0 {
+				index += len(n)
+			}
+			if index >= 0 && index < len(n) {
+				return []interface{}{n[index]}
+			}
+		case wildcardSegment:
+			return n
+		}
+	}
+	return nil
+}
+
+// updateIn removes or replaces the values selected by the segment in the node
+// and returns whether a value was found.
+func (s segment) updateIn(node interface{}, replace func(interface{}) interface{}) bool {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		var keys []string
+		switch s.kind {
+		case childSegment:
+			if _, ok := n[s.name]; ok {
+				keys = append(keys, s.name)
+			}
+		case wildcardSegment:
+			for k := range n {
+				keys = append(keys, k)
+			}
+		}
+		for _, k := range keys {
+			if replace == nil {
+				delete(n, k)
+			} else {
+				n[k] = replace(n[k])
+			}
+		}
+		return len(keys) > 0
+	case []interface{}:
+		var indexes []int
+		switch s.kind {
+		case indexSegment:
+			index := s.index
+			if index < 0 {
+				index += len(n)
+			}
+			if index >= 0 && index < len(n) {
+				indexes = append(indexes, index)
+			}
+		case wildcardSegment:
+			for i := range n {
+				indexes = append(indexes, i)
+			}
+		}
+		for _, i := range indexes {
+			if replace == nil {
+				n[i] = nil
+			} else {
+				n[i] = replace(n[i])
+			}
+		}
+		return len(indexes) > 0
+	}
+	return false
+}
+
+// IsJSONMediaType returns whether the data content type of an event denotes
+// JSON data, which paths are applied to. Events without data content type
+// are JSON events.
+func IsJSONMediaType(mediaType string) bool {
+	return mediaType == "" || mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package jsonpath
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const document = `{
+	"order": {"id": "o-1", "total": 12.5, "customer": {"name": "Jane"}},
+	"items": [{"sku": "a", "qty": 1}, {"sku": "b", "qty": 2}],
+	"with.dot": true
+}`
+
+func TestSelect(t *testing.T) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		expr      string
+		want      interface{}
+		wantFound bool
+	}{
+		{expr: "$", want: doc, wantFound: true},
+		{expr: "$.order.id", want: "o-1", wantFound: true},
+		{expr: "$.order['customer'].name", want: "Jane", wantFound: true},
+		{expr: `$["with.dot"]`, want: true, wantFound: true},
+		{expr: "$.items[1].sku", want: "b", wantFound: true},
+		{expr: "$.items[-1].qty", want: float64(2), wantFound: true},
+		{expr: "$.items[*].sku", want: []interface{}{"a", "b"}, wantFound: true},
+		{expr: "$.order.customer.*", want: []interface{}{"Jane"}, wantFound: true},
+		{expr: "$.order.missing"},
+		{expr: "$.items[2]"},
+		{expr: "$.order.id.nested"},
+		{expr: "$.missing[*]"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			p, err := Parse(tc.expr)
+			if err != nil {
+				t.Fatal("Parse() =", err)
+			}
+			got, found := p.Select(doc)
+			if found != tc.wantFound {
+				t.Errorf("Select() found = %v, want %v", found, tc.wantFound)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Error("Select() (-want, +got) =", diff)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"order.id",
+		"$.",
+		"$..id",
+		"$.items[",
+		"$.items[a]",
+		"$['order",
+		"$order",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := Parse(expr); err == nil {
+				t.Errorf("Parse(%q) expected an error", expr)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	redacted := func(interface{}) interface{} { return "redacted" }
+
+	tests := []struct {
+		expr      string
+		replace   func(interface{}) interface{}
+		want      string
+		wantFound bool
+	}{
+		{
+			expr:      "$.order.customer.name",
+			want:      `{"items":[{"qty":1,"sku":"a"},{"qty":2,"sku":"b"}],"order":{"customer":{},"id":"o-1","total":12.5},"with.dot":true}`,
+			wantFound: true,
+		},
+		{
+			expr:      "$.items[0]",
+			want:      `{"items":[null,{"qty":2,"sku":"b"}],"order":{"customer":{"name":"Jane"},"id":"o-1","total":12.5},"with.dot":true}`,
+			wantFound: true,
+		},
+		{
+			expr:      "$.items[*].sku",
+			replace:   redacted,
+			want:      `{"items":[{"qty":1,"sku":"redacted"},{"qty":2,"sku":"redacted"}],"order":{"customer":{"name":"Jane"},"id":"o-1","total":12.5},"with.dot":true}`,
+			wantFound: true,
+		},
+		{
+			expr:      `$["with.dot"]`,
+			replace:   redacted,
+			want:      `{"items":[{"qty":1,"sku":"a"},{"qty":2,"sku":"b"}],"order":{"customer":{"name":"Jane"},"id":"o-1","total":12.5},"with.dot":"redacted"}`,
+			wantFound: true,
+		},
+		{
+			expr: "$.order.missing",
+			want: `{"items":[{"qty":1,"sku":"a"},{"qty":2,"sku":"b"}],"order":{"customer":{"name":"Jane"},"id":"o-1","total":12.5},"with.dot":true}`,
+		},
+		{
+			expr: "$",
+			want: `{"items":[{"qty":1,"sku":"a"},{"qty":2,"sku":"b"}],"order":{"customer":{"name":"Jane"},"id":"o-1","total":12.5},"with.dot":true}`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			var doc interface{}
+			if err := json.Unmarshal([]byte(document), &doc); err != nil {
+				t.Fatal(err)
+			}
+			p, err := Parse(tc.expr)
+			if err != nil {
+				t.Fatal("Parse() =", err)
+			}
+
+			var found bool
+			if tc.replace != nil {
+				found = p.Replace(doc, tc.replace)
+			} else {
+				found = p.Remove(doc)
+			}
+			if found != tc.wantFound {
+				t.Errorf("found = %v, want %v", found, tc.wantFound)
+			}
+			got, _ := json.Marshal(doc)
+			if diff := cmp.Diff(tc.want, string(got)); diff != "" {
+				t.Error("document (-want, +got) =", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package utils
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -124,3 +125,23 @@ func CopyRequest(req *http.Request) (*http.Request, error) {
 		Body:   io.NopCloser(bytes.NewReader(buf.Bytes())),
 	}, nil
 }
+
+// LimitRequestBody limits the body of the request to maxBytes. It returns false when the
+// Content-Length of the request already exceeds maxBytes. Otherwise reading more than maxBytes
+// from the body returns an error for which IsRequestBodyTooLarge returns true.
+func LimitRequestBody(writer http.ResponseWriter, req *http.Request, maxBytes int64) bool {
+	if req.ContentLength > maxBytes {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		req.Body = http.MaxBytesReader(writer, req.Body, maxBytes)
+	}
+	return true
+}
+
+// IsRequestBodyTooLarge returns true if err is caused by reading a request body exceeding the
+// limit set by LimitRequestBody.
+func IsRequestBodyTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.As(err, &maxBytesErr)
+}
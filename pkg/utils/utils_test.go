@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -214,3 +215,30 @@ func TestCopyRequest(t *testing.T) {
 		t.Errorf("Body not copied correctly. Original: %s, Copied: %s", originalBody, copiedBody)
 	}
 }
+
+func TestLimitRequestBody(t *testing.T) {
+	// Content-Length exceeding the limit.
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("0123456789"))
+	if LimitRequestBody(httptest.NewRecorder(), req, 5) {
+		t.Error("expected request with a Content-Length exceeding the limit to be rejected")
+	}
+
+	// Unknown Content-Length, the body is read up to the limit.
+	req = httptest.NewRequest(http.MethodPost, "/", io.NopCloser(strings.NewReader("0123456789")))
+	req.ContentLength = -1
+	if !LimitRequestBody(httptest.NewRecorder(), req, 5) {
+		t.Fatal("expected request with an unknown Content-Length to be accepted")
+	}
+	if _, err := CopyRequest(req); !IsRequestBodyTooLarge(err) {
+		t.Errorf("expected request body too large error, got %v", err)
+	}
+
+	// Body within the limit.
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader("01234"))
+	if !LimitRequestBody(httptest.NewRecorder(), req, 5) {
+		t.Fatal("expected request within the limit to be accepted")
+	}
+	if _, err := CopyRequest(req); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}